@@ -12,7 +12,9 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -42,15 +44,16 @@ type (
 // BaseApp reflects the ABCI application implementation.
 type BaseApp struct { // nolint: maligned
 	// initialized on creation
-	logger          log.Logger
-	name            string               // application name from abci.Info
-	db              dbm.DB               // common DB backend
-	cms             sdk.CommitMultiStore // Main (uncached) state
-	storeLoader     StoreLoader          // function to handle store loading, may be overridden with SetStoreLoader()
-	router          sdk.Router           // handle any kind of message
-	queryRouter     sdk.QueryRouter      // router for redirecting query calls
-	grpcQueryRouter *GRPCQueryRouter     // router for redirecting gRPC query calls
-	txDecoder       sdk.TxDecoder        // unmarshal []byte into sdk.Tx
+	logger           log.Logger
+	name             string               // application name from abci.Info
+	db               dbm.DB               // common DB backend
+	cms              sdk.CommitMultiStore // Main (uncached) state
+	storeLoader      StoreLoader          // function to handle store loading, may be overridden with SetStoreLoader()
+	router           sdk.Router           // handle any kind of message
+	msgServiceRouter *MsgServiceRouter    // router for redirecting Msg service messages
+	queryRouter      sdk.QueryRouter      // router for redirecting query calls
+	grpcQueryRouter  *GRPCQueryRouter     // router for redirecting gRPC query calls
+	txDecoder        sdk.TxDecoder        // unmarshal []byte into sdk.Tx
 
 	anteHandler    sdk.AnteHandler  // ante handler for fee and auth
 	initChainer    sdk.InitChainer  // initialize state with validators and state blob
@@ -60,6 +63,14 @@ type BaseApp struct { // nolint: maligned
 	idPeerFilter   sdk.PeerFilter   // filter peers by node ID
 	fauxMerkleMode bool             // if true, IAVL MountStores uses MountStoresDB for simulation speed.
 
+	// manages snapshots, i.e. dumps of app state at certain intervals
+	snapshotManager    *snapshots.Manager
+	snapshotInterval   uint64 // block interval between state sync snapshots
+	snapshotKeepRecent uint32 // recent state sync snapshots to keep
+
+	// listeners notified of the ABCI messages, set by SetStreamingService
+	abciListeners []ABCIListener
+
 	// volatile states:
 	//
 	// checkState is set on InitChain and reset on Commit
@@ -109,16 +120,17 @@ func NewBaseApp(
 	name string, logger log.Logger, db dbm.DB, txDecoder sdk.TxDecoder, options ...func(*BaseApp),
 ) *BaseApp {
 	app := &BaseApp{
-		logger:          logger,
-		name:            name,
-		db:              db,
-		cms:             store.NewCommitMultiStore(db),
-		storeLoader:     DefaultStoreLoader,
-		router:          NewRouter(),
-		queryRouter:     NewQueryRouter(),
-		grpcQueryRouter: NewGRPCQueryRouter(),
-		txDecoder:       txDecoder,
-		fauxMerkleMode:  false,
+		logger:           logger,
+		name:             name,
+		db:               db,
+		cms:              store.NewCommitMultiStore(db),
+		storeLoader:      DefaultStoreLoader,
+		router:           NewRouter(),
+		msgServiceRouter: NewMsgServiceRouter(),
+		queryRouter:      NewQueryRouter(),
+		grpcQueryRouter:  NewGRPCQueryRouter(),
+		txDecoder:        txDecoder,
+		fauxMerkleMode:   false,
 	}
 
 	for _, option := range options {
@@ -129,6 +141,10 @@ func NewBaseApp(
 		app.cms.SetInterBlockCache(app.interBlockCache)
 	}
 
+	if rs, ok := app.cms.(*rootmulti.Store); ok {
+		rs.SetLogger(logger.With("module", "store"))
+	}
+
 	app.runTxRecoveryMiddleware = newDefaultRecoveryMiddleware()
 
 	return app
@@ -260,6 +276,23 @@ func (app *BaseApp) init() error {
 	app.setCheckState(abci.Header{})
 	app.Seal()
 
+	// make sure the snapshot interval is a multiple of the pruning KeepEvery interval
+	if app.snapshotManager != nil && app.snapshotInterval > 0 {
+		pruningOpts := app.cms.GetPruning()
+		if pruningOpts.KeepEvery > 0 && app.snapshotInterval%pruningOpts.KeepEvery != 0 {
+			return fmt.Errorf(
+				"state sync snapshot interval %v must be a multiple of pruning keep every interval %v",
+				app.snapshotInterval, pruningOpts.KeepEvery)
+		}
+
+		// make sure all the stores can be snapshotted
+		if rs, ok := app.cms.(*rootmulti.Store); ok {
+			if err := rs.ValidateSnapshotStores(); err != nil {
+				return fmt.Errorf("state sync snapshots are enabled: %w", err)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -283,6 +316,18 @@ func (app *BaseApp) setTrace(trace bool) {
 	app.trace = trace
 }
 
+// CommitMultiStore returns the root multi-store of the BaseApp, holding the
+// committed application state.
+func (app *BaseApp) CommitMultiStore() sdk.CommitMultiStore {
+	return app.cms
+}
+
+// SnapshotManager returns the snapshot manager of the BaseApp. It is nil if no
+// snapshot store has been set.
+func (app *BaseApp) SnapshotManager() *snapshots.Manager {
+	return app.snapshotManager
+}
+
 // Router returns the router of the BaseApp.
 func (app *BaseApp) Router() sdk.Router {
 	if app.sealed {
@@ -294,6 +339,9 @@ func (app *BaseApp) Router() sdk.Router {
 	return app.router
 }
 
+// MsgServiceRouter returns the MsgServiceRouter of a BaseApp.
+func (app *BaseApp) MsgServiceRouter() *MsgServiceRouter { return app.msgServiceRouter }
+
 // QueryRouter returns the QueryRouter of a BaseApp.
 func (app *BaseApp) QueryRouter() sdk.QueryRouter { return app.queryRouter }
 
@@ -621,14 +669,26 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 			break
 		}
 
-		msgRoute := msg.Route()
-		handler := app.router.Route(ctx, msgRoute)
+		var (
+			msgResult *sdk.Result
+			err       error
+		)
+
+		// Msg's handled by a protobuf Msg service take precedence over the legacy
+		// route based router.
+		if handler := app.msgServiceRouter.Handler(msg); handler != nil {
+			msgResult, err = handler(ctx, msg)
+		} else {
+			msgRoute := msg.Route()
+			handler := app.router.Route(ctx, msgRoute)
+
+			if handler == nil {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msgRoute, i)
+			}
 
-		if handler == nil {
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msgRoute, i)
+			msgResult, err = handler(ctx, msg)
 		}
 
-		msgResult, err := handler(ctx, msg)
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
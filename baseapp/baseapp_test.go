@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"strings"
 	"sync"
@@ -20,6 +21,7 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -305,6 +307,31 @@ func TestLoadVersionInvalid(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestLoadVersionSnapshotUnsupportedStore(t *testing.T) {
+	snapshotDir, err := ioutil.TempDir("", "baseapp")
+	require.NoError(t, err)
+	defer os.RemoveAll(snapshotDir)
+
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), snapshotDir)
+	require.NoError(t, err)
+
+	newApp := func(interval uint64) *BaseApp {
+		app := NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), nil,
+			SetSnapshotStore(snapshotStore), SetSnapshotInterval(interval))
+		app.MountStores(capKey1)
+		app.MountStore(sdk.NewKVStoreKey("smt"), sdk.StoreTypeSMT)
+		return app
+	}
+
+	// a SMT store cannot be snapshotted
+	err = newApp(10).LoadLatestVersion()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "don't know how to snapshot store \"smt\"")
+
+	// which does not matter unless snapshots are taken
+	require.NoError(t, newApp(0).LoadLatestVersion())
+}
+
 func TestLoadVersionPruning(t *testing.T) {
 	logger := log.NewNopLogger()
 	pruningOptions := store.PruningOptions{
@@ -0,0 +1,132 @@
+package baseapp
+
+import (
+	"context"
+	"fmt"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgServiceRouter routes sdk.Msg's to the protobuf Msg service handler that
+// was registered for their concrete type.
+type MsgServiceRouter struct {
+	routes map[string]MsgServiceHandler
+}
+
+var _ gogogrpc.Server = &MsgServiceRouter{}
+
+// NewMsgServiceRouter creates a new MsgServiceRouter.
+func NewMsgServiceRouter() *MsgServiceRouter {
+	return &MsgServiceRouter{
+		routes: map[string]MsgServiceHandler{},
+	}
+}
+
+// MsgServiceHandler defines a function type which handles an sdk.Msg using
+// a protobuf Msg service.
+type MsgServiceHandler = func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error)
+
+// Handler returns the MsgServiceHandler for a given sdk.Msg or nil if no Msg
+// service handles its type.
+func (msr *MsgServiceRouter) Handler(msg sdk.Msg) MsgServiceHandler {
+	return msr.routes[proto.MessageName(msg)]
+}
+
+// DispatchMsgs executes msgs in order with the handlers of their types and
+// returns their results. If check is not nil, it is called on each msg before
+// it is executed and may reject it. The handlers run with their own
+// EventManager, so their events are forwarded to the one of ctx. The first
+// error aborts the execution, and the caller must discard the state changes of
+// the msgs executed before.
+func (msr *MsgServiceRouter) DispatchMsgs(ctx sdk.Context, msgs []sdk.Msg, check func(sdk.Msg) error) ([]*sdk.Result, error) {
+	results := make([]*sdk.Result, len(msgs))
+
+	for i, msg := range msgs {
+		if check != nil {
+			if err := check(msg); err != nil {
+				return nil, err
+			}
+		}
+
+		handler := msr.Handler(msg)
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message type: %s; message index: %d", sdk.MsgTypeURL(msg), i)
+		}
+
+		msgResult, err := handler(ctx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+
+		results[i] = msgResult
+		ctx.EventManager().EmitEvents(msgResult.GetEvents())
+	}
+
+	return results, nil
+}
+
+// RegisterService implements the gRPC Server.RegisterService method. sd is a gRPC
+// service description, handler is an object which implements that gRPC service.
+//
+// Each method of the service is routed by the fully-qualified protobuf name of
+// its request type, which must implement sdk.Msg. The method's response is
+// proto-marshaled into the Data field of the returned sdk.Result.
+func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler interface{}) {
+	for _, method := range sd.Methods {
+		fqMethod := fmt.Sprintf("/%s/%s", sd.ServiceName, method.MethodName)
+		methodHandler := method.Handler
+
+		// Retrieve the concrete request type of the method by calling its handler
+		// with a decoder that only records the type and an interceptor that never
+		// calls into the service implementation.
+		var msgName string
+		_, _ = methodHandler(nil, context.Background(), func(i interface{}) error {
+			msg, ok := i.(sdk.Msg)
+			if !ok {
+				// There is no way to return an error from RegisterService, and the
+				// app cannot work correctly with a misconfigured service anyway.
+				panic(fmt.Errorf("unable to register service method %s: %T does not implement sdk.Msg", fqMethod, i))
+			}
+
+			msgName = proto.MessageName(msg)
+			return nil
+		}, noopInterceptor)
+
+		if _, found := msr.routes[msgName]; found {
+			panic(fmt.Sprintf("msg service %s has already been registered for %s", fqMethod, msgName))
+		}
+
+		msr.routes[msgName] = func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			// pass the msg to the method handler through the interceptor, as the
+			// handler only knows how to decode from raw bytes
+			interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+				return handler(goCtx, msg)
+			}
+
+			res, err := methodHandler(handler, sdk.WrapSDKContext(ctx), noopDecoder, interceptor)
+			if err != nil {
+				return nil, err
+			}
+
+			resMsg, ok := res.(proto.Message)
+			if !ok {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expecting proto.Message, got %T", res)
+			}
+
+			return sdk.WrapServiceResult(ctx, resMsg, nil)
+		}
+	}
+}
+
+func noopDecoder(_ interface{}) error { return nil }
+
+func noopInterceptor(_ context.Context, _ interface{}, _ *grpc.UnaryServerInfo, _ grpc.UnaryHandler) (interface{}, error) {
+	return nil, nil
+}
@@ -0,0 +1,120 @@
+package baseapp_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var errVote = errors.New("vote failed")
+
+// testGovMsgServer is a stub implementation of the gov Msg service.
+type testGovMsgServer struct{}
+
+var _ govtypes.MsgServer = testGovMsgServer{}
+
+func (testGovMsgServer) SubmitProposal(goCtx context.Context, _ *govtypes.MsgSubmitProposal) (*govtypes.MsgSubmitProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.EventManager().EmitEvent(sdk.NewEvent("submit_proposal", sdk.NewAttribute("proposal_id", "7")))
+
+	return &govtypes.MsgSubmitProposalResponse{ProposalId: 7}, nil
+}
+
+func (testGovMsgServer) Vote(context.Context, *govtypes.MsgVote) (*govtypes.MsgVoteResponse, error) {
+	return nil, errVote
+}
+
+func (testGovMsgServer) VoteWeighted(context.Context, *govtypes.MsgVoteWeighted) (*govtypes.MsgVoteWeightedResponse, error) {
+	return nil, errVote
+}
+
+func (testGovMsgServer) Deposit(context.Context, *govtypes.MsgDeposit) (*govtypes.MsgDepositResponse, error) {
+	return &govtypes.MsgDepositResponse{}, nil
+}
+
+func TestMsgServiceRouter(t *testing.T) {
+	router := baseapp.NewMsgServiceRouter()
+	govtypes.RegisterMsgServer(router, testGovMsgServer{})
+
+	// msgs without a registered Msg service are not handled
+	require.Nil(t, router.Handler(&stakingtypes.MsgDelegate{}))
+
+	ctx := sdk.NewContext(nil, abci.Header{}, false, log.NewNopLogger())
+
+	handler := router.Handler(&govtypes.MsgSubmitProposal{})
+	require.NotNil(t, handler)
+
+	res, err := handler(ctx, &govtypes.MsgSubmitProposal{})
+	require.NoError(t, err)
+
+	var resData govtypes.MsgSubmitProposalResponse
+	require.NoError(t, proto.Unmarshal(res.Data, &resData))
+	require.Equal(t, uint64(7), resData.ProposalId)
+	require.Len(t, res.Events, 1)
+	require.Equal(t, "submit_proposal", res.Events[0].Type)
+
+	handler = router.Handler(&govtypes.MsgVote{})
+	require.NotNil(t, handler)
+
+	_, err = handler(ctx, &govtypes.MsgVote{})
+	require.Equal(t, errVote, err)
+
+	handler = router.Handler(&govtypes.MsgDeposit{})
+	require.NotNil(t, handler)
+
+	res, err = handler(ctx, &govtypes.MsgDeposit{})
+	require.NoError(t, err)
+	require.Empty(t, res.Data)
+	require.Empty(t, res.Events)
+
+	// registering a Msg service twice must panic
+	require.Panics(t, func() {
+		govtypes.RegisterMsgServer(router, testGovMsgServer{})
+	})
+}
+
+func TestMsgServiceRouterDispatchMsgs(t *testing.T) {
+	router := baseapp.NewMsgServiceRouter()
+	govtypes.RegisterMsgServer(router, testGovMsgServer{})
+
+	ctx := sdk.NewContext(nil, abci.Header{}, false, log.NewNopLogger())
+
+	results, err := router.DispatchMsgs(ctx, []sdk.Msg{&govtypes.MsgSubmitProposal{}, &govtypes.MsgDeposit{}}, nil)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	var resData govtypes.MsgSubmitProposalResponse
+	require.NoError(t, proto.Unmarshal(results[0].Data, &resData))
+	require.Equal(t, uint64(7), resData.ProposalId)
+
+	// the events of the handlers are forwarded to the context
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "submit_proposal", events[0].Type)
+
+	_, err = router.DispatchMsgs(ctx, []sdk.Msg{&govtypes.MsgDeposit{}, &govtypes.MsgVote{}}, nil)
+	require.True(t, errors.Is(err, errVote))
+	require.Contains(t, err.Error(), "message index: 1")
+
+	_, err = router.DispatchMsgs(ctx, []sdk.Msg{&stakingtypes.MsgDelegate{}}, nil)
+	require.True(t, errors.Is(err, sdkerrors.ErrUnknownRequest))
+
+	// a msg rejected by check is not executed
+	errRejected := errors.New("rejected")
+	_, err = router.DispatchMsgs(ctx, []sdk.Msg{&govtypes.MsgSubmitProposal{}}, func(msg sdk.Msg) error {
+		return errRejected
+	})
+	require.Equal(t, errRejected, err)
+	require.Len(t, ctx.EventManager().Events(), 1)
+}
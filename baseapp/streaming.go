@@ -0,0 +1,38 @@
+package baseapp
+
+import (
+	"io"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ABCIListener is notified of the ABCI messages processed by the BaseApp. As
+// the state changes of a block are written to the root multi-store on Commit,
+// they are streamed to the WriteListeners between ListenEndBlock and
+// ListenCommit, which therefore mark the boundaries of the block.
+type ABCIListener interface {
+	// ListenBeginBlock is called after BeginBlock with its request and response.
+	ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error
+	// ListenDeliverTx is called after each DeliverTx with its request and
+	// response.
+	ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
+	// ListenEndBlock is called after EndBlock with its request and response.
+	ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error
+	// ListenCommit is called after Commit, once all the state changes of the
+	// block have been streamed.
+	ListenCommit(ctx sdk.Context, res abci.ResponseCommit) error
+}
+
+// StreamingService streams the state changes of the KVStores it listens to,
+// along with the ABCI messages of the blocks they were made in.
+type StreamingService interface {
+	ABCIListener
+	io.Closer
+
+	// Listeners returns the WriteListeners to attach to the KVStore of each
+	// StoreKey.
+	Listeners() map[types.StoreKey][]types.WriteListener
+}
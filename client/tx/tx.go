@@ -240,6 +240,7 @@ func BuildUnsignedTx(txf Factory, msgs ...sdk.Msg) (client.TxBuilder, error) {
 	tx.SetFeeAmount(fees)
 	tx.SetGasLimit(txf.gas)
 	tx.SetTimeoutHeight(txf.TimeoutHeight())
+	tx.SetFeeGranter(txf.FeeGranter())
 
 	return tx, nil
 }
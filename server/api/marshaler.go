@@ -0,0 +1,69 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// protoJSONMarshaler implements the gRPC-gateway runtime.Marshaler interface
+// using the gogoproto jsonpb encoding used throughout the SDK.
+type protoJSONMarshaler struct{}
+
+var _ runtime.Marshaler = protoJSONMarshaler{}
+
+// Marshal implements runtime.Marshaler.
+func (m protoJSONMarshaler) Marshal(v interface{}) ([]byte, error) {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("expected proto.Message, got %T", v)
+	}
+
+	return codec.ProtoMarshalJSON(msg)
+}
+
+// Unmarshal implements runtime.Marshaler.
+func (m protoJSONMarshaler) Unmarshal(data []byte, v interface{}) error {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("expected proto.Message, got %T", v)
+	}
+
+	return jsonpb.UnmarshalString(string(data), msg)
+}
+
+// NewDecoder implements runtime.Marshaler.
+func (m protoJSONMarshaler) NewDecoder(r io.Reader) runtime.Decoder {
+	return runtime.DecoderFunc(func(v interface{}) error {
+		bz, err := ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+
+		return m.Unmarshal(bz, v)
+	})
+}
+
+// NewEncoder implements runtime.Marshaler.
+func (m protoJSONMarshaler) NewEncoder(w io.Writer) runtime.Encoder {
+	return runtime.EncoderFunc(func(v interface{}) error {
+		bz, err := m.Marshal(v)
+		if err != nil {
+			return err
+		}
+
+		_, err = w.Write(bz)
+		return err
+	})
+}
+
+// ContentType implements runtime.Marshaler.
+func (m protoJSONMarshaler) ContentType() string {
+	return "application/json"
+}
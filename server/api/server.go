@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"path"
 	"strings"
 	"time"
 
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/rakyll/statik/fs"
 	"github.com/tendermint/tendermint/libs/log"
 	tmrpcserver "github.com/tendermint/tendermint/rpc/jsonrpc/server"
@@ -24,8 +26,9 @@ import (
 
 // Server defines the server's API interface.
 type Server struct {
-	Router    *mux.Router
-	ClientCtx client.Context
+	Router            *mux.Router
+	GRPCGatewayRouter *runtime.ServeMux
+	ClientCtx         client.Context
 
 	logger   log.Logger
 	metrics  *telemetry.Metrics
@@ -34,7 +37,13 @@ type Server struct {
 
 func New(clientCtx client.Context, logger log.Logger) *Server {
 	return &Server{
-		Router:    mux.NewRouter(),
+		Router: mux.NewRouter(),
+		// The default gRPC-gateway marshaler is unable to encode gogoproto
+		// messages, so responses are encoded the same way as by the CLI.
+		GRPCGatewayRouter: runtime.NewServeMux(
+			runtime.WithMarshalerOption(runtime.MIMEWildcard, protoJSONMarshaler{}),
+			runtime.WithProtoErrorHandler(runtime.DefaultHTTPProtoErrorHandler),
+		),
 		ClientCtx: clientCtx,
 		logger:    logger,
 	}
@@ -59,6 +68,8 @@ func (s *Server) Start(cfg config.Config) error {
 		s.registerMetrics()
 	}
 
+	s.registerGRPCGatewayRoutes()
+
 	tmCfg := tmrpcserver.DefaultConfig()
 	tmCfg.MaxOpenConnections = int(cfg.API.MaxOpenConnections)
 	tmCfg.ReadTimeout = time.Duration(cfg.API.RPCReadTimeout) * time.Second
@@ -92,8 +103,24 @@ func (s *Server) registerSwaggerUI() {
 		panic(err)
 	}
 
+	// The swagger UI is served from "/" but only matches its own files, so that
+	// the other paths are left to the gRPC-gateway routes.
 	staticServer := http.FileServer(statikFS)
-	s.Router.PathPrefix("/").Handler(staticServer)
+	s.Router.MatcherFunc(func(r *http.Request, _ *mux.RouteMatch) bool {
+		f, err := statikFS.Open(path.Clean(r.URL.Path))
+		if err != nil {
+			return false
+		}
+
+		_ = f.Close()
+		return true
+	}).Handler(staticServer)
+}
+
+// registerGRPCGatewayRoutes mounts the gRPC-gateway routes registered on the
+// GRPCGatewayRouter. They are matched after all other routes.
+func (s *Server) registerGRPCGatewayRoutes() {
+	s.Router.PathPrefix("/").Handler(s.GRPCGatewayRouter)
 }
 
 func (s *Server) registerMetrics() {
@@ -16,6 +16,9 @@ const (
 
 	// DefaultGRPCAddress is the default address the gRPC server binds to.
 	DefaultGRPCAddress = "0.0.0.0:9090"
+
+	// DefaultRosettaAddress is the default address the Rosetta server binds to.
+	DefaultRosettaAddress = ":8080"
 )
 
 // BaseConfig defines the server's basic configuration
@@ -30,6 +33,14 @@ type BaseConfig struct {
 	PruningKeepEvery  string `mapstructure:"pruning-keep-every"`
 	PruningInterval   string `mapstructure:"pruning-interval"`
 
+	// SnapshotInterval defines the block height interval at which state sync
+	// snapshots of the application state are taken (0 to disable).
+	SnapshotInterval uint64 `mapstructure:"snapshot-interval"`
+
+	// SnapshotKeepRecent defines the number of recent snapshots to keep
+	// (0 to keep all).
+	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
 	// HaltHeight contains a non-zero block height at which a node will gracefully
 	// halt and shutdown that can be used to assist upgrades and testing.
 	//
@@ -87,6 +98,33 @@ type GRPCConfig struct {
 	Address string `mapstructure:"address"`
 }
 
+// RosettaConfig defines configuration for the Rosetta API server.
+type RosettaConfig struct {
+	// Enable defines if the Rosetta server should be enabled.
+	Enable bool `mapstructure:"enable"`
+
+	// Address defines the Rosetta server to listen on.
+	Address string `mapstructure:"address"`
+
+	// Blockchain defines the blockchain name of the Rosetta network identifier.
+	Blockchain string `mapstructure:"blockchain"`
+
+	// Network defines the network name of the Rosetta network identifier. The
+	// chain ID is used if it is empty.
+	Network string `mapstructure:"network"`
+
+	// Offline defines if the Rosetta server only serves the construction
+	// endpoints which do not need to query the node.
+	Offline bool `mapstructure:"offline"`
+
+	// DenomToSuggest defines the denom of the fees suggested by the
+	// construction API.
+	DenomToSuggest string `mapstructure:"denom-to-suggest"`
+
+	// GasToSuggest defines the gas limit suggested by the construction API.
+	GasToSuggest uint64 `mapstructure:"gas-to-suggest"`
+}
+
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
@@ -95,6 +133,7 @@ type Config struct {
 	Telemetry telemetry.Config `mapstructure:"telemetry"`
 	API       APIConfig        `mapstructure:"api"`
 	GRPC      GRPCConfig       `mapstructure:"grpc"`
+	Rosetta   RosettaConfig    `mapstructure:"rosetta"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -128,12 +167,14 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:      defaultMinGasPrices,
-			InterBlockCache:   true,
-			Pruning:           storetypes.PruningOptionDefault,
-			PruningKeepRecent: "0",
-			PruningKeepEvery:  "0",
-			PruningInterval:   "0",
+			MinGasPrices:       defaultMinGasPrices,
+			InterBlockCache:    true,
+			Pruning:            storetypes.PruningOptionDefault,
+			PruningKeepRecent:  "0",
+			PruningKeepEvery:   "0",
+			PruningInterval:    "0",
+			SnapshotInterval:   0,
+			SnapshotKeepRecent: 2,
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -151,6 +192,15 @@ func DefaultConfig() *Config {
 			Enable:  true,
 			Address: DefaultGRPCAddress,
 		},
+		Rosetta: RosettaConfig{
+			Enable:         false,
+			Address:        DefaultRosettaAddress,
+			Blockchain:     "app",
+			Network:        "",
+			Offline:        false,
+			DenomToSuggest: sdk.DefaultBondDenom,
+			GasToSuggest:   200000,
+		},
 	}
 }
 
@@ -167,14 +217,16 @@ func GetConfig(v *viper.Viper) Config {
 
 	return Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:      v.GetString("minimum-gas-prices"),
-			InterBlockCache:   v.GetBool("inter-block-cache"),
-			Pruning:           v.GetString("pruning"),
-			PruningKeepRecent: v.GetString("pruning-keep-recent"),
-			PruningKeepEvery:  v.GetString("pruning-keep-every"),
-			PruningInterval:   v.GetString("pruning-interval"),
-			HaltHeight:        v.GetUint64("halt-height"),
-			HaltTime:          v.GetUint64("halt-time"),
+			MinGasPrices:       v.GetString("minimum-gas-prices"),
+			InterBlockCache:    v.GetBool("inter-block-cache"),
+			Pruning:            v.GetString("pruning"),
+			PruningKeepRecent:  v.GetString("pruning-keep-recent"),
+			PruningKeepEvery:   v.GetString("pruning-keep-every"),
+			PruningInterval:    v.GetString("pruning-interval"),
+			SnapshotInterval:   v.GetUint64("snapshot-interval"),
+			SnapshotKeepRecent: v.GetUint32("snapshot-keep-recent"),
+			HaltHeight:         v.GetUint64("halt-height"),
+			HaltTime:           v.GetUint64("halt-time"),
 		},
 		Telemetry: telemetry.Config{
 			ServiceName:             v.GetString("telemetry.service-name"),
@@ -198,5 +250,14 @@ func GetConfig(v *viper.Viper) Config {
 			Enable:  v.GetBool("grpc.enable"),
 			Address: v.GetString("grpc.address"),
 		},
+		Rosetta: RosettaConfig{
+			Enable:         v.GetBool("rosetta.enable"),
+			Address:        v.GetString("rosetta.address"),
+			Blockchain:     v.GetString("rosetta.blockchain"),
+			Network:        v.GetString("rosetta.network"),
+			Offline:        v.GetBool("rosetta.offline"),
+			DenomToSuggest: v.GetString("rosetta.denom-to-suggest"),
+			GasToSuggest:   v.GetUint64("rosetta.gas-to-suggest"),
+		},
 	}
 }
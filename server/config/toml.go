@@ -31,6 +31,13 @@ pruning-keep-recent = "{{ .BaseConfig.PruningKeepRecent }}"
 pruning-keep-every = "{{ .BaseConfig.PruningKeepEvery }}"
 pruning-interval = "{{ .BaseConfig.PruningInterval }}"
 
+# snapshot-interval specifies the block interval at which local state sync snapshots are
+# taken (0 to disable). Must be a multiple of pruning-keep-every.
+snapshot-interval = {{ .BaseConfig.SnapshotInterval }}
+
+# snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
+snapshot-keep-recent = {{ .BaseConfig.SnapshotKeepRecent }}
+
 # HaltHeight contains a non-zero block height at which a node will gracefully
 # halt and shutdown that can be used to assist upgrades and testing.
 #
@@ -123,6 +130,35 @@ enable = {{ .GRPC.Enable }}
 
 # Address defines the gRPC server address to bind to.
 address = "{{ .GRPC.Address }}"
+
+###############################################################################
+###                          Rosetta Configuration                          ###
+###############################################################################
+
+[rosetta]
+
+# Enable defines if the Rosetta API server should be enabled.
+enable = {{ .Rosetta.Enable }}
+
+# Address defines the Rosetta API server to listen on.
+address = "{{ .Rosetta.Address }}"
+
+# Blockchain defines the blockchain name of the Rosetta network identifier.
+blockchain = "{{ .Rosetta.Blockchain }}"
+
+# Network defines the network name of the Rosetta network identifier. The
+# chain ID is used if it is empty.
+network = "{{ .Rosetta.Network }}"
+
+# Offline defines if the Rosetta API server only serves the construction
+# endpoints which do not need to query the node.
+offline = {{ .Rosetta.Offline }}
+
+# DenomToSuggest defines the denom of the fees suggested by the construction API.
+denom-to-suggest = "{{ .Rosetta.DenomToSuggest }}"
+
+# GasToSuggest defines the gas limit suggested by the construction API.
+gas-to-suggest = {{ .Rosetta.GasToSuggest }}
 `
 
 var configTemplate *template.Template
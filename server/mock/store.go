@@ -55,6 +55,10 @@ func (ms multiStore) SetPruning(opts sdk.PruningOptions) {
 	panic("not implemented")
 }
 
+func (ms multiStore) GetPruning() sdk.PruningOptions {
+	panic("not implemented")
+}
+
 func (ms multiStore) GetCommitKVStore(key sdk.StoreKey) sdk.CommitKVStore {
 	panic("not implemented")
 }
@@ -83,6 +87,18 @@ func (ms multiStore) LoadVersion(ver int64) error {
 	panic("not implemented")
 }
 
+func (ms multiStore) GetCommitInfo(ver int64) (*store.CommitInfo, error) {
+	panic("not implemented")
+}
+
+func (ms multiStore) RollbackToVersion(ver int64) error {
+	panic("not implemented")
+}
+
+func (ms multiStore) PruneVersions(opts sdk.PruningOptions, batchSize int, progress func(pruned, total int)) error {
+	panic("not implemented")
+}
+
 func (ms multiStore) GetKVStore(key sdk.StoreKey) sdk.KVStore {
 	return ms.kv[key]
 }
@@ -99,6 +115,22 @@ func (ms multiStore) SetInterBlockCache(_ sdk.MultiStorePersistentCache) {
 	panic("not implemented")
 }
 
+func (ms multiStore) AddListeners(_ sdk.StoreKey, _ []store.WriteListener) {
+	panic("not implemented")
+}
+
+func (ms multiStore) ListeningEnabled(_ sdk.StoreKey) bool {
+	panic("not implemented")
+}
+
+func (ms multiStore) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error) {
+	panic("not implemented")
+}
+
+func (ms multiStore) Restore(height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{}) error {
+	panic("not implemented")
+}
+
 var _ sdk.KVStore = kvStore{}
 
 type kvStore struct {
@@ -0,0 +1,130 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+const (
+	flagPruneBatchSize = "batch-size"
+	flagCompact        = "compact"
+)
+
+// PruneCmd returns a command that deletes the versions of the application
+// state which the given pruning options do not keep.
+func PruneCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Prune the application state with the given pruning options",
+		Long: `Delete from all the stores of the application the past versions which the given
+pruning options do not keep, as if the node had been run with them from the
+start. Changing the pruning options in app.toml only applies to the heights
+committed afterwards, so this removes the versions kept by former options.
+
+The pruning options are read from the flags, or else from app.toml. The
+versions are deleted in batches, and the application database can then be
+compacted to reclaim the disk space. The node must not be running.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			if err := serverCtx.Viper.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			pruningOpts, err := GetPruningOptionsFromFlags(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+
+			batchSize, _ := cmd.Flags().GetInt(flagPruneBatchSize)
+
+			db, err := openDB(config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			app := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+			compact, _ := cmd.Flags().GetBool(flagCompact)
+
+			return pruneAppState(cmd, app.CommitMultiStore(), db, pruningOpts, batchSize, compact)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(FlagPruning, storetypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
+	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
+	cmd.Flags().Uint64(FlagPruningKeepEvery, 0, "Offset heights to keep on disk after 'keep-every' (ignored if pruning is not 'custom')")
+	cmd.Flags().Int(flagPruneBatchSize, 100, "Number of heights deleted per batch")
+	cmd.Flags().Bool(flagCompact, false, "Compact the application database once pruned")
+
+	return cmd
+}
+
+// pruneAppState deletes from the application multistore the versions which
+// the pruning options do not keep, and then compacts the application database
+// if compact is set. A database which cannot be compacted is rejected before
+// anything is pruned.
+func pruneAppState(
+	cmd *cobra.Command, cms storetypes.CommitMultiStore, db dbm.DB,
+	pruningOpts storetypes.PruningOptions, batchSize int, compact bool,
+) error {
+	if compact {
+		if _, err := goLevelDB(db); err != nil {
+			return err
+		}
+	}
+
+	cmd.Printf(
+		"pruning the versions before height %d, keeping recent %d and every %d\n",
+		cms.LastCommitID().Version, pruningOpts.KeepRecent, pruningOpts.KeepEvery,
+	)
+
+	err := cms.PruneVersions(pruningOpts, batchSize, func(pruned, total int) {
+		cmd.Printf("pruned %d/%d heights\n", pruned, total)
+	})
+	if err != nil {
+		return err
+	}
+
+	if compact {
+		cmd.Println("compacting the application database")
+		return compactDB(db)
+	}
+
+	return nil
+}
+
+// compactDB compacts the whole key range of a database, which only goleveldb
+// databases support.
+func compactDB(db dbm.DB) error {
+	levelDB, err := goLevelDB(db)
+	if err != nil {
+		return err
+	}
+
+	return levelDB.DB().CompactRange(util.Range{})
+}
+
+// goLevelDB returns the goleveldb database underlying db, which is the only
+// backend the prune command can compact.
+func goLevelDB(db dbm.DB) (*dbm.GoLevelDB, error) {
+	levelDB, ok := db.(*dbm.GoLevelDB)
+	if !ok {
+		return nil, fmt.Errorf("cannot compact a %T database: --%s requires the goleveldb backend", db, flagCompact)
+	}
+
+	return levelDB, nil
+}
@@ -0,0 +1,115 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// newPruneTestStore commits n versions to a multistore which keeps them all.
+func newPruneTestStore(t *testing.T, db dbm.DB, n int) (*rootmulti.Store, storetypes.StoreKey) {
+	key := storetypes.NewKVStoreKey("store")
+
+	ms := rootmulti.NewStore(db)
+	ms.SetPruning(storetypes.PruneNothing)
+	ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for v := 1; v <= n; v++ {
+		ms.GetKVStore(key).Set([]byte("key"), []byte(fmt.Sprintf("value%d", v)))
+		ms.Commit()
+	}
+
+	return ms, key
+}
+
+func TestPruneAppState(t *testing.T) {
+	dir, err := ioutil.TempDir("", "prune")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	db, err := dbm.NewGoLevelDB("application", dir)
+	require.NoError(t, err)
+	defer db.Close()
+
+	ms, key := newPruneTestStore(t, db, 10)
+
+	output := &bytes.Buffer{}
+	cmd := &cobra.Command{}
+	cmd.SetOut(output)
+
+	require.NoError(t, pruneAppState(cmd, ms, db, storetypes.NewPruningOptions(2, 3, 1), 2, true))
+
+	// heights 1 to 7 are pruned but for the multiples of 3
+	require.Equal(t, `pruning the versions before height 10, keeping recent 2 and every 3
+pruned 2/5 heights
+pruned 4/5 heights
+pruned 5/5 heights
+compacting the application database
+`, output.String())
+
+	kept := map[int64]bool{3: true, 6: true, 8: true, 9: true, 10: true}
+	for v := int64(1); v <= 10; v++ {
+		cms, err := ms.CacheMultiStoreWithVersion(v)
+		if !kept[v] {
+			require.Error(t, err, "version %d", v)
+			continue
+		}
+
+		require.NoError(t, err, "version %d", v)
+		require.Equal(t, []byte(fmt.Sprintf("value%d", v)), cms.GetKVStore(key).Get([]byte("key")))
+	}
+}
+
+func TestPruneAppStateCompactUnsupported(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms, _ := newPruneTestStore(t, db, 5)
+
+	output := &bytes.Buffer{}
+	cmd := &cobra.Command{}
+	cmd.SetOut(output)
+
+	err := pruneAppState(cmd, ms, db, storetypes.PruneEverything, 2, true)
+	require.EqualError(t, err, "cannot compact a *db.MemDB database: --compact requires the goleveldb backend")
+
+	// nothing is pruned when the database cannot be compacted
+	require.Empty(t, output.String())
+	for v := int64(1); v <= 5; v++ {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		require.NoError(t, err, "version %d", v)
+	}
+}
+
+func TestCompactDB(t *testing.T) {
+	dir, err := ioutil.TempDir("", "compact")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	db, err := dbm.NewGoLevelDB("application", dir)
+	require.NoError(t, err)
+	defer db.Close()
+
+	for i := 0; i < 100; i++ {
+		require.NoError(t, db.Set([]byte{byte(i)}, []byte("value")))
+	}
+	for i := 0; i < 50; i++ {
+		require.NoError(t, db.Delete([]byte{byte(i)}))
+	}
+
+	require.NoError(t, compactDB(db))
+
+	value, err := db.Get([]byte{99})
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+
+	require.Error(t, compactDB(dbm.NewMemDB()))
+}
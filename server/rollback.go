@@ -0,0 +1,327 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	amino "github.com/tendermint/go-amino"
+	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	"github.com/tendermint/tendermint/libs/tempfile"
+	"github.com/tendermint/tendermint/node"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const flagHeights = "heights"
+
+// tmCdc decodes the validator sets and consensus params infos persisted by
+// Tendermint, the same way the Tendermint state store does.
+var tmCdc = amino.NewCodec()
+
+func init() {
+	cryptoamino.RegisterAmino(tmCdc)
+}
+
+// RollbackCmd returns a command that rolls back the application state and the
+// Tendermint state and block store by a number of heights.
+func RollbackCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Roll back the application and Tendermint states by a number of heights",
+		Long: `Roll back the application state, the Tendermint state and the Tendermint block
+store by a number of heights, e.g. to recover from an app hash mismatch after a
+bad upgrade without resyncing the node. The blocks after the target height are
+deleted from the block store and fetched again from peers once the node is
+restarted.
+
+The command fails without changing anything if the target height was pruned
+from the application state or from the Tendermint stores, or if the app hash
+of the application state at the target height differs from the one committed
+by Tendermint. The target height is recorded in the data directory before the
+application state is rolled back, then the Tendermint state and the block
+store, so that an interrupted rollback is completed by running the command
+again, whatever the number of heights given. The consensus write
+ahead log is removed, while the validator signing state is kept, so that a
+validator never signs the rolled back heights again. The node must not be
+running.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			if err := serverCtx.Viper.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			heights, _ := cmd.Flags().GetInt64(flagHeights)
+			if heights < 1 {
+				return fmt.Errorf("invalid number of heights to roll back: %d", heights)
+			}
+
+			db, err := openDB(config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			app := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+
+			blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: config})
+			if err != nil {
+				return err
+			}
+			defer blockStoreDB.Close()
+
+			stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: config})
+			if err != nil {
+				return err
+			}
+			defer stateDB.Close()
+
+			state := sm.LoadState(stateDB)
+			if state.IsEmpty() {
+				return errors.New("no Tendermint state found")
+			}
+
+			blockStore := store.NewBlockStore(blockStoreDB)
+
+			target, resumed, err := rollbackTarget(config.RootDir, state, heights)
+			if err != nil {
+				return err
+			}
+			if resumed {
+				cmd.Printf("resuming the interrupted rollback to height %d\n", target)
+			}
+
+			// A rollback interrupted once the Tendermint state was saved is
+			// completed from that state.
+			newState := state
+			if target != state.LastBlockHeight {
+				newState, err = tendermintStateAt(blockStore, stateDB, state, target)
+				if err != nil {
+					return err
+				}
+			}
+
+			// both states are checked before any of them is rolled back
+			cInfo, err := app.CommitMultiStore().GetCommitInfo(target)
+			if err != nil {
+				return fmt.Errorf("cannot roll back the application state to height %d: %w", target, err)
+			}
+
+			if appHash := cInfo.Hash(); !bytes.Equal(appHash, newState.AppHash) {
+				return fmt.Errorf(
+					"the application state at height %d has app hash %X, while Tendermint committed %X",
+					target, appHash, newState.AppHash,
+				)
+			}
+
+			// Until the marker is removed, running the command again rolls back
+			// to the same target height.
+			if err := writeRollbackMarker(config.RootDir, target); err != nil {
+				return err
+			}
+
+			if err := app.CommitMultiStore().RollbackToVersion(target); err != nil {
+				return err
+			}
+
+			if err := os.RemoveAll(filepath.Dir(config.Consensus.WalFile())); err != nil {
+				return err
+			}
+
+			if err := rollbackTendermint(blockStore, blockStoreDB, stateDB, newState); err != nil {
+				return err
+			}
+
+			if err := os.Remove(rollbackMarkerPath(config.RootDir)); err != nil {
+				return err
+			}
+
+			cmd.Printf("rolled back to height %d; app hash: %X\n", target, newState.AppHash)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(flagHeights, 1, "Number of heights to roll back")
+
+	return cmd
+}
+
+// rollbackTarget returns the height to roll back to: the one recorded by an
+// interrupted rollback, which is then resumed, or the given number of heights
+// before the latest Tendermint state. The block store being ahead of the state
+// is no sign of an interrupted rollback, as it also happens when the node
+// stops between saving a block and its state.
+func rollbackTarget(rootDir string, state sm.State, heights int64) (target int64, resumed bool, err error) {
+	bz, err := ioutil.ReadFile(rollbackMarkerPath(rootDir))
+	switch {
+	case os.IsNotExist(err):
+		return state.LastBlockHeight - heights, false, nil
+
+	case err != nil:
+		return 0, false, err
+	}
+
+	var marker rollbackMarker
+	if err := json.Unmarshal(bz, &marker); err != nil {
+		return 0, false, fmt.Errorf("invalid rollback marker %s: %w", rollbackMarkerPath(rootDir), err)
+	}
+
+	if marker.Height < 1 || marker.Height > state.LastBlockHeight {
+		return 0, false, fmt.Errorf(
+			"cannot resume the rollback to height %d with the Tendermint state at height %d", marker.Height, state.LastBlockHeight,
+		)
+	}
+
+	return marker.Height, true, nil
+}
+
+// rollbackMarker records the target height of a rollback in progress.
+type rollbackMarker struct {
+	Height int64 `json:"height"`
+}
+
+func rollbackMarkerPath(rootDir string) string {
+	return filepath.Join(rootDir, "data", "rollback.json")
+}
+
+// writeRollbackMarker records a rollback to height as in progress.
+func writeRollbackMarker(rootDir string, height int64) error {
+	bz, err := json.Marshal(rollbackMarker{Height: height})
+	if err != nil {
+		return err
+	}
+
+	return tempfile.WriteFileAtomic(rollbackMarkerPath(rootDir), bz, 0600)
+}
+
+// tendermintStateAt returns the Tendermint state after the block at height,
+// rebuilt from the state and block stores. The header of the next block holds
+// the app hash and the results hash of height, so it must be in the block
+// store.
+func tendermintStateAt(blockStore *store.BlockStore, stateDB dbm.DB, state sm.State, height int64) (sm.State, error) {
+	if height < 1 || height >= state.LastBlockHeight {
+		return sm.State{}, fmt.Errorf("cannot roll back Tendermint state at height %d to height %d", state.LastBlockHeight, height)
+	}
+
+	if height < blockStore.Base() {
+		return sm.State{}, fmt.Errorf("cannot roll back to height %d; it was pruned from the block store (base %d)", height, blockStore.Base())
+	}
+
+	meta, next := blockStore.LoadBlockMeta(height), blockStore.LoadBlockMeta(height+1)
+	if meta == nil || next == nil {
+		return sm.State{}, fmt.Errorf("blocks %d and %d must be in the block store", height, height+1)
+	}
+
+	lastValidators, err := sm.LoadValidators(stateDB, height)
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	validators, err := sm.LoadValidators(stateDB, height+1)
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	nextValidators, err := sm.LoadValidators(stateDB, height+2)
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	var valsInfo sm.ValidatorsInfo
+	if err := loadTendermintInfo(stateDB, fmt.Sprintf("validatorsKey:%d", height+2), &valsInfo); err != nil {
+		return sm.State{}, err
+	}
+
+	params, err := sm.LoadConsensusParams(stateDB, height+1)
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	var paramsInfo sm.ConsensusParamsInfo
+	if err := loadTendermintInfo(stateDB, fmt.Sprintf("consensusParamsKey:%d", height+1), &paramsInfo); err != nil {
+		return sm.State{}, err
+	}
+
+	return sm.State{
+		Version: state.Version,
+		ChainID: state.ChainID,
+
+		LastBlockHeight: height,
+		LastBlockID:     meta.BlockID,
+		LastBlockTime:   meta.Header.Time,
+
+		NextValidators:              nextValidators,
+		Validators:                  validators,
+		LastValidators:              lastValidators,
+		LastHeightValidatorsChanged: valsInfo.LastHeightChanged,
+
+		ConsensusParams:                  params,
+		LastHeightConsensusParamsChanged: paramsInfo.LastHeightChanged,
+
+		LastResultsHash: next.Header.LastResultsHash,
+		AppHash:         next.Header.AppHash,
+	}, nil
+}
+
+// loadTendermintInfo decodes the info persisted under key in the Tendermint
+// state store.
+func loadTendermintInfo(stateDB dbm.DB, key string, ptr interface{}) error {
+	bz, err := stateDB.Get([]byte(key))
+	if err != nil {
+		return err
+	}
+	if len(bz) == 0 {
+		return fmt.Errorf("%s not found in the Tendermint state store", key)
+	}
+
+	return tmCdc.UnmarshalBinaryBare(bz, ptr)
+}
+
+// rollbackTendermint saves state as the latest Tendermint state and deletes
+// the blocks after its height from the block store.
+func rollbackTendermint(blockStore *store.BlockStore, blockStoreDB, stateDB dbm.DB, state sm.State) error {
+	sm.SaveState(stateDB, state)
+
+	batch := blockStoreDB.NewBatch()
+	defer batch.Close()
+
+	// The keys are the ones of the Tendermint block store. The commit of a
+	// block is stored along with the next block.
+	for h := state.LastBlockHeight + 1; h <= blockStore.Height(); h++ {
+		if meta := blockStore.LoadBlockMeta(h); meta != nil {
+			batch.Delete([]byte(fmt.Sprintf("BH:%x", meta.BlockID.Hash)))
+			for p := 0; p < meta.BlockID.PartsHeader.Total; p++ {
+				batch.Delete([]byte(fmt.Sprintf("P:%d:%d", h, p)))
+			}
+		}
+
+		batch.Delete([]byte(fmt.Sprintf("H:%d", h)))
+		batch.Delete([]byte(fmt.Sprintf("C:%d", h-1)))
+		batch.Delete([]byte(fmt.Sprintf("SC:%d", h)))
+	}
+
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	store.BlockStoreStateJSON{Base: blockStore.Base(), Height: state.LastBlockHeight}.Save(blockStoreDB)
+
+	return nil
+}
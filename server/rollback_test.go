@@ -0,0 +1,178 @@
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// makeTendermintChain saves n blocks to the block and state stores, and
+// returns the Tendermint state after each height.
+func makeTendermintChain(t *testing.T, blockStore *store.BlockStore, stateDB dbm.DB, n int64) []sm.State {
+	pubKey := ed25519.GenPrivKey().PubKey()
+
+	state, err := sm.MakeGenesisState(&tmtypes.GenesisDoc{
+		ChainID:     "test-chain",
+		GenesisTime: time.Now().UTC(),
+		Validators: []tmtypes.GenesisValidator{
+			{Address: pubKey.Address(), PubKey: pubKey, Power: 10},
+		},
+		ConsensusParams: tmtypes.DefaultConsensusParams(),
+	})
+	require.NoError(t, err)
+	sm.SaveState(stateDB, state)
+
+	states := []sm.State{state}
+	lastCommit := tmtypes.NewCommit(0, 0, tmtypes.BlockID{}, nil)
+
+	for h := int64(1); h <= n; h++ {
+		block, parts := state.MakeBlock(h, nil, lastCommit, nil, pubKey.Address())
+		blockID := tmtypes.BlockID{Hash: block.Hash(), PartsHeader: parts.Header()}
+
+		lastCommit = tmtypes.NewCommit(h, 0, blockID, []tmtypes.CommitSig{tmtypes.NewCommitSigAbsent()})
+		blockStore.SaveBlock(block, parts, lastCommit)
+
+		state.LastBlockHeight = h
+		state.LastBlockID = blockID
+		state.LastBlockTime = block.Time
+		state.LastValidators = state.Validators.Copy()
+		state.Validators = state.NextValidators.Copy()
+		state.NextValidators = state.NextValidators.CopyIncrementProposerPriority(1)
+		state.LastResultsHash = []byte{byte(h), 'r'}
+		state.AppHash = []byte{byte(h), 'a'}
+		sm.SaveState(stateDB, state)
+
+		states = append(states, state.Copy())
+	}
+
+	return states
+}
+
+func TestRollbackTendermint(t *testing.T) {
+	blockStoreDB, stateDB := dbm.NewMemDB(), dbm.NewMemDB()
+	blockStore := store.NewBlockStore(blockStoreDB)
+
+	states := makeTendermintChain(t, blockStore, stateDB, 6)
+	latest := sm.LoadState(stateDB)
+	require.Equal(t, int64(6), latest.LastBlockHeight)
+
+	// the target height must be before the latest one
+	for _, height := range []int64{0, 6, 7} {
+		_, err := tendermintStateAt(blockStore, stateDB, latest, height)
+		require.Error(t, err)
+	}
+
+	// the target height must be in the block store
+	_, err := blockStore.PruneBlocks(2)
+	require.NoError(t, err)
+	_, err = tendermintStateAt(blockStore, stateDB, latest, 1)
+	require.Error(t, err)
+
+	state, err := tendermintStateAt(blockStore, stateDB, latest, 3)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), state.LastBlockHeight)
+	require.Equal(t, states[3].LastBlockID, state.LastBlockID)
+	require.Equal(t, states[3].LastBlockTime, state.LastBlockTime)
+	require.Equal(t, states[3].AppHash, state.AppHash)
+	require.Equal(t, states[3].LastResultsHash, state.LastResultsHash)
+	require.Equal(t, states[3].LastValidators.Hash(), state.LastValidators.Hash())
+	require.Equal(t, states[3].Validators.Hash(), state.Validators.Hash())
+	require.Equal(t, states[3].NextValidators.Hash(), state.NextValidators.Hash())
+	require.Equal(t, states[3].LastHeightValidatorsChanged, state.LastHeightValidatorsChanged)
+	require.Equal(t, states[3].ConsensusParams, state.ConsensusParams)
+	require.Equal(t, states[3].LastHeightConsensusParamsChanged, state.LastHeightConsensusParamsChanged)
+
+	require.NoError(t, rollbackTendermint(blockStore, blockStoreDB, stateDB, state))
+
+	require.Equal(t, state.Bytes(), sm.LoadState(stateDB).Bytes())
+
+	blockStore = store.NewBlockStore(blockStoreDB)
+	require.Equal(t, int64(2), blockStore.Base())
+	require.Equal(t, int64(3), blockStore.Height())
+	require.NotNil(t, blockStore.LoadBlock(3))
+	require.NotNil(t, blockStore.LoadSeenCommit(3))
+	require.Nil(t, blockStore.LoadBlockCommit(3))
+
+	for h := int64(4); h <= 6; h++ {
+		require.Nil(t, blockStore.LoadBlockMeta(h))
+		require.Nil(t, blockStore.LoadBlockByHash(states[h].LastBlockID.Hash))
+		require.Nil(t, blockStore.LoadBlockPart(h, 0))
+		require.Nil(t, blockStore.LoadSeenCommit(h))
+	}
+
+	// the rolled back heights can be committed again
+	makeBlock := func(h int64) {
+		block, parts := state.MakeBlock(h, nil, blockStore.LoadSeenCommit(h-1), nil, state.Validators.Validators[0].Address)
+		blockStore.SaveBlock(block, parts, tmtypes.NewCommit(h, 0, tmtypes.BlockID{Hash: block.Hash(), PartsHeader: parts.Header()}, nil))
+	}
+	require.NotPanics(t, func() { makeBlock(4) })
+	require.Equal(t, int64(4), blockStore.Height())
+}
+
+func TestRollbackTendermintInterrupted(t *testing.T) {
+	blockStoreDB, stateDB := dbm.NewMemDB(), dbm.NewMemDB()
+	blockStore := store.NewBlockStore(blockStoreDB)
+
+	makeTendermintChain(t, blockStore, stateDB, 6)
+	state, err := tendermintStateAt(blockStore, stateDB, sm.LoadState(stateDB), 3)
+	require.NoError(t, err)
+
+	// the rollback is interrupted once the Tendermint state is saved
+	sm.SaveState(stateDB, state)
+	latest := sm.LoadState(stateDB)
+	require.Equal(t, int64(3), latest.LastBlockHeight)
+	require.Equal(t, int64(6), blockStore.Height())
+
+	// it is completed from the saved state
+	require.NoError(t, rollbackTendermint(blockStore, blockStoreDB, stateDB, latest))
+	require.Equal(t, state.Bytes(), sm.LoadState(stateDB).Bytes())
+
+	blockStore = store.NewBlockStore(blockStoreDB)
+	require.Equal(t, int64(3), blockStore.Height())
+	for h := int64(4); h <= 6; h++ {
+		require.Nil(t, blockStore.LoadBlockMeta(h))
+	}
+}
+
+func TestRollbackTarget(t *testing.T) {
+	rootDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(rootDir, "data"), 0700))
+
+	blockStoreDB, stateDB := dbm.NewMemDB(), dbm.NewMemDB()
+	blockStore := store.NewBlockStore(blockStoreDB)
+	states := makeTendermintChain(t, blockStore, stateDB, 6)
+
+	// the node stopped between saving block 6 and its state: the block store
+	// being ahead does not resume any rollback
+	sm.SaveState(stateDB, states[5])
+	require.Equal(t, int64(6), blockStore.Height())
+
+	target, resumed, err := rollbackTarget(rootDir, sm.LoadState(stateDB), 2)
+	require.NoError(t, err)
+	require.False(t, resumed)
+	require.Equal(t, int64(3), target)
+
+	// a recorded rollback is resumed whatever the number of heights
+	require.NoError(t, writeRollbackMarker(rootDir, 2))
+	target, resumed, err = rollbackTarget(rootDir, sm.LoadState(stateDB), 1)
+	require.NoError(t, err)
+	require.True(t, resumed)
+	require.Equal(t, int64(2), target)
+
+	// the Tendermint state cannot be behind the recorded height
+	_, _, err = rollbackTarget(rootDir, states[1], 1)
+	require.Error(t, err)
+
+	require.NoError(t, ioutil.WriteFile(rollbackMarkerPath(rootDir), []byte("{"), 0600))
+	_, _, err = rollbackTarget(rootDir, sm.LoadState(stateDB), 1)
+	require.Error(t, err)
+}
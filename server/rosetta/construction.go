@@ -0,0 +1,540 @@
+package rosetta
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// signMode is the sign mode of the transactions built by the construction
+// API. Its sign bytes do not depend on the encoding of the messages, so they
+// can be signed by any Rosetta signer.
+const signMode = signing.SignMode_SIGN_MODE_DIRECT
+
+// constructionOptions are the options returned by /construction/preprocess,
+// which /construction/metadata resolves into a constructionMetadata.
+type constructionOptions struct {
+	Signers  []string   `json:"signers,omitempty"`
+	Memo     string     `json:"memo,omitempty"`
+	GasLimit jsonUint64 `json:"gas_limit,omitempty"`
+	GasPrice string     `json:"gas_price,omitempty"`
+}
+
+// constructionMetadata is the metadata needed to build and sign a
+// transaction.
+type constructionMetadata struct {
+	ChainID  string           `json:"chain_id"`
+	Signers  []signerMetadata `json:"signers"`
+	Memo     string           `json:"memo,omitempty"`
+	GasLimit jsonUint64       `json:"gas_limit"`
+	GasPrice string           `json:"gas_price,omitempty"`
+}
+
+// signerMetadata is the account of a signer.
+type signerMetadata struct {
+	Address       string     `json:"address"`
+	AccountNumber jsonUint64 `json:"account_number"`
+	Sequence      jsonUint64 `json:"sequence"`
+}
+
+// jsonUint64 is an uint64 encoded as a JSON string, so that it does not lose
+// precision in clients decoding numbers as floats. It can be decoded from a
+// string or a number.
+type jsonUint64 uint64
+
+func (u jsonUint64) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strconv.FormatUint(uint64(u), 10))
+}
+
+func (u *jsonUint64) UnmarshalJSON(bz []byte) error {
+	s := strings.Trim(string(bz), `"`)
+
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	*u = jsonUint64(v)
+
+	return nil
+}
+
+func (s *Server) constructionDerive(req ConstructionDeriveRequest) (*ConstructionDeriveResponse, error) {
+	if err := s.checkNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	pubKey, err := parsePubKey(req.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConstructionDeriveResponse{
+		AccountIdentifier: &AccountIdentifier{Address: sdk.AccAddress(pubKey.Address()).String()},
+	}, nil
+}
+
+func (s *Server) constructionPreprocess(req ConstructionPreprocessRequest) (*ConstructionPreprocessResponse, error) {
+	if err := s.checkNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	msgs, err := operationsToMsgs(req.Operations)
+	if err != nil {
+		return nil, err
+	}
+
+	// the memo, gas limit and gas price may be given in the metadata
+	var options constructionOptions
+	if err := fromMetadata(req.Metadata, &options); err != nil {
+		return nil, err
+	}
+
+	signers := signersToAccounts(msgs)
+
+	options.Signers = make([]string, len(signers))
+	for i, signer := range signers {
+		options.Signers[i] = signer.Address
+	}
+
+	optionsMap, err := toMetadata(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConstructionPreprocessResponse{
+		Options:            optionsMap,
+		RequiredPublicKeys: signers,
+	}, nil
+}
+
+func (s *Server) constructionMetadata(req ConstructionMetadataRequest) (*ConstructionMetadataResponse, error) {
+	if err := s.checkNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkOnline(); err != nil {
+		return nil, err
+	}
+
+	var options constructionOptions
+	if err := fromMetadata(req.Options, &options); err != nil {
+		return nil, err
+	}
+
+	if len(options.Signers) == 0 {
+		return nil, ErrInvalidRequest.Wrap("options must list the signers")
+	}
+
+	metadata := constructionMetadata{
+		ChainID:  s.clientCtx.ChainID,
+		Signers:  make([]signerMetadata, len(options.Signers)),
+		Memo:     options.Memo,
+		GasLimit: options.GasLimit,
+		GasPrice: options.GasPrice,
+	}
+
+	if metadata.GasLimit == 0 {
+		metadata.GasLimit = jsonUint64(s.config.GasToSuggest)
+	}
+
+	for i, signer := range options.Signers {
+		addr, err := sdk.AccAddressFromBech32(signer)
+		if err != nil {
+			return nil, ErrInvalidAddress.Wrap(err.Error())
+		}
+
+		accNum, seq, err := s.clientCtx.AccountRetriever.GetAccountNumberSequence(s.clientCtx, addr)
+		if err != nil {
+			return nil, ErrNodeUnavailable.Wrap(err.Error())
+		}
+
+		metadata.Signers[i] = signerMetadata{
+			Address:       signer,
+			AccountNumber: jsonUint64(accNum),
+			Sequence:      jsonUint64(seq),
+		}
+	}
+
+	fee := sdk.NewCoin(s.config.DenomToSuggest, sdk.ZeroInt())
+	if metadata.GasPrice != "" {
+		gasPrice, err := sdk.ParseDecCoin(metadata.GasPrice)
+		if err != nil {
+			return nil, ErrInvalidRequest.Wrap(err.Error())
+		}
+
+		// fee = ceil(gasPrice * gasLimit), as computed by tx.BuildUnsignedTx
+		amount := gasPrice.Amount.MulInt64(int64(metadata.GasLimit)).Ceil().RoundInt()
+		fee = sdk.NewCoin(gasPrice.Denom, amount)
+	}
+
+	metadataMap, err := toMetadata(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConstructionMetadataResponse{
+		Metadata:     metadataMap,
+		SuggestedFee: []*Amount{coinToAmount(fee, false)},
+	}, nil
+}
+
+func (s *Server) constructionPayloads(req ConstructionPayloadsRequest) (*ConstructionPayloadsResponse, error) {
+	if err := s.checkNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	msgs, err := operationsToMsgs(req.Operations)
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata constructionMetadata
+	if err := fromMetadata(req.Metadata, &metadata); err != nil {
+		return nil, err
+	}
+
+	if metadata.GasPrice != "" {
+		if _, err := sdk.ParseDecCoins(metadata.GasPrice); err != nil {
+			return nil, ErrInvalidRequest.Wrap(err.Error())
+		}
+	}
+
+	pubKeys, err := signerPubKeys(msgs, req.PublicKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(metadata.Signers) != len(pubKeys) {
+		return nil, ErrInvalidRequest.Wrapf("expected metadata for %d signers, got %d", len(pubKeys), len(metadata.Signers))
+	}
+
+	txf := tx.Factory{}.
+		WithTxConfig(s.clientCtx.TxConfig).
+		WithChainID(metadata.ChainID).
+		WithGas(uint64(metadata.GasLimit)).
+		WithGasPrices(metadata.GasPrice).
+		WithMemo(metadata.Memo).
+		WithSignMode(signMode)
+
+	txBuilder, err := tx.BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return nil, ErrInvalidTransaction.Wrap(err.Error())
+	}
+
+	// the signer infos are part of the SIGN_MODE_DIRECT sign bytes, so they
+	// are set with empty signatures before the sign bytes are computed
+	sigs := make([]signing.SignatureV2, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		sigs[i] = signing.SignatureV2{
+			PubKey: pubKey,
+			Data:   &signing.SingleSignatureData{SignMode: signMode},
+		}
+	}
+
+	if err := txBuilder.SetSignatures(sigs...); err != nil {
+		return nil, ErrInvalidTransaction.Wrap(err.Error())
+	}
+
+	payloads := make([]*SigningPayload, len(pubKeys))
+	for i, signer := range metadata.Signers {
+		addr := sdk.AccAddress(pubKeys[i].Address())
+		if signer.Address != addr.String() {
+			return nil, ErrInvalidRequest.Wrapf("expected metadata of signer %s, got %s", addr, signer.Address)
+		}
+
+		txf = txf.
+			WithAccountNumber(uint64(signer.AccountNumber)).
+			WithSequence(uint64(signer.Sequence))
+
+		signBytes, err := s.signBytes(txf, txBuilder)
+		if err != nil {
+			return nil, err
+		}
+
+		// Rosetta signers sign a hash, which secp256k1 keys compute with sha256
+		hash := sha256.Sum256(signBytes)
+		payloads[i] = &SigningPayload{
+			AccountIdentifier: &AccountIdentifier{Address: signer.Address},
+			HexBytes:          hex.EncodeToString(hash[:]),
+			SignatureType:     Ecdsa,
+		}
+	}
+
+	txBytes, err := s.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, ErrInvalidTransaction.Wrap(err.Error())
+	}
+
+	return &ConstructionPayloadsResponse{
+		UnsignedTransaction: hex.EncodeToString(txBytes),
+		Payloads:            payloads,
+	}, nil
+}
+
+func (s *Server) constructionCombine(req ConstructionCombineRequest) (*ConstructionCombineResponse, error) {
+	if err := s.checkNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	txBuilder, err := s.decodeTx(req.UnsignedTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// signatures are set in the order of the signers of the transaction
+	sigsBySigner := make(map[string]signing.SignatureV2, len(req.Signatures))
+	for _, sig := range req.Signatures {
+		if sig == nil {
+			return nil, ErrInvalidSignature.Wrap("signature cannot be empty")
+		}
+
+		if sig.SignatureType != Ecdsa {
+			return nil, ErrUnsupportedSignature
+		}
+
+		pubKey, err := parsePubKey(sig.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+
+		sigBytes, err := hex.DecodeString(trimHexPrefix(sig.HexBytes))
+		if err != nil {
+			return nil, ErrInvalidSignature.Wrap(err.Error())
+		}
+
+		sigsBySigner[sdk.AccAddress(pubKey.Address()).String()] = signing.SignatureV2{
+			PubKey: pubKey,
+			Data: &signing.SingleSignatureData{
+				SignMode:  signMode,
+				Signature: sigBytes,
+			},
+		}
+	}
+
+	signers := txBuilder.GetTx().GetSigners()
+	sigs := make([]signing.SignatureV2, len(signers))
+	for i, signer := range signers {
+		sig, ok := sigsBySigner[signer.String()]
+		if !ok {
+			return nil, ErrInvalidSignature.Wrapf("missing signature of %s", signer)
+		}
+
+		sigs[i] = sig
+	}
+
+	if err := txBuilder.SetSignatures(sigs...); err != nil {
+		return nil, ErrInvalidTransaction.Wrap(err.Error())
+	}
+
+	txBytes, err := s.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, ErrInvalidTransaction.Wrap(err.Error())
+	}
+
+	return &ConstructionCombineResponse{
+		SignedTransaction: hex.EncodeToString(txBytes),
+	}, nil
+}
+
+func (s *Server) constructionParse(req ConstructionParseRequest) (*ConstructionParseResponse, error) {
+	if err := s.checkNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	txBuilder, err := s.decodeTx(req.Transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	theTx := txBuilder.GetTx()
+	msgs := theTx.GetMsgs()
+
+	res := &ConstructionParseResponse{
+		Operations: msgsToOperations(msgs, "", 0),
+		Metadata: map[string]interface{}{
+			"memo":      theTx.GetMemo(),
+			"gas_limit": fmt.Sprint(theTx.GetGas()),
+			"fee":       theTx.GetFee().String(),
+		},
+	}
+
+	if req.Signed {
+		res.AccountIdentifierSigners = signersToAccounts(msgs)
+	}
+
+	return res, nil
+}
+
+func (s *Server) constructionHash(req ConstructionHashRequest) (*TransactionIdentifierResponse, error) {
+	if err := s.checkNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	txBytes, err := hex.DecodeString(trimHexPrefix(req.SignedTransaction))
+	if err != nil {
+		return nil, ErrInvalidTransaction.Wrap(err.Error())
+	}
+
+	return &TransactionIdentifierResponse{
+		TransactionIdentifier: &TransactionIdentifier{Hash: txHash(txBytes)},
+	}, nil
+}
+
+func (s *Server) constructionSubmit(req ConstructionSubmitRequest) (*TransactionIdentifierResponse, error) {
+	if err := s.checkNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkOnline(); err != nil {
+		return nil, err
+	}
+
+	txBytes, err := hex.DecodeString(trimHexPrefix(req.SignedTransaction))
+	if err != nil {
+		return nil, ErrInvalidTransaction.Wrap(err.Error())
+	}
+
+	res, err := s.clientCtx.BroadcastTxSync(txBytes)
+	if err != nil {
+		return nil, ErrNodeUnavailable.Wrap(err.Error())
+	}
+
+	if res.Code != 0 {
+		return nil, ErrTransactionRejected.Wrapf("code %d: %s", res.Code, res.RawLog)
+	}
+
+	return &TransactionIdentifierResponse{
+		TransactionIdentifier: &TransactionIdentifier{Hash: res.TxHash},
+	}, nil
+}
+
+// signBytes returns the bytes a signer signs over, given the chain ID and
+// account of the signer in the factory.
+func (s *Server) signBytes(txf tx.Factory, txBuilder client.TxBuilder) ([]byte, error) {
+	signerData := authsigning.SignerData{
+		ChainID:         txf.ChainID(),
+		AccountNumber:   txf.AccountNumber(),
+		AccountSequence: txf.Sequence(),
+	}
+
+	signBytes, err := s.clientCtx.TxConfig.SignModeHandler().GetSignBytes(txf.SignMode(), signerData, txBuilder.GetTx())
+	if err != nil {
+		return nil, ErrInvalidTransaction.Wrap(err.Error())
+	}
+
+	return signBytes, nil
+}
+
+// decodeTx decodes a hex-encoded transaction into a TxBuilder.
+func (s *Server) decodeTx(txHex string) (client.TxBuilder, error) {
+	txBytes, err := hex.DecodeString(trimHexPrefix(txHex))
+	if err != nil {
+		return nil, ErrInvalidTransaction.Wrap(err.Error())
+	}
+
+	theTx, err := s.clientCtx.TxConfig.TxDecoder()(txBytes)
+	if err != nil {
+		return nil, ErrInvalidTransaction.Wrap(err.Error())
+	}
+
+	txBuilder, err := s.clientCtx.TxConfig.WrapTxBuilder(theTx)
+	if err != nil {
+		return nil, ErrInvalidTransaction.Wrap(err.Error())
+	}
+
+	return txBuilder, nil
+}
+
+// signerPubKeys returns the public keys of the signers of msgs, in the order
+// of the signers.
+func signerPubKeys(msgs []sdk.Msg, publicKeys []*PublicKey) ([]crypto.PubKey, error) {
+	pubKeysByAddr := make(map[string]crypto.PubKey, len(publicKeys))
+	for _, pk := range publicKeys {
+		pubKey, err := parsePubKey(pk)
+		if err != nil {
+			return nil, err
+		}
+
+		pubKeysByAddr[sdk.AccAddress(pubKey.Address()).String()] = pubKey
+	}
+
+	signers := signersToAccounts(msgs)
+	pubKeys := make([]crypto.PubKey, len(signers))
+	for i, signer := range signers {
+		pubKey, ok := pubKeysByAddr[signer.Address]
+		if !ok {
+			return nil, ErrInvalidPubKey.Wrapf("missing public key of %s", signer.Address)
+		}
+
+		pubKeys[i] = pubKey
+	}
+
+	return pubKeys, nil
+}
+
+// parsePubKey parses a compressed secp256k1 public key.
+func parsePubKey(pk *PublicKey) (crypto.PubKey, error) {
+	if pk == nil {
+		return nil, ErrInvalidPubKey.Wrap("public key cannot be empty")
+	}
+
+	if pk.CurveType != Secp256k1 {
+		return nil, ErrUnsupportedCurve
+	}
+
+	bz, err := hex.DecodeString(trimHexPrefix(pk.HexBytes))
+	if err != nil {
+		return nil, ErrInvalidPubKey.Wrap(err.Error())
+	}
+
+	var pubKey secp256k1.PubKeySecp256k1
+	if len(bz) != len(pubKey) {
+		return nil, ErrInvalidPubKey.Wrapf("expected %d bytes, got %d", len(pubKey), len(bz))
+	}
+
+	copy(pubKey[:], bz)
+
+	return pubKey, nil
+}
+
+// fromMetadata decodes Rosetta metadata or options into v.
+func fromMetadata(metadata map[string]interface{}, v interface{}) error {
+	bz, err := json.Marshal(metadata)
+	if err != nil {
+		return ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err := json.Unmarshal(bz, v); err != nil {
+		return ErrInvalidRequest.Wrapf("invalid metadata: %s", err)
+	}
+
+	return nil
+}
+
+// toMetadata encodes v into Rosetta metadata or options.
+func toMetadata(v interface{}) (map[string]interface{}, error) {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata map[string]interface{}
+	if err := json.Unmarshal(bz, &metadata); err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
@@ -0,0 +1,263 @@
+package rosetta
+
+import (
+	"fmt"
+	"strings"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Operation types and statuses of the network. Only the balance changes made
+// by fees and bank transfers are described by operations.
+const (
+	OperationTransfer = "transfer"
+	OperationFee      = "fee"
+
+	StatusSuccess  = "Success"
+	StatusReverted = "Reverted"
+)
+
+// txHash returns the hex-encoded hash of a transaction, as indexed by
+// Tendermint.
+func txHash(txBytes []byte) string {
+	return fmt.Sprintf("%X", tmtypes.Tx(txBytes).Hash())
+}
+
+// txToTransaction converts a transaction into its Rosetta representation. The
+// fee is deducted even if the messages failed, so the fee operations always
+// succeed while the message operations have the given status.
+func txToTransaction(tx sdk.Tx, txBytes []byte, status string) *Transaction {
+	var ops []*Operation
+
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		payer := feeTx.FeePayer()
+		if granter := feeTx.FeeGranter(); !granter.Empty() {
+			payer = granter
+		}
+
+		for _, coin := range feeTx.GetFee() {
+			ops = append(ops, &Operation{
+				OperationIdentifier: &OperationIdentifier{Index: int64(len(ops))},
+				Type:                OperationFee,
+				Status:              StatusSuccess,
+				Account:             &AccountIdentifier{Address: payer.String()},
+				Amount:              coinToAmount(coin, true),
+			})
+		}
+	}
+
+	ops = append(ops, msgsToOperations(tx.GetMsgs(), status, int64(len(ops)))...)
+
+	return &Transaction{
+		TransactionIdentifier: &TransactionIdentifier{Hash: txHash(txBytes)},
+		Operations:            ops,
+	}
+}
+
+// msgsToOperations converts the bank transfers of msgs into pairs of transfer
+// operations, indexed from startIndex. Messages which are not bank transfers
+// have no operation.
+func msgsToOperations(msgs []sdk.Msg, status string, startIndex int64) []*Operation {
+	var ops []*Operation
+
+	addTransfer := func(from, to sdk.AccAddress, coin sdk.Coin) {
+		fromIndex := startIndex + int64(len(ops))
+		ops = append(ops,
+			&Operation{
+				OperationIdentifier: &OperationIdentifier{Index: fromIndex},
+				Type:                OperationTransfer,
+				Status:              status,
+				Account:             &AccountIdentifier{Address: from.String()},
+				Amount:              coinToAmount(coin, true),
+			},
+			&Operation{
+				OperationIdentifier: &OperationIdentifier{Index: fromIndex + 1},
+				RelatedOperations:   []*OperationIdentifier{{Index: fromIndex}},
+				Type:                OperationTransfer,
+				Status:              status,
+				Account:             &AccountIdentifier{Address: to.String()},
+				Amount:              coinToAmount(coin, false),
+			},
+		)
+	}
+
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *banktypes.MsgSend:
+			for _, coin := range msg.Amount {
+				addTransfer(msg.FromAddress, msg.ToAddress, coin)
+			}
+
+		case *banktypes.MsgMultiSend:
+			for _, input := range msg.Inputs {
+				for _, coin := range input.Coins {
+					ops = append(ops, &Operation{
+						OperationIdentifier: &OperationIdentifier{Index: startIndex + int64(len(ops))},
+						Type:                OperationTransfer,
+						Status:              status,
+						Account:             &AccountIdentifier{Address: input.Address.String()},
+						Amount:              coinToAmount(coin, true),
+					})
+				}
+			}
+
+			for _, output := range msg.Outputs {
+				for _, coin := range output.Coins {
+					ops = append(ops, &Operation{
+						OperationIdentifier: &OperationIdentifier{Index: startIndex + int64(len(ops))},
+						Type:                OperationTransfer,
+						Status:              status,
+						Account:             &AccountIdentifier{Address: output.Address.String()},
+						Amount:              coinToAmount(coin, false),
+					})
+				}
+			}
+		}
+	}
+
+	return ops
+}
+
+// operationsToMsgs converts transfer operations into bank MsgSends. The
+// operations must come in pairs debiting and crediting the same amount, and
+// consecutive pairs between the same accounts are merged into a single
+// MsgSend.
+func operationsToMsgs(ops []*Operation) ([]sdk.Msg, error) {
+	if len(ops) == 0 || len(ops)%2 != 0 {
+		return nil, ErrInvalidOperation.Wrap("expected pairs of transfer operations")
+	}
+
+	var msgs []sdk.Msg
+
+	for i := 0; i < len(ops); i += 2 {
+		from, fromCoin, err := parseTransferOperation(ops[i])
+		if err != nil {
+			return nil, err
+		}
+
+		to, toCoin, err := parseTransferOperation(ops[i+1])
+		if err != nil {
+			return nil, err
+		}
+
+		// accept both orders of the debit and credit
+		if fromCoin.IsNegative() == toCoin.IsNegative() {
+			return nil, ErrInvalidOperation.Wrapf("operations %d and %d must debit and credit an account", i, i+1)
+		}
+		if !fromCoin.IsNegative() {
+			from, to = to, from
+			fromCoin, toCoin = toCoin, fromCoin
+		}
+
+		coin := sdk.Coin{Denom: toCoin.Denom, Amount: toCoin.Amount}
+		if fromCoin.Denom != toCoin.Denom || !fromCoin.Amount.Neg().Equal(toCoin.Amount) {
+			return nil, ErrInvalidOperation.Wrapf("operations %d and %d must transfer the same amount", i, i+1)
+		}
+		if !coin.IsPositive() {
+			return nil, ErrInvalidOperation.Wrapf("operations %d and %d must transfer a positive amount", i, i+1)
+		}
+
+		if len(msgs) > 0 {
+			last := msgs[len(msgs)-1].(*banktypes.MsgSend)
+			if last.FromAddress.Equals(from) && last.ToAddress.Equals(to) {
+				last.Amount = last.Amount.Add(coin)
+				continue
+			}
+		}
+
+		msgs = append(msgs, banktypes.NewMsgSend(from, to, sdk.NewCoins(coin)))
+	}
+
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, ErrInvalidOperation.Wrap(err.Error())
+		}
+	}
+
+	return msgs, nil
+}
+
+// parseTransferOperation returns the account and the signed amount of a
+// transfer operation. The amount is returned as a coin, which may be
+// negative.
+func parseTransferOperation(op *Operation) (sdk.AccAddress, sdk.Coin, error) {
+	if op == nil || op.Type != OperationTransfer {
+		return nil, sdk.Coin{}, ErrInvalidOperation.Wrapf("only %s operations are supported", OperationTransfer)
+	}
+
+	if op.Account == nil || op.Amount == nil || op.Amount.Currency == nil {
+		return nil, sdk.Coin{}, ErrInvalidOperation.Wrap("transfer operations must have an account and an amount")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(op.Account.Address)
+	if err != nil {
+		return nil, sdk.Coin{}, ErrInvalidAddress.Wrap(err.Error())
+	}
+
+	amount, ok := sdk.NewIntFromString(op.Amount.Value)
+	if !ok {
+		return nil, sdk.Coin{}, ErrInvalidOperation.Wrapf("invalid amount %s", op.Amount.Value)
+	}
+
+	if err := sdk.ValidateDenom(op.Amount.Currency.Symbol); err != nil {
+		return nil, sdk.Coin{}, ErrInvalidOperation.Wrap(err.Error())
+	}
+
+	// the coin is built directly as sdk.NewCoin rejects negative amounts
+	return addr, sdk.Coin{Denom: op.Amount.Currency.Symbol, Amount: amount}, nil
+}
+
+// coinToAmount converts a coin into a Rosetta amount, which is negative for
+// debits.
+func coinToAmount(coin sdk.Coin, debit bool) *Amount {
+	value := coin.Amount.String()
+	if debit {
+		value = "-" + value
+	}
+
+	return &Amount{
+		Value:    value,
+		Currency: &Currency{Symbol: coin.Denom},
+	}
+}
+
+// coinsToAmounts converts balances into Rosetta amounts.
+func coinsToAmounts(coins sdk.Coins) []*Amount {
+	amounts := make([]*Amount, len(coins))
+	for i, coin := range coins {
+		amounts[i] = coinToAmount(coin, false)
+	}
+
+	return amounts
+}
+
+// signersToAccounts converts the signers of msgs into account identifiers,
+// without duplicates and in the order they sign.
+func signersToAccounts(msgs []sdk.Msg) []*AccountIdentifier {
+	var (
+		accounts []*AccountIdentifier
+		seen     = make(map[string]bool)
+	)
+
+	for _, msg := range msgs {
+		for _, signer := range msg.GetSigners() {
+			addr := signer.String()
+			if seen[addr] {
+				continue
+			}
+
+			seen[addr] = true
+			accounts = append(accounts, &AccountIdentifier{Address: addr})
+		}
+	}
+
+	return accounts
+}
+
+// trimHexPrefix removes the optional 0x prefix of hex-encoded bytes.
+func trimHexPrefix(s string) string {
+	return strings.TrimPrefix(s, "0x")
+}
@@ -0,0 +1,344 @@
+package rosetta
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmversion "github.com/tendermint/tendermint/version"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// mempoolLimit is the maximum number of mempool transactions returned, which
+// is the maximum page size of Tendermint.
+const mempoolLimit = 100
+
+func (s *Server) networkList(_ MetadataRequest) (*NetworkListResponse, error) {
+	return &NetworkListResponse{
+		NetworkIdentifiers: []*NetworkIdentifier{s.networkIdentifier()},
+	}, nil
+}
+
+func (s *Server) networkOptions(req NetworkRequest) (*NetworkOptionsResponse, error) {
+	if err := s.checkNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	nodeVersion := version.Version
+	if nodeVersion == "" {
+		nodeVersion = tmversion.TMCoreSemVer
+	}
+
+	return &NetworkOptionsResponse{
+		Version: &Version{
+			RosettaVersion: SpecVersion,
+			NodeVersion:    nodeVersion,
+		},
+		Allow: &Allow{
+			OperationStatuses: []*OperationStatus{
+				{Status: StatusSuccess, Successful: true},
+				{Status: StatusReverted, Successful: false},
+			},
+			OperationTypes:          []string{OperationTransfer, OperationFee},
+			Errors:                  allErrors,
+			HistoricalBalanceLookup: true,
+		},
+	}, nil
+}
+
+func (s *Server) networkStatus(req NetworkRequest) (*NetworkStatusResponse, error) {
+	if err := s.checkNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	node, err := s.node()
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := node.Status()
+	if err != nil {
+		return nil, ErrNodeUnavailable.Wrap(err.Error())
+	}
+
+	netInfo, err := node.NetInfo()
+	if err != nil {
+		return nil, ErrNodeUnavailable.Wrap(err.Error())
+	}
+
+	peers := make([]*Peer, len(netInfo.Peers))
+	for i, peer := range netInfo.Peers {
+		peers[i] = &Peer{
+			PeerID: string(peer.NodeInfo.ID()),
+			Metadata: map[string]interface{}{
+				"moniker":   peer.NodeInfo.Moniker,
+				"remote_ip": peer.RemoteIP,
+			},
+		}
+	}
+
+	syncInfo := status.SyncInfo
+	synced := !syncInfo.CatchingUp
+
+	// the genesis block is the first block kept by the node, unless it has
+	// been pruned
+	oldest := &BlockIdentifier{Index: syncInfo.EarliestBlockHeight, Hash: syncInfo.EarliestBlockHash.String()}
+	genesis := oldest
+	if syncInfo.EarliestBlockHeight > 1 {
+		genesisHeight := int64(1)
+		if resBlock, err := node.Block(&genesisHeight); err == nil {
+			genesis = &BlockIdentifier{Index: genesisHeight, Hash: resBlock.BlockID.Hash.String()}
+		}
+	}
+
+	return &NetworkStatusResponse{
+		CurrentBlockIdentifier: &BlockIdentifier{Index: syncInfo.LatestBlockHeight, Hash: syncInfo.LatestBlockHash.String()},
+		CurrentBlockTimestamp:  syncInfo.LatestBlockTime.UnixNano() / 1e6,
+		GenesisBlockIdentifier: genesis,
+		OldestBlockIdentifier:  oldest,
+		SyncStatus: &SyncStatus{
+			CurrentIndex: &syncInfo.LatestBlockHeight,
+			Synced:       &synced,
+		},
+		Peers: peers,
+	}, nil
+}
+
+func (s *Server) accountBalance(req AccountBalanceRequest) (*AccountBalanceResponse, error) {
+	if err := s.checkNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	if req.AccountIdentifier == nil {
+		return nil, ErrInvalidAddress.Wrap("account identifier cannot be empty")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.AccountIdentifier.Address)
+	if err != nil {
+		return nil, ErrInvalidAddress.Wrap(err.Error())
+	}
+
+	resBlock, err := s.getBlock(req.BlockIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	// the state queried at a height is the state after the block of that
+	// height was committed
+	queryClient := banktypes.NewQueryClient(s.clientCtx.WithHeight(resBlock.Block.Height))
+
+	var (
+		balances sdk.Coins
+		nextKey  []byte
+	)
+
+	for {
+		res, err := queryClient.AllBalances(context.Background(), &banktypes.QueryAllBalancesRequest{
+			Address:    addr,
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return nil, ErrNodeUnavailable.Wrap(err.Error())
+		}
+
+		balances = append(balances, res.Balances...)
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+
+		nextKey = res.Pagination.NextKey
+	}
+
+	return &AccountBalanceResponse{
+		BlockIdentifier: blockIdentifier(resBlock),
+		Balances:        coinsToAmounts(balances),
+	}, nil
+}
+
+func (s *Server) block(req BlockRequest) (*BlockResponse, error) {
+	if err := s.checkNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	resBlock, err := s.getBlock(req.BlockIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := s.blockTransactions(resBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	// the first block is its own parent
+	parent := blockIdentifier(resBlock)
+	if lastBlockID := resBlock.Block.LastBlockID; len(lastBlockID.Hash) > 0 {
+		parent = &BlockIdentifier{Index: resBlock.Block.Height - 1, Hash: lastBlockID.Hash.String()}
+	}
+
+	return &BlockResponse{
+		Block: &Block{
+			BlockIdentifier:       blockIdentifier(resBlock),
+			ParentBlockIdentifier: parent,
+			Timestamp:             resBlock.Block.Time.UnixNano() / 1e6,
+			Transactions:          txs,
+		},
+	}, nil
+}
+
+func (s *Server) blockTransaction(req BlockTransactionRequest) (*BlockTransactionResponse, error) {
+	if err := s.checkNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	if req.BlockIdentifier == nil || req.TransactionIdentifier == nil {
+		return nil, ErrInvalidRequest.Wrap("block and transaction identifiers cannot be empty")
+	}
+
+	resBlock, err := s.getBlock(&PartialBlockIdentifier{
+		Index: &req.BlockIdentifier.Index,
+		Hash:  &req.BlockIdentifier.Hash,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := s.blockTransactions(resBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tx := range txs {
+		if tx.TransactionIdentifier.Hash == req.TransactionIdentifier.Hash {
+			return &BlockTransactionResponse{Transaction: tx}, nil
+		}
+	}
+
+	return nil, ErrTransactionNotFound.Wrapf("%s in block %d", req.TransactionIdentifier.Hash, req.BlockIdentifier.Index)
+}
+
+func (s *Server) mempool(req NetworkRequest) (*MempoolResponse, error) {
+	if err := s.checkNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	node, err := s.node()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := node.UnconfirmedTxs(mempoolLimit)
+	if err != nil {
+		return nil, ErrNodeUnavailable.Wrap(err.Error())
+	}
+
+	txIDs := make([]*TransactionIdentifier, len(res.Txs))
+	for i, tx := range res.Txs {
+		txIDs[i] = &TransactionIdentifier{Hash: txHash(tx)}
+	}
+
+	return &MempoolResponse{TransactionIdentifiers: txIDs}, nil
+}
+
+// node returns the client of the node, or an error if the server is offline.
+func (s *Server) node() (rpcclient.Client, error) {
+	if err := s.checkOnline(); err != nil {
+		return nil, err
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, ErrNodeUnavailable.Wrap(err.Error())
+	}
+
+	return node, nil
+}
+
+// getBlock returns the block of a partial block identifier, or the latest
+// block if it is empty. Tendermint cannot look blocks up by their hash, so a
+// hash must come with the index of the block.
+func (s *Server) getBlock(id *PartialBlockIdentifier) (*ctypes.ResultBlock, error) {
+	node, err := s.node()
+	if err != nil {
+		return nil, err
+	}
+
+	var height *int64
+	if id != nil {
+		if id.Index == nil && id.Hash != nil {
+			return nil, ErrHashLookupUnsupported
+		}
+
+		height = id.Index
+	}
+
+	resBlock, err := node.Block(height)
+	if err != nil {
+		return nil, ErrBlockNotFound.Wrap(err.Error())
+	}
+
+	if id != nil && id.Hash != nil {
+		hash, err := hex.DecodeString(trimHexPrefix(*id.Hash))
+		if err != nil || !bytes.Equal(hash, resBlock.BlockID.Hash) {
+			return nil, ErrBlockNotFound.Wrapf("block %d does not have hash %s", resBlock.Block.Height, *id.Hash)
+		}
+	}
+
+	return resBlock, nil
+}
+
+// blockTransactions converts the transactions of a block. Their status is
+// read from the results of the block.
+func (s *Server) blockTransactions(resBlock *ctypes.ResultBlock) ([]*Transaction, error) {
+	node, err := s.node()
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := node.BlockResults(&resBlock.Block.Height)
+	if err != nil {
+		return nil, ErrBlockNotFound.Wrap(err.Error())
+	}
+
+	blockTxs := resBlock.Block.Txs
+	if len(results.TxsResults) != len(blockTxs) {
+		return nil, ErrUnknown.Wrap(fmt.Sprintf("block %d has %d txs but %d results", resBlock.Block.Height, len(blockTxs), len(results.TxsResults)))
+	}
+
+	txs := make([]*Transaction, len(blockTxs))
+	for i, txBytes := range blockTxs {
+		tx, err := s.clientCtx.TxConfig.TxDecoder()(txBytes)
+		if err != nil {
+			// a tx which cannot be decoded was rejected and changed no balance
+			txs[i] = &Transaction{
+				TransactionIdentifier: &TransactionIdentifier{Hash: txHash(txBytes)},
+				Operations:            []*Operation{},
+			}
+			continue
+		}
+
+		status := StatusSuccess
+		if results.TxsResults[i].Code != 0 {
+			status = StatusReverted
+		}
+
+		txs[i] = txToTransaction(tx, txBytes, status)
+	}
+
+	return txs, nil
+}
+
+func blockIdentifier(resBlock *ctypes.ResultBlock) *BlockIdentifier {
+	return &BlockIdentifier{
+		Index: resBlock.Block.Height,
+		Hash:  resBlock.BlockID.Hash.String(),
+	}
+}
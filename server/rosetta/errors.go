@@ -0,0 +1,87 @@
+package rosetta
+
+import (
+	"fmt"
+)
+
+// Error is a Rosetta API error. Every error the server can return is listed
+// by /network/options.
+type Error struct {
+	Code      int32                  `json:"code"`
+	Message   string                 `json:"message"`
+	Retriable bool                   `json:"retriable"`
+	Details   map[string]interface{} `json:"details,omitempty"`
+}
+
+func (e *Error) Error() string {
+	if context, ok := e.Details["context"]; ok {
+		return fmt.Sprintf("%s: %v", e.Message, context)
+	}
+
+	return e.Message
+}
+
+// Wrap returns a copy of the error with context added to its details.
+func (e *Error) Wrap(context string) *Error {
+	return &Error{
+		Code:      e.Code,
+		Message:   e.Message,
+		Retriable: e.Retriable,
+		Details:   map[string]interface{}{"context": context},
+	}
+}
+
+// Wrapf returns a copy of the error with a formatted context added to its
+// details.
+func (e *Error) Wrapf(format string, args ...interface{}) *Error {
+	return e.Wrap(fmt.Sprintf(format, args...))
+}
+
+var (
+	ErrUnknown               = &Error{Code: 0, Message: "unknown error"}
+	ErrOffline               = &Error{Code: 1, Message: "endpoint unavailable in offline mode"}
+	ErrNodeUnavailable       = &Error{Code: 2, Message: "node unavailable", Retriable: true}
+	ErrInvalidNetwork        = &Error{Code: 3, Message: "invalid network identifier"}
+	ErrInvalidRequest        = &Error{Code: 4, Message: "invalid request"}
+	ErrInvalidAddress        = &Error{Code: 5, Message: "invalid address"}
+	ErrInvalidPubKey         = &Error{Code: 6, Message: "invalid public key"}
+	ErrInvalidOperation      = &Error{Code: 7, Message: "invalid operation"}
+	ErrInvalidTransaction    = &Error{Code: 8, Message: "invalid transaction"}
+	ErrInvalidSignature      = &Error{Code: 9, Message: "invalid signature"}
+	ErrBlockNotFound         = &Error{Code: 10, Message: "block not found", Retriable: true}
+	ErrTransactionNotFound   = &Error{Code: 11, Message: "transaction not found"}
+	ErrTransactionRejected   = &Error{Code: 12, Message: "transaction rejected"}
+	ErrUnsupportedCurve      = &Error{Code: 13, Message: "unsupported curve, expected secp256k1"}
+	ErrUnsupportedSignature  = &Error{Code: 14, Message: "unsupported signature type, expected ecdsa"}
+	ErrHashLookupUnsupported = &Error{Code: 15, Message: "block lookup by hash requires its index"}
+)
+
+// allErrors lists the errors returned by the server.
+var allErrors = []*Error{
+	ErrUnknown,
+	ErrOffline,
+	ErrNodeUnavailable,
+	ErrInvalidNetwork,
+	ErrInvalidRequest,
+	ErrInvalidAddress,
+	ErrInvalidPubKey,
+	ErrInvalidOperation,
+	ErrInvalidTransaction,
+	ErrInvalidSignature,
+	ErrBlockNotFound,
+	ErrTransactionNotFound,
+	ErrTransactionRejected,
+	ErrUnsupportedCurve,
+	ErrUnsupportedSignature,
+	ErrHashLookupUnsupported,
+}
+
+// toRosettaError converts err into a Rosetta error, wrapping errors which are
+// not Rosetta errors into ErrUnknown.
+func toRosettaError(err error) *Error {
+	if rosErr, ok := err.(*Error); ok {
+		return rosErr
+	}
+
+	return ErrUnknown.Wrap(err.Error())
+}
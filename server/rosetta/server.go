@@ -0,0 +1,257 @@
+// Package rosetta implements a server for the Rosetta Data and Construction
+// APIs, which exchanges use to integrate with the chain.
+package rosetta
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+// SpecVersion is the version of the Rosetta API specification implemented by
+// the server.
+const SpecVersion = "1.4.6"
+
+// Server serves the Rosetta Data and Construction APIs of a node. The Data
+// API and the construction endpoints which need the state of the chain query
+// the node through the client context, mapping Rosetta requests onto the
+// gRPC queries of the modules and the Tendermint RPC.
+type Server struct {
+	Router *mux.Router
+
+	clientCtx client.Context
+	config    config.RosettaConfig
+	logger    log.Logger
+	listener  net.Listener
+}
+
+// New creates a Rosetta server. The client context must have a TxConfig and,
+// unless the server is offline, a node client.
+func New(clientCtx client.Context, cfg config.RosettaConfig, logger log.Logger) *Server {
+	s := &Server{
+		Router:    mux.NewRouter(),
+		clientCtx: clientCtx,
+		config:    cfg,
+		logger:    logger,
+	}
+
+	s.registerRoutes()
+
+	return s
+}
+
+// Start starts the Rosetta server on the configured address. The process is
+// blocking until the server is closed.
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", s.config.Address)
+	if err != nil {
+		return err
+	}
+
+	s.listener = listener
+	s.logger.Info("starting Rosetta server", "address", s.config.Address, "offline", s.config.Offline)
+
+	srv := &http.Server{
+		Handler:      s.Router,
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 60 * time.Second,
+	}
+
+	if err := srv.Serve(listener); err != http.ErrServerClosed {
+		return err
+	}
+
+	return nil
+}
+
+// Close closes the Rosetta server.
+func (s *Server) Close() error {
+	if s.listener == nil {
+		return nil
+	}
+
+	return s.listener.Close()
+}
+
+// networkIdentifier returns the network identifier of the chain, whose
+// network defaults to the chain ID.
+func (s *Server) networkIdentifier() *NetworkIdentifier {
+	network := s.config.Network
+	if network == "" {
+		network = s.clientCtx.ChainID
+	}
+
+	return &NetworkIdentifier{
+		Blockchain: s.config.Blockchain,
+		Network:    network,
+	}
+}
+
+// checkNetwork returns an error if a request is not made for the network of
+// the chain.
+func (s *Server) checkNetwork(network *NetworkIdentifier) error {
+	if network == nil || *network != *s.networkIdentifier() {
+		return ErrInvalidNetwork
+	}
+
+	return nil
+}
+
+// checkOnline returns ErrOffline if the server is offline.
+func (s *Server) checkOnline() error {
+	if s.config.Offline {
+		return ErrOffline
+	}
+
+	return nil
+}
+
+func (s *Server) registerRoutes() {
+	s.handle("/network/list", func(r *http.Request) (interface{}, error) {
+		var req MetadataRequest
+		if err := decodeRequest(r, &req); err != nil {
+			return nil, err
+		}
+		return s.networkList(req)
+	})
+	s.handle("/network/options", func(r *http.Request) (interface{}, error) {
+		var req NetworkRequest
+		if err := decodeRequest(r, &req); err != nil {
+			return nil, err
+		}
+		return s.networkOptions(req)
+	})
+	s.handle("/network/status", func(r *http.Request) (interface{}, error) {
+		var req NetworkRequest
+		if err := decodeRequest(r, &req); err != nil {
+			return nil, err
+		}
+		return s.networkStatus(req)
+	})
+	s.handle("/account/balance", func(r *http.Request) (interface{}, error) {
+		var req AccountBalanceRequest
+		if err := decodeRequest(r, &req); err != nil {
+			return nil, err
+		}
+		return s.accountBalance(req)
+	})
+	s.handle("/block", func(r *http.Request) (interface{}, error) {
+		var req BlockRequest
+		if err := decodeRequest(r, &req); err != nil {
+			return nil, err
+		}
+		return s.block(req)
+	})
+	s.handle("/block/transaction", func(r *http.Request) (interface{}, error) {
+		var req BlockTransactionRequest
+		if err := decodeRequest(r, &req); err != nil {
+			return nil, err
+		}
+		return s.blockTransaction(req)
+	})
+	s.handle("/mempool", func(r *http.Request) (interface{}, error) {
+		var req NetworkRequest
+		if err := decodeRequest(r, &req); err != nil {
+			return nil, err
+		}
+		return s.mempool(req)
+	})
+
+	s.handle("/construction/derive", func(r *http.Request) (interface{}, error) {
+		var req ConstructionDeriveRequest
+		if err := decodeRequest(r, &req); err != nil {
+			return nil, err
+		}
+		return s.constructionDerive(req)
+	})
+	s.handle("/construction/preprocess", func(r *http.Request) (interface{}, error) {
+		var req ConstructionPreprocessRequest
+		if err := decodeRequest(r, &req); err != nil {
+			return nil, err
+		}
+		return s.constructionPreprocess(req)
+	})
+	s.handle("/construction/metadata", func(r *http.Request) (interface{}, error) {
+		var req ConstructionMetadataRequest
+		if err := decodeRequest(r, &req); err != nil {
+			return nil, err
+		}
+		return s.constructionMetadata(req)
+	})
+	s.handle("/construction/payloads", func(r *http.Request) (interface{}, error) {
+		var req ConstructionPayloadsRequest
+		if err := decodeRequest(r, &req); err != nil {
+			return nil, err
+		}
+		return s.constructionPayloads(req)
+	})
+	s.handle("/construction/combine", func(r *http.Request) (interface{}, error) {
+		var req ConstructionCombineRequest
+		if err := decodeRequest(r, &req); err != nil {
+			return nil, err
+		}
+		return s.constructionCombine(req)
+	})
+	s.handle("/construction/parse", func(r *http.Request) (interface{}, error) {
+		var req ConstructionParseRequest
+		if err := decodeRequest(r, &req); err != nil {
+			return nil, err
+		}
+		return s.constructionParse(req)
+	})
+	s.handle("/construction/hash", func(r *http.Request) (interface{}, error) {
+		var req ConstructionHashRequest
+		if err := decodeRequest(r, &req); err != nil {
+			return nil, err
+		}
+		return s.constructionHash(req)
+	})
+	s.handle("/construction/submit", func(r *http.Request) (interface{}, error) {
+		var req ConstructionSubmitRequest
+		if err := decodeRequest(r, &req); err != nil {
+			return nil, err
+		}
+		return s.constructionSubmit(req)
+	})
+}
+
+// handle registers a Rosetta endpoint. Errors are returned as Rosetta errors
+// with a 500 status code, as required by the specification.
+func (s *Server) handle(path string, handler func(r *http.Request) (interface{}, error)) {
+	s.Router.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		res, err := handler(r)
+		if err != nil {
+			s.logger.Debug("Rosetta request failed", "path", path, "err", err)
+			writeResponse(w, http.StatusInternalServerError, toRosettaError(err))
+			return
+		}
+
+		writeResponse(w, http.StatusOK, res)
+	}).Methods("POST")
+}
+
+// decodeRequest decodes the JSON body of a request. Numbers in metadata are
+// kept as json.Number so that they do not lose precision.
+func decodeRequest(r *http.Request, req interface{}) error {
+	dec := json.NewDecoder(r.Body)
+	dec.UseNumber()
+
+	if err := dec.Decode(req); err != nil {
+		return ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+func writeResponse(w http.ResponseWriter, status int, res interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(res)
+}
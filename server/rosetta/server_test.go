@@ -0,0 +1,276 @@
+package rosetta
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+func newTestServer() *Server {
+	encodingConfig := simapp.MakeEncodingConfig()
+	clientCtx := client.Context{}.
+		WithTxConfig(encodingConfig.TxConfig).
+		WithChainID("test-chain")
+
+	cfg := config.DefaultConfig().Rosetta
+	cfg.Offline = true
+
+	return New(clientCtx, cfg, log.NewNopLogger())
+}
+
+// post sends a request to the server and decodes its response into res,
+// returning the status code.
+func post(t *testing.T, s *Server, path string, req, res interface{}) int {
+	bz, err := json.Marshal(req)
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	s.Router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, bytes.NewReader(bz)))
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(res))
+
+	return rec.Code
+}
+
+func transferOperations(from, to sdk.AccAddress, amount string) []*Operation {
+	currency := &Currency{Symbol: "stake"}
+
+	return []*Operation{
+		{
+			OperationIdentifier: &OperationIdentifier{Index: 0},
+			Type:                OperationTransfer,
+			Account:             &AccountIdentifier{Address: from.String()},
+			Amount:              &Amount{Value: "-" + amount, Currency: currency},
+		},
+		{
+			OperationIdentifier: &OperationIdentifier{Index: 1},
+			RelatedOperations:   []*OperationIdentifier{{Index: 0}},
+			Type:                OperationTransfer,
+			Account:             &AccountIdentifier{Address: to.String()},
+			Amount:              &Amount{Value: amount, Currency: currency},
+		},
+	}
+}
+
+// signHash signs a hash the way a Rosetta signer does.
+func signHash(t *testing.T, privKey secp256k1.PrivKeySecp256k1, hash []byte) []byte {
+	priv, _ := btcec.PrivKeyFromBytes(btcec.S256(), privKey[:])
+	sig, err := priv.Sign(hash)
+	require.NoError(t, err)
+
+	sigBytes := make([]byte, 64)
+	rBytes, sBytes := sig.R.Bytes(), sig.S.Bytes()
+	copy(sigBytes[32-len(rBytes):32], rBytes)
+	copy(sigBytes[64-len(sBytes):], sBytes)
+
+	return sigBytes
+}
+
+func TestConstructionFlow(t *testing.T) {
+	s := newTestServer()
+	network := s.networkIdentifier()
+
+	privKey := secp256k1.GenPrivKey()
+	pk := privKey.PubKey().(secp256k1.PubKeySecp256k1)
+	pubKey := &PublicKey{HexBytes: hex.EncodeToString(pk[:]), CurveType: Secp256k1}
+	toAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	var deriveRes ConstructionDeriveResponse
+	require.Equal(t, http.StatusOK, post(t, s, "/construction/derive", ConstructionDeriveRequest{
+		NetworkIdentifier: network,
+		PublicKey:         pubKey,
+	}, &deriveRes))
+
+	fromAddr, err := sdk.AccAddressFromBech32(deriveRes.AccountIdentifier.Address)
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(privKey.PubKey().Address()), fromAddr)
+
+	ops := transferOperations(fromAddr, toAddr, "100")
+
+	var preprocessRes ConstructionPreprocessResponse
+	require.Equal(t, http.StatusOK, post(t, s, "/construction/preprocess", ConstructionPreprocessRequest{
+		NetworkIdentifier: network,
+		Operations:        ops,
+		Metadata:          map[string]interface{}{"memo": "rosetta", "gas_limit": 100000},
+	}, &preprocessRes))
+	require.Equal(t, []*AccountIdentifier{{Address: fromAddr.String()}}, preprocessRes.RequiredPublicKeys)
+	require.Equal(t, []interface{}{fromAddr.String()}, preprocessRes.Options["signers"])
+	require.Equal(t, "100000", preprocessRes.Options["gas_limit"])
+
+	// the metadata is queried from the node by /construction/metadata, which
+	// is unavailable offline
+	var errRes Error
+	require.Equal(t, http.StatusInternalServerError, post(t, s, "/construction/metadata", ConstructionMetadataRequest{
+		NetworkIdentifier: network,
+		Options:           preprocessRes.Options,
+	}, &errRes))
+	require.Equal(t, ErrOffline.Code, errRes.Code)
+
+	metadata := map[string]interface{}{
+		"chain_id":  "test-chain",
+		"memo":      "rosetta",
+		"gas_limit": "100000",
+		"gas_price": "0.01stake",
+		"signers": []map[string]interface{}{
+			{"address": fromAddr.String(), "account_number": "7", "sequence": "3"},
+		},
+	}
+
+	var payloadsRes ConstructionPayloadsResponse
+	require.Equal(t, http.StatusOK, post(t, s, "/construction/payloads", ConstructionPayloadsRequest{
+		NetworkIdentifier: network,
+		Operations:        ops,
+		Metadata:          metadata,
+		PublicKeys:        []*PublicKey{pubKey},
+	}, &payloadsRes))
+	require.Len(t, payloadsRes.Payloads, 1)
+	require.Equal(t, fromAddr.String(), payloadsRes.Payloads[0].AccountIdentifier.Address)
+
+	var parseRes ConstructionParseResponse
+	require.Equal(t, http.StatusOK, post(t, s, "/construction/parse", ConstructionParseRequest{
+		NetworkIdentifier: network,
+		Transaction:       payloadsRes.UnsignedTransaction,
+	}, &parseRes))
+	require.Equal(t, ops, parseRes.Operations)
+	require.Empty(t, parseRes.AccountIdentifierSigners)
+	require.Equal(t, "rosetta", parseRes.Metadata["memo"])
+	require.Equal(t, "1000stake", parseRes.Metadata["fee"])
+
+	hash, err := hex.DecodeString(payloadsRes.Payloads[0].HexBytes)
+	require.NoError(t, err)
+
+	var combineRes ConstructionCombineResponse
+	require.Equal(t, http.StatusOK, post(t, s, "/construction/combine", ConstructionCombineRequest{
+		NetworkIdentifier:   network,
+		UnsignedTransaction: payloadsRes.UnsignedTransaction,
+		Signatures: []*Signature{{
+			SigningPayload: payloadsRes.Payloads[0],
+			PublicKey:      pubKey,
+			SignatureType:  Ecdsa,
+			HexBytes:       hex.EncodeToString(signHash(t, privKey, hash)),
+		}},
+	}, &combineRes))
+
+	// the signature verifies against the sign bytes of the signer account
+	txBuilder, err := s.decodeTx(combineRes.SignedTransaction)
+	require.NoError(t, err)
+
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+
+	signBytes, err := s.clientCtx.TxConfig.SignModeHandler().GetSignBytes(signMode, authsigning.SignerData{
+		ChainID:         "test-chain",
+		AccountNumber:   7,
+		AccountSequence: 3,
+	}, txBuilder.GetTx())
+	require.NoError(t, err)
+	expHash := sha256.Sum256(signBytes)
+	require.Equal(t, expHash[:], hash)
+	require.True(t, privKey.PubKey().VerifyBytes(signBytes, txBuilder.GetTx().GetSignatures()[0]))
+
+	require.Equal(t, http.StatusOK, post(t, s, "/construction/parse", ConstructionParseRequest{
+		NetworkIdentifier: network,
+		Signed:            true,
+		Transaction:       combineRes.SignedTransaction,
+	}, &parseRes))
+	require.Equal(t, []*AccountIdentifier{{Address: fromAddr.String()}}, parseRes.AccountIdentifierSigners)
+
+	signedTx, err := hex.DecodeString(combineRes.SignedTransaction)
+	require.NoError(t, err)
+
+	var hashRes TransactionIdentifierResponse
+	require.Equal(t, http.StatusOK, post(t, s, "/construction/hash", ConstructionHashRequest{
+		NetworkIdentifier: network,
+		SignedTransaction: combineRes.SignedTransaction,
+	}, &hashRes))
+	require.Equal(t, txHash(signedTx), hashRes.TransactionIdentifier.Hash)
+
+	require.Equal(t, http.StatusInternalServerError, post(t, s, "/construction/submit", ConstructionSubmitRequest{
+		NetworkIdentifier: network,
+		SignedTransaction: combineRes.SignedTransaction,
+	}, &errRes))
+	require.Equal(t, ErrOffline.Code, errRes.Code)
+}
+
+func TestNetworkEndpoints(t *testing.T) {
+	s := newTestServer()
+
+	var listRes NetworkListResponse
+	require.Equal(t, http.StatusOK, post(t, s, "/network/list", MetadataRequest{}, &listRes))
+	require.Equal(t, []*NetworkIdentifier{{Blockchain: "app", Network: "test-chain"}}, listRes.NetworkIdentifiers)
+
+	var optionsRes NetworkOptionsResponse
+	require.Equal(t, http.StatusOK, post(t, s, "/network/options", NetworkRequest{
+		NetworkIdentifier: s.networkIdentifier(),
+	}, &optionsRes))
+	require.Equal(t, SpecVersion, optionsRes.Version.RosettaVersion)
+	require.Equal(t, []string{OperationTransfer, OperationFee}, optionsRes.Allow.OperationTypes)
+	require.Len(t, optionsRes.Allow.Errors, len(allErrors))
+
+	var errRes Error
+	require.Equal(t, http.StatusInternalServerError, post(t, s, "/network/options", NetworkRequest{
+		NetworkIdentifier: &NetworkIdentifier{Blockchain: "app", Network: "other-chain"},
+	}, &errRes))
+	require.Equal(t, ErrInvalidNetwork.Code, errRes.Code)
+
+	require.Equal(t, http.StatusInternalServerError, post(t, s, "/network/status", NetworkRequest{
+		NetworkIdentifier: s.networkIdentifier(),
+	}, &errRes))
+	require.Equal(t, ErrOffline.Code, errRes.Code)
+}
+
+func TestOperationsToMsgs(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+
+	reversed := transferOperations(addr1, addr2, "10")
+	reversed[0], reversed[1] = reversed[1], reversed[0]
+
+	testCases := []struct {
+		name      string
+		ops       []*Operation
+		expAmount sdk.Coins
+		expErr    bool
+	}{
+		{"valid transfer", transferOperations(addr1, addr2, "10"), sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), false},
+		{"credit before debit", reversed, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), false},
+		{"merged transfers", append(transferOperations(addr1, addr2, "10"), transferOperations(addr1, addr2, "5")...), sdk.NewCoins(sdk.NewInt64Coin("stake", 15)), false},
+		{"no operations", nil, nil, true},
+		{"single operation", transferOperations(addr1, addr2, "10")[:1], nil, true},
+		{"zero amount", transferOperations(addr1, addr2, "0"), nil, true},
+		{"mismatched amounts", []*Operation{transferOperations(addr1, addr2, "10")[0], transferOperations(addr1, addr2, "5")[1]}, nil, true},
+		{"two debits", []*Operation{transferOperations(addr1, addr2, "10")[0], transferOperations(addr2, addr1, "10")[0]}, nil, true},
+		{"fee operation", []*Operation{{Type: OperationFee}, transferOperations(addr1, addr2, "10")[1]}, nil, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			msgs, err := operationsToMsgs(tc.ops)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, msgs, 1)
+			require.Equal(t, []sdk.AccAddress{addr1}, msgs[0].GetSigners())
+			require.Equal(t, transferOperations(addr1, addr2, tc.expAmount[0].Amount.String()), msgsToOperations(msgs, "", 0))
+		})
+	}
+}
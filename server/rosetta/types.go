@@ -0,0 +1,315 @@
+package rosetta
+
+// The types below model the subset of the Rosetta API specification served by
+// the Rosetta server. Their JSON encoding follows the specification at
+// https://www.rosetta-api.org/docs/api_objects.html.
+
+// NetworkIdentifier specifies which network a request refers to.
+type NetworkIdentifier struct {
+	Blockchain string `json:"blockchain"`
+	Network    string `json:"network"`
+}
+
+// BlockIdentifier uniquely identifies a block.
+type BlockIdentifier struct {
+	Index int64  `json:"index"`
+	Hash  string `json:"hash"`
+}
+
+// PartialBlockIdentifier identifies a block by its index, its hash or both.
+// An empty identifier refers to the latest block.
+type PartialBlockIdentifier struct {
+	Index *int64  `json:"index,omitempty"`
+	Hash  *string `json:"hash,omitempty"`
+}
+
+// TransactionIdentifier uniquely identifies a transaction.
+type TransactionIdentifier struct {
+	Hash string `json:"hash"`
+}
+
+// OperationIdentifier identifies an operation within a transaction.
+type OperationIdentifier struct {
+	Index int64 `json:"index"`
+}
+
+// AccountIdentifier uniquely identifies an account.
+type AccountIdentifier struct {
+	Address string `json:"address"`
+}
+
+// Currency is a denom of the chain.
+type Currency struct {
+	Symbol   string `json:"symbol"`
+	Decimals int32  `json:"decimals"`
+}
+
+// Amount is a signed amount of a Currency.
+type Amount struct {
+	Value    string    `json:"value"`
+	Currency *Currency `json:"currency"`
+}
+
+// Operation is a single balance change of an account.
+type Operation struct {
+	OperationIdentifier *OperationIdentifier   `json:"operation_identifier"`
+	RelatedOperations   []*OperationIdentifier `json:"related_operations,omitempty"`
+	Type                string                 `json:"type"`
+	Status              string                 `json:"status,omitempty"`
+	Account             *AccountIdentifier     `json:"account,omitempty"`
+	Amount              *Amount                `json:"amount,omitempty"`
+	Metadata            map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// Transaction contains the operations of a transaction.
+type Transaction struct {
+	TransactionIdentifier *TransactionIdentifier `json:"transaction_identifier"`
+	Operations            []*Operation           `json:"operations"`
+	Metadata              map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// Block contains the transactions of a block.
+type Block struct {
+	BlockIdentifier       *BlockIdentifier `json:"block_identifier"`
+	ParentBlockIdentifier *BlockIdentifier `json:"parent_block_identifier"`
+	Timestamp             int64            `json:"timestamp"`
+	Transactions          []*Transaction   `json:"transactions"`
+}
+
+// CurveType is the type of cryptographic curve of a PublicKey.
+type CurveType string
+
+// SignatureType is the type of a cryptographic signature.
+type SignatureType string
+
+const (
+	// Secp256k1 is the secp256k1 curve, with keys in their SEC compressed
+	// format.
+	Secp256k1 CurveType = "secp256k1"
+
+	// Ecdsa is an ECDSA signature in its r || s form over a 32 bytes hash.
+	Ecdsa SignatureType = "ecdsa"
+)
+
+// PublicKey is a hex-encoded public key.
+type PublicKey struct {
+	HexBytes  string    `json:"hex_bytes"`
+	CurveType CurveType `json:"curve_type"`
+}
+
+// SigningPayload is a payload to be signed by an account.
+type SigningPayload struct {
+	AccountIdentifier *AccountIdentifier `json:"account_identifier"`
+	HexBytes          string             `json:"hex_bytes"`
+	SignatureType     SignatureType      `json:"signature_type"`
+}
+
+// Signature is the signature of a SigningPayload.
+type Signature struct {
+	SigningPayload *SigningPayload `json:"signing_payload"`
+	PublicKey      *PublicKey      `json:"public_key"`
+	SignatureType  SignatureType   `json:"signature_type"`
+	HexBytes       string          `json:"hex_bytes"`
+}
+
+// Version describes the versions of the Rosetta specification and of the
+// node.
+type Version struct {
+	RosettaVersion    string `json:"rosetta_version"`
+	NodeVersion       string `json:"node_version"`
+	MiddlewareVersion string `json:"middleware_version,omitempty"`
+}
+
+// OperationStatus is a status an operation can have.
+type OperationStatus struct {
+	Status     string `json:"status"`
+	Successful bool   `json:"successful"`
+}
+
+// Allow describes the operation types, statuses and errors of the network.
+type Allow struct {
+	OperationStatuses       []*OperationStatus `json:"operation_statuses"`
+	OperationTypes          []string           `json:"operation_types"`
+	Errors                  []*Error           `json:"errors"`
+	HistoricalBalanceLookup bool               `json:"historical_balance_lookup"`
+}
+
+// SyncStatus describes the synchronization of the node.
+type SyncStatus struct {
+	CurrentIndex *int64 `json:"current_index,omitempty"`
+	Synced       *bool  `json:"synced,omitempty"`
+}
+
+// Peer is a peer of the node.
+type Peer struct {
+	PeerID   string                 `json:"peer_id"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// MetadataRequest is the request of /network/list.
+type MetadataRequest struct {
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// NetworkRequest is the request of /network/options and /network/status.
+type NetworkRequest struct {
+	NetworkIdentifier *NetworkIdentifier `json:"network_identifier"`
+}
+
+// NetworkListResponse is the response of /network/list.
+type NetworkListResponse struct {
+	NetworkIdentifiers []*NetworkIdentifier `json:"network_identifiers"`
+}
+
+// NetworkOptionsResponse is the response of /network/options.
+type NetworkOptionsResponse struct {
+	Version *Version `json:"version"`
+	Allow   *Allow   `json:"allow"`
+}
+
+// NetworkStatusResponse is the response of /network/status.
+type NetworkStatusResponse struct {
+	CurrentBlockIdentifier *BlockIdentifier `json:"current_block_identifier"`
+	CurrentBlockTimestamp  int64            `json:"current_block_timestamp"`
+	GenesisBlockIdentifier *BlockIdentifier `json:"genesis_block_identifier"`
+	OldestBlockIdentifier  *BlockIdentifier `json:"oldest_block_identifier,omitempty"`
+	SyncStatus             *SyncStatus      `json:"sync_status,omitempty"`
+	Peers                  []*Peer          `json:"peers"`
+}
+
+// AccountBalanceRequest is the request of /account/balance.
+type AccountBalanceRequest struct {
+	NetworkIdentifier *NetworkIdentifier      `json:"network_identifier"`
+	AccountIdentifier *AccountIdentifier      `json:"account_identifier"`
+	BlockIdentifier   *PartialBlockIdentifier `json:"block_identifier,omitempty"`
+}
+
+// AccountBalanceResponse is the response of /account/balance.
+type AccountBalanceResponse struct {
+	BlockIdentifier *BlockIdentifier `json:"block_identifier"`
+	Balances        []*Amount        `json:"balances"`
+}
+
+// BlockRequest is the request of /block.
+type BlockRequest struct {
+	NetworkIdentifier *NetworkIdentifier      `json:"network_identifier"`
+	BlockIdentifier   *PartialBlockIdentifier `json:"block_identifier"`
+}
+
+// BlockResponse is the response of /block.
+type BlockResponse struct {
+	Block *Block `json:"block,omitempty"`
+}
+
+// BlockTransactionRequest is the request of /block/transaction.
+type BlockTransactionRequest struct {
+	NetworkIdentifier     *NetworkIdentifier     `json:"network_identifier"`
+	BlockIdentifier       *BlockIdentifier       `json:"block_identifier"`
+	TransactionIdentifier *TransactionIdentifier `json:"transaction_identifier"`
+}
+
+// BlockTransactionResponse is the response of /block/transaction.
+type BlockTransactionResponse struct {
+	Transaction *Transaction `json:"transaction"`
+}
+
+// MempoolResponse is the response of /mempool.
+type MempoolResponse struct {
+	TransactionIdentifiers []*TransactionIdentifier `json:"transaction_identifiers"`
+}
+
+// ConstructionDeriveRequest is the request of /construction/derive.
+type ConstructionDeriveRequest struct {
+	NetworkIdentifier *NetworkIdentifier `json:"network_identifier"`
+	PublicKey         *PublicKey         `json:"public_key"`
+}
+
+// ConstructionDeriveResponse is the response of /construction/derive.
+type ConstructionDeriveResponse struct {
+	AccountIdentifier *AccountIdentifier `json:"account_identifier"`
+}
+
+// ConstructionPreprocessRequest is the request of /construction/preprocess.
+type ConstructionPreprocessRequest struct {
+	NetworkIdentifier *NetworkIdentifier     `json:"network_identifier"`
+	Operations        []*Operation           `json:"operations"`
+	Metadata          map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// ConstructionPreprocessResponse is the response of /construction/preprocess.
+type ConstructionPreprocessResponse struct {
+	Options            map[string]interface{} `json:"options,omitempty"`
+	RequiredPublicKeys []*AccountIdentifier   `json:"required_public_keys,omitempty"`
+}
+
+// ConstructionMetadataRequest is the request of /construction/metadata.
+type ConstructionMetadataRequest struct {
+	NetworkIdentifier *NetworkIdentifier     `json:"network_identifier"`
+	Options           map[string]interface{} `json:"options,omitempty"`
+	PublicKeys        []*PublicKey           `json:"public_keys,omitempty"`
+}
+
+// ConstructionMetadataResponse is the response of /construction/metadata.
+type ConstructionMetadataResponse struct {
+	Metadata     map[string]interface{} `json:"metadata"`
+	SuggestedFee []*Amount              `json:"suggested_fee,omitempty"`
+}
+
+// ConstructionPayloadsRequest is the request of /construction/payloads.
+type ConstructionPayloadsRequest struct {
+	NetworkIdentifier *NetworkIdentifier     `json:"network_identifier"`
+	Operations        []*Operation           `json:"operations"`
+	Metadata          map[string]interface{} `json:"metadata,omitempty"`
+	PublicKeys        []*PublicKey           `json:"public_keys,omitempty"`
+}
+
+// ConstructionPayloadsResponse is the response of /construction/payloads.
+type ConstructionPayloadsResponse struct {
+	UnsignedTransaction string            `json:"unsigned_transaction"`
+	Payloads            []*SigningPayload `json:"payloads"`
+}
+
+// ConstructionCombineRequest is the request of /construction/combine.
+type ConstructionCombineRequest struct {
+	NetworkIdentifier   *NetworkIdentifier `json:"network_identifier"`
+	UnsignedTransaction string             `json:"unsigned_transaction"`
+	Signatures          []*Signature       `json:"signatures"`
+}
+
+// ConstructionCombineResponse is the response of /construction/combine.
+type ConstructionCombineResponse struct {
+	SignedTransaction string `json:"signed_transaction"`
+}
+
+// ConstructionParseRequest is the request of /construction/parse.
+type ConstructionParseRequest struct {
+	NetworkIdentifier *NetworkIdentifier `json:"network_identifier"`
+	Signed            bool               `json:"signed"`
+	Transaction       string             `json:"transaction"`
+}
+
+// ConstructionParseResponse is the response of /construction/parse.
+type ConstructionParseResponse struct {
+	Operations               []*Operation           `json:"operations"`
+	AccountIdentifierSigners []*AccountIdentifier   `json:"account_identifier_signers,omitempty"`
+	Metadata                 map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// ConstructionHashRequest is the request of /construction/hash.
+type ConstructionHashRequest struct {
+	NetworkIdentifier *NetworkIdentifier `json:"network_identifier"`
+	SignedTransaction string             `json:"signed_transaction"`
+}
+
+// ConstructionSubmitRequest is the request of /construction/submit.
+type ConstructionSubmitRequest struct {
+	NetworkIdentifier *NetworkIdentifier `json:"network_identifier"`
+	SignedTransaction string             `json:"signed_transaction"`
+}
+
+// TransactionIdentifierResponse is the response of /construction/hash and
+// /construction/submit.
+type TransactionIdentifierResponse struct {
+	TransactionIdentifier *TransactionIdentifier `json:"transaction_identifier"`
+	Metadata              map[string]interface{} `json:"metadata,omitempty"`
+}
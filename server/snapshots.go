@@ -0,0 +1,204 @@
+package server
+
+// DONTCOVER
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+)
+
+// SnapshotCmd returns the snapshots command group, which manages state sync
+// snapshots of the application state stored in the node's data directory.
+func SnapshotCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage state sync snapshots of the application state",
+	}
+
+	cmd.AddCommand(
+		ListSnapshotsCmd(appCreator),
+		ExportSnapshotCmd(appCreator),
+		RestoreSnapshotCmd(appCreator),
+	)
+
+	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// ListSnapshotsCmd returns a command that lists the local snapshots.
+func ListSnapshotsCmd(appCreator types.AppCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List local snapshots",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			app, err := openSnapshotApp(cmd, appCreator)
+			if err != nil {
+				return err
+			}
+
+			snapshots, err := app.SnapshotManager().List()
+			if err != nil {
+				return err
+			}
+
+			for _, s := range snapshots {
+				cmd.Printf("height: %d format: %d chunks: %d hash: %X\n", s.Height, s.Format, s.Chunks, s.Hash)
+			}
+
+			return nil
+		},
+	}
+}
+
+// ExportSnapshotCmd returns a command that creates a snapshot of the
+// application state at its latest committed height.
+func ExportSnapshotCmd(appCreator types.AppCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "export",
+		Short: "Export the application state at the latest height to a local snapshot",
+		Long: `Export the application state at the latest committed height into a chunked,
+hashed snapshot stored in the node's snapshot directory (<home>/data/snapshots).
+The node must not be running.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			app, err := openSnapshotApp(cmd, appCreator)
+			if err != nil {
+				return err
+			}
+
+			height := app.Info(abci.RequestInfo{}).LastBlockHeight
+			if height <= 0 {
+				return errors.New("no committed state to export")
+			}
+
+			cmd.Printf("exporting snapshot for height %d\n", height)
+
+			snapshot, err := app.SnapshotManager().Create(uint64(height))
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf(
+				"snapshot created; height: %d format: %d chunks: %d hash: %X\n",
+				snapshot.Height, snapshot.Format, snapshot.Chunks, snapshot.Hash,
+			)
+
+			return nil
+		},
+	}
+}
+
+// RestoreSnapshotCmd returns a command that restores the application state
+// from a local snapshot.
+func RestoreSnapshotCmd(appCreator types.AppCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore [height] [format]",
+		Short: "Restore the application state from a local snapshot",
+		Long: fmt.Sprintf(`Restore the application state from a snapshot in the node's snapshot directory
+(<home>/data/snapshots), e.g. one copied from another node. The application
+database must be empty. If the format is omitted, the current snapshot format
+(%d) is used.
+
+Note, this only restores the application state. The Tendermint block store and
+state must be bootstrapped to the same height before the node can be started.`, snapshottypes.CurrentFormat),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height: %w", err)
+			}
+
+			format := snapshottypes.CurrentFormat
+			if len(args) > 1 {
+				f, err := strconv.ParseUint(args[1], 10, 32)
+				if err != nil {
+					return fmt.Errorf("invalid format: %w", err)
+				}
+
+				format = uint32(f)
+			}
+
+			app, err := openSnapshotApp(cmd, appCreator)
+			if err != nil {
+				return err
+			}
+
+			if latest := app.Info(abci.RequestInfo{}).LastBlockHeight; latest != 0 {
+				return fmt.Errorf("application state is not empty; found committed height %d", latest)
+			}
+
+			manager := app.SnapshotManager()
+
+			snapshot, err := manager.Get(height, format)
+			if err != nil {
+				return err
+			}
+			if snapshot == nil {
+				return fmt.Errorf("%w: height %d format %d", snapshottypes.ErrSnapshotNotFound, height, format)
+			}
+
+			if err := manager.Restore(*snapshot); err != nil {
+				return err
+			}
+
+			for i := uint32(0); i < snapshot.Chunks; i++ {
+				chunk, err := manager.LoadChunk(height, format, i)
+				if err != nil {
+					return err
+				}
+				if chunk == nil {
+					return fmt.Errorf("snapshot chunk %d is missing", i)
+				}
+
+				done, err := manager.RestoreChunk(chunk)
+				if err != nil {
+					return fmt.Errorf("failed to restore chunk %d: %w", i, err)
+				}
+				if done {
+					break
+				}
+			}
+
+			info := app.Info(abci.RequestInfo{})
+			cmd.Printf("restored application state; height: %d app hash: %X\n", info.LastBlockHeight, info.LastBlockAppHash)
+
+			return nil
+		},
+	}
+}
+
+// openSnapshotApp opens the application from the node's home directory and
+// ensures it has a snapshot manager configured.
+func openSnapshotApp(cmd *cobra.Command, appCreator types.AppCreator) (types.Application, error) {
+	serverCtx := GetServerContextFromCmd(cmd)
+	config := serverCtx.Config
+
+	if err := serverCtx.Viper.BindPFlags(cmd.Flags()); err != nil {
+		return nil, err
+	}
+
+	homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+	config.SetRoot(homeDir)
+
+	db, err := openDB(config.RootDir)
+	if err != nil {
+		return nil, err
+	}
+
+	app := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+	if app.SnapshotManager() == nil {
+		return nil, errors.New("application does not have a snapshot store configured")
+	}
+
+	return app, nil
+}
@@ -21,10 +21,10 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
-	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
+	"github.com/cosmos/cosmos-sdk/server/rosetta"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 )
@@ -48,6 +48,9 @@ const (
 	FlagPruningKeepRecent = "pruning-keep-recent"
 	FlagPruningKeepEvery  = "pruning-keep-every"
 	FlagPruningInterval   = "pruning-interval"
+
+	FlagSnapshotInterval   = "snapshot-interval"
+	FlagSnapshotKeepRecent = "snapshot-keep-recent"
 )
 
 // GRPC-related flags.
@@ -106,8 +109,7 @@ which accepts a path for the resulting pprof file.
 
 			serverCtx.Logger.Info("starting ABCI with Tendermint")
 
-			// amino is needed here for backwards compatibility of REST routes
-			err := startInProcess(serverCtx, clientCtx.LegacyAmino, appCreator)
+			err := startInProcess(serverCtx, clientCtx, appCreator)
 			return err
 		},
 	}
@@ -129,6 +131,8 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(FlagPruningKeepEvery, 0, "Offset heights to keep on disk after 'keep-every' (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
+	cmd.Flags().Uint64(FlagSnapshotInterval, 0, "State sync snapshot interval in blocks (0 to disable); must be a multiple of pruning-keep-every")
+	cmd.Flags().Uint32(FlagSnapshotKeepRecent, 2, "Number of recent state sync snapshots to keep (0 to keep all)")
 
 	cmd.Flags().Bool(flagGRPCEnable, true, "Define if the gRPC server should be enabled")
 	cmd.Flags().String(flagGRPCAddress, config.DefaultGRPCAddress, "the gRPC server address to listen on")
@@ -178,8 +182,7 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 	select {}
 }
 
-// legacyAminoCdc is used for the legacy REST API
-func startInProcess(ctx *Context, legacyAminoCdc *codec.LegacyAmino, appCreator types.AppCreator) error {
+func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.AppCreator) error {
 	cfg := ctx.Config
 	home := cfg.RootDir
 
@@ -216,27 +219,30 @@ func startInProcess(ctx *Context, legacyAminoCdc *codec.LegacyAmino, appCreator
 		return err
 	}
 
-	if err := tmNode.Start(); err != nil {
-		return err
-	}
-
-	var apiSrv *api.Server
-
 	config := config.GetConfig(ctx.Viper)
-	if config.API.Enable {
+	if config.API.Enable || config.GRPC.Enable || config.Rosetta.Enable {
 		genDoc, err := genDocProvider()
 		if err != nil {
 			return err
 		}
 
-		clientCtx := client.Context{}.
+		clientCtx = clientCtx.
 			WithHomeDir(home).
 			WithChainID(genDoc.ChainID).
-			WithJSONMarshaler(legacyAminoCdc).
-			// amino is needed here for backwards compatibility of REST routes
-			WithLegacyAmino(legacyAminoCdc).
 			WithClient(local.New(tmNode))
 
+		// the tx service must be registered before the node starts serving
+		// queries and before the gRPC server is started
+		app.RegisterTxService(clientCtx)
+	}
+
+	if err := tmNode.Start(); err != nil {
+		return err
+	}
+
+	var apiSrv *api.Server
+
+	if config.API.Enable {
 		apiSrv = api.New(clientCtx, ctx.Logger.With("module", "api-server"))
 		app.RegisterAPIRoutes(apiSrv)
 
@@ -263,6 +269,25 @@ func startInProcess(ctx *Context, legacyAminoCdc *codec.LegacyAmino, appCreator
 		}
 	}
 
+	var rosettaSrv *rosetta.Server
+	if config.Rosetta.Enable {
+		rosettaSrv = rosetta.New(clientCtx, config.Rosetta, ctx.Logger.With("module", "rosetta-server"))
+
+		errCh := make(chan error)
+
+		go func() {
+			if err := rosettaSrv.Start(); err != nil {
+				errCh <- err
+			}
+		}()
+
+		select {
+		case err := <-errCh:
+			return err
+		case <-time.After(5 * time.Second): // assume server started successfully
+		}
+	}
+
 	var cpuProfileCleanup func()
 
 	if cpuProfile := ctx.Viper.GetString(flagCPUProfile); cpuProfile != "" {
@@ -300,6 +325,10 @@ func startInProcess(ctx *Context, legacyAminoCdc *codec.LegacyAmino, appCreator
 			grpcSrv.Stop()
 		}
 
+		if rosettaSrv != nil {
+			_ = rosettaSrv.Close()
+		}
+
 		ctx.Logger.Info("exiting...")
 	})
 
@@ -10,7 +10,10 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/api"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type (
@@ -36,6 +39,18 @@ type (
 		// RegisterGRPCServer registers gRPC services directly with the gRPC
 		// server.
 		RegisterGRPCServer(grpc.Server)
+
+		// RegisterTxService registers the gRPC Tx service, which uses the
+		// provided client context to query transactions from Tendermint.
+		RegisterTxService(clientCtx client.Context)
+
+		// SnapshotManager returns the application's state sync snapshot manager,
+		// or nil if no snapshot store is configured.
+		SnapshotManager() *snapshots.Manager
+
+		// CommitMultiStore returns the multi-store holding the application state,
+		// which the offline commands operate on.
+		CommitMultiStore() sdk.CommitMultiStore
 	}
 
 	// AppCreator is a function that allows us to lazily initialize an
@@ -187,6 +187,9 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 		flags.LineBreak,
 		tendermintCmd,
 		ExportCmd(appExport, defaultNodeHome),
+		SnapshotCmd(appCreator, defaultNodeHome),
+		RollbackCmd(appCreator, defaultNodeHome),
+		PruneCmd(appCreator, defaultNodeHome),
 		flags.LineBreak,
 		version.NewVersionCommand(),
 	)
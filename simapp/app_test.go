@@ -10,6 +10,11 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 func TestSimAppExport(t *testing.T) {
@@ -49,3 +54,37 @@ func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
 }
+
+func TestBankStoreMigrationUpgrade(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 10})
+	store := ctx.KVStore(app.GetKey(banktypes.StoreKey))
+
+	// replace the supply written by the genesis with the v0.39 layout
+	total := sdk.NewCoins(sdk.NewInt64Coin("bar", 20), sdk.NewInt64Coin("foo", 10))
+	iterator := sdk.KVStorePrefixIterator(store, banktypes.SupplyKey)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	bz, err := codec.MarshalAny(app.AppCodec(), banktypes.NewSupply(total))
+	require.NoError(t, err)
+	store.Set(banktypes.SupplyKey, bz)
+	require.True(t, app.BankKeeper.GetSupply(ctx, "foo").IsZero())
+
+	require.True(t, app.UpgradeKeeper.HasHandler(BankStoreMigrationUpgrade))
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: BankStoreMigrationUpgrade, Height: ctx.BlockHeight()})
+
+	for _, coin := range total {
+		require.Equal(t, coin, app.BankKeeper.GetSupply(ctx, coin.Denom))
+	}
+
+	supply, _, err := app.BankKeeper.GetPaginatedTotalSupply(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, total, supply)
+}
@@ -23,6 +23,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -51,7 +52,7 @@ func Setup(isCheckTx bool) *SimApp {
 	app := NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, 5, MakeEncodingConfig())
 	if !isCheckTx {
 		// init chain must be called to stop deliverState from being nil
-		genesisState := NewDefaultGenesisState()
+		genesisState := WithoutBaseFee(NewDefaultGenesisState())
 		stateBytes, err := json.MarshalIndent(genesisState, "", " ")
 		if err != nil {
 			panic(err)
@@ -70,6 +71,22 @@ func Setup(isCheckTx bool) *SimApp {
 	return app
 }
 
+// WithoutBaseFee sets a zero base fee and minimum base fee in the fee market
+// genesis of genesisState, so that the test transactions are not required to
+// pay any fee.
+func WithoutBaseFee(genesisState GenesisState) GenesisState {
+	cdc := MakeEncodingConfig().Marshaler
+
+	var feemarketGenesis feemarkettypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[feemarkettypes.ModuleName], &feemarketGenesis)
+
+	feemarketGenesis.Params.MinBaseFee = sdk.ZeroDec()
+	feemarketGenesis.BaseFee = sdk.ZeroDec()
+	genesisState[feemarkettypes.ModuleName] = cdc.MustMarshalJSON(&feemarketGenesis)
+
+	return genesisState
+}
+
 // SetupWithGenesisValSet initializes a new SimApp with a validator set and genesis accounts
 // that also act as delegators. For simplicity, each validator is bonded with a delegation
 // of one consensus engine unit (10^6) in the default token of the simapp from first genesis
@@ -78,7 +95,7 @@ func SetupWithGenesisValSet(t *testing.T, valSet *tmtypes.ValidatorSet, genAccs
 	db := dbm.NewMemDB()
 	app := NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, 5, MakeEncodingConfig())
 
-	genesisState := NewDefaultGenesisState()
+	genesisState := WithoutBaseFee(NewDefaultGenesisState())
 
 	// set genesis accounts
 	authGenesis := authtypes.NewGenesisState(authtypes.DefaultParams(), genAccs)
@@ -153,7 +170,7 @@ func SetupWithGenesisAccounts(genAccs []authtypes.GenesisAccount, balances ...ba
 	app := NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig())
 
 	// initialize the chain with the passed in genesis accounts
-	genesisState := NewDefaultGenesisState()
+	genesisState := WithoutBaseFee(NewDefaultGenesisState())
 
 	authGenesis := authtypes.NewGenesisState(authtypes.DefaultParams(), genAccs)
 	genesisState[authtypes.ModuleName] = app.AppCodec().MustMarshalJSON(authGenesis)
@@ -234,9 +251,9 @@ func AddTestAddrsFromPubKeys(app *SimApp, ctx sdk.Context, pubKeys []crypto.PubK
 
 // setTotalSupply provides the total supply based on accAmt * totalAccounts.
 func setTotalSupply(app *SimApp, ctx sdk.Context, accAmt sdk.Int, totalAccounts int) {
-	totalSupply := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), accAmt.MulRaw(int64(totalAccounts))))
-	prevSupply := app.BankKeeper.GetSupply(ctx)
-	app.BankKeeper.SetSupply(ctx, banktypes.NewSupply(prevSupply.GetTotal().Add(totalSupply...)))
+	totalSupply := sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), accAmt.MulRaw(int64(totalAccounts)))
+	prevSupply := app.BankKeeper.GetSupply(ctx, totalSupply.Denom)
+	app.BankKeeper.SetSupply(ctx, prevSupply.Add(totalSupply))
 }
 
 // AddTestAddrs constructs and returns accNum amount of accounts with an
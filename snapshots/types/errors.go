@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SnapshotCodespace is the codespace used by snapshot errors.
+const SnapshotCodespace = "snapshots"
+
+var (
+	// ErrUnknownFormat is returned when an unknown format is used.
+	ErrUnknownFormat = sdkerrors.Register(SnapshotCodespace, 2, "unknown snapshot format")
+
+	// ErrChunkHashMismatch is returned when chunk hash verification failed.
+	ErrChunkHashMismatch = sdkerrors.Register(SnapshotCodespace, 3, "chunk hash verification failed")
+
+	// ErrInvalidMetadata is returned when the snapshot metadata is invalid.
+	ErrInvalidMetadata = sdkerrors.Register(SnapshotCodespace, 4, "invalid snapshot metadata")
+
+	// ErrSnapshotNotFound is returned when a snapshot does not exist in the store.
+	ErrSnapshotNotFound = sdkerrors.Register(SnapshotCodespace, 5, "snapshot not found")
+)
@@ -3,6 +3,7 @@ package cachemulti
 import (
 	"fmt"
 	"io"
+	"sort"
 
 	dbm "github.com/tendermint/tm-db"
 
@@ -107,11 +108,22 @@ func (cms Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
 }
 
-// Write calls Write on each underlying store.
+// Write calls Write on each underlying store, sorted by name so that the
+// writes reach the listeners of the stores in a deterministic order.
 func (cms Store) Write() {
 	cms.db.Write()
-	for _, store := range cms.stores {
-		store.Write()
+
+	keys := make([]types.StoreKey, 0, len(cms.stores))
+	for key := range cms.stores {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Name() < keys[j].Name()
+	})
+
+	for _, key := range keys {
+		cms.stores[key].Write()
 	}
 }
 
@@ -0,0 +1,236 @@
+package decoupled
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/internal/keys"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var (
+	_ types.KVStore  = (*historicalStore)(nil)
+	_ types.Iterator = (*historyIterator)(nil)
+)
+
+// historicalStore is a read-only KVStore serving the state of a Store at a
+// past version from the history of its state storage.
+type historicalStore struct {
+	db      dbm.DB
+	version int64
+}
+
+// Implements Store.
+func (hs *historicalStore) GetStoreType() types.StoreType {
+	return types.StoreTypeDecoupled
+}
+
+// Implements Store.
+func (hs *historicalStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(hs)
+}
+
+// CacheWrapWithTrace implements the Store interface.
+func (hs *historicalStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(hs, w, tc))
+}
+
+// Implements types.KVStore.
+func (hs *historicalStore) Get(key []byte) []byte {
+	value, err := getVersioned(hs.db, key, hs.version)
+	if err != nil {
+		panic(err)
+	}
+
+	return value
+}
+
+// Implements types.KVStore.
+func (hs *historicalStore) Has(key []byte) bool {
+	return hs.Get(key) != nil
+}
+
+// Set panics as a historicalStore is read-only.
+func (hs *historicalStore) Set(_, _ []byte) {
+	panic("cannot write to a historical decoupled store")
+}
+
+// Delete panics as a historicalStore is read-only.
+func (hs *historicalStore) Delete(_ []byte) {
+	panic("cannot write to a historical decoupled store")
+}
+
+// Implements types.KVStore.
+func (hs *historicalStore) Iterator(start, end []byte) types.Iterator {
+	return newHistoryIterator(hs.db, start, end, hs.version, true)
+}
+
+// Implements types.KVStore.
+func (hs *historicalStore) ReverseIterator(start, end []byte) types.Iterator {
+	return newHistoryIterator(hs.db, start, end, hs.version, false)
+}
+
+// getVersioned returns the value of key at the given version, or nil if the
+// key was not set at this version.
+func getVersioned(db dbm.DB, key []byte, version int64) ([]byte, error) {
+	iter, err := db.ReverseIterator(historyKey(key, 0), historyKey(key, version+1))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil, iter.Error()
+	}
+
+	return parseValue(iter.Value()), nil
+}
+
+// parseValue returns the value of a history entry, or nil for a tombstone.
+func parseValue(bz []byte) []byte {
+	if bz[0] == valueDeleted {
+		return nil
+	}
+
+	return bz[1:]
+}
+
+func parseVersion(bz []byte) int64 {
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+//----------------------------------------
+
+// historyIterator iterates over the history entries of a range of keys and
+// yields the value of each key at a given version. As the entries of a key are
+// contiguous and ordered by version, it only keeps the last entry of each key
+// up to the version when iterating in ascending order, and the first one when
+// iterating in descending order.
+type historyIterator struct {
+	start, end []byte
+
+	source    dbm.Iterator
+	version   int64
+	ascending bool
+
+	key, value []byte
+	valid      bool
+	err        error
+}
+
+func newHistoryIterator(db dbm.DB, start, end []byte, version int64, ascending bool) *historyIterator {
+	lower := historyPrefix
+	if start != nil {
+		lower = concat(historyPrefix, keys.Encode(start))
+	}
+
+	upper := types.PrefixEndBytes(historyPrefix)
+	if end != nil {
+		upper = concat(historyPrefix, keys.Encode(end))
+	}
+
+	var (
+		source dbm.Iterator
+		err    error
+	)
+
+	if ascending {
+		source, err = db.Iterator(lower, upper)
+	} else {
+		source, err = db.ReverseIterator(lower, upper)
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	iter := &historyIterator{
+		start:     start,
+		end:       end,
+		source:    source,
+		version:   version,
+		ascending: ascending,
+	}
+	iter.next()
+
+	return iter
+}
+
+// next moves to the next key set at the version of the iterator.
+func (iter *historyIterator) next() {
+	for iter.source.Valid() {
+		key, _ := splitHistoryKey(iter.source.Key())
+
+		var value []byte
+		for ; iter.source.Valid(); iter.source.Next() {
+			entryKey, version := splitHistoryKey(iter.source.Key())
+			if !bytes.Equal(entryKey, key) {
+				break
+			}
+
+			if version <= iter.version && (value == nil || iter.ascending) {
+				value = iter.source.Value()
+			}
+		}
+
+		if value != nil && value[0] == valueSet {
+			iter.key, iter.value, iter.valid = key, value[1:], true
+			return
+		}
+	}
+
+	iter.err = iter.source.Error()
+	iter.key, iter.value, iter.valid = nil, nil, false
+}
+
+// Implements types.Iterator.
+func (iter *historyIterator) Domain() (start, end []byte) {
+	return iter.start, iter.end
+}
+
+// Implements types.Iterator.
+func (iter *historyIterator) Valid() bool {
+	return iter.valid
+}
+
+// Implements types.Iterator.
+func (iter *historyIterator) Next() {
+	if !iter.valid {
+		panic("invalid iterator")
+	}
+
+	iter.next()
+}
+
+// Implements types.Iterator.
+func (iter *historyIterator) Key() []byte {
+	if !iter.valid {
+		panic("invalid iterator")
+	}
+
+	return iter.key
+}
+
+// Implements types.Iterator.
+func (iter *historyIterator) Value() []byte {
+	if !iter.valid {
+		panic("invalid iterator")
+	}
+
+	return iter.value
+}
+
+// Implements types.Iterator.
+func (iter *historyIterator) Error() error {
+	return iter.err
+}
+
+// Implements types.Iterator.
+func (iter *historyIterator) Close() {
+	iter.source.Close()
+}
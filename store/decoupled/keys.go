@@ -0,0 +1,68 @@
+package decoupled
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/internal/keys"
+)
+
+var (
+	// statePrefix prefixes the latest committed value of each key.
+	statePrefix = []byte("s/")
+
+	// historyPrefix prefixes the history of the values of each key, keyed by
+	// keys.Encode(key) | version. A deleted key is recorded as a tombstone.
+	historyPrefix = []byte("h/")
+
+	// changesPrefix prefixes the index of the keys changed by each version,
+	// keyed by version | key.
+	changesPrefix = []byte("c/")
+
+	// versionsPrefix prefixes an empty entry for each retained version.
+	versionsPrefix = []byte("v/")
+
+	// commitmentPrefix prefixes the nodes of the commitment store.
+	commitmentPrefix = []byte("t/")
+)
+
+const (
+	valueDeleted byte = iota
+	valueSet
+)
+
+func versionBytes(version int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(version))
+	return bz
+}
+
+func concat(parts ...[]byte) []byte {
+	var bz []byte
+	for _, part := range parts {
+		bz = append(bz, part...)
+	}
+
+	return bz
+}
+
+func stateKey(key []byte) []byte {
+	return concat(statePrefix, key)
+}
+
+func historyKey(key []byte, version int64) []byte {
+	return concat(historyPrefix, keys.Encode(key), versionBytes(version))
+}
+
+// splitHistoryKey returns the key and the version of a history entry.
+func splitHistoryKey(bz []byte) ([]byte, int64) {
+	key, n := keys.Decode(bz[len(historyPrefix):])
+	return key, int64(binary.BigEndian.Uint64(bz[len(historyPrefix)+n:]))
+}
+
+func changesKey(version int64, key []byte) []byte {
+	return concat(changesPrefix, versionBytes(version), key)
+}
+
+func versionKey(version int64) []byte {
+	return concat(versionsPrefix, versionBytes(version))
+}
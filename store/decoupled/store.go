@@ -0,0 +1,670 @@
+package decoupled
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	iavltree "github.com/tendermint/iavl"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/smt"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+var (
+	_ types.KVStore       = (*Store)(nil)
+	_ types.CommitKVStore = (*Store)(nil)
+	_ types.Queryable     = (*Store)(nil)
+)
+
+// CommitmentStore is the Merkle structure committing to the state of a Store.
+// It is written to on each commit and only read to build the proofs of the
+// queries, all the other reads are served by the state storage.
+type CommitmentStore interface {
+	types.CommitKVStore
+	types.Queryable
+
+	DeleteVersions(versions ...int64) error
+}
+
+// Store implements types.CommitKVStore by decoupling the storage of the state
+// from its commitment. The state storage is a flat key/value database holding
+// the latest value and the versioned history of each key, so that reads at the
+// latest and at historical heights don't walk a tree. A separate
+// CommitmentStore is kept in sync with it and only produces the commit hashes
+// and the ICS23 proofs.
+type Store struct {
+	db    dbm.DB
+	state dbadapter.Store
+	sc    CommitmentStore
+
+	// working holds the writes of the version being built, they are flushed
+	// to the state storage and to the commitment store on Commit.
+	working *cachekv.Store
+	writer  *stateWriter
+
+	logger log.Logger
+}
+
+// LoadStore returns a Store as a CommitKVStore with an IAVL commitment store.
+// Internally, it will load the store's version (id) from the provided DB. An
+// error is returned if the version fails to load.
+func LoadStore(db dbm.DB, id types.CommitID, lazyLoading bool) (types.CommitKVStore, error) {
+	return LoadStoreWithCommitment(db, id, lazyLoading, types.StoreTypeIAVL)
+}
+
+// LoadStoreWithCommitment returns a Store as a CommitKVStore with a commitment
+// store of the given type, IAVL or SMT. Internally, it will load the store's
+// version (id) from the provided DB. An error is returned if the version fails
+// to load.
+func LoadStoreWithCommitment(db dbm.DB, id types.CommitID, lazyLoading bool, commitment types.StoreType) (types.CommitKVStore, error) {
+	latest, err := latestVersion(db)
+	if err != nil {
+		return nil, err
+	}
+
+	// the versions after the loaded one are rolled back, in the commitment
+	// store as well as in the state storage
+	overwrite := latest > id.Version
+	if overwrite && id.Version != 0 && !hasKey(db, versionKey(id.Version)) {
+		return nil, fmt.Errorf("cannot roll back the state storage to the pruned version %d", id.Version)
+	}
+
+	var sc types.CommitKVStore
+
+	scDB := dbm.NewPrefixDB(db, commitmentPrefix)
+	switch commitment {
+	case types.StoreTypeIAVL:
+		if overwrite {
+			sc, err = iavl.LoadStoreForOverwriting(scDB, id)
+		} else {
+			sc, err = iavl.LoadStore(scDB, id, lazyLoading)
+		}
+
+	case types.StoreTypeSMT:
+		if overwrite {
+			sc, err = smt.LoadStoreForOverwriting(scDB, id)
+		} else {
+			sc, err = smt.LoadStore(scDB, id, lazyLoading)
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported commitment store type %s", commitment)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewStore(db, sc.(CommitmentStore))
+}
+
+// NewStore returns a Store storing its state in db and committing to it with
+// sc. The commitment store must be loaded at the version the store is loaded
+// at: the state storage is rolled back to it if it is ahead, and rebuilt from
+// the commitment store if it is empty, e.g. after a snapshot was restored.
+func NewStore(db dbm.DB, sc CommitmentStore) (*Store, error) {
+	st := &Store{
+		db:     db,
+		state:  dbadapter.Store{DB: dbm.NewPrefixDB(db, statePrefix)},
+		sc:     sc,
+		logger: log.NewNopLogger(),
+	}
+	st.writer = &stateWriter{Store: st.state, sc: sc}
+	st.working = cachekv.NewStore(st.writer)
+
+	version := sc.LastCommitID().Version
+	latest, err := latestVersion(db)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case latest == version:
+
+	case latest == 0:
+		if err := st.rebuild(version); err != nil {
+			return nil, err
+		}
+
+	case latest > version:
+		if !st.VersionExists(version) && version != 0 {
+			return nil, fmt.Errorf("cannot roll back the state storage to the pruned version %d", version)
+		}
+
+		for latest > version {
+			if err := st.revert(latest); err != nil {
+				return nil, err
+			}
+
+			if latest, err = latestVersion(db); err != nil {
+				return nil, err
+			}
+		}
+
+	default:
+		return nil, fmt.Errorf("state storage at version %d is behind the commitment at version %d", latest, version)
+	}
+
+	return st, nil
+}
+
+// Commit flushes the writes of the working set to the state storage and to the
+// commitment store, and returns a CommitID with the new version and the hash
+// of the commitment.
+func (st *Store) Commit() types.CommitID {
+	defer telemetry.MeasureSince(time.Now(), "store", "decoupled", "commit")
+
+	version := st.sc.LastCommitID().Version + 1
+
+	batch := st.db.NewBatch()
+	defer batch.Close()
+
+	st.writer.batch, st.writer.version = batch, version
+	st.working.Write()
+	st.writer.batch = nil
+
+	batch.Set(versionKey(version), []byte{})
+	if err := batch.Write(); err != nil {
+		panic(err)
+	}
+
+	id := st.sc.Commit()
+	if id.Version != version {
+		panic(fmt.Sprintf("commitment store committed version %d instead of %d", id.Version, version))
+	}
+
+	return id
+}
+
+// SetLogger sets the logger the store reports its inconsistencies to.
+func (st *Store) SetLogger(logger log.Logger) {
+	st.logger = logger
+}
+
+// Implements Committer.
+func (st *Store) LastCommitID() types.CommitID {
+	return st.sc.LastCommitID()
+}
+
+// SetPruning panics as pruning options should be provided to the root store,
+// which prunes the versions through DeleteVersions.
+func (st *Store) SetPruning(_ types.PruningOptions) {
+	panic("cannot set pruning options on an initialized decoupled store")
+}
+
+// GetPruning panics as pruning options should be provided to the root store,
+// which prunes the versions through DeleteVersions.
+func (st *Store) GetPruning() types.PruningOptions {
+	panic("cannot get pruning options on an initialized decoupled store")
+}
+
+// VersionExists returns whether or not a given version is stored.
+func (st *Store) VersionExists(version int64) bool {
+	return hasKey(st.db, versionKey(version))
+}
+
+// CommitmentType returns the type of the commitment store, IAVL or SMT.
+func (st *Store) CommitmentType() types.StoreType {
+	return st.sc.GetStoreType()
+}
+
+// Implements Store.
+func (st *Store) GetStoreType() types.StoreType {
+	return types.StoreTypeDecoupled
+}
+
+// Implements Store.
+func (st *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(st)
+}
+
+// CacheWrapWithTrace implements the Store interface.
+func (st *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(st, w, tc))
+}
+
+// Implements types.KVStore.
+func (st *Store) Set(key, value []byte) {
+	defer telemetry.MeasureSince(time.Now(), "store", "decoupled", "set")
+	types.AssertValidKey(key)
+	types.AssertValidValue(value)
+	st.working.Set(key, value)
+}
+
+// Implements types.KVStore.
+func (st *Store) Get(key []byte) []byte {
+	defer telemetry.MeasureSince(time.Now(), "store", "decoupled", "get")
+	return st.working.Get(key)
+}
+
+// Implements types.KVStore.
+func (st *Store) Has(key []byte) bool {
+	defer telemetry.MeasureSince(time.Now(), "store", "decoupled", "has")
+	return st.working.Has(key)
+}
+
+// Implements types.KVStore.
+func (st *Store) Delete(key []byte) {
+	defer telemetry.MeasureSince(time.Now(), "store", "decoupled", "delete")
+	st.working.Delete(key)
+}
+
+// Implements types.KVStore.
+func (st *Store) Iterator(start, end []byte) types.Iterator {
+	return st.working.Iterator(start, end)
+}
+
+// Implements types.KVStore.
+func (st *Store) ReverseIterator(start, end []byte) types.Iterator {
+	return st.working.ReverseIterator(start, end)
+}
+
+// GetImmutable returns a read-only store serving the state at a specific
+// version (height) from the state storage. This should be used for querying
+// and iteration only. If the version does not exist or has been pruned, an
+// error will be returned. Any mutable operations executed will result in a
+// panic.
+func (st *Store) GetImmutable(version int64) (types.KVStore, error) {
+	if !st.VersionExists(version) {
+		return nil, iavltree.ErrVersionDoesNotExist
+	}
+
+	return &historicalStore{db: st.db, version: version}, nil
+}
+
+// DeleteVersions deletes a series of versions from the state storage and from
+// the commitment store. The history entries that are not needed anymore to
+// read the remaining versions are deleted along with them. The latest version
+// cannot be deleted.
+func (st *Store) DeleteVersions(versions ...int64) error {
+	for _, version := range versions {
+		if !st.VersionExists(version) {
+			continue
+		}
+
+		if err := st.deleteVersion(version); err != nil {
+			return err
+		}
+	}
+
+	return st.sc.DeleteVersions(versions...)
+}
+
+// Export exports the commitment store at the given version, returning an
+// iavl.Exporter for the tree. Only IAVL commitment stores can be exported. The
+// caller must call Close() on the exporter when done.
+func (st *Store) Export(version int64) (*iavltree.Exporter, error) {
+	sc, ok := st.sc.(*iavl.Store)
+	if !ok {
+		return nil, fmt.Errorf("cannot export commitment store of type %T", st.sc)
+	}
+
+	return sc.Export(version)
+}
+
+// Import imports the commitment store at the given version, returning an
+// iavl.Importer for importing. The state storage is rebuilt from the imported
+// tree when the store is loaded again. The store must be empty, i.e. at
+// version 0.
+func (st *Store) Import(version int64) (*iavltree.Importer, error) {
+	sc, ok := st.sc.(*iavl.Store)
+	if !ok {
+		return nil, fmt.Errorf("cannot import commitment store of type %T", st.sc)
+	}
+
+	return sc.Import(version)
+}
+
+// getHeight returns the height to serve a query at: the requested height, or
+// the latest height with a proof available if it is 0.
+func (st *Store) getHeight(req abci.RequestQuery) int64 {
+	if req.Height != 0 {
+		return req.Height
+	}
+
+	latest := st.LastCommitID().Version
+	if st.VersionExists(latest - 1) {
+		return latest - 1
+	}
+
+	return latest
+}
+
+// Query implements ABCI interface, allows queries.
+//
+// Values are read from the state storage, the commitment store is only queried
+// for the proof of the value when one is requested. As with IAVL stores, the
+// query is served at the latest height - 1 by default as we will have merkle
+// proofs immediately (header height = data height + 1).
+func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
+	defer telemetry.MeasureSince(time.Now(), "store", "decoupled", "query")
+
+	if len(req.Data) == 0 {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrTxDecode, "query cannot be zero length"))
+	}
+
+	res.Height = st.getHeight(req)
+
+	view, err := st.GetImmutable(res.Height)
+	if err != nil {
+		res.Key = req.Data
+		res.Log = err.Error()
+		return res
+	}
+
+	switch req.Path {
+	case "/key":
+		res.Key = req.Data
+		res.Value = view.Get(req.Data)
+		if !req.Prove {
+			break
+		}
+
+		req.Height = res.Height
+		proofRes := st.sc.Query(req)
+		if proofRes.Code != 0 {
+			return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to prove key %X at height %d: %s", req.Data, res.Height, proofRes.Log))
+		}
+
+		// sanity check: the state storage and the commitment must agree
+		if !bytes.Equal(proofRes.Value, res.Value) {
+			st.logger.Error("state storage and commitment disagree", "key", fmt.Sprintf("%X", req.Data), "height", res.Height)
+			return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrLogic, "state storage and commitment disagree on key %X at height %d", req.Data, res.Height))
+		}
+
+		res.Proof = proofRes.Proof
+
+	case "/subspace":
+		pairs := kv.Pairs{
+			Pairs: make([]kv.Pair, 0),
+		}
+
+		subspace := req.Data
+		res.Key = subspace
+
+		iterator := types.KVStorePrefixIterator(view, subspace)
+		for ; iterator.Valid(); iterator.Next() {
+			pairs.Pairs = append(pairs.Pairs, kv.Pair{Key: iterator.Key(), Value: iterator.Value()})
+		}
+		iterator.Close()
+
+		bz, err := pairs.Marshal()
+		if err != nil {
+			panic(fmt.Errorf("failed to marshal KV pairs: %w", err))
+		}
+
+		res.Value = bz
+
+	default:
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected query path: %v", req.Path))
+	}
+
+	return res
+}
+
+//----------------------------------------
+
+func hasKey(db dbm.DB, key []byte) bool {
+	ok, err := db.Has(key)
+	if err != nil {
+		panic(err)
+	}
+
+	return ok
+}
+
+// latestVersion returns the latest version of the state storage, or 0 if it
+// is empty.
+func latestVersion(db dbm.DB) (int64, error) {
+	return previousVersion(db, math.MaxInt64)
+}
+
+// previousVersion returns the latest retained version stored before the given
+// version, or 0 if there is none.
+func previousVersion(db dbm.DB, version int64) (int64, error) {
+	iter, err := db.ReverseIterator(versionsPrefix, versionKey(version))
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, nil
+	}
+
+	return parseVersion(iter.Key()[len(versionsPrefix):]), nil
+}
+
+// nextVersion returns the first retained version stored after the given
+// version, or 0 if there is none.
+func (st *Store) nextVersion(version int64) (int64, error) {
+	iter, err := st.db.Iterator(versionKey(version+1), types.PrefixEndBytes(versionsPrefix))
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, nil
+	}
+
+	return parseVersion(iter.Key()[len(versionsPrefix):]), nil
+}
+
+// changedKeys returns the keys changed since the previous retained version by
+// the given version.
+func (st *Store) changedKeys(version int64) ([][]byte, error) {
+	prefix := concat(changesPrefix, versionBytes(version))
+
+	iter, err := st.db.Iterator(prefix, types.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key()[len(prefix):])
+	}
+
+	return keys, iter.Error()
+}
+
+// lastChange returns the version of the last change of key up to the given
+// version, or 0 if there is none.
+func (st *Store) lastChange(key []byte, version int64) (int64, error) {
+	iter, err := st.db.ReverseIterator(historyKey(key, 0), historyKey(key, version+1))
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, nil
+	}
+
+	_, last := splitHistoryKey(iter.Key())
+	return last, nil
+}
+
+// deleteHistory deletes in batch the history entries of key in the version
+// range (from, to].
+func (st *Store) deleteHistory(batch dbm.Batch, key []byte, from, to int64) error {
+	iter, err := st.db.Iterator(historyKey(key, from+1), historyKey(key, to+1))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		batch.Delete(iter.Key())
+	}
+
+	return iter.Error()
+}
+
+// deleteVersion deletes a version of the state storage. The changes index of
+// each version covers the keys changed since the previous retained version, so
+// the entries changed by version v, previous retained version p and next
+// retained version n are:
+//
+// - deleted if they were overwritten before v, as no version reads them.
+// - deleted if they are overwritten again by n, as no version reads them anymore.
+// - merged in the changes index of n otherwise, as n reads them.
+func (st *Store) deleteVersion(version int64) error {
+	previous, err := previousVersion(st.db, version)
+	if err != nil {
+		return err
+	}
+
+	next, err := st.nextVersion(version)
+	if err != nil {
+		return err
+	}
+
+	if next == 0 {
+		return fmt.Errorf("cannot delete latest version %d", version)
+	}
+
+	keys, err := st.changedKeys(version)
+	if err != nil {
+		return err
+	}
+
+	batch := st.db.NewBatch()
+	defer batch.Close()
+
+	for _, key := range keys {
+		// the last entry of the key is kept as the next version reads it, unless
+		// the next version overwrote it
+		until := version
+		if !hasKey(st.db, changesKey(next, key)) {
+			last, err := st.lastChange(key, version)
+			if err != nil {
+				return err
+			}
+
+			until = last - 1
+		}
+
+		if until > previous {
+			if err := st.deleteHistory(batch, key, previous, until); err != nil {
+				return err
+			}
+		}
+
+		batch.Delete(changesKey(version, key))
+		batch.Set(changesKey(next, key), []byte{})
+	}
+
+	batch.Delete(versionKey(version))
+
+	return batch.Write()
+}
+
+// revert rolls back the latest version of the state storage to the previous
+// retained version.
+func (st *Store) revert(version int64) error {
+	previous, err := previousVersion(st.db, version)
+	if err != nil {
+		return err
+	}
+
+	keys, err := st.changedKeys(version)
+	if err != nil {
+		return err
+	}
+
+	batch := st.db.NewBatch()
+	defer batch.Close()
+
+	for _, key := range keys {
+		if err := st.deleteHistory(batch, key, previous, version); err != nil {
+			return err
+		}
+
+		value, err := getVersioned(st.db, key, previous)
+		if err != nil {
+			return err
+		}
+
+		if value == nil {
+			batch.Delete(stateKey(key))
+		} else {
+			batch.Set(stateKey(key), value)
+		}
+
+		batch.Delete(changesKey(version, key))
+	}
+
+	batch.Delete(versionKey(version))
+
+	return batch.Write()
+}
+
+// rebuild writes the state of the commitment store as the given version of the
+// empty state storage.
+func (st *Store) rebuild(version int64) error {
+	batch := st.db.NewBatch()
+	defer batch.Close()
+
+	iter := st.sc.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key, value := iter.Key(), iter.Value()
+
+		batch.Set(stateKey(key), value)
+		batch.Set(historyKey(key, version), concat([]byte{valueSet}, value))
+		batch.Set(changesKey(version, key), []byte{})
+	}
+
+	batch.Set(versionKey(version), []byte{})
+
+	return batch.Write()
+}
+
+// stateWriter is the parent of the working set of a Store. It serves the
+// latest committed state and, when the working set is flushed on commit,
+// records each write in the batch of the new version and in the commitment
+// store.
+type stateWriter struct {
+	dbadapter.Store
+
+	sc      types.KVStore
+	batch   dbm.Batch
+	version int64
+}
+
+func (w *stateWriter) Set(key, value []byte) {
+	w.batch.Set(stateKey(key), value)
+	w.batch.Set(historyKey(key, w.version), concat([]byte{valueSet}, value))
+	w.batch.Set(changesKey(w.version, key), []byte{})
+	w.sc.Set(key, value)
+}
+
+func (w *stateWriter) Delete(key []byte) {
+	// the working set records the deletion of keys it never read, only the
+	// keys of the state are recorded as deleted
+	if !w.Store.Has(key) {
+		return
+	}
+
+	w.batch.Delete(stateKey(key))
+	w.batch.Set(historyKey(key, w.version), []byte{valueDeleted})
+	w.batch.Set(changesKey(w.version, key), []byte{})
+	w.sc.Delete(key)
+}
@@ -0,0 +1,379 @@
+package decoupled
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/smt"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func newStore(t *testing.T, db dbm.DB, version int64) *Store {
+	store, err := LoadStore(db, types.CommitID{Version: version}, false)
+	require.NoError(t, err)
+	return store.(*Store)
+}
+
+// commitVersions commits a version per set of changes, where a nil value
+// deletes the key, and returns the expected state at each version.
+func commitVersions(t *testing.T, store *Store, changes []map[string][]byte) []map[string]string {
+	states := []map[string]string{{}}
+	for _, change := range changes {
+		state := map[string]string{}
+		for k, v := range states[len(states)-1] {
+			state[k] = v
+		}
+
+		for _, k := range sortedKeys(change) {
+			if v := change[k]; v == nil {
+				store.Delete([]byte(k))
+				delete(state, k)
+			} else {
+				store.Set([]byte(k), v)
+				state[k] = string(v)
+			}
+		}
+
+		store.Commit()
+		states = append(states, state)
+	}
+
+	return states
+}
+
+var testChanges = []map[string][]byte{
+	{"a": []byte("1"), "b": []byte("1"), "c\x00": []byte("1")},
+	{"a": []byte("2"), "b": nil, "d": []byte("2")},
+	{"a": nil, "c": []byte("3"), "x": nil},
+	{"b": []byte("4"), "c\x00": nil},
+	{"a": []byte("5"), "d": []byte("5")},
+}
+
+func sortedKeys(change map[string][]byte) []string {
+	keys := make([]string, 0, len(change))
+	for k := range change {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func requireState(t *testing.T, expected map[string]string, store types.KVStore) {
+	keys := make([]string, 0, len(expected))
+	for k, v := range expected {
+		keys = append(keys, k)
+		require.Equal(t, []byte(v), store.Get([]byte(k)))
+		require.True(t, store.Has([]byte(k)))
+	}
+	sort.Strings(keys)
+
+	var got []string
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		got = append(got, string(iter.Key()))
+		require.Equal(t, []byte(expected[string(iter.Key())]), iter.Value())
+	}
+	iter.Close()
+	require.Equal(t, keys, got)
+
+	got = nil
+	iter = store.ReverseIterator([]byte("b"), []byte("d"))
+	for ; iter.Valid(); iter.Next() {
+		got = append([]string{string(iter.Key())}, got...)
+	}
+	iter.Close()
+
+	var inRange []string
+	for _, k := range keys {
+		if k >= "b" && k < "d" {
+			inRange = append(inRange, k)
+		}
+	}
+	require.Equal(t, inRange, got)
+}
+
+func TestStoreGetSetHasDelete(t *testing.T) {
+	store := newStore(t, dbm.NewMemDB(), 0)
+	require.Equal(t, types.StoreTypeDecoupled, store.GetStoreType())
+
+	store.Set([]byte("hello"), []byte("goodbye"))
+	require.True(t, store.Has([]byte("hello")))
+	require.Equal(t, []byte("goodbye"), store.Get([]byte("hello")))
+
+	store.Delete([]byte("hello"))
+	require.False(t, store.Has([]byte("hello")))
+	require.Panics(t, func() { store.Set([]byte("nil"), nil) })
+	require.Panics(t, func() { store.SetPruning(types.PruneNothing) })
+}
+
+func TestStoreCommitHashMatchesIAVL(t *testing.T) {
+	store := newStore(t, dbm.NewMemDB(), 0)
+	iavlStore, err := iavl.LoadStore(dbm.NewMemDB(), types.CommitID{}, false)
+	require.NoError(t, err)
+
+	for _, change := range testChanges {
+		// the shape of an IAVL tree depends on the order of the writes, which
+		// are sorted by the working set of the store
+		for _, k := range sortedKeys(change) {
+			if v := change[k]; v == nil {
+				store.Delete([]byte(k))
+				iavlStore.Delete([]byte(k))
+			} else {
+				store.Set([]byte(k), v)
+				iavlStore.Set([]byte(k), v)
+			}
+		}
+
+		require.Equal(t, iavlStore.Commit(), store.Commit())
+		require.Equal(t, iavlStore.LastCommitID(), store.LastCommitID())
+	}
+}
+
+func TestStoreGetImmutable(t *testing.T) {
+	store := newStore(t, dbm.NewMemDB(), 0)
+	states := commitVersions(t, store, testChanges)
+
+	// uncommitted writes are not visible at any version
+	store.Set([]byte("e"), []byte("6"))
+
+	for version := int64(1); version < int64(len(states)); version++ {
+		view, err := store.GetImmutable(version)
+		require.NoError(t, err)
+		requireState(t, states[version], view)
+
+		require.Panics(t, func() { view.Set([]byte("a"), []byte("1")) })
+		require.Panics(t, func() { view.Delete([]byte("a")) })
+	}
+
+	_, err := store.GetImmutable(int64(len(states)))
+	require.Error(t, err)
+}
+
+func TestStoreDeleteVersions(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newStore(t, db, 0)
+	states := commitVersions(t, store, testChanges)
+
+	countHistory := func() int {
+		iter, err := db.Iterator(historyPrefix, types.PrefixEndBytes(historyPrefix))
+		require.NoError(t, err)
+		defer iter.Close()
+
+		count := 0
+		for ; iter.Valid(); iter.Next() {
+			count++
+		}
+		return count
+	}
+	before := countHistory()
+
+	require.NoError(t, store.DeleteVersions(2, 3))
+	require.Less(t, countHistory(), before)
+
+	for _, version := range []int64{1, 4, 5} {
+		view, err := store.GetImmutable(version)
+		require.NoError(t, err)
+		requireState(t, states[version], view)
+	}
+
+	for _, version := range []int64{2, 3} {
+		require.False(t, store.VersionExists(version))
+		_, err := store.GetImmutable(version)
+		require.Error(t, err)
+	}
+
+	// the previous deletions are merged in the following ones
+	require.NoError(t, store.DeleteVersions(1, 4))
+	view, err := store.GetImmutable(5)
+	require.NoError(t, err)
+	requireState(t, states[5], view)
+	require.Equal(t, len(states[5])+1, countHistory())
+
+	require.Error(t, store.DeleteVersions(5))
+}
+
+func TestStoreLoadRevert(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newStore(t, db, 0)
+	states := commitVersions(t, store, testChanges)
+	require.NoError(t, store.DeleteVersions(1))
+
+	// the commitment at version 3 rolls the state storage back to it
+	store = newStore(t, db, 3)
+	require.Equal(t, int64(3), store.LastCommitID().Version)
+	require.False(t, store.VersionExists(4))
+	requireState(t, states[3], store)
+
+	view, err := store.GetImmutable(3)
+	require.NoError(t, err)
+	requireState(t, states[3], view)
+
+	store.Set([]byte("f"), []byte("4"))
+	require.Equal(t, int64(4), store.Commit().Version)
+	require.Equal(t, []byte("4"), store.Get([]byte("f")))
+
+	// a pruned version cannot be loaded
+	_, err = LoadStore(db, types.CommitID{Version: 1}, false)
+	require.Error(t, err)
+}
+
+func TestStoreLoadRebuild(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newStore(t, db, 0)
+	states := commitVersions(t, store, testChanges)
+	id := store.LastCommitID()
+
+	// wipe the state storage, keeping the commitment store only
+	iter, err := db.Iterator(nil, nil)
+	require.NoError(t, err)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		if !bytes.HasPrefix(iter.Key(), commitmentPrefix) {
+			keys = append(keys, iter.Key())
+		}
+	}
+	iter.Close()
+	for _, key := range keys {
+		require.NoError(t, db.Delete(key))
+	}
+
+	store = newStore(t, db, id.Version)
+	require.Equal(t, id, store.LastCommitID())
+	requireState(t, states[len(states)-1], store)
+
+	view, err := store.GetImmutable(id.Version)
+	require.NoError(t, err)
+	requireState(t, states[len(states)-1], view)
+}
+
+func TestStoreQuery(t *testing.T) {
+	store := newStore(t, dbm.NewMemDB(), 0)
+	commitVersions(t, store, testChanges[:2])
+
+	query := abci.RequestQuery{Path: "/key", Data: []byte("a")}
+
+	// the default height is the latest height - 1
+	res := store.Query(query)
+	require.Equal(t, uint32(0), res.Code)
+	require.Equal(t, int64(1), res.Height)
+	require.Equal(t, []byte("1"), res.Value)
+	require.Nil(t, res.Proof)
+
+	query.Height = 2
+	query.Prove = true
+	res = store.Query(query)
+	require.Equal(t, []byte("2"), res.Value)
+	require.NotNil(t, res.Proof)
+
+	// absence proofs are served as well
+	query.Data = []byte("b")
+	res = store.Query(query)
+	require.Nil(t, res.Value)
+	require.NotNil(t, res.Proof)
+
+	query.Height = 3
+	res = store.Query(query)
+	require.NotEmpty(t, res.Log)
+	require.Nil(t, res.Value)
+
+	res = store.Query(abci.RequestQuery{Path: "/subspace", Data: []byte("a"), Height: 1})
+	require.Equal(t, uint32(0), res.Code)
+	require.NotEmpty(t, res.Value)
+
+	res = store.Query(abci.RequestQuery{Path: "/unknown", Data: []byte("a")})
+	require.NotEqual(t, uint32(0), res.Code)
+}
+
+// proofStore is a commitment store answering every query with res.
+type proofStore struct {
+	CommitmentStore
+	res abci.ResponseQuery
+}
+
+func (s proofStore) Query(abci.RequestQuery) abci.ResponseQuery {
+	return s.res
+}
+
+func TestStoreQueryProofFailure(t *testing.T) {
+	store := newStore(t, dbm.NewMemDB(), 0)
+	commitVersions(t, store, testChanges[:2])
+
+	query := abci.RequestQuery{Path: "/key", Data: []byte("a"), Height: 2, Prove: true}
+	proof := &merkle.Proof{Ops: []merkle.ProofOp{{Type: types.ProofOpIAVLCommitment}}}
+
+	// the commitment store disagrees with the state storage
+	store.sc = proofStore{CommitmentStore: store.sc, res: abci.ResponseQuery{Value: []byte("1"), Proof: proof}}
+	res := store.Query(query)
+	require.NotEqual(t, uint32(0), res.Code)
+	require.Nil(t, res.Proof)
+
+	// the commitment store fails to prove the value, e.g. at a pruned version
+	store.sc = proofStore{CommitmentStore: store.sc, res: abci.ResponseQuery{Code: 1, Log: "version does not exist"}}
+	query.Data = []byte("b")
+	res = store.Query(query)
+	require.NotEqual(t, uint32(0), res.Code)
+	require.Contains(t, res.Log, "version does not exist")
+	require.Nil(t, res.Proof)
+}
+
+func TestStoreSMTCommitment(t *testing.T) {
+	db := dbm.NewMemDB()
+	sc, err := smt.LoadStore(dbm.NewPrefixDB(db, commitmentPrefix), types.CommitID{}, false)
+	require.NoError(t, err)
+	store, err := NewStore(db, sc.(*smt.Store))
+	require.NoError(t, err)
+
+	smtStore, err := smt.LoadStore(dbm.NewMemDB(), types.CommitID{}, false)
+	require.NoError(t, err)
+
+	states := commitVersions(t, store, testChanges)
+	for _, change := range testChanges {
+		for k, v := range change {
+			if v == nil {
+				smtStore.Delete([]byte(k))
+			} else {
+				smtStore.Set([]byte(k), v)
+			}
+		}
+		smtStore.Commit()
+	}
+	require.Equal(t, smtStore.LastCommitID(), store.LastCommitID())
+
+	view, err := store.GetImmutable(3)
+	require.NoError(t, err)
+	requireState(t, states[3], view)
+
+	res := store.Query(abci.RequestQuery{Path: "/key", Data: []byte("d"), Height: 5, Prove: true})
+	require.Equal(t, []byte("5"), res.Value)
+	require.Equal(t, types.ProofOpSMTCommitment, res.Proof.Ops[0].Type)
+}
+
+func BenchmarkStoreGetImmutable(b *testing.B) {
+	db := dbm.NewMemDB()
+	st, err := LoadStore(db, types.CommitID{}, false)
+	require.NoError(b, err)
+	store := st.(*Store)
+
+	for version := 0; version < 100; version++ {
+		for i := 0; i < 100; i++ {
+			store.Set([]byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("value%03d", version)))
+		}
+		store.Commit()
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		view, err := store.GetImmutable(int64(i%100 + 1))
+		require.NoError(b, err)
+		view.Get([]byte("key050"))
+	}
+}
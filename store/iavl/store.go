@@ -1,6 +1,7 @@
 package iavl
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"sync"
@@ -62,6 +63,25 @@ func LoadStore(db dbm.DB, id types.CommitID, lazyLoading bool) (types.CommitKVSt
 	}, nil
 }
 
+// LoadStoreForOverwriting returns an IAVL Store as a CommitKVStore loaded at
+// the store's version (id) from the provided DB. All the versions after it are
+// deleted so they can be committed again. An error is returned if the version
+// fails to load.
+func LoadStoreForOverwriting(db dbm.DB, id types.CommitID) (types.CommitKVStore, error) {
+	tree, err := iavl.NewMutableTree(db, defaultIAVLCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = tree.LoadVersionForOverwriting(id.Version); err != nil {
+		return nil, err
+	}
+
+	return &Store{
+		tree: tree,
+	}, nil
+}
+
 // UnsafeNewStore returns a reference to a new IAVL Store with a given mutable
 // IAVL tree reference. It should only be used for testing purposes.
 //
@@ -124,6 +144,12 @@ func (st *Store) SetPruning(_ types.PruningOptions) {
 	panic("cannot set pruning options on an initialized IAVL store")
 }
 
+// GetPruning panics as pruning options should be provided at initialization
+// since IAVl accepts pruning options directly.
+func (st *Store) GetPruning() types.PruningOptions {
+	panic("cannot get pruning options on an initialized IAVL store")
+}
+
 // VersionExists returns whether or not a given version is stored.
 func (st *Store) VersionExists(version int64) bool {
 	return st.tree.VersionExists(version)
@@ -178,6 +204,33 @@ func (st *Store) DeleteVersions(versions ...int64) error {
 	return st.tree.DeleteVersions(versions...)
 }
 
+// Export exports the IAVL store at the given version, returning an iavl.Exporter
+// for the tree. The caller must call Close() on the exporter when done.
+func (st *Store) Export(version int64) (*iavl.Exporter, error) {
+	istore, err := st.GetImmutable(version)
+	if err != nil {
+		return nil, fmt.Errorf("iavl export failed for version %v: %w", version, err)
+	}
+
+	tree, ok := istore.tree.(*immutableTree)
+	if !ok || tree == nil {
+		return nil, fmt.Errorf("iavl export failed: unable to fetch tree for version %v", version)
+	}
+
+	return tree.Export(), nil
+}
+
+// Import imports an IAVL tree at the given version, returning an iavl.Importer
+// for importing. The store must be empty, i.e. at version 0.
+func (st *Store) Import(version int64) (*iavl.Importer, error) {
+	tree, ok := st.tree.(*iavl.MutableTree)
+	if !ok {
+		return nil, errors.New("iavl import failed: unable to find mutable tree")
+	}
+
+	return tree.Import(version)
+}
+
 // Implements types.KVStore.
 func (st *Store) Iterator(start, end []byte) types.Iterator {
 	var iTree *iavl.ImmutableTree
@@ -0,0 +1,40 @@
+package keys
+
+import "fmt"
+
+// Encode escapes each 0x00 byte of key as 0x00 0xFF and terminates it with
+// 0x00 0x00. The encoding preserves the order of the keys and no encoded key is
+// a prefix of another one, so the encoded keys can be suffixed or walked bit
+// by bit without breaking their order.
+func Encode(key []byte) []byte {
+	bz := make([]byte, 0, len(key)+2)
+	for _, b := range key {
+		bz = append(bz, b)
+		if b == 0x00 {
+			bz = append(bz, 0xFF)
+		}
+	}
+
+	return append(bz, 0x00, 0x00)
+}
+
+// Decode decodes a key encoded by Encode at the start of bz and returns it
+// along with the number of bytes it was encoded on.
+func Decode(bz []byte) ([]byte, int) {
+	key := make([]byte, 0, len(bz))
+	for i := 0; i < len(bz)-1; i++ {
+		if bz[i] != 0x00 {
+			key = append(key, bz[i])
+			continue
+		}
+
+		i++
+		if bz[i] == 0x00 {
+			return key, i + 1
+		}
+
+		key = append(key, 0x00)
+	}
+
+	panic(fmt.Sprintf("invalid encoded key %X", bz))
+}
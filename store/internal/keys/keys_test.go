@@ -0,0 +1,24 @@
+package keys
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	keys := [][]byte{{}, {0x00}, {0x00, 0x00}, {0x00, 0x01}, {0x00, 0xFF}, {0x01}, {0x01, 0x00}, {0xFF}, {0xFF, 0x00, 0xFF}}
+	for i, key := range keys {
+		encoded := Encode(key)
+		decoded, n := Decode(append(encoded, 0x12, 0x34))
+		require.Equal(t, key, decoded)
+		require.Equal(t, len(encoded), n)
+
+		if i > 0 {
+			require.Equal(t, -1, bytes.Compare(Encode(keys[i-1]), encoded), "%X < %X", keys[i-1], key)
+		}
+	}
+
+	require.Panics(t, func() { Decode([]byte{0x01, 0x00}) })
+}
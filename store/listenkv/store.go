@@ -0,0 +1,94 @@
+package listenkv
+
+import (
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.KVStore = &Store{}
+
+// Store implements the KVStore interface with listening enabled. Every set
+// and delete is passed to the WriteListeners of the store after it has been
+// applied to the parent KVStore.
+type Store struct {
+	parent         types.KVStore
+	listeners      []types.WriteListener
+	parentStoreKey types.StoreKey
+}
+
+// NewStore returns a reference to a new listenkv Store given a parent
+// KVStore implementation, the key of the parent store and the listeners
+// notified of its writes.
+func NewStore(parent types.KVStore, parentStoreKey types.StoreKey, listeners []types.WriteListener) *Store {
+	return &Store{parent: parent, listeners: listeners, parentStoreKey: parentStoreKey}
+}
+
+// Get implements the KVStore interface. It delegates the Get call to the
+// parent KVStore.
+func (s *Store) Get(key []byte) []byte {
+	return s.parent.Get(key)
+}
+
+// Set implements the KVStore interface. It notifies the listeners of the set
+// and delegates the Set call to the parent KVStore.
+func (s *Store) Set(key []byte, value []byte) {
+	types.AssertValidKey(key)
+	s.parent.Set(key, value)
+	s.onWrite(false, key, value)
+}
+
+// Delete implements the KVStore interface. It notifies the listeners of the
+// delete and delegates the Delete call to the parent KVStore.
+func (s *Store) Delete(key []byte) {
+	s.parent.Delete(key)
+	s.onWrite(true, key, nil)
+}
+
+// Has implements the KVStore interface. It delegates the Has call to the
+// parent KVStore.
+func (s *Store) Has(key []byte) bool {
+	return s.parent.Has(key)
+}
+
+// Iterator implements the KVStore interface. It delegates the Iterator call
+// to the parent KVStore.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	return s.parent.Iterator(start, end)
+}
+
+// ReverseIterator implements the KVStore interface. It delegates the
+// ReverseIterator call to the parent KVStore.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	return s.parent.ReverseIterator(start, end)
+}
+
+// GetStoreType implements the KVStore interface. It returns the underlying
+// KVStore type.
+func (s *Store) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// CacheWrap implements the KVStore interface. The writes of the cache are
+// streamed to the listeners when it is written.
+func (s *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements the KVStore interface.
+func (s *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// onWrite notifies every listener of a write, in the order they were given.
+// A listener error is fatal, as the write could not be streamed.
+func (s *Store) onWrite(delete bool, key, value []byte) {
+	for _, l := range s.listeners {
+		if err := l.OnWrite(s.parentStoreKey, key, value, delete); err != nil {
+			panic(errors.Wrap(err, "failed to notify write listener"))
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package listenkv_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func bz(s string) []byte { return []byte(s) }
+
+func keyFmt(i int) []byte { return bz(fmt.Sprintf("key%0.8d", i)) }
+func valFmt(i int) []byte { return bz(fmt.Sprintf("value%0.8d", i)) }
+
+var (
+	testStoreKey   = types.NewKVStoreKey("listen_test")
+	testMarshaller = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+)
+
+func newEmptyListenKVStore(buf *bytes.Buffer) *listenkv.Store {
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+	listener := types.NewStoreKVPairWriteListener(buf, testMarshaller)
+
+	return listenkv.NewStore(memDB, testStoreKey, []types.WriteListener{listener})
+}
+
+func readStoreKVPair(t *testing.T, buf *bytes.Buffer) types.StoreKVPair {
+	var kvPair types.StoreKVPair
+	require.NoError(t, testMarshaller.UnmarshalBinaryLengthPrefixed(buf.Bytes(), &kvPair))
+
+	return kvPair
+}
+
+func TestListenKVStoreSet(t *testing.T) {
+	var buf bytes.Buffer
+	store := newEmptyListenKVStore(&buf)
+
+	store.Set(keyFmt(1), valFmt(1))
+	require.Equal(t, valFmt(1), store.Get(keyFmt(1)))
+	require.Equal(t, types.StoreKVPair{
+		StoreKey: testStoreKey.Name(),
+		Delete:   false,
+		Key:      keyFmt(1),
+		Value:    valFmt(1),
+	}, readStoreKVPair(t, &buf))
+
+	require.Panics(t, func() { store.Set(nil, valFmt(1)) }, "setting a nil key should panic")
+	require.Panics(t, func() { store.Set([]byte(""), valFmt(1)) }, "setting an empty key should panic")
+}
+
+func TestListenKVStoreDelete(t *testing.T) {
+	var buf bytes.Buffer
+	store := newEmptyListenKVStore(&buf)
+
+	store.Set(keyFmt(1), valFmt(1))
+	buf.Reset()
+
+	store.Delete(keyFmt(1))
+	require.False(t, store.Has(keyFmt(1)))
+	require.Equal(t, types.StoreKVPair{
+		StoreKey: testStoreKey.Name(),
+		Delete:   true,
+		Key:      keyFmt(1),
+	}, readStoreKVPair(t, &buf))
+}
+
+func TestListenKVStoreReadsAreNotEmitted(t *testing.T) {
+	var buf bytes.Buffer
+	store := newEmptyListenKVStore(&buf)
+
+	for i := 0; i < 3; i++ {
+		store.Set(keyFmt(i), valFmt(i))
+	}
+	buf.Reset()
+
+	store.Get(keyFmt(1))
+	store.Has(keyFmt(2))
+
+	iter := store.Iterator(nil, nil)
+	for i := 0; iter.Valid(); iter.Next() {
+		require.Equal(t, keyFmt(i), iter.Key())
+		i++
+	}
+	iter.Close()
+
+	require.Zero(t, buf.Len())
+}
+
+func TestListenKVStoreGetStoreType(t *testing.T) {
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+	store := newEmptyListenKVStore(&bytes.Buffer{})
+	require.Equal(t, memDB.GetStoreType(), store.GetStoreType())
+}
+
+func TestListenKVStoreCacheWrap(t *testing.T) {
+	var buf bytes.Buffer
+	store := newEmptyListenKVStore(&buf)
+
+	cache := store.CacheWrap().(types.CacheKVStore)
+	cache.Set(keyFmt(1), valFmt(1))
+	require.Zero(t, buf.Len())
+
+	cache.Write()
+	require.Equal(t, valFmt(1), store.Get(keyFmt(1)))
+	require.Equal(t, keyFmt(1), readStoreKVPair(t, &buf).Key)
+}
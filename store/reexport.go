@@ -28,4 +28,5 @@ type (
 	Gas              = types.Gas
 	GasMeter         = types.GasMeter
 	GasConfig        = types.GasConfig
+	WriteListener    = types.WriteListener
 )
@@ -1,21 +1,34 @@
 package rootmulti
 
 import (
+	"bufio"
+	"compress/zlib"
 	"encoding/binary"
 	"fmt"
 	"io"
+	"math"
+	"sort"
 	"strings"
+	"sync"
 
+	protoio "github.com/gogo/protobuf/io"
 	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/pkg/errors"
 	iavltree "github.com/tendermint/iavl"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
 	"github.com/cosmos/cosmos-sdk/store/cachemulti"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/decoupled"
 	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
 	"github.com/cosmos/cosmos-sdk/store/mem"
+	"github.com/cosmos/cosmos-sdk/store/smt"
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
 	"github.com/cosmos/cosmos-sdk/store/transient"
 	"github.com/cosmos/cosmos-sdk/store/types"
@@ -26,6 +39,11 @@ const (
 	latestVersionKey = "s/latest"
 	pruneHeightsKey  = "s/pruneheights"
 	commitInfoKeyFmt = "s/%d" // s/<version>
+
+	// Do not change chunk size without new snapshot format (must be uniform across nodes)
+	snapshotChunkSize   = uint64(10e6)
+	snapshotBufferSize  = int(snapshotChunkSize)
+	snapshotMaxItemSize = int(64e6) // SDK has no key/value size limit, so we set an arbitrary limit
 )
 
 // Store is composed of many CommitStores. Name contrasts with
@@ -41,10 +59,26 @@ type Store struct {
 	lazyLoading    bool
 	pruneHeights   []int64
 
+	// storeMtxs serialize the commit of each store and the reads of its past
+	// versions with the deletion of its versions by the background pruning.
+	storeMtxs map[types.StoreKey]*sync.RWMutex
+
+	// pruning is closed once the background pruning of pruningHeights is
+	// done, it is nil if pruning never started. pruneErr is the error it
+	// failed with, if any. All are guarded by pruneMtx.
+	pruneMtx       sync.Mutex
+	pruning        chan struct{}
+	pruningHeights []int64
+	pruneErr       error
+
 	traceWriter  io.Writer
 	traceContext types.TraceContext
 
 	interBlockCache types.MultiStorePersistentCache
+
+	listeners map[types.StoreKey][]types.WriteListener
+
+	logger log.Logger
 }
 
 var (
@@ -62,8 +96,11 @@ func NewStore(db dbm.DB) *Store {
 		pruningOpts:  types.PruneNothing,
 		storesParams: make(map[types.StoreKey]storeParams),
 		stores:       make(map[types.StoreKey]types.CommitKVStore),
+		storeMtxs:    make(map[types.StoreKey]*sync.RWMutex),
 		keysByName:   make(map[string]types.StoreKey),
 		pruneHeights: make([]int64, 0),
+		listeners:    make(map[types.StoreKey][]types.WriteListener),
+		logger:       log.NewNopLogger(),
 	}
 }
 
@@ -74,6 +111,11 @@ func (rs *Store) SetPruning(pruningOpts types.PruningOptions) {
 	rs.pruningOpts = pruningOpts
 }
 
+// GetPruning fetches the pruning strategy from the root store.
+func (rs *Store) GetPruning() types.PruningOptions {
+	return rs.pruningOpts
+}
+
 // SetLazyLoading sets if the iavl store should be loaded lazily or not
 func (rs *Store) SetLazyLoading(lazyLoading bool) {
 	rs.lazyLoading = lazyLoading
@@ -84,8 +126,24 @@ func (rs *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
 }
 
-// MountStoreWithDB implements CommitMultiStore.
+// MountStoreWithDB implements CommitMultiStore. The decoupled stores are
+// mounted with an IAVL commitment store.
 func (rs *Store) MountStoreWithDB(key types.StoreKey, typ types.StoreType, db dbm.DB) {
+	rs.mountStore(storeParams{key: key, typ: typ, db: db, commitment: types.StoreTypeIAVL})
+}
+
+// MountDecoupledStoreWithDB mounts a decoupled store committing to its state
+// with a commitment store of the given type, IAVL or SMT.
+func (rs *Store) MountDecoupledStoreWithDB(key types.StoreKey, commitment types.StoreType, db dbm.DB) {
+	if commitment != types.StoreTypeIAVL && commitment != types.StoreTypeSMT {
+		panic(fmt.Sprintf("unsupported commitment store type %v", commitment))
+	}
+
+	rs.mountStore(storeParams{key: key, typ: types.StoreTypeDecoupled, db: db, commitment: commitment})
+}
+
+func (rs *Store) mountStore(params storeParams) {
+	key := params.key
 	if key == nil {
 		panic("MountIAVLStore() key cannot be nil")
 	}
@@ -95,11 +153,7 @@ func (rs *Store) MountStoreWithDB(key types.StoreKey, typ types.StoreType, db db
 	if _, ok := rs.keysByName[key.Name()]; ok {
 		panic(fmt.Sprintf("store duplicate store key name %v", key))
 	}
-	rs.storesParams[key] = storeParams{
-		key: key,
-		typ: typ,
-		db:  db,
-	}
+	rs.storesParams[key] = params
 	rs.keysByName[key.Name()] = key
 }
 
@@ -146,7 +200,210 @@ func (rs *Store) LoadVersion(ver int64) error {
 	return rs.loadVersion(ver, nil)
 }
 
+// GetCommitInfo returns the commit info of the given version, which holds the
+// app hash of the version and the commit ID of each sub-store.
+func (rs *Store) GetCommitInfo(version int64) (*types.CommitInfo, error) {
+	return getCommitInfo(rs.db, version)
+}
+
+// RollbackToVersion deletes all the versions after the given one from the
+// root store and its sub-stores, making it the latest version, and reloads
+// the stores at it. An error is returned, before anything is deleted, if the
+// version does not exist in every persisted sub-store, e.g. if it was pruned.
+func (rs *Store) RollbackToVersion(version int64) error {
+	if err := rs.waitPruning(); err != nil {
+		return err
+	}
+
+	latest := getLatestVersion(rs.db)
+	if version <= 0 || version > latest {
+		return fmt.Errorf("cannot roll back to version %d; latest version is %d", version, latest)
+	}
+
+	cInfo, err := getCommitInfo(rs.db, version)
+	if err != nil {
+		return errors.Wrapf(err, "cannot roll back to version %d", version)
+	}
+
+	infos := make(map[string]types.StoreInfo)
+	for _, storeInfo := range cInfo.StoreInfos {
+		infos[storeInfo.Name] = storeInfo
+	}
+
+	for key := range rs.stores {
+		if versionExists(rs.GetCommitKVStore(key), version) {
+			continue
+		}
+
+		return fmt.Errorf("cannot roll back to version %d; it was pruned from store %s or predates it", version, key.Name())
+	}
+
+	// Loading the stores for overwriting deletes their versions after the given
+	// one right away.
+	for key, params := range rs.storesParams {
+		id := rs.getCommitID(infos, key.Name())
+		db := rs.commitStoreDB(params)
+
+		switch params.typ {
+		case types.StoreTypeIAVL:
+			_, err = iavl.LoadStoreForOverwriting(db, id)
+
+		case types.StoreTypeDecoupled:
+			_, err = decoupled.LoadStoreWithCommitment(db, id, false, params.commitment)
+
+		case types.StoreTypeSMT:
+			_, err = smt.LoadStoreForOverwriting(db, id)
+		}
+
+		if err != nil {
+			return errors.Wrapf(err, "failed to roll back store %s", key.Name())
+		}
+	}
+
+	batch := rs.db.NewBatch()
+	defer batch.Close()
+
+	for v := version + 1; v <= latest; v++ {
+		batch.Delete([]byte(fmt.Sprintf(commitInfoKeyFmt, v)))
+	}
+
+	pruneHeights := make([]int64, 0, len(rs.pruneHeights))
+	for _, h := range rs.pruneHeights {
+		if h < version {
+			pruneHeights = append(pruneHeights, h)
+		}
+	}
+
+	setLatestVersion(batch, version)
+	setPruningHeights(batch, pruneHeights)
+
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	rs.pruneHeights = pruneHeights
+
+	return rs.loadVersion(version, nil)
+}
+
+// versionExists returns whether a version of a sub-store exists. It is true
+// for the sub-stores without past versions.
+func versionExists(store types.CommitKVStore, version int64) bool {
+	switch store.GetStoreType() {
+	case types.StoreTypeIAVL:
+		return store.(*iavl.Store).VersionExists(version)
+
+	case types.StoreTypeDecoupled:
+		return store.(*decoupled.Store).VersionExists(version)
+
+	case types.StoreTypeSMT:
+		return store.(*smt.Store).VersionExists(version)
+
+	default:
+		return true
+	}
+}
+
+// PruneVersions deletes from all the sub-stores the versions the pruning
+// options do not keep, as if the store had been committed with them from the
+// start. The versions are deleted in batches of batchSize heights and progress
+// is called after each batch with the number of heights pruned so far and the
+// total number of heights to prune.
+func (rs *Store) PruneVersions(opts types.PruningOptions, batchSize int, progress func(pruned, total int)) error {
+	if batchSize <= 0 {
+		return fmt.Errorf("invalid batch size: %d", batchSize)
+	}
+
+	if err := rs.waitPruning(); err != nil {
+		return err
+	}
+
+	// If a store is wrapped with an inter-block cache, we must first unwrap it
+	// to get the underlying store. Only the stores with past versions are kept.
+	stores := make(map[types.StoreKey]types.CommitKVStore, len(rs.stores))
+	for key := range rs.stores {
+		switch store := rs.GetCommitKVStore(key); store.GetStoreType() {
+		case types.StoreTypeIAVL, types.StoreTypeDecoupled, types.StoreTypeSMT:
+			stores[key] = store
+		}
+	}
+
+	// the same heights as Commit would have pruned, where the latest one is
+	// (latest - 1) - KeepRecent
+	var heights []int64
+	for h := int64(1); h < rs.lastCommitInfo.Version-int64(opts.KeepRecent); h++ {
+		if opts.KeepEvery != 0 && h%int64(opts.KeepEvery) == 0 {
+			continue
+		}
+
+		for _, store := range stores {
+			if versionExists(store, h) {
+				heights = append(heights, h)
+				break
+			}
+		}
+	}
+
+	for start := 0; start < len(heights); start += batchSize {
+		end := start + batchSize
+		if end > len(heights) {
+			end = len(heights)
+		}
+
+		for key, store := range stores {
+			// a store mounted by an upgrade has none of the versions before it
+			var existing []int64
+			for _, h := range heights[start:end] {
+				if versionExists(store, h) {
+					existing = append(existing, h)
+				}
+			}
+
+			rs.storeMtxs[key].Lock()
+			err := deleteVersions(store, existing)
+			rs.storeMtxs[key].Unlock()
+
+			if err != nil {
+				return errors.Wrapf(err, "failed to prune store %s", key.Name())
+			}
+		}
+
+		if progress != nil {
+			progress(end, len(heights))
+		}
+	}
+
+	// the pending heights which were just pruned are not pruned again
+	pruneHeights := make([]int64, 0, len(rs.pruneHeights))
+	for _, h := range rs.pruneHeights {
+		if opts.KeepEvery != 0 && h%int64(opts.KeepEvery) == 0 || h >= rs.lastCommitInfo.Version-int64(opts.KeepRecent) {
+			pruneHeights = append(pruneHeights, h)
+		}
+	}
+
+	batch := rs.db.NewBatch()
+	defer batch.Close()
+
+	setPruningHeights(batch, pruneHeights)
+
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	rs.pruneHeights = pruneHeights
+
+	return nil
+}
+
 func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
+	// The stores being pruned are about to be replaced. The heights of a failed
+	// pruning are still persisted, so they are loaded below to be pruned again.
+	_ = rs.waitPruning()
+
+	rs.pruneMtx.Lock()
+	rs.pruning, rs.pruningHeights, rs.pruneErr = nil, nil, nil
+	rs.pruneMtx.Unlock()
+
 	infos := make(map[string]types.StoreInfo)
 
 	cInfo := &types.CommitInfo{}
@@ -204,6 +461,11 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 	rs.lastCommitInfo = cInfo
 	rs.stores = newStores
 
+	rs.storeMtxs = make(map[types.StoreKey]*sync.RWMutex, len(newStores))
+	for key := range newStores {
+		rs.storeMtxs[key] = &sync.RWMutex{}
+	}
+
 	// load any pruned heights we missed from disk to be pruned on the next run
 	ph, err := getPruningHeights(rs.db)
 	if err == nil && len(ph) > 0 {
@@ -259,6 +521,11 @@ func (rs *Store) SetInterBlockCache(c types.MultiStorePersistentCache) {
 	rs.interBlockCache = c
 }
 
+// SetLogger sets the logger of the stores loaded afterwards.
+func (rs *Store) SetLogger(logger log.Logger) {
+	rs.logger = logger
+}
+
 // SetTracer sets the tracer for the MultiStore that the underlying
 // stores will utilize to trace operations. A MultiStore is returned.
 func (rs *Store) SetTracer(w io.Writer) types.MultiStore {
@@ -287,6 +554,17 @@ func (rs *Store) TracingEnabled() bool {
 	return rs.traceWriter != nil
 }
 
+// AddListeners adds listeners for the KVStore of key. They are notified of
+// the writes flushed to the store, e.g. when a CacheMultiStore is written.
+func (rs *Store) AddListeners(key types.StoreKey, listeners []types.WriteListener) {
+	rs.listeners[key] = append(rs.listeners[key], listeners...)
+}
+
+// ListeningEnabled returns if listening is enabled for the KVStore of key.
+func (rs *Store) ListeningEnabled(key types.StoreKey) bool {
+	return len(rs.listeners[key]) > 0
+}
+
 // LastCommitID implements Committer/CommitStore.
 func (rs *Store) LastCommitID() types.CommitID {
 	if rs.lastCommitInfo == nil {
@@ -300,7 +578,7 @@ func (rs *Store) LastCommitID() types.CommitID {
 func (rs *Store) Commit() types.CommitID {
 	previousHeight := rs.lastCommitInfo.Version
 	version := previousHeight + 1
-	rs.lastCommitInfo = commitStores(version, rs.stores)
+	rs.lastCommitInfo = rs.commitStores(version)
 
 	// Determine if pruneHeight height needs to be added to the list of heights to
 	// be pruned, where pruneHeight = (commitHeight - 1) - KeepRecent.
@@ -321,7 +599,9 @@ func (rs *Store) Commit() types.CommitID {
 		rs.pruneStores()
 	}
 
-	flushMetadata(rs.db, version, rs.lastCommitInfo, rs.pruneHeights)
+	// the heights being pruned are persisted as well until they are done, so
+	// that they are pruned again after a crash
+	flushMetadata(rs.db, version, rs.lastCommitInfo, append(rs.unprunedHeights(), rs.pruneHeights...))
 
 	return types.CommitID{
 		Version: version,
@@ -329,30 +609,196 @@ func (rs *Store) Commit() types.CommitID {
 	}
 }
 
-// pruneStores will batch delete a list of heights from each mounted sub-store.
-// Afterwards, pruneHeights is reset.
+// pruneStores starts deleting the list of heights from each mounted sub-store
+// in the background. Afterwards, pruneHeights is reset. Only one batch of
+// heights is pruned at a time: if the previous one is still being pruned, it
+// blocks until it is done so that pruning cannot fall behind the commits. As
+// Commit cannot return an error, the failure of the previous pruning panics
+// here, on the committing goroutine.
+//
+// The stores returned by GetKVStore and CacheMultiStore wait for the pruning
+// to be done before they are accessed, so it only overlaps with the time left
+// until the next block starts.
 func (rs *Store) pruneStores() {
 	if len(rs.pruneHeights) == 0 {
 		return
 	}
 
-	for key, store := range rs.stores {
-		if store.GetStoreType() == types.StoreTypeIAVL {
-			// If the store is wrapped with an inter-block cache, we must first unwrap
-			// it to get the underlying IAVL store.
-			store = rs.GetCommitKVStore(key)
-
-			if err := store.(*iavl.Store).DeleteVersions(rs.pruneHeights...); err != nil {
-				if errCause := errors.Cause(err); errCause != nil && errCause != iavltree.ErrVersionDoesNotExist {
-					panic(err)
-				}
+	if err := rs.waitPruning(); err != nil {
+		panic(err)
+	}
+
+	// If a store is wrapped with an inter-block cache, we must first unwrap it
+	// to get the underlying store.
+	stores := make(map[types.StoreKey]types.CommitKVStore, len(rs.stores))
+	for key := range rs.stores {
+		stores[key] = rs.GetCommitKVStore(key)
+	}
+
+	heights, mtxs, done := rs.pruneHeights, rs.storeMtxs, make(chan struct{})
+
+	rs.pruneMtx.Lock()
+	rs.pruning, rs.pruningHeights = done, heights
+	rs.pruneMtx.Unlock()
+
+	go func() {
+		defer close(done)
+
+		for key, store := range stores {
+			mtxs[key].Lock()
+			err := deleteVersions(store, heights)
+			mtxs[key].Unlock()
+
+			if err != nil {
+				rs.pruneMtx.Lock()
+				rs.pruneErr = errors.Wrapf(err, "failed to prune store %s", key.Name())
+				rs.pruneMtx.Unlock()
+				return
 			}
 		}
-	}
+	}()
 
 	rs.pruneHeights = make([]int64, 0)
 }
 
+// deleteVersions deletes the heights from a sub-store, ignoring the heights
+// which do not exist.
+func deleteVersions(store types.CommitKVStore, heights []int64) error {
+	var err error
+
+	switch store.GetStoreType() {
+	case types.StoreTypeIAVL:
+		err = store.(*iavl.Store).DeleteVersions(heights...)
+
+	case types.StoreTypeDecoupled:
+		err = store.(*decoupled.Store).DeleteVersions(heights...)
+
+	case types.StoreTypeSMT:
+		err = store.(*smt.Store).DeleteVersions(heights...)
+	}
+
+	if errCause := errors.Cause(err); errCause != iavltree.ErrVersionDoesNotExist && errCause != smt.ErrVersionDoesNotExist {
+		return err
+	}
+
+	return nil
+}
+
+// waitPruning blocks until the background pruning is done and returns the
+// error it failed with, if any.
+func (rs *Store) waitPruning() error {
+	rs.waitPruned()
+
+	rs.pruneMtx.Lock()
+	defer rs.pruneMtx.Unlock()
+
+	return rs.pruneErr
+}
+
+// waitPruned blocks until the background pruning is done, whether it failed
+// or not.
+func (rs *Store) waitPruned() {
+	rs.pruneMtx.Lock()
+	done := rs.pruning
+	rs.pruneMtx.Unlock()
+
+	if done != nil {
+		<-done
+	}
+}
+
+// waitPruningOf blocks until the background pruning is done if it is deleting
+// the given version, which may be partly deleted until then.
+func (rs *Store) waitPruningOf(version int64) {
+	rs.pruneMtx.Lock()
+	done, heights := rs.pruning, rs.pruningHeights
+	rs.pruneMtx.Unlock()
+
+	for _, height := range heights {
+		if height == version {
+			<-done
+			return
+		}
+	}
+}
+
+// unprunedHeights returns a copy of the heights being pruned in the
+// background, or which failed to be pruned, or nil if the pruning is done.
+func (rs *Store) unprunedHeights() []int64 {
+	rs.pruneMtx.Lock()
+	defer rs.pruneMtx.Unlock()
+
+	if rs.pruning == nil {
+		return nil
+	}
+
+	select {
+	case <-rs.pruning:
+		if rs.pruneErr == nil {
+			return nil
+		}
+	default:
+	}
+
+	return append([]int64{}, rs.pruningHeights...)
+}
+
+// prunedStore is a KVStore giving access to a sub-store once it is no longer
+// being pruned. The versions are deleted from the tree the store works on,
+// so the writes and reads of a block cannot run alongside the pruning started
+// by the commit of the previous one.
+type prunedStore struct {
+	types.KVStore
+	wait func()
+}
+
+// Get implements the KVStore interface.
+func (s prunedStore) Get(key []byte) []byte {
+	s.wait()
+	return s.KVStore.Get(key)
+}
+
+// Has implements the KVStore interface.
+func (s prunedStore) Has(key []byte) bool {
+	s.wait()
+	return s.KVStore.Has(key)
+}
+
+// Set implements the KVStore interface.
+func (s prunedStore) Set(key, value []byte) {
+	s.wait()
+	s.KVStore.Set(key, value)
+}
+
+// Delete implements the KVStore interface.
+func (s prunedStore) Delete(key []byte) {
+	s.wait()
+	s.KVStore.Delete(key)
+}
+
+// Iterator implements the KVStore interface.
+func (s prunedStore) Iterator(start, end []byte) types.Iterator {
+	s.wait()
+	return s.KVStore.Iterator(start, end)
+}
+
+// ReverseIterator implements the KVStore interface.
+func (s prunedStore) ReverseIterator(start, end []byte) types.Iterator {
+	s.wait()
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+// CacheWrap implements the KVStore interface, the cache is written to the
+// store once it is no longer being pruned.
+func (s prunedStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements the KVStore interface.
+func (s prunedStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
 // CacheWrap implements CacheWrapper/Store/CommitStore.
 func (rs *Store) CacheWrap() types.CacheWrap {
 	return rs.CacheMultiStore().(types.CacheWrap)
@@ -368,7 +814,12 @@ func (rs *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.Cac
 func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range rs.stores {
-		stores[k] = v
+		var store types.KVStore = prunedStore{v, rs.waitPruned}
+		if rs.ListeningEnabled(k) {
+			store = listenkv.NewStore(store, k, rs.listeners[k])
+		}
+
+		stores[k] = store
 	}
 
 	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.traceContext)
@@ -379,31 +830,51 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 // any store cannot be loaded. This should only be used for querying and
 // iterating at past heights.
 func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
+	// a version being pruned may be partly deleted
+	rs.waitPruningOf(version)
+
 	cachedStores := make(map[types.StoreKey]types.CacheWrapper)
 	for key, store := range rs.stores {
-		switch store.GetStoreType() {
-		case types.StoreTypeIAVL:
-			// If the store is wrapped with an inter-block cache, we must first unwrap
-			// it to get the underlying IAVL store.
-			store = rs.GetCommitKVStore(key)
-
-			// Attempt to lazy-load an already saved IAVL store version. If the
-			// version does not exist or is pruned, an error should be returned.
-			iavlStore, err := store.(*iavl.Store).GetImmutable(version)
-			if err != nil {
-				return nil, err
-			}
-
-			cachedStores[key] = iavlStore
-
-		default:
-			cachedStores[key] = store
+		cachedStore, err := rs.getStoreWithVersion(key, store, version)
+		if err != nil {
+			return nil, err
 		}
+
+		cachedStores[key] = cachedStore
 	}
 
 	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.traceContext), nil
 }
 
+// getStoreWithVersion returns a read-only view of a sub-store at a given
+// version, or the store itself if it has no past versions.
+func (rs *Store) getStoreWithVersion(key types.StoreKey, store types.CommitKVStore, version int64) (types.CacheWrapper, error) {
+	rs.storeMtxs[key].RLock()
+	defer rs.storeMtxs[key].RUnlock()
+
+	switch store.GetStoreType() {
+	case types.StoreTypeIAVL:
+		// If the store is wrapped with an inter-block cache, we must first unwrap
+		// it to get the underlying IAVL store.
+		store = rs.GetCommitKVStore(key)
+
+		// Attempt to lazy-load an already saved IAVL store version. If the
+		// version does not exist or is pruned, an error should be returned.
+		return store.(*iavl.Store).GetImmutable(version)
+
+	case types.StoreTypeDecoupled:
+		// Historical versions are read from the state storage, the commitment
+		// store is not involved.
+		return store.(*decoupled.Store).GetImmutable(version)
+
+	case types.StoreTypeSMT:
+		return store.(*smt.Store).GetImmutable(version)
+
+	default:
+		return store, nil
+	}
+}
+
 // GetStore returns a mounted Store for a given StoreKey. If the StoreKey does
 // not exist, it will panic. If the Store is wrapped in an inter-block cache, it
 // will be unwrapped prior to being returned.
@@ -421,17 +892,23 @@ func (rs *Store) GetStore(key types.StoreKey) types.Store {
 
 // GetKVStore returns a mounted KVStore for a given StoreKey. If tracing is
 // enabled on the KVStore, a wrapped TraceKVStore will be returned with the root
-// store's tracer, otherwise, the original KVStore will be returned.
+// store's tracer, otherwise, the original KVStore will be returned. If
+// listening is enabled, the KVStore is further wrapped to notify its
+// listeners.
 //
 // NOTE: The returned KVStore may be wrapped in an inter-block cache if it is
 // set on the root store.
 func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
-	store := rs.stores[key].(types.KVStore)
+	var store types.KVStore = prunedStore{rs.stores[key], rs.waitPruned}
 
 	if rs.TracingEnabled() {
 		store = tracekv.NewStore(store, rs.traceWriter, rs.traceContext)
 	}
 
+	if rs.ListeningEnabled(key) {
+		store = listenkv.NewStore(store, key, rs.listeners[key])
+	}
+
 	return store
 }
 
@@ -469,9 +946,17 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "store %s (type %T) doesn't support queries", storeName, store))
 	}
 
+	// a version being pruned may be partly deleted
+	if req.Height != 0 {
+		rs.waitPruningOf(req.Height)
+	}
+
 	// trim the path and make the query
 	req.Path = subpath
+	mtx := rs.storeMtxs[rs.keysByName[storeName]]
+	mtx.RLock()
 	res := queryable.Query(req)
+	mtx.RUnlock()
 
 	if !req.Prove || !RequireProof(subpath) {
 		return res
@@ -519,16 +1004,324 @@ func parsePath(path string) (storeName string, subpath string, err error) {
 	return storeName, subpath, nil
 }
 
-func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
-	var db dbm.DB
+// iavlSnapshotter is a store which can be snapshotted and restored as an IAVL
+// tree, i.e. an IAVL store or a decoupled store committing with IAVL.
+type iavlSnapshotter interface {
+	Export(version int64) (*iavltree.Exporter, error)
+	Import(version int64) (*iavltree.Importer, error)
+}
+
+// namedIAVLStore is an IAVL store along with the name it is mounted under, used
+// when snapshotting and restoring.
+type namedIAVLStore struct {
+	iavlSnapshotter
+	name string
+}
+
+// ValidateSnapshotStores returns an error if any of the mounted stores cannot be
+// snapshotted, e.g. an SMT store, so that an application taking snapshots can
+// fail when it is loaded rather than at every snapshot height.
+func (rs *Store) ValidateSnapshotStores() error {
+	_, err := rs.snapshotStores()
+	return err
+}
+
+// snapshotStores collects the stores to snapshot sorted by name. Only the IAVL
+// stores and the decoupled stores with an IAVL commitment are supported.
+func (rs *Store) snapshotStores() ([]namedIAVLStore, error) {
+	stores := []namedIAVLStore{}
+	for key := range rs.stores {
+		switch store := rs.GetCommitKVStore(key).(type) {
+		case *iavl.Store:
+			stores = append(stores, namedIAVLStore{name: key.Name(), iavlSnapshotter: store})
+
+		case *decoupled.Store:
+			if store.CommitmentType() != types.StoreTypeIAVL {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic,
+					"don't know how to snapshot store %q with a commitment store of type %s", key.Name(), store.CommitmentType())
+			}
+			stores = append(stores, namedIAVLStore{name: key.Name(), iavlSnapshotter: store})
+
+		case *transient.Store, *mem.Store:
+			// Non-persisted stores shouldn't be snapshotted
+			continue
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic,
+				"don't know how to snapshot store %q of type %T", key.Name(), store)
+		}
+	}
+	sort.Slice(stores, func(i, j int) bool {
+		return strings.Compare(stores[i].name, stores[j].name) == -1
+	})
+
+	return stores, nil
+}
+
+// Snapshot implements snapshottypes.Snapshotter. The snapshot output for a given format must be
+// identical across nodes such that chunks from different sources fit together. If the output for a
+// given format changes (at the byte level), the snapshot format must be bumped - see
+// TestMultistoreSnapshot_Checksum test.
+func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error) {
+	if format != snapshottypes.CurrentFormat {
+		return nil, sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+	if height == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot snapshot height 0")
+	}
+	if height > uint64(rs.LastCommitID().Version) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot future height %v", height)
+	}
+
+	rs.waitPruningOf(int64(height))
+
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return nil, err
+	}
+
+	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
+	ch := make(chan io.ReadCloser)
+	go func() {
+		// Set up a stream pipeline to serialize snapshot nodes:
+		// ExportNode -> delimited Protobuf -> zlib -> buffer -> chunkWriter -> chan io.ReadCloser
+		chunkWriter := snapshots.NewChunkWriter(ch, snapshotChunkSize)
+		bufWriter := bufio.NewWriterSize(chunkWriter, snapshotBufferSize)
+
+		zWriter, err := zlib.NewWriterLevel(bufWriter, 7)
+		if err != nil {
+			chunkWriter.CloseWithError(sdkerrors.Wrap(err, "zlib failure"))
+			return
+		}
+
+		protoWriter := protoio.NewDelimitedWriter(zWriter)
+		if err := exportSnapshotItems(height, stores, protoWriter); err != nil {
+			chunkWriter.CloseWithError(err)
+			return
+		}
+
+		// Flush and close every stage of the pipeline in order, so that all
+		// buffered data reaches the final chunk before it is closed.
+		for _, closeFn := range []func() error{protoWriter.Close, zWriter.Close, bufWriter.Flush} {
+			if err := closeFn(); err != nil {
+				chunkWriter.CloseWithError(err)
+				return
+			}
+		}
+
+		chunkWriter.Close()
+	}()
+
+	return ch, nil
+}
+
+// exportSnapshotItems exports each IAVL store at the given height. Stores are serialized as a
+// stream of SnapshotItem Protobuf messages. The first item contains a SnapshotStoreItem with store
+// metadata (i.e. name), and the following messages contain a SnapshotIAVLItem (i.e. an
+// ExportNode). Store changes are demarcated by new SnapshotStoreItem items.
+func exportSnapshotItems(height uint64, stores []namedIAVLStore, protoWriter protoio.WriteCloser) error {
+	for _, store := range stores {
+		exporter, err := store.Export(int64(height))
+		if err != nil {
+			return err
+		}
+
+		err = protoWriter.WriteMsg(&types.SnapshotItem{
+			Item: &types.SnapshotItem_Store{
+				Store: &types.SnapshotStoreItem{
+					Name: store.name,
+				},
+			},
+		})
+		if err != nil {
+			exporter.Close()
+			return err
+		}
+
+		for {
+			node, err := exporter.Next()
+			if err == iavltree.ExportDone {
+				break
+			} else if err != nil {
+				exporter.Close()
+				return err
+			}
 
+			err = protoWriter.WriteMsg(&types.SnapshotItem{
+				Item: &types.SnapshotItem_IAVL{
+					IAVL: &types.SnapshotIAVLItem{
+						Key:     node.Key,
+						Value:   node.Value,
+						Height:  int32(node.Height),
+						Version: node.Version,
+					},
+				},
+			})
+			if err != nil {
+				exporter.Close()
+				return err
+			}
+		}
+
+		exporter.Close()
+	}
+
+	return nil
+}
+
+// Restore implements snapshottypes.Snapshotter. The stores must be empty, i.e.
+// the multistore must not have any committed versions.
+func (rs *Store) Restore(
+	height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{},
+) error {
+	if format != snapshottypes.CurrentFormat {
+		return sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+	if height == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot restore snapshot at height 0")
+	}
+	if height > uint64(math.MaxInt64) {
+		return sdkerrors.Wrapf(snapshottypes.ErrInvalidMetadata,
+			"snapshot height %v cannot exceed %v", height, int64(math.MaxInt64))
+	}
+
+	// Signal readiness. Must be done before the readers below are set up, since the zlib
+	// reader reads from the stream on initialization, potentially causing deadlocks.
+	if ready != nil {
+		close(ready)
+	}
+
+	// Set up a restore stream pipeline
+	// chan io.ReadCloser -> chunkReader -> zlib -> delimited Protobuf -> ExportNode
+	chunkReader := snapshots.NewChunkReader(chunks)
+	defer chunkReader.Close()
+
+	zReader, err := zlib.NewReader(chunkReader)
+	if err != nil {
+		return sdkerrors.Wrap(err, "zlib failure")
+	}
+	defer zReader.Close()
+
+	protoReader := protoio.NewDelimitedReader(zReader, snapshotMaxItemSize)
+	defer protoReader.Close()
+
+	// Import nodes into stores. The first item is expected to be a SnapshotItem containing
+	// a SnapshotStoreItem, telling us which store to import into. The following items will contain
+	// SnapshotIAVLItem (i.e. ExportNode) until we reach the next SnapshotStoreItem or EOF.
+	var importer *iavltree.Importer
+	defer func() {
+		if importer != nil {
+			importer.Close()
+		}
+	}()
+
+	for {
+		item := &types.SnapshotItem{}
+		err := protoReader.ReadMsg(item)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+
+		switch item := item.Item.(type) {
+		case *types.SnapshotItem_Store:
+			if importer != nil {
+				if err := importer.Commit(); err != nil {
+					return sdkerrors.Wrap(err, "IAVL commit failed")
+				}
+				importer.Close()
+			}
+
+			store, ok := rs.getStoreByName(item.Store.Name).(iavlSnapshotter)
+			if !ok || store == nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot import into non-IAVL store %q", item.Store.Name)
+			}
+
+			importer, err = store.Import(int64(height))
+			if err != nil {
+				return sdkerrors.Wrap(err, "import failed")
+			}
+
+		case *types.SnapshotItem_IAVL:
+			if importer == nil {
+				return sdkerrors.Wrap(sdkerrors.ErrLogic, "received IAVL node item before store item")
+			}
+			if item.IAVL.Height > math.MaxInt8 {
+				return sdkerrors.Wrapf(sdkerrors.ErrLogic, "node height %v cannot exceed %v",
+					item.IAVL.Height, math.MaxInt8)
+			}
+
+			node := &iavltree.ExportNode{
+				Key:     item.IAVL.Key,
+				Value:   item.IAVL.Value,
+				Height:  int8(item.IAVL.Height),
+				Version: item.IAVL.Version,
+			}
+			// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
+			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+			if node.Key == nil {
+				node.Key = []byte{}
+			}
+			if node.Height == 0 && node.Value == nil {
+				node.Value = []byte{}
+			}
+
+			if err := importer.Add(node); err != nil {
+				return sdkerrors.Wrap(err, "IAVL node import failed")
+			}
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unknown snapshot item %T", item)
+		}
+	}
+
+	if importer != nil {
+		if err := importer.Commit(); err != nil {
+			return sdkerrors.Wrap(err, "IAVL commit failed")
+		}
+		importer.Close()
+		importer = nil
+	}
+
+	flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)), []int64{})
+	return rs.LoadLatestVersion()
+}
+
+// buildCommitInfo builds a CommitInfo for the given version from the last
+// commit IDs of all mounted (non-transient) stores.
+func (rs *Store) buildCommitInfo(version int64) *types.CommitInfo {
+	storeInfos := []types.StoreInfo{}
+	for key, store := range rs.stores {
+		if store.GetStoreType() == types.StoreTypeTransient {
+			continue
+		}
+
+		storeInfos = append(storeInfos, types.StoreInfo{
+			Name:     key.Name(),
+			CommitId: store.LastCommitID(),
+		})
+	}
+
+	return &types.CommitInfo{
+		Version:    version,
+		StoreInfos: storeInfos,
+	}
+}
+
+// commitStoreDB returns the database of a sub-store.
+func (rs *Store) commitStoreDB(params storeParams) dbm.DB {
 	if params.db != nil {
-		db = dbm.NewPrefixDB(params.db, []byte("s/_/"))
-	} else {
-		prefix := "s/k:" + params.key.Name() + "/"
-		db = dbm.NewPrefixDB(rs.db, []byte(prefix))
+		return dbm.NewPrefixDB(params.db, []byte("s/_/"))
 	}
 
+	prefix := "s/k:" + params.key.Name() + "/"
+	return dbm.NewPrefixDB(rs.db, []byte(prefix))
+}
+
+func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
+	db := rs.commitStoreDB(params)
+
 	switch params.typ {
 	case types.StoreTypeMulti:
 		panic("recursive MultiStores not yet supported")
@@ -548,6 +1341,19 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 
 		return store, err
 
+	case types.StoreTypeDecoupled:
+		store, err := decoupled.LoadStoreWithCommitment(db, id, rs.lazyLoading, params.commitment)
+		if err != nil {
+			return nil, err
+		}
+
+		store.(*decoupled.Store).SetLogger(rs.logger)
+
+		return store, nil
+
+	case types.StoreTypeSMT:
+		return smt.LoadStore(db, id, rs.lazyLoading)
+
 	case types.StoreTypeDB:
 		return commitDBStoreAdapter{Store: dbadapter.Store{DB: db}}, nil
 
@@ -575,6 +1381,9 @@ type storeParams struct {
 	key types.StoreKey
 	db  dbm.DB
 	typ types.StoreType
+
+	// the type of the commitment store of a decoupled store
+	commitment types.StoreType
 }
 
 func getLatestVersion(db dbm.DB) int64 {
@@ -594,20 +1403,56 @@ func getLatestVersion(db dbm.DB) int64 {
 	return latestVersion
 }
 
-// Commits each store and returns a new commitInfo.
-func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore) *types.CommitInfo {
-	storeInfos := make([]types.StoreInfo, 0, len(storeMap))
+// commitStores commits the sub-stores concurrently, each one holding its lock,
+// and returns the resulting CommitInfo. The StoreInfos are sorted by store
+// name so that the CommitInfo does not depend on the order of the commits.
+func (rs *Store) commitStores(version int64) *types.CommitInfo {
+	keys := make([]types.StoreKey, 0, len(rs.stores))
+	for key := range rs.stores {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Name() < keys[j].Name()
+	})
 
-	for key, store := range storeMap {
-		commitID := store.Commit()
+	commitIDs := make([]types.CommitID, len(keys))
+	panics := make([]interface{}, len(keys))
 
-		if store.GetStoreType() == types.StoreTypeTransient {
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Add(1)
+
+		go func(i int, key types.StoreKey) {
+			defer wg.Done()
+
+			// a panicking commit is re-raised by the committing goroutine
+			defer func() {
+				panics[i] = recover()
+			}()
+
+			rs.storeMtxs[key].Lock()
+			defer rs.storeMtxs[key].Unlock()
+
+			commitIDs[i] = rs.stores[key].Commit()
+		}(i, key)
+	}
+	wg.Wait()
+
+	for _, p := range panics {
+		if p != nil {
+			panic(p)
+		}
+	}
+
+	storeInfos := make([]types.StoreInfo, 0, len(keys))
+	for i, key := range keys {
+		if rs.stores[key].GetStoreType() == types.StoreTypeTransient {
 			continue
 		}
 
 		si := types.StoreInfo{}
 		si.Name = key.Name()
-		si.CommitId = commitID
+		si.CommitId = commitIDs[i]
 		storeInfos = append(storeInfos, si)
 	}
 
@@ -1,13 +1,19 @@
 package rootmulti
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"runtime"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	dbm "github.com/tendermint/tm-db"
 
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/decoupled"
 	"github.com/cosmos/cosmos-sdk/store/iavl"
 	sdkmaps "github.com/cosmos/cosmos-sdk/store/internal/maps"
 	"github.com/cosmos/cosmos-sdk/store/types"
@@ -518,9 +524,678 @@ func TestMultiStore_PruningRestart(t *testing.T) {
 	}
 }
 
+func TestMultiStore_PruningInBackground(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.NewPruningOptions(1, 0, 5))
+	blockerKey := types.NewTransientStoreKey("blocker")
+	ms.MountStoreWithDB(blockerKey, types.StoreTypeTransient, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := int64(0); i < 4; i++ {
+		ms.Commit()
+	}
+
+	// The final commit locks store1 once it is committed, so that the
+	// background pruning cannot complete until it is unlocked.
+	store1, mtx := ms.stores[ms.keysByName["store1"]], ms.storeMtxs[ms.keysByName["store1"]]
+	blocker := ms.stores[blockerKey]
+	ms.stores[blockerKey] = lockingStore{blocker, func() {
+		for {
+			mtx.Lock()
+			if store1.LastCommitID().Version == 5 {
+				return
+			}
+			mtx.Unlock()
+			runtime.Gosched()
+		}
+	}}
+	ms.Commit()
+	ms.stores[blockerKey] = blocker
+	require.Empty(t, ms.pruneHeights)
+
+	// the heights are persisted until they are pruned
+	require.Equal(t, []int64{1, 2, 3}, ms.unprunedHeights())
+
+	ph, err := getPruningHeights(db)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3}, ph)
+
+	mtx.Unlock()
+
+	// reads of the heights being pruned wait for the pruning
+	for _, v := range []int64{1, 2, 3} {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		require.Error(t, err, "expected error when loading height: %d", v)
+	}
+	require.Nil(t, ms.unprunedHeights())
+
+	_, err = ms.CacheMultiStoreWithVersion(4)
+	require.NoError(t, err)
+
+	ms.Commit()
+	ph, err = getPruningHeights(db)
+	require.NoError(t, err)
+	require.Equal(t, []int64{4}, ph)
+}
+
+func TestMultiStore_PruningConcurrentWrites(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.NewPruningOptions(0, 0, 2))
+	blockerKey := types.NewTransientStoreKey("blocker")
+	ms.MountStoreWithDB(blockerKey, types.StoreTypeTransient, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	key := ms.keysByName["store1"]
+	for i := 0; i < 9; i++ {
+		ms.GetKVStore(key).Set([]byte(fmt.Sprintf("k%d", i)), []byte("v"))
+		ms.Commit()
+	}
+
+	// The next commit locks store1 once it is committed, which keeps the
+	// pruning it starts running until the writes of the next block are issued.
+	store1, mtx := ms.stores[key], ms.storeMtxs[key]
+	blocker := ms.stores[blockerKey]
+	ms.stores[blockerKey] = lockingStore{blocker, func() {
+		for {
+			mtx.Lock()
+			if store1.LastCommitID().Version == 10 {
+				return
+			}
+			mtx.Unlock()
+			runtime.Gosched()
+		}
+	}}
+	ms.Commit()
+	ms.stores[blockerKey] = blocker
+	require.Equal(t, []int64{8, 9}, ms.unprunedHeights())
+
+	written := make(chan struct{})
+	go func() {
+		defer close(written)
+
+		cacheMulti := ms.CacheMultiStore()
+		for i := 0; i < 5; i++ {
+			cacheMulti.GetKVStore(key).Set([]byte(fmt.Sprintf("k%d", i)), []byte("w"))
+			cacheMulti.GetKVStore(key).Delete([]byte(fmt.Sprintf("k%d", i+5)))
+		}
+		cacheMulti.Write()
+
+		ms.GetKVStore(key).Set([]byte("k"), []byte("w"))
+	}()
+
+	select {
+	case <-written:
+		t.Fatal("wrote to a store being pruned")
+	case <-time.After(50 * time.Millisecond):
+	}
+	mtx.Unlock()
+	<-written
+
+	ms.Commit()
+	require.NoError(t, ms.waitPruning())
+	require.Equal(t, []byte("w"), ms.GetKVStore(key).Get([]byte("k")))
+	require.Equal(t, []byte("w"), ms.GetKVStore(key).Get([]byte("k0")))
+	require.Nil(t, ms.GetKVStore(key).Get([]byte("k5")))
+}
+
+func TestMultiStore_PruningFailure(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.NewPruningOptions(1, 0, 5))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := int64(0); i < 4; i++ {
+		ms.Commit()
+	}
+
+	// the latest version cannot be deleted, which fails the pruning without
+	// panicking in the background
+	ms.pruneHeights = []int64{5}
+	ms.Commit()
+	require.Error(t, ms.waitPruning())
+
+	// the failed heights remain persisted, to be pruned again after a restart
+	require.Equal(t, []int64{5, 3}, ms.unprunedHeights())
+	ms.Commit()
+
+	ph, err := getPruningHeights(db)
+	require.NoError(t, err)
+	require.Equal(t, []int64{5, 3, 4}, ph)
+
+	// the failure is raised by the next commit pruning the stores
+	for i := int64(0); i < 3; i++ {
+		ms.Commit()
+	}
+	require.Panics(t, func() { ms.Commit() })
+}
+
+// lockingStore is a CommitKVStore which calls lock once committed.
+type lockingStore struct {
+	types.CommitKVStore
+	lock func()
+}
+
+func (s lockingStore) Commit() types.CommitID {
+	id := s.CommitKVStore.Commit()
+	s.lock()
+	return id
+}
+
+// panickingStore is a CommitKVStore whose commit panics.
+type panickingStore struct {
+	types.CommitKVStore
+}
+
+func (panickingStore) Commit() types.CommitID {
+	panic("commit failure")
+}
+
+func TestMultiStoreCommitStores(t *testing.T) {
+	newStore := func(db dbm.DB) *Store {
+		store := NewStore(db)
+		for _, name := range []string{"c", "a", "d", "b"} {
+			store.MountStoreWithDB(types.NewKVStoreKey(name), types.StoreTypeIAVL, nil)
+		}
+		store.MountStoreWithDB(types.NewTransientStoreKey("transient"), types.StoreTypeTransient, nil)
+		require.NoError(t, store.LoadLatestVersion())
+		return store
+	}
+
+	ms := newStore(dbm.NewMemDB())
+	for i := 0; i < 3; i++ {
+		for name, key := range ms.keysByName {
+			ms.GetKVStore(key).Set([]byte(name), []byte(fmt.Sprintf("%d", i)))
+		}
+		ms.Commit()
+	}
+
+	// the store infos are sorted by name whatever the order of the commits
+	var names []string
+	for _, si := range ms.lastCommitInfo.StoreInfos {
+		names = append(names, si.Name)
+		require.Equal(t, ms.GetCommitKVStore(ms.keysByName[si.Name]).LastCommitID(), si.CommitId)
+	}
+	require.Equal(t, []string{"a", "b", "c", "d"}, names)
+
+	cInfo, err := getCommitInfo(ms.db, 3)
+	require.NoError(t, err)
+	require.Equal(t, ms.lastCommitInfo, cInfo)
+
+	// a panic of a store commit is raised by Commit
+	key := ms.keysByName["b"]
+	ms.stores[key] = panickingStore{ms.stores[key]}
+	require.PanicsWithValue(t, "commit failure", func() { ms.Commit() })
+}
+
+func TestMultiStoreRollbackToVersion(t *testing.T) {
+	newStore := func(db dbm.DB) *Store {
+		store := NewStore(db)
+		store.pruningOpts = types.NewPruningOptions(2, 0, 1)
+		store.MountStoreWithDB(types.NewKVStoreKey("iavl"), types.StoreTypeIAVL, nil)
+		store.MountStoreWithDB(types.NewKVStoreKey("decoupled"), types.StoreTypeDecoupled, nil)
+		store.MountStoreWithDB(types.NewKVStoreKey("smt"), types.StoreTypeSMT, nil)
+		store.MountStoreWithDB(types.NewTransientStoreKey("transient"), types.StoreTypeTransient, nil)
+		require.NoError(t, store.LoadLatestVersion())
+		return store
+	}
+
+	db := dbm.NewMemDB()
+	ms := newStore(db)
+
+	var cids []types.CommitID
+	for v := 1; v <= 5; v++ {
+		for _, name := range []string{"iavl", "decoupled", "smt"} {
+			ms.GetKVStore(ms.keysByName[name]).Set([]byte("k"), []byte(fmt.Sprintf("v%d", v)))
+		}
+		cids = append(cids, ms.Commit())
+	}
+
+	// pruned versions cannot be rolled back to, and nothing is deleted
+	require.Error(t, ms.RollbackToVersion(2))
+	require.Error(t, ms.RollbackToVersion(6))
+	require.Equal(t, cids[4], ms.LastCommitID())
+
+	cInfo, err := ms.GetCommitInfo(3)
+	require.NoError(t, err)
+	require.Equal(t, cids[2].Hash, cInfo.Hash())
+
+	require.NoError(t, ms.RollbackToVersion(3))
+	require.Equal(t, cids[2], ms.LastCommitID())
+
+	// rolling back again to the same version is a no-op
+	require.NoError(t, ms.RollbackToVersion(3))
+	require.Equal(t, cids[2], ms.LastCommitID())
+	_, err = ms.GetCommitInfo(4)
+	require.Error(t, err)
+
+	// the rolled back versions can be committed again with a different state
+	for _, name := range []string{"iavl", "decoupled", "smt"} {
+		key := ms.keysByName[name]
+		require.Equal(t, []byte("v3"), ms.GetKVStore(key).Get([]byte("k")))
+		ms.GetKVStore(key).Set([]byte("k"), []byte("other"))
+	}
+	cid := ms.Commit()
+	require.Equal(t, int64(4), cid.Version)
+	require.NotEqual(t, cids[3].Hash, cid.Hash)
+
+	// restart
+	ms = newStore(db)
+	require.Equal(t, cid, ms.LastCommitID())
+	require.Equal(t, []byte("other"), ms.GetKVStore(ms.keysByName["smt"]).Get([]byte("k")))
+}
+
+func TestMultiStorePruneVersions(t *testing.T) {
+	newStore := func(db dbm.DB) *Store {
+		store := NewStore(db)
+		store.pruningOpts = types.PruneNothing
+		store.MountStoreWithDB(types.NewKVStoreKey("iavl"), types.StoreTypeIAVL, nil)
+		store.MountStoreWithDB(types.NewKVStoreKey("decoupled"), types.StoreTypeDecoupled, nil)
+		store.MountStoreWithDB(types.NewKVStoreKey("smt"), types.StoreTypeSMT, nil)
+		store.MountStoreWithDB(types.NewTransientStoreKey("transient"), types.StoreTypeTransient, nil)
+		require.NoError(t, store.LoadLatestVersion())
+		return store
+	}
+
+	db := dbm.NewMemDB()
+	ms := newStore(db)
+
+	for v := 1; v <= 10; v++ {
+		for _, name := range []string{"iavl", "decoupled", "smt"} {
+			ms.GetKVStore(ms.keysByName[name]).Set([]byte("k"), []byte(fmt.Sprintf("v%d", v)))
+		}
+		ms.Commit()
+	}
+
+	require.Error(t, ms.PruneVersions(types.NewPruningOptions(2, 3, 1), 0, nil))
+
+	var progress [][2]int
+	err := ms.PruneVersions(types.NewPruningOptions(2, 3, 1), 2, func(pruned, total int) {
+		progress = append(progress, [2]int{pruned, total})
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][2]int{{2, 5}, {4, 5}, {5, 5}}, progress)
+
+	kept := map[int64]bool{3: true, 6: true, 8: true, 9: true, 10: true}
+	for _, name := range []string{"iavl", "decoupled", "smt"} {
+		store := ms.GetCommitKVStore(ms.keysByName[name])
+		for v := int64(1); v <= 10; v++ {
+			require.Equal(t, kept[v], versionExists(store, v), "store %s version %d", name, v)
+		}
+	}
+
+	// pruning again only reports the versions still to delete
+	progress = nil
+	require.NoError(t, ms.PruneVersions(types.NewPruningOptions(2, 3, 1), 2, func(pruned, total int) {
+		progress = append(progress, [2]int{pruned, total})
+	}))
+	require.Empty(t, progress)
+
+	// restart
+	cid := ms.LastCommitID()
+	ms = newStore(db)
+	require.Equal(t, cid, ms.LastCommitID())
+	require.Equal(t, []byte("v10"), ms.GetKVStore(ms.keysByName["smt"]).Get([]byte("k")))
+
+	cms, err := ms.CacheMultiStoreWithVersion(6)
+	require.NoError(t, err)
+	require.Equal(t, []byte("v6"), cms.GetKVStore(ms.keysByName["iavl"]).Get([]byte("k")))
+}
+
+func TestMultistoreSnapshotRestore(t *testing.T) {
+	source := newMultiStoreWithGeneratedData(dbm.NewMemDB(), 4, 100)
+	target := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, target.LoadLatestVersion())
+
+	version := uint64(source.LastCommitID().Version)
+	require.EqualValues(t, 4, version)
+
+	chunks, err := source.Snapshot(version, snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+
+	ready := make(chan struct{})
+	err = target.Restore(version, snapshottypes.CurrentFormat, chunks, ready)
+	require.NoError(t, err)
+	require.Equal(t, source.LastCommitID(), target.LastCommitID())
+
+	for key, sourceStore := range source.stores {
+		targetStore := target.getStoreByName(key.Name()).(types.CommitKVStore)
+		require.Equal(t, sourceStore.LastCommitID(), targetStore.LastCommitID())
+
+		sourceIter := sourceStore.(types.KVStore).Iterator(nil, nil)
+		targetIter := targetStore.(types.KVStore).Iterator(nil, nil)
+		for ; sourceIter.Valid(); sourceIter.Next() {
+			require.True(t, targetIter.Valid())
+			require.Equal(t, sourceIter.Key(), targetIter.Key())
+			require.Equal(t, sourceIter.Value(), targetIter.Value())
+			targetIter.Next()
+		}
+		require.False(t, targetIter.Valid())
+		sourceIter.Close()
+		targetIter.Close()
+	}
+
+	// the restored version must be persisted and reloadable
+	reloaded := newMultiStoreWithMounts(target.db, types.PruneNothing)
+	require.NoError(t, reloaded.LoadLatestVersion())
+	require.Equal(t, source.LastCommitID(), reloaded.LastCommitID())
+}
+
+func TestMultistoreSnapshot_Errors(t *testing.T) {
+	store := newMultiStoreWithGeneratedData(dbm.NewMemDB(), 2, 10)
+
+	testcases := map[string]struct {
+		height     uint64
+		format     uint32
+		expectType error
+	}{
+		"0 height":       {0, snapshottypes.CurrentFormat, sdkerrors.ErrLogic},
+		"0 format":       {1, 0, snapshottypes.ErrUnknownFormat},
+		"unknown height": {9, snapshottypes.CurrentFormat, sdkerrors.ErrLogic},
+		"unknown format": {1, 9, snapshottypes.ErrUnknownFormat},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			_, err := store.Snapshot(tc.height, tc.format)
+			require.Error(t, err)
+			require.True(t, errors.Is(err, tc.expectType))
+		})
+	}
+}
+
+func TestMultistoreSnapshotUnsupportedStore(t *testing.T) {
+	store := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+	require.NoError(t, store.ValidateSnapshotStores())
+
+	store = newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	store.MountStoreWithDB(types.NewKVStoreKey("smt"), types.StoreTypeSMT, nil)
+	require.NoError(t, store.LoadLatestVersion())
+	store.Commit()
+
+	err := store.ValidateSnapshotStores()
+	require.True(t, errors.Is(err, sdkerrors.ErrLogic))
+
+	_, err = store.Snapshot(1, snapshottypes.CurrentFormat)
+	require.True(t, errors.Is(err, sdkerrors.ErrLogic))
+	require.Contains(t, err.Error(), "don't know how to snapshot store \"smt\"")
+}
+
+func TestMultistoreRestore_Errors(t *testing.T) {
+	store := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+
+	testcases := map[string]struct {
+		height     uint64
+		format     uint32
+		expectType error
+	}{
+		"0 height":       {0, snapshottypes.CurrentFormat, sdkerrors.ErrLogic},
+		"0 format":       {1, 0, snapshottypes.ErrUnknownFormat},
+		"unknown format": {1, 9, snapshottypes.ErrUnknownFormat},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := store.Restore(tc.height, tc.format, make(chan io.ReadCloser), nil)
+			require.Error(t, err)
+			require.True(t, errors.Is(err, tc.expectType))
+		})
+	}
+}
+
+func TestMultiStoreDecoupled(t *testing.T) {
+	newStore := func(db dbm.DB) *Store {
+		store := NewStore(db)
+		store.pruningOpts = types.NewPruningOptions(1, 0, 1)
+		store.MountStoreWithDB(types.NewKVStoreKey("store1"), types.StoreTypeIAVL, nil)
+		store.MountStoreWithDB(types.NewKVStoreKey("decoupled"), types.StoreTypeDecoupled, nil)
+		require.NoError(t, store.LoadLatestVersion())
+		return store
+	}
+
+	db := dbm.NewMemDB()
+	multi := newStore(db)
+	key := multi.keysByName["decoupled"]
+	require.Equal(t, types.StoreTypeDecoupled, multi.GetCommitKVStore(key).GetStoreType())
+
+	for v := 1; v <= 3; v++ {
+		multi.GetKVStore(key).Set([]byte("k"), []byte(fmt.Sprintf("v%d", v)))
+		multi.Commit()
+	}
+	cid := multi.LastCommitID()
+
+	// historical reads are served by the state storage, pruned heights are gone
+	cms, err := multi.CacheMultiStoreWithVersion(2)
+	require.NoError(t, err)
+	require.Equal(t, []byte("v2"), cms.GetKVStore(key).Get([]byte("k")))
+	_, err = multi.CacheMultiStoreWithVersion(1)
+	require.Error(t, err)
+
+	// proofs are built by the commitment store
+	res := multi.Query(abci.RequestQuery{Path: "/decoupled/key", Data: []byte("k"), Height: 3, Prove: true})
+	require.EqualValues(t, 0, res.Code)
+	require.Equal(t, []byte("v3"), res.Value)
+	require.NoError(t, DefaultProofRuntime().VerifyValue(res.Proof, cid.Hash, "/decoupled/k", []byte("v3")))
+
+	// the store is snapshotted and restored through its commitment store
+	chunks, err := multi.Snapshot(uint64(cid.Version), snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+
+	target := newStore(dbm.NewMemDB())
+	require.NoError(t, target.Restore(uint64(cid.Version), snapshottypes.CurrentFormat, chunks, nil))
+	require.Equal(t, cid, target.LastCommitID())
+	require.Equal(t, []byte("v3"), target.GetKVStore(target.keysByName["decoupled"]).Get([]byte("k")))
+
+	// restart
+	multi = newStore(db)
+	require.Equal(t, cid, multi.LastCommitID())
+	require.Equal(t, []byte("v3"), multi.GetKVStore(multi.keysByName["decoupled"]).Get([]byte("k")))
+}
+
+func TestMultiStoreDecoupledSMT(t *testing.T) {
+	newStore := func(db dbm.DB) *Store {
+		store := NewStore(db)
+		store.pruningOpts = types.PruneNothing
+		store.MountStoreWithDB(types.NewKVStoreKey("store1"), types.StoreTypeIAVL, nil)
+		store.MountDecoupledStoreWithDB(types.NewKVStoreKey("decoupled"), types.StoreTypeSMT, nil)
+		store.MountStoreWithDB(types.NewKVStoreKey("smt"), types.StoreTypeSMT, nil)
+		require.NoError(t, store.LoadLatestVersion())
+		return store
+	}
+
+	require.Panics(t, func() {
+		NewStore(dbm.NewMemDB()).MountDecoupledStoreWithDB(types.NewKVStoreKey("decoupled"), types.StoreTypeTransient, nil)
+	})
+
+	db := dbm.NewMemDB()
+	multi := newStore(db)
+	key, smtKey := multi.keysByName["decoupled"], multi.keysByName["smt"]
+	require.Equal(t, types.StoreTypeSMT, multi.GetCommitKVStore(key).(*decoupled.Store).CommitmentType())
+
+	for v := 1; v <= 3; v++ {
+		multi.GetKVStore(key).Set([]byte("k"), []byte(fmt.Sprintf("v%d", v)))
+		multi.GetKVStore(smtKey).Set([]byte("k"), []byte(fmt.Sprintf("v%d", v)))
+		multi.Commit()
+	}
+	cid := multi.LastCommitID()
+
+	// the store commits to its state as a SMT store holding the same data
+	require.Equal(t, multi.GetCommitKVStore(smtKey).LastCommitID(), multi.GetCommitKVStore(key).LastCommitID())
+
+	// proofs are built by the SMT commitment store
+	res := multi.Query(abci.RequestQuery{Path: "/decoupled/key", Data: []byte("k"), Height: 3, Prove: true})
+	require.EqualValues(t, 0, res.Code)
+	require.Equal(t, []byte("v3"), res.Value)
+	require.NoError(t, DefaultProofRuntime().VerifyValue(res.Proof, cid.Hash, "/decoupled/k", []byte("v3")))
+
+	// snapshots are not supported
+	require.Error(t, multi.ValidateSnapshotStores())
+
+	// restart
+	multi = newStore(db)
+	key, smtKey = multi.keysByName["decoupled"], multi.keysByName["smt"]
+	require.Equal(t, cid, multi.LastCommitID())
+	require.Equal(t, []byte("v3"), multi.GetKVStore(key).Get([]byte("k")))
+
+	// roll back the state storage and the commitment store
+	require.NoError(t, multi.RollbackToVersion(2))
+	multi = newStore(db)
+	key, smtKey = multi.keysByName["decoupled"], multi.keysByName["smt"]
+	require.EqualValues(t, 2, multi.LastCommitID().Version)
+	require.Equal(t, []byte("v2"), multi.GetKVStore(key).Get([]byte("k")))
+	require.Equal(t, multi.GetCommitKVStore(smtKey).LastCommitID(), multi.GetCommitKVStore(key).LastCommitID())
+}
+
+func TestMultiStoreSMT(t *testing.T) {
+	newStore := func(db dbm.DB) *Store {
+		store := NewStore(db)
+		store.pruningOpts = types.NewPruningOptions(1, 0, 1)
+		store.MountStoreWithDB(types.NewKVStoreKey("store1"), types.StoreTypeIAVL, nil)
+		store.MountStoreWithDB(types.NewKVStoreKey("smt"), types.StoreTypeSMT, nil)
+		require.NoError(t, store.LoadLatestVersion())
+		return store
+	}
+
+	db := dbm.NewMemDB()
+	multi := newStore(db)
+	key := multi.keysByName["smt"]
+	require.Equal(t, types.StoreTypeSMT, multi.GetCommitKVStore(key).GetStoreType())
+
+	for v := 1; v <= 3; v++ {
+		multi.GetKVStore(key).Set([]byte("k"), []byte(fmt.Sprintf("v%d", v)))
+		multi.Commit()
+	}
+	cid := multi.LastCommitID()
+
+	// pruned heights are gone
+	cms, err := multi.CacheMultiStoreWithVersion(2)
+	require.NoError(t, err)
+	require.Equal(t, []byte("v2"), cms.GetKVStore(key).Get([]byte("k")))
+	_, err = multi.CacheMultiStoreWithVersion(1)
+	require.Error(t, err)
+
+	// existence and absence proofs verify against the app hash
+	res := multi.Query(abci.RequestQuery{Path: "/smt/key", Data: []byte("k"), Height: 3, Prove: true})
+	require.EqualValues(t, 0, res.Code)
+	require.Equal(t, []byte("v3"), res.Value)
+	require.NoError(t, DefaultProofRuntime().VerifyValue(res.Proof, cid.Hash, "/smt/k", []byte("v3")))
+
+	res = multi.Query(abci.RequestQuery{Path: "/smt/key", Data: []byte("missing"), Height: 3, Prove: true})
+	require.EqualValues(t, 0, res.Code)
+	require.Nil(t, res.Value)
+	require.NoError(t, DefaultProofRuntime().VerifyAbsence(res.Proof, cid.Hash, "/smt/missing"))
+
+	// snapshots are not supported
+	_, err = multi.Snapshot(uint64(cid.Version), snapshottypes.CurrentFormat)
+	require.Error(t, err)
+
+	// restart
+	multi = newStore(db)
+	require.Equal(t, cid, multi.LastCommitID())
+	require.Equal(t, []byte("v3"), multi.GetKVStore(multi.keysByName["smt"]).Get([]byte("k")))
+}
+
+func TestMultiStoreListeners(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, multi.LoadLatestVersion())
+
+	key1, key2 := multi.keysByName["store1"], multi.keysByName["store2"]
+	listener := &mockWriteListener{}
+
+	require.False(t, multi.ListeningEnabled(key1))
+	multi.AddListeners(key1, []types.WriteListener{listener})
+	require.True(t, multi.ListeningEnabled(key1))
+	require.False(t, multi.ListeningEnabled(key2))
+
+	// writes to a cache are only emitted once the cache is written
+	cacheMulti := multi.CacheMultiStore()
+	cacheMulti.GetKVStore(key1).Set([]byte("key1"), []byte("value1"))
+	cacheMulti.GetKVStore(key1).Delete([]byte("key2"))
+	cacheMulti.GetKVStore(key2).Set([]byte("key3"), []byte("value3"))
+	require.Empty(t, listener.writes)
+
+	cacheMulti.Write()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store1", Key: []byte("key1"), Value: []byte("value1")},
+		{StoreKey: "store1", Delete: true, Key: []byte("key2")},
+	}, listener.writes)
+
+	// direct writes are emitted immediately
+	multi.GetKVStore(key1).Set([]byte("key4"), []byte("value4"))
+	require.Len(t, listener.writes, 3)
+}
+
+func TestMultiStoreListenersOrder(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, multi.LoadLatestVersion())
+
+	listener := &mockWriteListener{}
+	for _, name := range []string{"store1", "store2", "store3"} {
+		multi.AddListeners(multi.keysByName[name], []types.WriteListener{listener})
+	}
+
+	// the writes are emitted sorted by store name and key, whatever the order
+	// they were made in
+	for i := 0; i < 10; i++ {
+		listener.writes = nil
+
+		cacheMulti := multi.CacheMultiStore()
+		for _, name := range []string{"store3", "store1", "store2"} {
+			store := cacheMulti.GetKVStore(multi.keysByName[name])
+			store.Set([]byte("b"), []byte(name))
+			store.Delete([]byte("a"))
+		}
+		cacheMulti.Write()
+
+		require.Equal(t, []types.StoreKVPair{
+			{StoreKey: "store1", Delete: true, Key: []byte("a")},
+			{StoreKey: "store1", Key: []byte("b"), Value: []byte("store1")},
+			{StoreKey: "store2", Delete: true, Key: []byte("a")},
+			{StoreKey: "store2", Key: []byte("b"), Value: []byte("store2")},
+			{StoreKey: "store3", Delete: true, Key: []byte("a")},
+			{StoreKey: "store3", Key: []byte("b"), Value: []byte("store3")},
+		}, listener.writes)
+	}
+}
+
+type mockWriteListener struct {
+	writes []types.StoreKVPair
+}
+
+func (l *mockWriteListener) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	l.writes = append(l.writes, types.StoreKVPair{StoreKey: storeKey.Name(), Delete: delete, Key: key, Value: value})
+	return nil
+}
+
 //-----------------------------------------------------------------------
 // utils
 
+// newMultiStoreWithGeneratedData returns a committed multistore with the
+// given number of versions, each setting the given number of keys per store.
+func newMultiStoreWithGeneratedData(db dbm.DB, versions int, keys int) *Store {
+	store := newMultiStoreWithMounts(db, types.PruneNothing)
+	if err := store.LoadLatestVersion(); err != nil {
+		panic(err)
+	}
+
+	for v := 0; v < versions; v++ {
+		for key := range store.stores {
+			kv := store.GetKVStore(key)
+			for i := 0; i < keys; i++ {
+				kv.Set([]byte(fmt.Sprintf("key-%d", (i*7+v)%(keys+v))), []byte(fmt.Sprintf("%s-%d-%d", key.Name(), v, i)))
+			}
+			if v > 0 {
+				kv.Delete([]byte(fmt.Sprintf("key-%d", v)))
+			}
+		}
+		store.Commit()
+	}
+
+	return store
+}
+
 func newMultiStoreWithMounts(db dbm.DB, pruningOpts types.PruningOptions) *Store {
 	store := NewStore(db)
 	store.pruningOpts = pruningOpts
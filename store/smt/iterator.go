@@ -0,0 +1,189 @@
+package smt
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/internal/keys"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ types.Iterator = (*iterator)(nil)
+
+// iterator iterates over the leaves of a tree in a range of keys. It keeps a
+// stack of the subtrees left to iterate over, each subtree of the stack
+// coming after the ones above it in the order of the iteration.
+type iterator struct {
+	tree       *tree
+	start, end []byte
+	ascending  bool
+
+	stack []*node
+	leaf  *node
+}
+
+func newIterator(t *tree, start, end []byte, ascending bool) *iterator {
+	iter := &iterator{
+		tree:      t,
+		start:     start,
+		end:       end,
+		ascending: ascending,
+	}
+
+	if t.root != nil {
+		if ascending {
+			iter.seek(start, true)
+		} else {
+			iter.seek(end, false)
+		}
+	}
+
+	iter.next()
+
+	return iter
+}
+
+// seek fills the stack with the subtrees holding the keys from the bound key
+// in the order of the iteration, i.e. the keys greater than or equal to key
+// when ascending and the keys strictly lower than key otherwise.
+func (iter *iterator) seek(key []byte, ascending bool) {
+	if key == nil {
+		iter.stack = []*node{iter.tree.root}
+		return
+	}
+
+	// forward is the direction of the iteration: the subtrees on this side of
+	// the path of the key are iterated over after the key
+	var forward byte
+	if ascending {
+		forward = 1
+	}
+
+	path := keys.Encode(key)
+
+	// follow the path of the key down to the closest leaf, stacking the
+	// subtrees left behind on the forward side
+	var (
+		steps []*node
+		dirs  []byte
+	)
+
+	n := iter.tree.load(iter.tree.root)
+	for !n.isLeaf() {
+		dir := bitAt(path, n.bit)
+		steps, dirs = append(steps, n), append(dirs, dir)
+		n = iter.tree.load(n.child(dir))
+	}
+
+	if bytes.Equal(n.key, key) {
+		for i, step := range steps {
+			if dirs[i] != forward {
+				iter.stack = append(iter.stack, step.child(forward))
+			}
+		}
+
+		if ascending {
+			iter.stack = append(iter.stack, n)
+		}
+
+		return
+	}
+
+	// the path of the key leaves the tree at its first differing bit with the
+	// closest leaf: the steps above it were taken along the path while the
+	// subtree below is entirely before or after the key
+	bit := critBit(path, keys.Encode(n.key))
+
+	subtree := iter.tree.root
+	for i, step := range steps {
+		if step.bit > bit {
+			break
+		}
+
+		if dirs[i] != forward {
+			iter.stack = append(iter.stack, step.child(forward))
+		}
+		subtree = step.child(dirs[i])
+	}
+
+	if bitAt(path, bit) != forward {
+		iter.stack = append(iter.stack, subtree)
+	}
+}
+
+// next moves to the next leaf of the stack in the range.
+func (iter *iterator) next() {
+	iter.leaf = nil
+
+	for len(iter.stack) > 0 {
+		n := iter.tree.load(iter.stack[len(iter.stack)-1])
+		iter.stack = iter.stack[:len(iter.stack)-1]
+
+		for !n.isLeaf() {
+			if iter.ascending {
+				iter.stack = append(iter.stack, n.right)
+				n = iter.tree.load(n.left)
+			} else {
+				iter.stack = append(iter.stack, n.left)
+				n = iter.tree.load(n.right)
+			}
+		}
+
+		// the seek skipped the keys before the range, the iteration stops at
+		// the first key after it
+		if iter.ascending && iter.end != nil && bytes.Compare(n.key, iter.end) >= 0 ||
+			!iter.ascending && iter.start != nil && bytes.Compare(n.key, iter.start) < 0 {
+			iter.stack = nil
+			return
+		}
+
+		iter.leaf = n
+		return
+	}
+}
+
+// Implements types.Iterator.
+func (iter *iterator) Domain() (start, end []byte) {
+	return iter.start, iter.end
+}
+
+// Implements types.Iterator.
+func (iter *iterator) Valid() bool {
+	return iter.leaf != nil
+}
+
+// Implements types.Iterator.
+func (iter *iterator) Next() {
+	if !iter.Valid() {
+		panic("invalid iterator")
+	}
+
+	iter.next()
+}
+
+// Implements types.Iterator.
+func (iter *iterator) Key() []byte {
+	if !iter.Valid() {
+		panic("invalid iterator")
+	}
+
+	return iter.leaf.key
+}
+
+// Implements types.Iterator.
+func (iter *iterator) Value() []byte {
+	if !iter.Valid() {
+		panic("invalid iterator")
+	}
+
+	return iter.leaf.value
+}
+
+// Implements types.Iterator.
+func (iter *iterator) Error() error {
+	return nil
+}
+
+// Implements types.Iterator.
+func (iter *iterator) Close() {
+	iter.stack, iter.leaf = nil, nil
+}
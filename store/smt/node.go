@@ -0,0 +1,242 @@
+package smt
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const (
+	leafTag  byte = 0x00
+	innerTag byte = 0x01
+
+	hashSize    = sha256.Size
+	nodeKeySize = 12
+)
+
+// nodeKey identifies a persisted node by the version it was created at and
+// its sequence number within this version.
+type nodeKey struct {
+	version int64
+	seq     uint32
+}
+
+func (nk nodeKey) bytes() []byte {
+	bz := make([]byte, nodeKeySize)
+	binary.BigEndian.PutUint64(bz, uint64(nk.version))
+	binary.BigEndian.PutUint32(bz[8:], nk.seq)
+	return bz
+}
+
+func parseNodeKey(bz []byte) nodeKey {
+	return nodeKey{
+		version: int64(binary.BigEndian.Uint64(bz)),
+		seq:     binary.BigEndian.Uint32(bz[8:]),
+	}
+}
+
+// node is a node of the tree. A leaf holds a key/value pair while an inner
+// node splits the keys of its subtree on the bit of their path at index bit,
+// the keys with a 0 bit going to the left child and the others to the right
+// one. Inner nodes always have two children.
+//
+// A node read from the database references its children by stubs, i.e. nodes
+// only holding their node key and hash, which are loaded on access. The nodes
+// of the working tree which are not persisted yet have no node key.
+type node struct {
+	nodeKey *nodeKey
+	hash    []byte
+
+	// leaf
+	key   []byte
+	value []byte
+
+	// inner node
+	bit         uint32
+	left, right *node
+}
+
+func newLeaf(key, value []byte) *node {
+	return &node{key: key, value: value}
+}
+
+// newInner returns a new inner node splitting on bit the leaf of path and
+// the other subtree.
+func newInner(bit uint32, path []byte, leaf, other *node) *node {
+	if bitAt(path, bit) == 0 {
+		return &node{bit: bit, left: leaf, right: other}
+	}
+
+	return &node{bit: bit, left: other, right: leaf}
+}
+
+func newStub(nk nodeKey, hash []byte) *node {
+	return &node{nodeKey: &nk, hash: hash}
+}
+
+func (n *node) isLeaf() bool {
+	return n.key != nil
+}
+
+func (n *node) isStub() bool {
+	return n.key == nil && n.left == nil
+}
+
+func (n *node) child(dir byte) *node {
+	if dir == 0 {
+		return n.left
+	}
+
+	return n.right
+}
+
+// clone returns a copy of an inner node to be modified in the working tree.
+func (n *node) clone() *node {
+	return &node{bit: n.bit, left: n.left, right: n.right}
+}
+
+func (n *node) setChild(dir byte, child *node) {
+	if dir == 0 {
+		n.left = child
+	} else {
+		n.right = child
+	}
+}
+
+// innerPrefix is the preimage of the hash of an inner node preceding its
+// children hashes.
+func innerPrefix(bit uint32) []byte {
+	bz := make([]byte, 6)
+	bz[0] = innerTag
+	binary.BigEndian.PutUint32(bz[1:], bit)
+	bz[5] = hashSize
+	return bz
+}
+
+// computeHash computes the hash of a node given the hashes of its children.
+// The preimages follow the format of the ICS23 IAVL proof spec:
+//
+//	leaf:  sha256(0x00 | uvarint(len(key)) | key | uvarint(32) | sha256(value))
+//	inner: sha256(0x01 | uint32(bit) | 0x20 | left hash | 0x20 | right hash)
+func (n *node) computeHash(leftHash, rightHash []byte) []byte {
+	var preimage []byte
+
+	if n.isLeaf() {
+		valueHash := sha256.Sum256(n.value)
+
+		preimage = append(preimage, leafTag)
+		preimage = appendUvarint(preimage, uint64(len(n.key)))
+		preimage = append(preimage, n.key...)
+		preimage = appendUvarint(preimage, hashSize)
+		preimage = append(preimage, valueHash[:]...)
+	} else {
+		preimage = append(preimage, innerPrefix(n.bit)...)
+		preimage = append(preimage, leftHash...)
+		preimage = append(preimage, hashSize)
+		preimage = append(preimage, rightHash...)
+	}
+
+	hash := sha256.Sum256(preimage)
+	return hash[:]
+}
+
+// encode encodes a node whose children are persisted.
+func (n *node) encode() []byte {
+	if n.isLeaf() {
+		bz := []byte{leafTag}
+		bz = appendUvarint(bz, uint64(len(n.key)))
+		bz = append(bz, n.key...)
+		return append(bz, n.value...)
+	}
+
+	bz := make([]byte, 5, 5+2*(nodeKeySize+hashSize))
+	bz[0] = innerTag
+	binary.BigEndian.PutUint32(bz[1:], n.bit)
+
+	for _, child := range []*node{n.left, n.right} {
+		bz = append(bz, child.nodeKey.bytes()...)
+		bz = append(bz, child.hash...)
+	}
+
+	return bz
+}
+
+// decodeNode decodes a node persisted under nk with the given hash.
+func decodeNode(nk nodeKey, hash, bz []byte) (*node, error) {
+	if len(bz) == 0 {
+		return nil, errors.New("empty node")
+	}
+
+	n := &node{nodeKey: &nk, hash: hash}
+
+	switch bz[0] {
+	case leafTag:
+		size, read := binary.Uvarint(bz[1:])
+		if read <= 0 || uint64(len(bz)-1-read) < size {
+			return nil, fmt.Errorf("invalid leaf node %X", bz)
+		}
+
+		offset := 1 + read
+		n.key = bz[offset : offset+int(size)]
+		n.value = bz[offset+int(size):]
+
+	case innerTag:
+		if len(bz) != 5+2*(nodeKeySize+hashSize) {
+			return nil, fmt.Errorf("invalid inner node %X", bz)
+		}
+
+		n.bit = binary.BigEndian.Uint32(bz[1:])
+
+		bz = bz[5:]
+		n.left = newStub(parseNodeKey(bz), bz[nodeKeySize:nodeKeySize+hashSize])
+
+		bz = bz[nodeKeySize+hashSize:]
+		n.right = newStub(parseNodeKey(bz), bz[nodeKeySize:])
+
+	default:
+		return nil, fmt.Errorf("invalid node tag %X", bz[0])
+	}
+
+	return n, nil
+}
+
+// bitAt returns the bit of path at index i, counting from the most significant
+// bit of its first byte so that the order of the paths is preserved. The bits
+// past the end of the path are 0.
+func bitAt(path []byte, i uint32) byte {
+	if int(i/8) >= len(path) {
+		return 0
+	}
+
+	return (path[i/8] >> (7 - i%8)) & 1
+}
+
+// critBit returns the index of the first bit at which two different paths
+// differ.
+func critBit(a, b []byte) uint32 {
+	for i := 0; ; i++ {
+		var x, y byte
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+
+		if diff := x ^ y; diff != 0 {
+			bit := uint32(i * 8)
+			for diff&0x80 == 0 {
+				diff <<= 1
+				bit++
+			}
+
+			return bit
+		}
+	}
+}
+
+func appendUvarint(bz []byte, x uint64) []byte {
+	buf := make([]byte, binary.MaxVarintLen64)
+	return append(bz, buf[:binary.PutUvarint(buf, x)]...)
+}
@@ -0,0 +1,303 @@
+package smt
+
+import (
+	"bytes"
+	"container/list"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var (
+	// nodePrefix prefixes the nodes, keyed by their node key.
+	nodePrefix = []byte("n/")
+
+	// rootPrefix prefixes the node key and the hash of the root of each
+	// version, keyed by version. The entry of an empty tree is empty.
+	rootPrefix = []byte("r/")
+
+	// orphanPrefix prefixes the nodes which are not part of the tree anymore,
+	// keyed by the last version they are part of and their node key.
+	orphanPrefix = []byte("o/")
+
+	// ErrVersionDoesNotExist is returned when a version is missing or pruned.
+	ErrVersionDoesNotExist = errors.New("version does not exist")
+)
+
+// nodeDB persists the nodes of the versions of a tree. As in IAVL, a node is
+// written once for the version which created it and is shared by the
+// following versions until it is replaced, it is then recorded as an orphan of
+// the last version it belongs to. Deleting a version deletes the orphans which
+// no remaining version references.
+type nodeDB struct {
+	db dbm.DB
+
+	mtx       sync.Mutex
+	cache     map[nodeKey]*list.Element
+	cacheList *list.List
+	cacheSize int
+}
+
+func newNodeDB(db dbm.DB, cacheSize int) *nodeDB {
+	return &nodeDB{
+		db:        db,
+		cache:     make(map[nodeKey]*list.Element),
+		cacheList: list.New(),
+		cacheSize: cacheSize,
+	}
+}
+
+func nodeDBKey(nk nodeKey) []byte {
+	return append(append([]byte{}, nodePrefix...), nk.bytes()...)
+}
+
+func rootKey(version int64) []byte {
+	bz := append([]byte{}, rootPrefix...)
+	return append(bz, versionBytes(version)...)
+}
+
+func orphanKey(toVersion int64, nk nodeKey) []byte {
+	bz := append([]byte{}, orphanPrefix...)
+	bz = append(bz, versionBytes(toVersion)...)
+	return append(bz, nk.bytes()...)
+}
+
+func versionBytes(version int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(version))
+	return bz
+}
+
+// getNode returns the node persisted under nk with the given hash.
+func (ndb *nodeDB) getNode(nk nodeKey, hash []byte) (*node, error) {
+	ndb.mtx.Lock()
+	defer ndb.mtx.Unlock()
+
+	if elem, ok := ndb.cache[nk]; ok {
+		ndb.cacheList.MoveToFront(elem)
+		return elem.Value.(*node), nil
+	}
+
+	bz, err := ndb.db.Get(nodeDBKey(nk))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, fmt.Errorf("node %d/%d not found", nk.version, nk.seq)
+	}
+
+	n, err := decodeNode(nk, hash, bz)
+	if err != nil {
+		return nil, err
+	}
+
+	ndb.cache[nk] = ndb.cacheList.PushFront(n)
+	if ndb.cacheList.Len() > ndb.cacheSize {
+		oldest := ndb.cacheList.Remove(ndb.cacheList.Back()).(*node)
+		delete(ndb.cache, *oldest.nodeKey)
+	}
+
+	return n, nil
+}
+
+func (ndb *nodeDB) uncache(nk nodeKey) {
+	ndb.mtx.Lock()
+	defer ndb.mtx.Unlock()
+
+	if elem, ok := ndb.cache[nk]; ok {
+		ndb.cacheList.Remove(elem)
+		delete(ndb.cache, nk)
+	}
+}
+
+// getRoot returns a stub of the root of a version, which is nil for an empty
+// tree. An error is returned if the version does not exist.
+func (ndb *nodeDB) getRoot(version int64) (*node, error) {
+	bz, err := ndb.db.Get(rootKey(version))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, ErrVersionDoesNotExist
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	return newStub(parseNodeKey(bz), bz[nodeKeySize:]), nil
+}
+
+func (ndb *nodeDB) versionExists(version int64) bool {
+	ok, err := ndb.db.Has(rootKey(version))
+	if err != nil {
+		panic(err)
+	}
+
+	return ok
+}
+
+// previousVersion returns the latest version before the given one, or 0 if
+// there is none.
+func (ndb *nodeDB) previousVersion(version int64) (int64, error) {
+	iter, err := ndb.db.ReverseIterator(rootPrefix, rootKey(version))
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, iter.Error()
+	}
+
+	return int64(binary.BigEndian.Uint64(iter.Key()[len(rootPrefix):])), nil
+}
+
+func (ndb *nodeDB) latestVersion() (int64, error) {
+	return ndb.previousVersion(math.MaxInt64)
+}
+
+// saveVersion writes in batch the new nodes of root, assigning them node
+// keys of the version, the root of the version and the given orphans.
+func (ndb *nodeDB) saveVersion(batch dbm.Batch, version int64, root *node, orphans []*node) {
+	if root == nil {
+		batch.Set(rootKey(version), []byte{})
+	} else {
+		var seq uint32
+		ndb.saveNode(batch, root, version, &seq)
+		batch.Set(rootKey(version), append(root.nodeKey.bytes(), root.hash...))
+	}
+
+	for _, orphan := range orphans {
+		batch.Set(orphanKey(version-1, *orphan.nodeKey), []byte{})
+	}
+}
+
+// saveNode persists the new nodes of a subtree in post-order. The hashes of
+// the nodes must be computed.
+func (ndb *nodeDB) saveNode(batch dbm.Batch, n *node, version int64, seq *uint32) {
+	if n.nodeKey != nil {
+		return
+	}
+
+	if !n.isLeaf() {
+		ndb.saveNode(batch, n.left, version, seq)
+		ndb.saveNode(batch, n.right, version, seq)
+	}
+
+	*seq++
+	n.nodeKey = &nodeKey{version: version, seq: *seq}
+	batch.Set(nodeDBKey(*n.nodeKey), n.encode())
+}
+
+// deleteVersion deletes a version which is not the latest one, along with the
+// orphans it was the last version of and which no previous version
+// references. The other orphans are handed down to the previous version.
+func (ndb *nodeDB) deleteVersion(version int64) error {
+	latest, err := ndb.latestVersion()
+	if err != nil {
+		return err
+	}
+
+	if version == latest {
+		return fmt.Errorf("cannot delete latest saved version (%d)", version)
+	}
+
+	if !ndb.versionExists(version) {
+		return ErrVersionDoesNotExist
+	}
+
+	previous, err := ndb.previousVersion(version)
+	if err != nil {
+		return err
+	}
+
+	batch := ndb.db.NewBatch()
+	defer batch.Close()
+
+	prefix := append(append([]byte{}, orphanPrefix...), versionBytes(version)...)
+
+	iter, err := ndb.db.Iterator(prefix, types.PrefixEndBytes(prefix))
+	if err != nil {
+		return err
+	}
+
+	var deleted []nodeKey
+	for ; iter.Valid(); iter.Next() {
+		nk := parseNodeKey(iter.Key()[len(prefix):])
+
+		batch.Delete(iter.Key())
+		if nk.version > previous {
+			batch.Delete(nodeDBKey(nk))
+			deleted = append(deleted, nk)
+		} else {
+			batch.Set(orphanKey(previous, nk), []byte{})
+		}
+	}
+
+	err = iter.Error()
+	iter.Close()
+	if err != nil {
+		return err
+	}
+
+	batch.Delete(rootKey(version))
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	for _, nk := range deleted {
+		ndb.uncache(nk)
+	}
+
+	return nil
+}
+
+// deleteVersionsFrom deletes all the versions from the given one, along with
+// their nodes. The nodes they orphaned belong to the previous version again.
+func (ndb *nodeDB) deleteVersionsFrom(version int64) error {
+	batch := ndb.db.NewBatch()
+	defer batch.Close()
+
+	ranges := [][2][]byte{
+		{rootKey(version), types.PrefixEndBytes(rootPrefix)},
+		{nodeDBKey(nodeKey{version: version}), types.PrefixEndBytes(nodePrefix)},
+		{orphanKey(version-1, nodeKey{}), types.PrefixEndBytes(orphanPrefix)},
+	}
+
+	var deleted []nodeKey
+	for _, r := range ranges {
+		iter, err := ndb.db.Iterator(r[0], r[1])
+		if err != nil {
+			return err
+		}
+
+		for ; iter.Valid(); iter.Next() {
+			batch.Delete(iter.Key())
+			if bytes.HasPrefix(iter.Key(), nodePrefix) {
+				deleted = append(deleted, parseNodeKey(iter.Key()[len(nodePrefix):]))
+			}
+		}
+
+		err = iter.Error()
+		iter.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	for _, nk := range deleted {
+		ndb.uncache(nk)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package smt
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	ics23 "github.com/confio/ics23/go"
+
+	"github.com/cosmos/cosmos-sdk/store/internal/keys"
+)
+
+// leafOp is the ICS23 operation hashing the leaves, see node.computeHash.
+var leafOp = &ics23.LeafOp{
+	Prefix:       []byte{leafTag},
+	Hash:         ics23.HashOp_SHA256,
+	PrehashValue: ics23.HashOp_SHA256,
+	Length:       ics23.LengthOp_VAR_PROTO,
+}
+
+// createExistenceProof returns the proof of the leaf of key, whose path is
+// made of the inner nodes from the leaf up to the root.
+func (t *tree) createExistenceProof(key []byte) (*ics23.ExistenceProof, error) {
+	if t.root == nil {
+		return nil, errors.New("cannot create existence proof in an empty tree")
+	}
+
+	path := keys.Encode(key)
+
+	var ops []*ics23.InnerOp
+
+	n := t.load(t.root)
+	for !n.isLeaf() {
+		dir := bitAt(path, n.bit)
+		leftHash, rightHash := t.computeHash(n.left), t.computeHash(n.right)
+
+		op := &ics23.InnerOp{Hash: ics23.HashOp_SHA256}
+		if dir == 0 {
+			op.Prefix = innerPrefix(n.bit)
+			op.Suffix = append([]byte{hashSize}, rightHash...)
+		} else {
+			op.Prefix = append(append(innerPrefix(n.bit), leftHash...), hashSize)
+		}
+
+		ops = append([]*ics23.InnerOp{op}, ops...)
+		n = t.load(n.child(dir))
+	}
+
+	if !bytes.Equal(n.key, key) {
+		return nil, fmt.Errorf("key %X is not in the tree", key)
+	}
+
+	return &ics23.ExistenceProof{
+		Key:   n.key,
+		Value: n.value,
+		Leaf:  leafOp,
+		Path:  ops,
+	}, nil
+}
+
+// createMembershipProof returns the ICS23 proof of an existing key.
+func (t *tree) createMembershipProof(key []byte) (*ics23.CommitmentProof, error) {
+	proof, err := t.createExistenceProof(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ics23.CommitmentProof{
+		Proof: &ics23.CommitmentProof_Exist{Exist: proof},
+	}, nil
+}
+
+// createNonMembershipProof returns the ICS23 proof of a missing key, made of
+// the existence proofs of its neighbors.
+func (t *tree) createNonMembershipProof(key []byte) (*ics23.CommitmentProof, error) {
+	if t.get(key) != nil {
+		return nil, fmt.Errorf("cannot create non-membership proof of existing key %X", key)
+	}
+
+	proof := &ics23.NonExistenceProof{Key: key}
+
+	left := newIterator(t, nil, key, false)
+	if left.Valid() {
+		exist, err := t.createExistenceProof(left.Key())
+		if err != nil {
+			return nil, err
+		}
+
+		proof.Left = exist
+	}
+	left.Close()
+
+	right := newIterator(t, key, nil, true)
+	if right.Valid() {
+		exist, err := t.createExistenceProof(right.Key())
+		if err != nil {
+			return nil, err
+		}
+
+		proof.Right = exist
+	}
+	right.Close()
+
+	return &ics23.CommitmentProof{
+		Proof: &ics23.CommitmentProof_Nonexist{Nonexist: proof},
+	}, nil
+}
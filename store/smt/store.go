@@ -0,0 +1,310 @@
+package smt
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	ics23 "github.com/confio/ics23/go"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+const (
+	defaultSMTCacheSize = 10000
+)
+
+var (
+	_ types.KVStore       = (*Store)(nil)
+	_ types.CommitStore   = (*Store)(nil)
+	_ types.CommitKVStore = (*Store)(nil)
+	_ types.Queryable     = (*Store)(nil)
+)
+
+// Store Implements types.KVStore and CommitKVStore over a sparse Merkle tree.
+type Store struct {
+	tree *tree
+}
+
+// LoadStore returns a sparse Merkle tree Store as a CommitKVStore. Internally,
+// it will load the store's version (id) from the provided DB, or the latest
+// version if id.Version is 0. An error is returned if the version fails to load.
+// The nodes are always loaded on access, so lazy loading makes no difference.
+func LoadStore(db dbm.DB, id types.CommitID, _ bool) (types.CommitKVStore, error) {
+	t := &tree{ndb: newNodeDB(db, defaultSMTCacheSize)}
+
+	version := id.Version
+	if version == 0 {
+		latest, err := t.ndb.latestVersion()
+		if err != nil {
+			return nil, err
+		}
+
+		version = latest
+	}
+
+	if version > 0 {
+		if err := t.loadVersion(version); err != nil {
+			return nil, fmt.Errorf("failed to load version %d: %w", version, err)
+		}
+	}
+
+	return &Store{
+		tree: t,
+	}, nil
+}
+
+// LoadStoreForOverwriting returns a sparse Merkle tree Store as a
+// CommitKVStore loaded at the store's version (id) from the provided DB. All
+// the versions after it are deleted so they can be committed again. An error
+// is returned if the version fails to load.
+func LoadStoreForOverwriting(db dbm.DB, id types.CommitID) (types.CommitKVStore, error) {
+	ndb := newNodeDB(db, defaultSMTCacheSize)
+	if id.Version > 0 && !ndb.versionExists(id.Version) {
+		return nil, fmt.Errorf("failed to load version %d: %w", id.Version, ErrVersionDoesNotExist)
+	}
+
+	if err := ndb.deleteVersionsFrom(id.Version + 1); err != nil {
+		return nil, err
+	}
+
+	return LoadStore(db, id, false)
+}
+
+// GetImmutable returns a reference to a new store backed by an immutable tree
+// at a specific version (height). This should be used for querying and
+// iteration only. If the version does not exist or has been pruned, an error
+// will be returned. Any mutable operations executed will result in a panic.
+func (st *Store) GetImmutable(version int64) (*Store, error) {
+	t, err := st.tree.getImmutable(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{
+		tree: t,
+	}, nil
+}
+
+// Commit commits the current store state and returns a CommitID with the new
+// version and hash.
+func (st *Store) Commit() types.CommitID {
+	defer telemetry.MeasureSince(time.Now(), "store", "smt", "commit")
+
+	hash, version, err := st.tree.saveVersion()
+	if err != nil {
+		panic(err)
+	}
+
+	return types.CommitID{
+		Version: version,
+		Hash:    hash,
+	}
+}
+
+// Implements Committer.
+func (st *Store) LastCommitID() types.CommitID {
+	return types.CommitID{
+		Version: st.tree.version,
+		Hash:    st.tree.hash,
+	}
+}
+
+// SetPruning panics as pruning is performed by the root multi-store through
+// DeleteVersions.
+func (st *Store) SetPruning(_ types.PruningOptions) {
+	panic("cannot set pruning options on an initialized SMT store")
+}
+
+// GetPruning panics as pruning is performed by the root multi-store through
+// DeleteVersions.
+func (st *Store) GetPruning() types.PruningOptions {
+	panic("cannot get pruning options on an initialized SMT store")
+}
+
+// VersionExists returns whether or not a given version is stored.
+func (st *Store) VersionExists(version int64) bool {
+	return st.tree.ndb.versionExists(version)
+}
+
+// Implements Store.
+func (st *Store) GetStoreType() types.StoreType {
+	return types.StoreTypeSMT
+}
+
+// Implements Store.
+func (st *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(st)
+}
+
+// CacheWrapWithTrace implements the Store interface.
+func (st *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(st, w, tc))
+}
+
+// Implements types.KVStore.
+func (st *Store) Set(key, value []byte) {
+	defer telemetry.MeasureSince(time.Now(), "store", "smt", "set")
+	types.AssertValidKey(key)
+	types.AssertValidValue(value)
+	st.tree.set(key, value)
+}
+
+// Implements types.KVStore.
+func (st *Store) Get(key []byte) []byte {
+	defer telemetry.MeasureSince(time.Now(), "store", "smt", "get")
+	return st.tree.get(key)
+}
+
+// Implements types.KVStore.
+func (st *Store) Has(key []byte) (exists bool) {
+	defer telemetry.MeasureSince(time.Now(), "store", "smt", "has")
+	return st.tree.get(key) != nil
+}
+
+// Implements types.KVStore.
+func (st *Store) Delete(key []byte) {
+	defer telemetry.MeasureSince(time.Now(), "store", "smt", "delete")
+	st.tree.remove(key)
+}
+
+// DeleteVersions deletes a series of versions, which must not include the
+// latest one. An error is returned if any single version is invalid or the
+// delete fails, the versions deleted before it remain deleted.
+func (st *Store) DeleteVersions(versions ...int64) error {
+	for _, version := range versions {
+		if err := st.tree.ndb.deleteVersion(version); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Implements types.KVStore.
+func (st *Store) Iterator(start, end []byte) types.Iterator {
+	return newIterator(st.tree, start, end, true)
+}
+
+// Implements types.KVStore.
+func (st *Store) ReverseIterator(start, end []byte) types.Iterator {
+	return newIterator(st.tree, start, end, false)
+}
+
+// getHeight returns the height of a query, defaulting to the latest height
+// with a proof, as in the IAVL store.
+func (st *Store) getHeight(req abci.RequestQuery) int64 {
+	height := req.Height
+	if height == 0 {
+		latest := st.tree.version
+		if st.VersionExists(latest - 1) {
+			height = latest - 1
+		} else {
+			height = latest
+		}
+	}
+	return height
+}
+
+// Query implements ABCI interface, allows queries. It follows the IAVL store,
+// see iavl.Store.Query.
+func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
+	defer telemetry.MeasureSince(time.Now(), "store", "smt", "query")
+
+	if len(req.Data) == 0 {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrTxDecode, "query cannot be zero length"))
+	}
+
+	// store the height we chose in the response, with 0 being changed to the
+	// latest height
+	res.Height = st.getHeight(req)
+
+	switch req.Path {
+	case "/key": // get by key
+		key := req.Data // data holds the key bytes
+
+		res.Key = key
+		if !st.VersionExists(res.Height) {
+			res.Log = ErrVersionDoesNotExist.Error()
+			break
+		}
+
+		t, err := st.tree.getImmutable(res.Height)
+		if err != nil {
+			// sanity check: If version exists, immutable tree must be retrievable
+			panic(fmt.Sprintf("version exists in store but could not retrieve corresponding versioned tree in store, %s", err.Error()))
+		}
+
+		res.Value = t.get(key)
+		if !req.Prove {
+			break
+		}
+
+		// get proof from tree and convert to merkle.Proof before adding to result
+		res.Proof = getProofFromTree(t, key, res.Value != nil)
+
+	case "/subspace":
+		pairs := kv.Pairs{
+			Pairs: make([]kv.Pair, 0),
+		}
+
+		subspace := req.Data
+		res.Key = subspace
+
+		iterator := types.KVStorePrefixIterator(st, subspace)
+		for ; iterator.Valid(); iterator.Next() {
+			pairs.Pairs = append(pairs.Pairs, kv.Pair{Key: iterator.Key(), Value: iterator.Value()})
+		}
+		iterator.Close()
+
+		bz, err := pairs.Marshal()
+		if err != nil {
+			panic(fmt.Errorf("failed to marshal KV pairs: %w", err))
+		}
+
+		res.Value = bz
+
+	default:
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected query path: %v", req.Path))
+	}
+
+	return res
+}
+
+// Takes a tree, a key, and a flag for creating existence or absence proof and
+// returns the appropriate merkle.Proof. Since this must be called after
+// querying for the value, this function should never error. Thus, it will
+// panic on error rather than returning it.
+func getProofFromTree(t *tree, key []byte, exists bool) *merkle.Proof {
+	var (
+		commitmentProof *ics23.CommitmentProof
+		err             error
+	)
+
+	if exists {
+		// value was found
+		commitmentProof, err = t.createMembershipProof(key)
+		if err != nil {
+			// sanity check: If value was found, membership proof must be creatable
+			panic(fmt.Sprintf("unexpected value for empty proof: %s", err.Error()))
+		}
+	} else {
+		// value wasn't found
+		commitmentProof, err = t.createNonMembershipProof(key)
+		if err != nil {
+			// sanity check: If value wasn't found, nonmembership proof must be creatable
+			panic(fmt.Sprintf("unexpected error for nonexistence proof: %s", err.Error()))
+		}
+	}
+
+	op := types.NewSMTCommitmentOp(key, commitmentProof)
+	return &merkle.Proof{Ops: []merkle.ProofOp{op.ProofOp()}}
+}
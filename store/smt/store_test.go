@@ -0,0 +1,334 @@
+package smt
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	ics23 "github.com/confio/ics23/go"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+func newStore(t *testing.T, db dbm.DB) *Store {
+	store, err := LoadStore(db, types.CommitID{}, false)
+	require.NoError(t, err)
+	return store.(*Store)
+}
+
+// randKey returns a short random key, so that keys share prefixes and
+// contain 0x00 bytes.
+func randKey(r *rand.Rand) []byte {
+	key := make([]byte, 1+r.Intn(3))
+	for i := range key {
+		key[i] = byte(r.Intn(3))
+	}
+	return key
+}
+
+// requireRange checks the iteration over [start, end) against the sorted keys
+// of the expected map.
+func requireRange(t *testing.T, store types.KVStore, expected map[string]string, start, end []byte, ascending bool) {
+	var keys []string
+	for k := range expected {
+		if (start == nil || k >= string(start)) && (end == nil || k < string(end)) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var iter types.Iterator
+	if ascending {
+		iter = store.Iterator(start, end)
+	} else {
+		iter = store.ReverseIterator(start, end)
+		for i, j := 0, len(keys)-1; i < j; i, j = i+1, j-1 {
+			keys[i], keys[j] = keys[j], keys[i]
+		}
+	}
+	defer iter.Close()
+
+	var i int
+	for ; iter.Valid(); iter.Next() {
+		require.Less(t, i, len(keys), "range [%X, %X)", start, end)
+		require.Equal(t, keys[i], string(iter.Key()), "range [%X, %X)", start, end)
+		require.Equal(t, expected[keys[i]], string(iter.Value()))
+		i++
+	}
+	require.Equal(t, len(keys), i, "range [%X, %X)", start, end)
+	require.NoError(t, iter.Error())
+}
+
+func TestRandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	db := dbm.NewMemDB()
+	store := newStore(t, db)
+	expected := make(map[string]string)
+
+	for v := 1; v <= 20; v++ {
+		for i := 0; i < 20; i++ {
+			key := randKey(r)
+			if r.Intn(3) == 0 {
+				store.Delete(key)
+				delete(expected, string(key))
+			} else {
+				value := fmt.Sprintf("v%d-%d", v, i)
+				store.Set(key, []byte(value))
+				expected[string(key)] = value
+			}
+		}
+
+		for k, value := range expected {
+			require.Equal(t, []byte(value), store.Get([]byte(k)))
+		}
+
+		requireRange(t, store, expected, nil, nil, true)
+		requireRange(t, store, expected, nil, nil, false)
+		for i := 0; i < 10; i++ {
+			start, end := randKey(r), randKey(r)
+			requireRange(t, store, expected, start, nil, true)
+			requireRange(t, store, expected, nil, end, false)
+			requireRange(t, store, expected, start, end, r.Intn(2) == 0)
+		}
+
+		cid := store.Commit()
+		require.Equal(t, int64(v), cid.Version)
+
+		// the hash only depends on the content of the tree
+		reloaded := newStore(t, dbm.NewMemDB())
+		for k, value := range expected {
+			reloaded.Set([]byte(k), []byte(value))
+		}
+		require.Equal(t, cid.Hash, reloaded.Commit().Hash)
+
+		// the committed version is read back from the database
+		reloaded = newStore(t, db)
+		require.Equal(t, cid, reloaded.LastCommitID())
+		requireRange(t, reloaded, expected, nil, nil, true)
+	}
+}
+
+func TestSetUnchangedValue(t *testing.T) {
+	store := newStore(t, dbm.NewMemDB())
+
+	store.Set([]byte("k"), []byte("v"))
+	cid := store.Commit()
+
+	store.Set([]byte("k"), []byte("v"))
+	store.Delete([]byte("missing"))
+	require.Equal(t, cid.Hash, store.Commit().Hash)
+
+	store.Delete([]byte("k"))
+	require.False(t, store.Has([]byte("k")))
+	require.Nil(t, store.Commit().Hash)
+}
+
+func TestGetImmutable(t *testing.T) {
+	store := newStore(t, dbm.NewMemDB())
+
+	store.Set([]byte("hello"), []byte("goodbye"))
+	store.Commit()
+	store.Set([]byte("hello"), []byte("adios"))
+	cid := store.Commit()
+
+	_, err := store.GetImmutable(cid.Version + 1)
+	require.Error(t, err)
+
+	old, err := store.GetImmutable(cid.Version - 1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("goodbye"), old.Get([]byte("hello")))
+	require.Panics(t, func() { old.Set([]byte("hello"), []byte("adios")) })
+	require.Panics(t, func() { old.Delete([]byte("hello")) })
+	require.Panics(t, func() { old.Commit() })
+
+	latest, err := store.GetImmutable(cid.Version)
+	require.NoError(t, err)
+	require.Equal(t, []byte("adios"), latest.Get([]byte("hello")))
+	require.Equal(t, cid, latest.LastCommitID())
+}
+
+func TestDeleteVersions(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newStore(t, db)
+
+	for v := 1; v <= 5; v++ {
+		for i := 0; i < 10; i++ {
+			store.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("v%d-%d", v, i)))
+		}
+		store.Commit()
+	}
+
+	require.Error(t, store.DeleteVersions(5))
+	require.NoError(t, store.DeleteVersions(1, 3))
+	require.Error(t, store.DeleteVersions(3))
+	require.False(t, store.VersionExists(1))
+	require.False(t, store.VersionExists(3))
+
+	for _, v := range []int64{2, 4, 5} {
+		view, err := store.GetImmutable(v)
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprintf("v%d-9", v)), view.Get([]byte("key9")))
+		requireRange(t, view, map[string]string{
+			"key0": fmt.Sprintf("v%d-0", v), "key1": fmt.Sprintf("v%d-1", v), "key2": fmt.Sprintf("v%d-2", v),
+			"key3": fmt.Sprintf("v%d-3", v), "key4": fmt.Sprintf("v%d-4", v), "key5": fmt.Sprintf("v%d-5", v),
+			"key6": fmt.Sprintf("v%d-6", v), "key7": fmt.Sprintf("v%d-7", v), "key8": fmt.Sprintf("v%d-8", v),
+			"key9": fmt.Sprintf("v%d-9", v),
+		}, nil, nil, true)
+	}
+
+	// once all the previous versions are deleted, only the nodes of the latest
+	// one remain
+	require.NoError(t, store.DeleteVersions(2, 4))
+
+	nodes := 0
+	iter, err := db.Iterator(nodePrefix, types.PrefixEndBytes(nodePrefix))
+	require.NoError(t, err)
+	for ; iter.Valid(); iter.Next() {
+		nodes++
+	}
+	iter.Close()
+	require.Equal(t, 2*10-1, nodes)
+
+	orphans, err := db.Iterator(orphanPrefix, types.PrefixEndBytes(orphanPrefix))
+	require.NoError(t, err)
+	require.False(t, orphans.Valid())
+	orphans.Close()
+}
+
+func TestLoadStoreForOverwriting(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newStore(t, db)
+
+	var cids []types.CommitID
+	for v := 1; v <= 3; v++ {
+		store.Set([]byte("k"), []byte(fmt.Sprintf("v%d", v)))
+		store.Set([]byte(fmt.Sprintf("k%d", v)), []byte("v"))
+		cids = append(cids, store.Commit())
+	}
+
+	// committing a different version 2 fails unless loading for overwriting
+	loaded, err := LoadStore(db, cids[0], false)
+	require.NoError(t, err)
+	loaded.Set([]byte("k"), []byte("other"))
+	require.Panics(t, func() { loaded.Commit() })
+
+	_, err = LoadStoreForOverwriting(db, types.CommitID{Version: 4})
+	require.Error(t, err)
+
+	loaded, err = LoadStoreForOverwriting(db, cids[0])
+	require.NoError(t, err)
+	require.Equal(t, cids[0], loaded.LastCommitID())
+	require.False(t, loaded.(*Store).VersionExists(2))
+
+	loaded.Set([]byte("k"), []byte("other"))
+	cid := loaded.Commit()
+	require.Equal(t, int64(2), cid.Version)
+	require.NotEqual(t, cids[1].Hash, cid.Hash)
+
+	reloaded := newStore(t, db)
+	require.Equal(t, cid, reloaded.LastCommitID())
+	require.Equal(t, []byte("other"), reloaded.Get([]byte("k")))
+	require.Nil(t, reloaded.Get([]byte("k2")))
+}
+
+func TestProofs(t *testing.T) {
+	store := newStore(t, dbm.NewMemDB())
+
+	var keys [][]byte
+	for _, k := range []string{"a", "a\x00", "a\x00\x00", "a\x01", "ab", "b", "bb", "\x00", "\xff\xff"} {
+		keys = append(keys, []byte(k))
+		store.Set([]byte(k), []byte("value of "+k))
+	}
+	cid := store.Commit()
+
+	for _, key := range keys {
+		proof, err := store.tree.createMembershipProof(key)
+		require.NoError(t, err)
+		require.True(t, ics23.VerifyMembership(ics23.IavlSpec, cid.Hash, proof, key, []byte("value of "+string(key))), "%X", key)
+		require.False(t, ics23.VerifyMembership(ics23.IavlSpec, cid.Hash, proof, key, []byte("wrong")), "%X", key)
+
+		_, err = store.tree.createNonMembershipProof(key)
+		require.Error(t, err)
+	}
+
+	for _, k := range []string{"", "\x00\x00", "\x01", "a\x00\x01", "a\x02", "aa", "ac", "ba", "c", "\xff\xff\x00"} {
+		key := []byte(k)
+
+		proof, err := store.tree.createNonMembershipProof(key)
+		require.NoError(t, err)
+		require.True(t, ics23.VerifyNonMembership(ics23.IavlSpec, cid.Hash, proof, key), "%X", key)
+
+		_, err = store.tree.createMembershipProof(key)
+		require.Error(t, err)
+	}
+}
+
+func TestQuery(t *testing.T) {
+	store := newStore(t, dbm.NewMemDB())
+
+	store.Set([]byte("key1"), []byte("v1"))
+	store.Set([]byte("key2"), []byte("v2"))
+	store.Set([]byte("other"), []byte("v3"))
+	cid := store.Commit()
+	store.Set([]byte("key1"), []byte("v4"))
+	store.Commit()
+
+	res := store.Query(abci.RequestQuery{Path: "/key", Data: []byte("key1"), Height: cid.Version, Prove: true})
+	require.EqualValues(t, 0, res.Code)
+	require.Equal(t, []byte("v1"), res.Value)
+	require.Len(t, res.Proof.Ops, 1)
+	require.Equal(t, types.ProofOpSMTCommitment, res.Proof.Ops[0].Type)
+
+	op, err := types.CommitmentOpDecoder(res.Proof.Ops[0])
+	require.NoError(t, err)
+	root, err := op.Run([][]byte{[]byte("v1")})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{cid.Hash}, root)
+
+	// the latest height with a proof is queried by default
+	res = store.Query(abci.RequestQuery{Path: "/key", Data: []byte("key1")})
+	require.Equal(t, cid.Version, res.Height)
+	require.Equal(t, []byte("v1"), res.Value)
+
+	res = store.Query(abci.RequestQuery{Path: "/key", Data: []byte("key1"), Height: cid.Version + 1})
+	require.Equal(t, []byte("v4"), res.Value)
+
+	res = store.Query(abci.RequestQuery{Path: "/key", Data: []byte("key1"), Height: cid.Version + 2})
+	require.Nil(t, res.Value)
+	require.Equal(t, ErrVersionDoesNotExist.Error(), res.Log)
+
+	res = store.Query(abci.RequestQuery{Path: "/subspace", Data: []byte("key")})
+	require.EqualValues(t, 0, res.Code)
+
+	var pairs kv.Pairs
+	require.NoError(t, pairs.Unmarshal(res.Value))
+	require.Len(t, pairs.Pairs, 2)
+	require.True(t, bytes.Equal([]byte("key1"), pairs.Pairs[0].Key))
+	require.Equal(t, []byte("v4"), pairs.Pairs[0].Value)
+
+	res = store.Query(abci.RequestQuery{Path: "/unknown", Data: []byte("key")})
+	require.NotEqualValues(t, 0, res.Code)
+}
+
+func BenchmarkSetCommit(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	store, err := LoadStore(dbm.NewMemDB(), types.CommitID{}, false)
+	require.NoError(b, err)
+
+	key, value := make([]byte, 32), make([]byte, 64)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		r.Read(key)
+		store.Set(key, value)
+		if i%100 == 99 {
+			store.Commit()
+		}
+	}
+}
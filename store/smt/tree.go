@@ -0,0 +1,281 @@
+package smt
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/internal/keys"
+)
+
+// tree is a compact sparse Merkle tree. The leaves are placed along the bits of
+// the paths of their keys, as in a sparse Merkle tree of depth the length of
+// the paths, with the empty subtrees removed and the inner nodes with a single
+// child replaced by this child. The remaining inner nodes thus have two
+// children and record the index of the bit on which they split their subtree.
+//
+// The path of a key is its encoding by keys.Encode, which is prefix-free and
+// preserves the order of the keys, so that the leaves are ordered by key and
+// adjacent leaves are neighbors in the tree, which allows to prove the absence
+// of a key with ICS23 non-existence proofs.
+type tree struct {
+	ndb *nodeDB
+
+	// root is the root of the working tree, nil for an empty tree.
+	root *node
+
+	// version and hash of the last saved version of the tree
+	version int64
+	hash    []byte
+
+	// orphans are the persisted nodes replaced in the working tree.
+	orphans []*node
+
+	readOnly bool
+}
+
+// load returns the node n with its content loaded from the database if it is
+// a stub.
+func (t *tree) load(n *node) *node {
+	if !n.isStub() {
+		return n
+	}
+
+	loaded, err := t.ndb.getNode(*n.nodeKey, n.hash)
+	if err != nil {
+		panic(err)
+	}
+
+	return loaded
+}
+
+// loadVersion sets the working tree to a saved version.
+func (t *tree) loadVersion(version int64) error {
+	root, err := t.ndb.getRoot(version)
+	if err != nil {
+		return err
+	}
+
+	t.root, t.version, t.orphans = root, version, nil
+	t.hash = t.computeHash(root)
+
+	return nil
+}
+
+func (t *tree) get(key []byte) []byte {
+	if t.root == nil {
+		return nil
+	}
+
+	leaf := t.closestLeaf(keys.Encode(key))
+	if !bytes.Equal(leaf.key, key) {
+		return nil
+	}
+
+	return leaf.value
+}
+
+// closestLeaf returns the leaf reached by following the bits of path. It holds
+// the key of the path if the key is set.
+func (t *tree) closestLeaf(path []byte) *node {
+	n := t.load(t.root)
+	for !n.isLeaf() {
+		n = t.load(n.child(bitAt(path, n.bit)))
+	}
+
+	return n
+}
+
+func (t *tree) set(key, value []byte) {
+	if t.readOnly {
+		panic("cannot set a key in an immutable tree")
+	}
+
+	// the leaf outlives the call, the caller may reuse its buffers
+	leaf := newLeaf(append([]byte{}, key...), append([]byte{}, value...))
+	if t.root == nil {
+		t.root = leaf
+		return
+	}
+
+	path := keys.Encode(key)
+	closest := t.closestLeaf(path)
+	if bytes.Equal(closest.key, key) {
+		if bytes.Equal(closest.value, value) {
+			return
+		}
+
+		t.root = t.replace(t.root, path, leaf)
+		return
+	}
+
+	t.root = t.insert(t.root, path, critBit(path, keys.Encode(closest.key)), leaf)
+}
+
+// replace returns a copy of the subtree n where the leaf of the same key
+// replaces the existing one.
+func (t *tree) replace(n *node, path []byte, leaf *node) *node {
+	n = t.load(n)
+	t.orphan(n)
+
+	if n.isLeaf() {
+		return leaf
+	}
+
+	dir := bitAt(path, n.bit)
+	parent := n.clone()
+	parent.setChild(dir, t.replace(n.child(dir), path, leaf))
+
+	return parent
+}
+
+// insert returns a copy of the subtree n where the leaf of a new key is added,
+// with the path of the key first differing from the paths of the subtree at
+// index bit.
+func (t *tree) insert(n *node, path []byte, bit uint32, leaf *node) *node {
+	loaded := t.load(n)
+	if loaded.isLeaf() || bit < loaded.bit {
+		// the new leaf splits from the whole subtree
+		return newInner(bit, path, leaf, n)
+	}
+
+	t.orphan(loaded)
+
+	dir := bitAt(path, loaded.bit)
+	parent := loaded.clone()
+	parent.setChild(dir, t.insert(loaded.child(dir), path, bit, leaf))
+
+	return parent
+}
+
+func (t *tree) remove(key []byte) {
+	if t.readOnly {
+		panic("cannot remove a key from an immutable tree")
+	}
+
+	if t.root == nil {
+		return
+	}
+
+	t.root, _ = t.delete(t.root, keys.Encode(key), key)
+}
+
+// delete returns a copy of the subtree n without key, or nil if the subtree
+// becomes empty, and whether the key was removed.
+func (t *tree) delete(n *node, path, key []byte) (*node, bool) {
+	loaded := t.load(n)
+	if loaded.isLeaf() {
+		if !bytes.Equal(loaded.key, key) {
+			return n, false
+		}
+
+		t.orphan(loaded)
+		return nil, true
+	}
+
+	dir := bitAt(path, loaded.bit)
+	child, removed := t.delete(loaded.child(dir), path, key)
+	if !removed {
+		return n, false
+	}
+
+	t.orphan(loaded)
+
+	// the sibling takes the place of a node left with a single child
+	if child == nil {
+		return loaded.child(1 - dir), true
+	}
+
+	parent := loaded.clone()
+	parent.setChild(dir, child)
+
+	return parent, true
+}
+
+// orphan records a persisted node replaced in the working tree.
+func (t *tree) orphan(n *node) {
+	if n.nodeKey != nil {
+		t.orphans = append(t.orphans, n)
+	}
+}
+
+// computeHash returns the hash of a subtree, computing and caching the hashes
+// of its new nodes. The hash of an empty tree is nil.
+func (t *tree) computeHash(n *node) []byte {
+	if n == nil {
+		return nil
+	}
+
+	if n.hash == nil {
+		if n.isLeaf() {
+			n.hash = n.computeHash(nil, nil)
+		} else {
+			n.hash = n.computeHash(t.computeHash(n.left), t.computeHash(n.right))
+		}
+	}
+
+	return n.hash
+}
+
+// workingHash returns the hash of the working tree.
+func (t *tree) workingHash() []byte {
+	return t.computeHash(t.root)
+}
+
+// saveVersion saves the working tree as a new version and returns its hash and
+// version. If the version was already saved, e.g. after loading a previous
+// version, the working tree must have the same hash.
+func (t *tree) saveVersion() ([]byte, int64, error) {
+	if t.readOnly {
+		panic("cannot save an immutable tree")
+	}
+
+	version := t.version + 1
+	hash := t.workingHash()
+
+	if t.ndb.versionExists(version) {
+		root, err := t.ndb.getRoot(version)
+		if err != nil {
+			return nil, version, err
+		}
+
+		if existing := t.computeHash(root); !bytes.Equal(existing, hash) {
+			return nil, version, fmt.Errorf("version %d was already saved to different hash %X (new hash %X)", version, existing, hash)
+		}
+
+		return hash, version, t.loadVersion(version)
+	}
+
+	batch := t.ndb.db.NewBatch()
+	defer batch.Close()
+
+	t.ndb.saveVersion(batch, version, t.root, t.orphans)
+	if err := batch.Write(); err != nil {
+		return nil, version, err
+	}
+
+	// keep only a stub of the saved tree, its nodes are loaded through the
+	// cache of the node database from now on
+	if t.root != nil {
+		t.root = newStub(*t.root.nodeKey, t.root.hash)
+	}
+
+	t.version, t.hash, t.orphans = version, hash, nil
+
+	return hash, version, nil
+}
+
+// getImmutable returns a read-only tree at a saved version.
+func (t *tree) getImmutable(version int64) (*tree, error) {
+	root, err := t.ndb.getRoot(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tree{
+		ndb:      t.ndb,
+		root:     root,
+		version:  version,
+		hash:     t.computeHash(root),
+		readOnly: true,
+	}, nil
+}
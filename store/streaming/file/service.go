@@ -0,0 +1,152 @@
+package file
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// StreamingService is a baseapp.StreamingService writing the ABCI messages
+// and the state changes of every block to files in a directory. All messages
+// are length-prefixed protobuf messages:
+//
+//	{prefix}block-{N}-begin     RequestBeginBlock, ResponseBeginBlock
+//	{prefix}block-{N}-tx-{i}    RequestDeliverTx, ResponseDeliverTx
+//	{prefix}block-{N}-end       RequestEndBlock, ResponseEndBlock
+//	{prefix}block-{N}-changes   StoreKVPair, ... sorted by store name and key
+//
+// The changes file of a block is written on Commit, and also contains the
+// state changes made by InitChain if N is the initial height.
+//
+// An app enables the service with BaseApp.SetStreamingService, once its store
+// keys are created and before its stores are loaded.
+type StreamingService struct {
+	writeDir   string
+	filePrefix string
+	marshaller codec.BinaryMarshaler
+	listeners  map[types.StoreKey][]types.WriteListener
+
+	// stateCache buffers the state changes of the current block
+	stateCacheLock sync.Mutex
+	stateCache     bytes.Buffer
+
+	currentBlockNumber int64
+	currentTxIndex     int64
+}
+
+// NewStreamingService creates a StreamingService writing files prefixed with
+// filePrefix in writeDir, which must exist, and streaming the state changes
+// of the KVStores of storeKeys.
+func NewStreamingService(writeDir, filePrefix string, storeKeys []types.StoreKey, m codec.BinaryMarshaler) (*StreamingService, error) {
+	if err := isDirWriteable(writeDir); err != nil {
+		return nil, err
+	}
+
+	fss := &StreamingService{
+		writeDir:   writeDir,
+		filePrefix: filePrefix,
+		marshaller: m,
+		listeners:  make(map[types.StoreKey][]types.WriteListener, len(storeKeys)),
+	}
+
+	// a single listener buffering the state changes of every store
+	listener := types.NewStoreKVPairWriteListener(lockedWriter{fss}, m)
+	for _, key := range storeKeys {
+		fss.listeners[key] = []types.WriteListener{listener}
+	}
+
+	return fss, nil
+}
+
+// Listeners implements baseapp.StreamingService.Listeners
+func (fss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return fss.listeners
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener.ListenBeginBlock
+func (fss *StreamingService) ListenBeginBlock(_ sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	fss.currentBlockNumber = req.Header.Height
+	fss.currentTxIndex = 0
+
+	return fss.writeFile(fmt.Sprintf("block-%d-begin", fss.currentBlockNumber), &req, &res)
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener.ListenDeliverTx
+func (fss *StreamingService) ListenDeliverTx(_ sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	name := fmt.Sprintf("block-%d-tx-%d", fss.currentBlockNumber, fss.currentTxIndex)
+	fss.currentTxIndex++
+
+	return fss.writeFile(name, &req, &res)
+}
+
+// ListenEndBlock implements baseapp.ABCIListener.ListenEndBlock
+func (fss *StreamingService) ListenEndBlock(_ sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	return fss.writeFile(fmt.Sprintf("block-%d-end", fss.currentBlockNumber), &req, &res)
+}
+
+// ListenCommit implements baseapp.ABCIListener.ListenCommit
+func (fss *StreamingService) ListenCommit(_ sdk.Context, _ abci.ResponseCommit) error {
+	fss.stateCacheLock.Lock()
+	defer fss.stateCacheLock.Unlock()
+
+	path := filepath.Join(fss.writeDir, fss.filePrefix+fmt.Sprintf("block-%d-changes", fss.currentBlockNumber))
+	err := ioutil.WriteFile(path, fss.stateCache.Bytes(), 0600)
+	fss.stateCache.Reset()
+
+	return err
+}
+
+// Close implements io.Closer. The StreamingService holds no open file.
+func (fss *StreamingService) Close() error {
+	return nil
+}
+
+// writeFile writes the length-prefixed msgs to a new file.
+func (fss *StreamingService) writeFile(name string, msgs ...codec.ProtoMarshaler) error {
+	var buf bytes.Buffer
+	for _, msg := range msgs {
+		bz, err := fss.marshaller.MarshalBinaryLengthPrefixed(msg)
+		if err != nil {
+			return err
+		}
+
+		buf.Write(bz)
+	}
+
+	return ioutil.WriteFile(filepath.Join(fss.writeDir, fss.filePrefix+name), buf.Bytes(), 0600)
+}
+
+// lockedWriter writes to the state cache of a StreamingService.
+type lockedWriter struct {
+	fss *StreamingService
+}
+
+func (w lockedWriter) Write(p []byte) (int, error) {
+	w.fss.stateCacheLock.Lock()
+	defer w.fss.stateCacheLock.Unlock()
+
+	return w.fss.stateCache.Write(p)
+}
+
+// isDirWriteable checks if dir is writable by writing and removing a file to
+// dir.
+func isDirWriteable(dir string) error {
+	f := filepath.Join(dir, ".touch")
+	if err := ioutil.WriteFile(f, []byte(""), 0600); err != nil {
+		return err
+	}
+
+	return os.Remove(f)
+}
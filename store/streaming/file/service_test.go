@@ -0,0 +1,97 @@
+package file
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestStreamingService(t *testing.T) {
+	dir, err := ioutil.TempDir("", "streaming")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	m := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	key1, key2 := types.NewKVStoreKey("store1"), types.NewKVStoreKey("store2")
+
+	_, err = NewStreamingService(filepath.Join(dir, "missing"), "", []types.StoreKey{key1}, m)
+	require.Error(t, err)
+
+	fss, err := NewStreamingService(dir, "test-", []types.StoreKey{key1, key2}, m)
+	require.NoError(t, err)
+	require.Len(t, fss.Listeners(), 2)
+
+	ctx := sdk.Context{}
+	beginReq := abci.RequestBeginBlock{Header: abci.Header{Height: 5}}
+	beginRes := abci.ResponseBeginBlock{Events: []abci.Event{{Type: "begin"}}}
+	require.NoError(t, fss.ListenBeginBlock(ctx, beginReq, beginRes))
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, fss.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: []byte{byte(i)}}, abci.ResponseDeliverTx{Code: uint32(i)}))
+	}
+
+	endReq := abci.RequestEndBlock{Height: 5}
+	require.NoError(t, fss.ListenEndBlock(ctx, endReq, abci.ResponseEndBlock{}))
+
+	require.NoError(t, fss.Listeners()[key1][0].OnWrite(key1, []byte("key1"), []byte("value1"), false))
+	require.NoError(t, fss.Listeners()[key2][0].OnWrite(key2, []byte("key2"), nil, true))
+	require.NoError(t, fss.ListenCommit(ctx, abci.ResponseCommit{}))
+
+	readFile := func(name string) []byte {
+		bz, err := ioutil.ReadFile(filepath.Join(dir, "test-"+name))
+		require.NoError(t, err)
+		return bz
+	}
+
+	// each file holds length-prefixed messages
+	nextMsg := func(bz []byte, msg codec.ProtoMarshaler) []byte {
+		size, n := binary.Uvarint(bz)
+		require.True(t, n > 0)
+		require.NoError(t, m.UnmarshalBinaryBare(bz[n:n+int(size)], msg))
+		return bz[n+int(size):]
+	}
+
+	bz := readFile("block-5-begin")
+	var gotBeginReq abci.RequestBeginBlock
+	var gotBeginRes abci.ResponseBeginBlock
+	bz = nextMsg(bz, &gotBeginReq)
+	bz = nextMsg(bz, &gotBeginRes)
+	require.Empty(t, bz)
+	require.Equal(t, int64(5), gotBeginReq.Header.Height)
+	require.Equal(t, beginRes, gotBeginRes)
+
+	bz = readFile("block-5-tx-1")
+	var gotTxReq abci.RequestDeliverTx
+	var gotTxRes abci.ResponseDeliverTx
+	bz = nextMsg(bz, &gotTxReq)
+	nextMsg(bz, &gotTxRes)
+	require.Equal(t, []byte{1}, gotTxReq.Tx)
+	require.Equal(t, uint32(1), gotTxRes.Code)
+
+	bz = readFile("block-5-end")
+	var gotEndReq abci.RequestEndBlock
+	nextMsg(bz, &gotEndReq)
+	require.Equal(t, endReq, gotEndReq)
+
+	bz = readFile("block-5-changes")
+	var kvPair1, kvPair2 types.StoreKVPair
+	bz = nextMsg(bz, &kvPair1)
+	bz = nextMsg(bz, &kvPair2)
+	require.Empty(t, bz)
+	require.Equal(t, types.StoreKVPair{StoreKey: "store1", Key: []byte("key1"), Value: []byte("value1")}, kvPair1)
+	require.Equal(t, types.StoreKVPair{StoreKey: "store2", Delete: true, Key: []byte("key2")}, kvPair2)
+
+	// the state cache is reset on commit
+	require.Zero(t, fss.stateCache.Len())
+	require.NoError(t, fss.Close())
+}
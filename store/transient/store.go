@@ -31,6 +31,12 @@ func (ts *Store) Commit() (id types.CommitID) {
 func (ts *Store) SetPruning(pruning types.PruningOptions) {
 }
 
+// GetPruning is a no-op as pruning options cannot be directly set on this store.
+// They must be set on the root commit multi-store.
+func (ts *Store) GetPruning() types.PruningOptions {
+	return types.PruningOptions{}
+}
+
 // Implements CommitStore
 func (ts *Store) LastCommitID() (id types.CommitID) {
 	return
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// WriteListener is notified of the writes made to a KVStore. A listener can
+// be attached to several stores, which are distinguished by storeKey.
+type WriteListener interface {
+	// OnWrite is called on every set or delete, in order. delete is true for a
+	// delete, in which case value is nil.
+	OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error
+}
+
+// StoreKVPairWriteListener is a WriteListener writing each write as a length
+// prefixed protobuf StoreKVPair to an io.Writer.
+type StoreKVPairWriteListener struct {
+	writer     io.Writer
+	marshaller codec.BinaryMarshaler
+}
+
+// NewStoreKVPairWriteListener returns a StoreKVPairWriteListener writing to w.
+func NewStoreKVPairWriteListener(w io.Writer, m codec.BinaryMarshaler) *StoreKVPairWriteListener {
+	return &StoreKVPairWriteListener{
+		writer:     w,
+		marshaller: m,
+	}
+}
+
+// OnWrite implements WriteListener.OnWrite
+func (wl *StoreKVPairWriteListener) OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error {
+	kvPair := &StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	}
+
+	bz, err := wl.marshaller.MarshalBinaryLengthPrefixed(kvPair)
+	if err != nil {
+		return err
+	}
+
+	_, err = wl.writer.Write(bz)
+	return err
+}
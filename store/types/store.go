@@ -7,6 +7,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	dbm "github.com/tendermint/tm-db"
 
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 )
 
@@ -22,6 +23,7 @@ type Committer interface {
 
 	// TODO: Deprecate after 0.38.5
 	SetPruning(PruningOptions)
+	GetPruning() PruningOptions
 }
 
 // Stores of MultiStore must implement CommitStore.
@@ -131,6 +133,7 @@ type CacheMultiStore interface {
 type CommitMultiStore interface {
 	Committer
 	MultiStore
+	snapshottypes.Snapshotter
 
 	// Mount a store of type using the given db.
 	// If db == nil, the new store will use the CommitMultiStore db.
@@ -162,9 +165,30 @@ type CommitMultiStore interface {
 	// undefined.
 	LoadVersion(ver int64) error
 
+	// GetCommitInfo returns the commit info of a version. It fails if the
+	// version was never committed.
+	GetCommitInfo(ver int64) (*CommitInfo, error)
+
+	// RollbackToVersion deletes all the versions after the given one, which
+	// becomes the latest version, and reloads the stores at it. It fails if the
+	// version was pruned.
+	RollbackToVersion(ver int64) error
+
+	// PruneVersions deletes the versions the pruning options do not keep, in
+	// batches of batchSize heights, calling progress after each batch.
+	PruneVersions(opts PruningOptions, batchSize int, progress func(pruned, total int)) error
+
 	// Set an inter-block (persistent) cache that maintains a mapping from
 	// StoreKeys to CommitKVStores.
 	SetInterBlockCache(MultiStorePersistentCache)
+
+	// AddListeners adds WriteListeners for the KVStore belonging to the
+	// provided StoreKey.
+	AddListeners(key StoreKey, listeners []WriteListener)
+
+	// ListeningEnabled returns if listening is enabled for the KVStore
+	// belonging to the provided StoreKey.
+	ListeningEnabled(key StoreKey) bool
 }
 
 //---------subsp-------------------------------
@@ -267,6 +291,8 @@ const (
 	StoreTypeIAVL
 	StoreTypeTransient
 	StoreTypeMemory
+	StoreTypeDecoupled
+	StoreTypeSMT
 )
 
 func (st StoreType) String() string {
@@ -285,6 +311,12 @@ func (st StoreType) String() string {
 
 	case StoreTypeMemory:
 		return "StoreTypeMemory"
+
+	case StoreTypeDecoupled:
+		return "StoreTypeDecoupled"
+
+	case StoreTypeSMT:
+		return "StoreTypeSMT"
 	}
 
 	return "unknown store type"
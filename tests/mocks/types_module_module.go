@@ -12,7 +12,7 @@ import (
 	codec "github.com/cosmos/cosmos-sdk/codec"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	types0 "github.com/cosmos/cosmos-sdk/types"
-	grpc "github.com/gogo/protobuf/grpc"
+	module "github.com/cosmos/cosmos-sdk/types/module"
 	gomock "github.com/golang/mock/gomock"
 	mux "github.com/gorilla/mux"
 	cobra "github.com/spf13/cobra"
@@ -516,16 +516,16 @@ func (mr *MockAppModuleMockRecorder) NewQuerierHandler() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LegacyQuerierHandler", reflect.TypeOf((*MockAppModule)(nil).LegacyQuerierHandler))
 }
 
-// RegisterQueryService mocks base method
-func (m *MockAppModule) RegisterQueryService(arg0 grpc.Server) {
+// RegisterServices mocks base method
+func (m *MockAppModule) RegisterServices(arg0 module.Configurator) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "RegisterQueryService", arg0)
+	m.ctrl.Call(m, "RegisterServices", arg0)
 }
 
-// RegisterQueryService indicates an expected call of RegisterQueryService
-func (mr *MockAppModuleMockRecorder) RegisterQueryService(arg0 interface{}) *gomock.Call {
+// RegisterServices indicates an expected call of RegisterServices
+func (mr *MockAppModuleMockRecorder) RegisterServices(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterQueryService", reflect.TypeOf((*MockAppModule)(nil).RegisterQueryService), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterServices", reflect.TypeOf((*MockAppModule)(nil).RegisterServices), arg0)
 }
 
 // BeginBlock mocks base method
@@ -49,20 +49,25 @@ func startInProcess(cfg Config, val *Validator) error {
 		return err
 	}
 
-	if err := tmNode.Start(); err != nil {
-		return err
-	}
-
-	val.tmNode = tmNode
-
 	if val.RPCAddress != "" {
 		val.RPCClient = local.New(tmNode)
 	}
 
-	if val.APIAddress != "" {
+	// We'll need a RPC client if the validator exposes a gRPC or REST endpoint.
+	if val.APIAddress != "" || val.AppConfig.GRPC.Enable {
 		val.ClientCtx = val.ClientCtx.
 			WithClient(val.RPCClient)
 
+		app.RegisterTxService(val.ClientCtx)
+	}
+
+	if err := tmNode.Start(); err != nil {
+		return err
+	}
+
+	val.tmNode = tmNode
+
+	if val.APIAddress != "" {
 		apiSrv := api.New(val.ClientCtx, logger.With("module", "api-server"))
 		app.RegisterAPIRoutes(apiSrv)
 
@@ -442,6 +442,23 @@ func (coins Coins) IsAnyGTE(coinsB Coins) bool {
 	return false
 }
 
+// Min returns the minimum amount of each denom of coins and coinsB. A denom
+// missing from either set of coins is omitted from the result.
+//
+// CONTRACT: coins must be sorted.
+func (coins Coins) Min(coinsB Coins) Coins {
+	min := Coins{}
+
+	for _, coin := range coins {
+		amount := MinInt(coin.Amount, coinsB.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			min = append(min, NewCoin(coin.Denom, amount))
+		}
+	}
+
+	return min
+}
+
 // IsZero returns true if there are no coins or all coins are zero.
 func (coins Coins) IsZero() bool {
 	for _, coin := range coins {
@@ -842,6 +842,18 @@ func TestNewCoins(t *testing.T) {
 	}
 }
 
+func TestCoinsMin(t *testing.T) {
+	twoAtom := NewInt64Coin(testDenom1, 2)
+	fiveAtom := NewInt64Coin(testDenom1, 5)
+	threeMuon := NewInt64Coin(testDenom2, 3)
+
+	require.Equal(t, Coins{}, Coins{}.Min(Coins{twoAtom}))
+	require.Equal(t, Coins{}, Coins{twoAtom}.Min(Coins{}))
+	require.Equal(t, Coins{twoAtom}, Coins{fiveAtom}.Min(Coins{twoAtom, threeMuon}))
+	require.Equal(t, Coins{twoAtom}, Coins{twoAtom, threeMuon}.Min(Coins{fiveAtom}))
+	require.Equal(t, Coins{twoAtom, threeMuon}, Coins{fiveAtom, threeMuon}.Min(Coins{twoAtom, threeMuon}))
+}
+
 func TestCoinsIsAnyGT(t *testing.T) {
 	twoAtom := NewInt64Coin("atom", 2)
 	fiveAtom := NewInt64Coin("atom", 5)
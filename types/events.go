@@ -1,12 +1,18 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"sort"
 	"strings"
 
+	"github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmkv "github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 )
 
 // ----------------------------------------------------------------------------
@@ -35,6 +41,33 @@ func (em *EventManager) EmitEvents(events Events) {
 	em.events = em.events.AppendEvents(events)
 }
 
+// EmitTypedEvent converts a typed protobuf event into an Event and stores it.
+func (em *EventManager) EmitTypedEvent(tev proto.Message) error {
+	event, err := TypedEventToEvent(tev)
+	if err != nil {
+		return err
+	}
+
+	em.EmitEvent(event)
+	return nil
+}
+
+// EmitTypedEvents converts a series of typed protobuf events into Event
+// objects and stores them. No event is stored if any of them fails to convert.
+func (em *EventManager) EmitTypedEvents(tevs ...proto.Message) error {
+	events := make(Events, len(tevs))
+	for i, tev := range tevs {
+		event, err := TypedEventToEvent(tev)
+		if err != nil {
+			return err
+		}
+		events[i] = event
+	}
+
+	em.EmitEvents(events)
+	return nil
+}
+
 // ABCIEvents returns all stored Event objects as abci.Event objects.
 func (em EventManager) ABCIEvents() []abci.Event {
 	return em.events.ToABCIEvents()
@@ -69,6 +102,80 @@ func NewAttribute(k, v string) Attribute {
 	return Attribute{k, v}
 }
 
+// TypedEventToEvent converts a typed protobuf event into an Event. The type of
+// the event is the fully-qualified name of the message and its attributes are
+// the JSON encoded fields of the message, keyed by their proto name and sorted
+// by key.
+func TypedEventToEvent(tev proto.Message) (Event, error) {
+	evtType := proto.MessageName(tev)
+	if evtType == "" {
+		return Event{}, fmt.Errorf("unregistered typed event %T", tev)
+	}
+
+	evtJSON, err := codec.ProtoMarshalJSON(tev)
+	if err != nil {
+		return Event{}, err
+	}
+
+	var attrMap map[string]json.RawMessage
+	if err := json.Unmarshal(evtJSON, &attrMap); err != nil {
+		return Event{}, err
+	}
+
+	// the attributes are sorted as the map iteration order is random and the
+	// events are part of the consensus state
+	keys := make([]string, 0, len(attrMap))
+	for k := range attrMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	attrs := make([]tmkv.Pair, len(keys))
+	for i, k := range keys {
+		attrs[i] = tmkv.Pair{Key: []byte(k), Value: attrMap[k]}
+	}
+
+	return Event{Type: evtType, Attributes: attrs}, nil
+}
+
+// ParseTypedEvent converts an abci.Event emitted with EmitTypedEvent back into
+// its typed protobuf event. The message type must be registered in the
+// protobuf registry of the process parsing the event.
+func ParseTypedEvent(event abci.Event) (proto.Message, error) {
+	concreteGoType := proto.MessageType(event.Type)
+	if concreteGoType == nil {
+		return nil, fmt.Errorf("failed to retrieve the message of type %q", event.Type)
+	}
+
+	var value reflect.Value
+	if concreteGoType.Kind() == reflect.Ptr {
+		value = reflect.New(concreteGoType.Elem())
+	} else {
+		value = reflect.Zero(concreteGoType)
+	}
+
+	protoMsg, ok := value.Interface().(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%q does not implement proto.Message", event.Type)
+	}
+
+	attrMap := make(map[string]json.RawMessage, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		attrMap[string(attr.Key)] = attr.Value
+	}
+
+	attrBytes, err := json.Marshal(attrMap)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := jsonpb.Unmarshal(strings.NewReader(string(attrBytes)), protoMsg); err != nil {
+		return nil, err
+	}
+
+	return protoMsg, nil
+}
+
 // EmptyEvents returns an empty slice of events.
 func EmptyEvents() Events {
 	return make(Events, 0)
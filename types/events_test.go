@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func TestAppendEvents(t *testing.T) {
@@ -53,6 +54,48 @@ func TestEventManager(t *testing.T) {
 	require.Equal(t, em.Events(), events.AppendEvent(event))
 }
 
+func TestEmitTypedEvent(t *testing.T) {
+	em := NewEventManager()
+	coin := NewCoin("fakedenom", NewInt(1999999))
+
+	require.NoError(t, em.EmitTypedEvent(&coin))
+	require.Len(t, em.Events(), 1)
+
+	event := em.Events()[0]
+	require.Equal(t, "cosmos.base.v1beta1.Coin", event.Type)
+	require.Equal(t, NewEvent(
+		"cosmos.base.v1beta1.Coin",
+		NewAttribute("amount", `"1999999"`),
+		NewAttribute("denom", `"fakedenom"`),
+	), event)
+
+	// several typed events can be stored at once
+	decCoin := NewDecCoin("fakedenom", NewInt(10))
+	require.NoError(t, em.EmitTypedEvents(&coin, &decCoin))
+	require.Len(t, em.Events(), 3)
+	require.Equal(t, "cosmos.base.v1beta1.DecCoin", em.Events()[2].Type)
+}
+
+func TestParseTypedEvent(t *testing.T) {
+	coin := NewCoin("fakedenom", NewInt(1999999))
+
+	event, err := TypedEventToEvent(&coin)
+	require.NoError(t, err)
+
+	msg, err := ParseTypedEvent(abci.Event(event))
+	require.NoError(t, err)
+	require.Equal(t, &coin, msg)
+
+	// the message type must be registered
+	_, err = ParseTypedEvent(abci.Event{Type: "cosmos.base.v1beta1.Unknown"})
+	require.Error(t, err)
+
+	// the attributes must hold the JSON encoded fields of the message
+	event = NewEvent("cosmos.base.v1beta1.Coin", NewAttribute("denom", "fakedenom"))
+	_, err = ParseTypedEvent(abci.Event(event))
+	require.Error(t, err)
+}
+
 func TestStringifyEvents(t *testing.T) {
 	e := Events{
 		NewEvent("message", NewAttribute("sender", "foo")),
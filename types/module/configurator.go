@@ -0,0 +1,39 @@
+package module
+
+import (
+	"github.com/gogo/protobuf/grpc"
+)
+
+// Configurator provides the hooks to allow modules to configure and register
+// their services in the RegisterServices method.
+type Configurator interface {
+	// MsgServer returns the server on which a module registers its protobuf
+	// Msg service.
+	MsgServer() grpc.Server
+
+	// QueryServer returns the server on which a module registers its gRPC
+	// query service.
+	QueryServer() grpc.Server
+}
+
+type configurator struct {
+	msgServer   grpc.Server
+	queryServer grpc.Server
+}
+
+// NewConfigurator returns a new Configurator instance
+func NewConfigurator(msgServer grpc.Server, queryServer grpc.Server) Configurator {
+	return configurator{msgServer: msgServer, queryServer: queryServer}
+}
+
+var _ Configurator = configurator{}
+
+// MsgServer implements the Configurator.MsgServer method
+func (c configurator) MsgServer() grpc.Server {
+	return c.msgServer
+}
+
+// QueryServer implements the Configurator.QueryServer method
+func (c configurator) QueryServer() grpc.Server {
+	return c.queryServer
+}
@@ -31,8 +31,6 @@ package module
 import (
 	"encoding/json"
 
-	"github.com/gogo/protobuf/grpc"
-
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -157,12 +155,13 @@ type AppModule interface {
 
 	// routes
 	Route() sdk.Route
-	// Deprecated: use RegisterQueryService
+	// Deprecated: use RegisterServices
 	QuerierRoute() string
-	// Deprecated: use RegisterQueryService
+	// Deprecated: use RegisterServices
 	LegacyQuerierHandler(codec.JSONMarshaler) sdk.Querier
-	// RegisterQueryService allows a module to register a gRPC query service
-	RegisterQueryService(grpc.Server)
+	// RegisterServices allows a module to register its protobuf Msg service and
+	// its gRPC query service
+	RegisterServices(Configurator)
 
 	// ABCI
 	BeginBlock(sdk.Context, abci.RequestBeginBlock)
@@ -195,7 +194,8 @@ func (GenesisOnlyAppModule) QuerierRoute() string { return "" }
 // LegacyQuerierHandler returns an empty module querier
 func (gam GenesisOnlyAppModule) LegacyQuerierHandler(codec.JSONMarshaler) sdk.Querier { return nil }
 
-func (gam GenesisOnlyAppModule) RegisterQueryService(grpc.Server) {}
+// RegisterServices registers all services.
+func (gam GenesisOnlyAppModule) RegisterServices(Configurator) {}
 
 // BeginBlock returns an empty module begin-block
 func (gam GenesisOnlyAppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {}
@@ -275,10 +275,10 @@ func (m *Manager) RegisterRoutes(router sdk.Router, queryRouter sdk.QueryRouter,
 	}
 }
 
-// RegisterQueryServices registers all module query services
-func (m *Manager) RegisterQueryServices(grpcRouter grpc.Server) {
+// RegisterServices registers all module Msg and query services
+func (m *Manager) RegisterServices(cfg Configurator) {
 	for _, module := range m.Modules {
-		module.RegisterQueryService(grpcRouter)
+		module.RegisterServices(cfg)
 	}
 }
 
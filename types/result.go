@@ -7,8 +7,10 @@ import (
 	"math"
 	"strings"
 
+	"github.com/gogo/protobuf/proto"
 	yaml "gopkg.in/yaml.v2"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -273,3 +275,31 @@ func (r TxResponse) GetTx() Tx {
 	}
 	return nil
 }
+
+// WrapServiceResult wraps the response of a protobuf Msg service method call in
+// a Result. The response is proto-marshaled into the Result's Data and the
+// events of the ctx's EventManager are attached to it. If err is non-nil, it is
+// returned as is.
+func WrapServiceResult(ctx Context, res proto.Message, err error) (*Result, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	var data []byte
+	if res != nil {
+		data, err = proto.Marshal(res)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var events []abci.Event
+	if evtMgr := ctx.EventManager(); evtMgr != nil {
+		events = evtMgr.ABCIEvents()
+	}
+
+	return &Result{
+		Data:   data,
+		Events: events,
+	}, nil
+}
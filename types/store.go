@@ -70,6 +70,8 @@ const (
 	StoreTypeIAVL      = types.StoreTypeIAVL
 	StoreTypeTransient = types.StoreTypeTransient
 	StoreTypeMemory    = types.StoreTypeMemory
+	StoreTypeDecoupled = types.StoreTypeDecoupled
+	StoreTypeSMT       = types.StoreTypeSMT
 )
 
 type (
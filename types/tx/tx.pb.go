@@ -658,6 +658,10 @@ type Fee struct {
 	// gas_limit is the maximum gas that can be used in transaction processing
 	// before an out of gas error occurs
 	GasLimit uint64 `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// fee_granter, if set, is the address of an account which granted the fee
+	// payer, i.e. the first signer, an allowance in the feegrant module. The
+	// fees are then deducted from the fee granter instead of the fee payer.
+	FeeGranter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=fee_granter,json=feeGranter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"fee_granter,omitempty"`
 }
 
 func (m *Fee) Reset()         { *m = Fee{} }
@@ -707,6 +711,13 @@ func (m *Fee) GetGasLimit() uint64 {
 	return 0
 }
 
+func (m *Fee) GetFeeGranter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.FeeGranter
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Tx)(nil), "cosmos.tx.v1beta1.Tx")
 	proto.RegisterType((*TxRaw)(nil), "cosmos.tx.v1beta1.TxRaw")
@@ -723,59 +734,61 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/tx.proto", fileDescriptor_96d1575ffde80842) }
 
 var fileDescriptor_96d1575ffde80842 = []byte{
-	// 830 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x6f, 0xdc, 0x44,
-	0x14, 0x5f, 0xef, 0xbf, 0xec, 0xbe, 0x24, 0x4d, 0x3b, 0xaa, 0xd0, 0x66, 0xa3, 0xba, 0xc1, 0x28,
-	0xb0, 0x08, 0xd5, 0x6e, 0xc3, 0x81, 0x3f, 0x42, 0x82, 0x6c, 0x4b, 0x49, 0x05, 0x05, 0x34, 0xc9,
-	0xa9, 0x17, 0x6b, 0xec, 0x9d, 0x78, 0x47, 0x5d, 0xcf, 0x2c, 0x9e, 0x71, 0xb1, 0x3f, 0x01, 0x97,
-	0x22, 0x71, 0xe1, 0x4b, 0xf0, 0x1d, 0xb8, 0xf7, 0xd8, 0x23, 0x07, 0x04, 0x28, 0xf9, 0x20, 0xa0,
-	0x19, 0x8f, 0xdd, 0x50, 0xa2, 0xdd, 0x0b, 0xa7, 0x7d, 0xf3, 0xe6, 0xf7, 0xfb, 0xbd, 0xdf, 0xbe,
-	0x37, 0xcf, 0x30, 0x8e, 0x85, 0x4c, 0x85, 0x0c, 0x54, 0x11, 0x3c, 0xbb, 0x17, 0x51, 0x45, 0xee,
-	0x05, 0xaa, 0xf0, 0x97, 0x99, 0x50, 0x02, 0xdd, 0xa8, 0xee, 0x7c, 0x55, 0xf8, 0xf6, 0x6e, 0x7c,
-	0x33, 0x11, 0x89, 0x30, 0xb7, 0x81, 0x8e, 0x2a, 0xe0, 0xf8, 0x1d, 0x2b, 0x12, 0x11, 0x49, 0x83,
-	0x38, 0x2b, 0x97, 0x4a, 0x34, 0x6a, 0xd5, 0xd1, 0x02, 0xdd, 0xcb, 0xc0, 0x06, 0x21, 0x18, 0x7f,
-	0x4d, 0x48, 0x15, 0x81, 0x64, 0x09, 0x67, 0x3c, 0x69, 0x50, 0xf6, 0x6c, 0x81, 0xbb, 0x89, 0x10,
-	0xc9, 0x82, 0x06, 0xe6, 0x14, 0xe5, 0x67, 0x01, 0xe1, 0x65, 0x75, 0xe5, 0xfd, 0xe8, 0x40, 0xfb,
-	0xb4, 0x40, 0x77, 0xa0, 0x1b, 0x89, 0x59, 0x39, 0x72, 0xf6, 0x9d, 0xc9, 0xe6, 0xe1, 0xae, 0xff,
-	0x9f, 0xff, 0xe2, 0x9f, 0x16, 0x53, 0x31, 0x2b, 0xb1, 0x81, 0xa1, 0x0f, 0x61, 0x48, 0x72, 0x35,
-	0x0f, 0x19, 0x3f, 0x13, 0xa3, 0xb6, 0xe1, 0xec, 0x5d, 0xc1, 0x39, 0xca, 0xd5, 0xfc, 0x11, 0x3f,
-	0x13, 0x78, 0x40, 0x6c, 0x84, 0x5c, 0x00, 0xed, 0x8d, 0xa8, 0x3c, 0xa3, 0x72, 0xd4, 0xd9, 0xef,
-	0x4c, 0xb6, 0xf0, 0xa5, 0x8c, 0xc7, 0xa1, 0x77, 0x5a, 0x60, 0xf2, 0x3d, 0xba, 0x05, 0xa0, 0x4b,
-	0x85, 0x51, 0xa9, 0xa8, 0x34, 0xbe, 0xb6, 0xf0, 0x50, 0x67, 0xa6, 0x3a, 0x81, 0xde, 0x86, 0x9d,
-	0xc6, 0x81, 0xc5, 0xb4, 0x0d, 0x66, 0xbb, 0x2e, 0x55, 0xe1, 0xd6, 0xd5, 0xfb, 0xd5, 0x81, 0x8d,
-	0x13, 0x96, 0xf0, 0x07, 0x22, 0xfe, 0xbf, 0x4a, 0xee, 0xc2, 0x20, 0x9e, 0x13, 0xc6, 0x43, 0x36,
-	0x1b, 0x75, 0xf6, 0x9d, 0xc9, 0x10, 0x6f, 0x98, 0xf3, 0xa3, 0x19, 0x3a, 0x80, 0x6b, 0x24, 0x8e,
-	0x45, 0xce, 0x55, 0xc8, 0xf3, 0x34, 0xa2, 0xd9, 0xa8, 0xbb, 0xef, 0x4c, 0xba, 0x78, 0xdb, 0x66,
-	0xbf, 0x36, 0x49, 0xf4, 0x2e, 0x5c, 0xaf, 0x61, 0x92, 0x7e, 0x97, 0x53, 0x1e, 0xd3, 0x51, 0xcf,
-	0x00, 0x77, 0x6c, 0xfe, 0xc4, 0xa6, 0xbd, 0x9f, 0xdb, 0xd0, 0xaf, 0x46, 0x83, 0xee, 0xc2, 0x20,
-	0xa5, 0x52, 0x92, 0xc4, 0x98, 0xef, 0x4c, 0x36, 0x0f, 0x6f, 0xfa, 0xd5, 0xe0, 0xfd, 0x7a, 0xf0,
-	0xfe, 0x11, 0x2f, 0x71, 0x83, 0x42, 0x08, 0xba, 0x29, 0x4d, 0xab, 0x09, 0x0e, 0xb1, 0x89, 0xb5,
-	0x45, 0xc5, 0x52, 0x2a, 0x72, 0x15, 0xce, 0x29, 0x4b, 0xe6, 0xca, 0xfc, 0x87, 0x2e, 0xde, 0xb6,
-	0xd9, 0x63, 0x93, 0x44, 0x53, 0xb8, 0x41, 0x0b, 0x45, 0xb9, 0x64, 0x82, 0x87, 0x62, 0xa9, 0x98,
-	0xe0, 0x72, 0xf4, 0xf7, 0xc6, 0x8a, 0xb2, 0xd7, 0x1b, 0xfc, 0x37, 0x15, 0x1c, 0x3d, 0x01, 0x97,
-	0x0b, 0x1e, 0xc6, 0x19, 0x53, 0x2c, 0x26, 0x8b, 0xf0, 0x0a, 0xc1, 0x9d, 0x15, 0x82, 0x7b, 0x5c,
-	0xf0, 0xfb, 0x96, 0xfb, 0xf9, 0x6b, 0xda, 0xde, 0x33, 0x18, 0xd4, 0xaf, 0x0f, 0x7d, 0x06, 0x5b,
-	0x7a, 0xe2, 0x34, 0x33, 0xa3, 0xab, 0x9b, 0x73, 0xeb, 0x8a, 0x07, 0x7b, 0x62, 0x60, 0xe6, 0xc9,
-	0x6e, 0xca, 0x26, 0x96, 0x68, 0x02, 0x9d, 0x33, 0x4a, 0xed, 0x4b, 0x7f, 0xe3, 0x0a, 0xe2, 0x43,
-	0x4a, 0xb1, 0x86, 0x78, 0xcf, 0x1d, 0x80, 0x57, 0x2a, 0xe8, 0x01, 0xc0, 0x32, 0x8f, 0x16, 0x2c,
-	0x0e, 0x9f, 0xd2, 0x7a, 0xbb, 0x0e, 0x6a, 0xbe, 0xde, 0x6b, 0xdf, 0x6e, 0x7c, 0x2d, 0xf4, 0xad,
-	0x41, 0x7f, 0x49, 0x4b, 0x3c, 0x5c, 0xd6, 0xa1, 0x5e, 0xb7, 0x54, 0xcc, 0xe8, 0xba, 0x75, 0x7b,
-	0x2c, 0x66, 0xb4, 0x5a, 0xb7, 0xd4, 0x46, 0xde, 0xef, 0x6d, 0x18, 0xd4, 0x69, 0xf4, 0x09, 0xf4,
-	0x25, 0xe3, 0xc9, 0x82, 0x5a, 0x23, 0xde, 0x0a, 0x0d, 0xff, 0xc4, 0x20, 0x8f, 0x5b, 0xd8, 0x72,
-	0xd0, 0x47, 0xd0, 0x4b, 0xf3, 0x85, 0x62, 0xd6, 0xc0, 0x9b, 0xab, 0xc8, 0x8f, 0x35, 0xf0, 0xb8,
-	0x85, 0x2b, 0xc6, 0xf8, 0x08, 0xfa, 0x95, 0x1c, 0xfa, 0x00, 0xba, 0xda, 0x9b, 0x31, 0x70, 0xed,
-	0xf0, 0xad, 0x4b, 0x1a, 0xf5, 0x17, 0xeb, 0xf2, 0x28, 0xb4, 0x1e, 0x36, 0x84, 0xf1, 0x73, 0x07,
-	0x7a, 0x46, 0x15, 0x7d, 0x01, 0x83, 0x88, 0x29, 0x92, 0x65, 0xa4, 0x6e, 0xe8, 0x7b, 0xab, 0x1a,
-	0x7a, 0x5f, 0xa4, 0x4b, 0x12, 0xab, 0x29, 0x53, 0x47, 0x9a, 0x82, 0x1b, 0x32, 0xfa, 0x18, 0xa0,
-	0xe9, 0xaa, 0x5e, 0xe5, 0xce, 0xba, 0xb6, 0x0e, 0xeb, 0xb6, 0xca, 0x69, 0x0f, 0x3a, 0x32, 0x4f,
-	0xbd, 0x1f, 0x1c, 0xe8, 0x3c, 0xa4, 0x14, 0xc5, 0xd0, 0x27, 0xa9, 0xde, 0x4b, 0xfb, 0xb6, 0x76,
-	0xff, 0xe5, 0xe8, 0x95, 0x15, 0xc6, 0xa7, 0x77, 0x5f, 0xfc, 0x71, 0xbb, 0xf5, 0xcb, 0x9f, 0xb7,
-	0x27, 0x09, 0x53, 0xf3, 0x3c, 0xf2, 0x63, 0x91, 0x06, 0xf6, 0x3b, 0x5e, 0xfd, 0xdc, 0x91, 0xb3,
-	0xa7, 0x81, 0x2a, 0x97, 0x54, 0x1a, 0x82, 0xc4, 0x56, 0x1a, 0xed, 0xc1, 0x30, 0x21, 0x32, 0x5c,
-	0xb0, 0x94, 0x29, 0x33, 0x84, 0x2e, 0x1e, 0x24, 0x44, 0x7e, 0xa5, 0xcf, 0xd3, 0x4f, 0x5f, 0x9c,
-	0xbb, 0xce, 0xcb, 0x73, 0xd7, 0xf9, 0xeb, 0xdc, 0x75, 0x7e, 0xba, 0x70, 0x5b, 0x2f, 0x2f, 0xdc,
-	0xd6, 0x6f, 0x17, 0x6e, 0xeb, 0xc9, 0xc1, 0xfa, 0x42, 0x81, 0x2a, 0xa2, 0xbe, 0xd9, 0xad, 0xf7,
-	0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x43, 0xc7, 0xe9, 0x47, 0xe3, 0x06, 0x00, 0x00,
+	// 864 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xfa, 0x5f, 0xec, 0x97, 0xa4, 0x69, 0x47, 0x15, 0x72, 0x1c, 0xd5, 0x09, 0x46, 0x01,
+	0x23, 0x94, 0xdd, 0x26, 0x1c, 0xf8, 0x23, 0x24, 0xb0, 0x5b, 0xda, 0x54, 0x50, 0x40, 0x93, 0x9c,
+	0x7a, 0x59, 0xcd, 0xee, 0x8e, 0xd7, 0xa3, 0x7a, 0x67, 0xcc, 0xce, 0x6c, 0x59, 0x7f, 0x87, 0x22,
+	0x71, 0xe1, 0x4b, 0xf0, 0x1d, 0xb8, 0xf7, 0xd8, 0x23, 0x12, 0xa8, 0xa0, 0xe4, 0x5b, 0x70, 0x01,
+	0xcd, 0xec, 0xec, 0x36, 0x84, 0xc8, 0xe1, 0xc0, 0xc9, 0x6f, 0xde, 0xfc, 0x7e, 0xbf, 0xf7, 0xf3,
+	0x7b, 0xf3, 0x16, 0xfa, 0xa1, 0x90, 0x89, 0x90, 0x9e, 0xca, 0xbd, 0x67, 0x87, 0x01, 0x55, 0xe4,
+	0xd0, 0x53, 0xb9, 0xbb, 0x48, 0x85, 0x12, 0xe8, 0x56, 0x71, 0xe7, 0xaa, 0xdc, 0xb5, 0x77, 0xfd,
+	0xdb, 0xb1, 0x88, 0x85, 0xb9, 0xf5, 0x74, 0x54, 0x00, 0xfb, 0xef, 0x58, 0x91, 0x80, 0x48, 0xea,
+	0x85, 0xe9, 0x72, 0xa1, 0x44, 0xa5, 0x56, 0x1c, 0x2d, 0x70, 0x70, 0x11, 0x58, 0x21, 0x04, 0xe3,
+	0x97, 0x84, 0x54, 0xee, 0x49, 0x16, 0x73, 0xc6, 0xe3, 0x0a, 0x65, 0xcf, 0x16, 0xb8, 0x1d, 0x0b,
+	0x11, 0xcf, 0xa9, 0x67, 0x4e, 0x41, 0x36, 0xf5, 0x08, 0x5f, 0x16, 0x57, 0xc3, 0xef, 0x1d, 0xa8,
+	0x9f, 0xe6, 0xe8, 0x00, 0x9a, 0x81, 0x88, 0x96, 0x3d, 0x67, 0xcf, 0x19, 0xad, 0x1f, 0x6d, 0xbb,
+	0xff, 0xfa, 0x2f, 0xee, 0x69, 0x3e, 0x11, 0xd1, 0x12, 0x1b, 0x18, 0xfa, 0x10, 0xba, 0x24, 0x53,
+	0x33, 0x9f, 0xf1, 0xa9, 0xe8, 0xd5, 0x0d, 0x67, 0xe7, 0x0a, 0xce, 0x38, 0x53, 0xb3, 0x47, 0x7c,
+	0x2a, 0x70, 0x87, 0xd8, 0x08, 0x0d, 0x00, 0xb4, 0x37, 0xa2, 0xb2, 0x94, 0xca, 0x5e, 0x63, 0xaf,
+	0x31, 0xda, 0xc0, 0x17, 0x32, 0x43, 0x0e, 0xad, 0xd3, 0x1c, 0x93, 0xef, 0xd0, 0x1d, 0x00, 0x5d,
+	0xca, 0x0f, 0x96, 0x8a, 0x4a, 0xe3, 0x6b, 0x03, 0x77, 0x75, 0x66, 0xa2, 0x13, 0xe8, 0x6d, 0xd8,
+	0xaa, 0x1c, 0x58, 0x4c, 0xdd, 0x60, 0x36, 0xcb, 0x52, 0x05, 0xee, 0xba, 0x7a, 0x3f, 0x3b, 0xb0,
+	0x76, 0xc2, 0x62, 0x7e, 0x5f, 0x84, 0xff, 0x57, 0xc9, 0x6d, 0xe8, 0x84, 0x33, 0xc2, 0xb8, 0xcf,
+	0xa2, 0x5e, 0x63, 0xcf, 0x19, 0x75, 0xf1, 0x9a, 0x39, 0x3f, 0x8a, 0xd0, 0x3e, 0xdc, 0x20, 0x61,
+	0x28, 0x32, 0xae, 0x7c, 0x9e, 0x25, 0x01, 0x4d, 0x7b, 0xcd, 0x3d, 0x67, 0xd4, 0xc4, 0x9b, 0x36,
+	0xfb, 0x95, 0x49, 0xa2, 0x77, 0xe1, 0x66, 0x09, 0x93, 0xf4, 0xdb, 0x8c, 0xf2, 0x90, 0xf6, 0x5a,
+	0x06, 0xb8, 0x65, 0xf3, 0x27, 0x36, 0x3d, 0xfc, 0xb1, 0x0e, 0xed, 0x62, 0x34, 0xe8, 0x2e, 0x74,
+	0x12, 0x2a, 0x25, 0x89, 0x8d, 0xf9, 0xc6, 0x68, 0xfd, 0xe8, 0xb6, 0x5b, 0x0c, 0xde, 0x2d, 0x07,
+	0xef, 0x8e, 0xf9, 0x12, 0x57, 0x28, 0x84, 0xa0, 0x99, 0xd0, 0xa4, 0x98, 0x60, 0x17, 0x9b, 0x58,
+	0x5b, 0x54, 0x2c, 0xa1, 0x22, 0x53, 0xfe, 0x8c, 0xb2, 0x78, 0xa6, 0xcc, 0x7f, 0x68, 0xe2, 0x4d,
+	0x9b, 0x3d, 0x36, 0x49, 0x34, 0x81, 0x5b, 0x34, 0x57, 0x94, 0x4b, 0x26, 0xb8, 0x2f, 0x16, 0x8a,
+	0x09, 0x2e, 0x7b, 0x7f, 0xad, 0xad, 0x28, 0x7b, 0xb3, 0xc2, 0x7f, 0x5d, 0xc0, 0xd1, 0x13, 0x18,
+	0x70, 0xc1, 0xfd, 0x30, 0x65, 0x8a, 0x85, 0x64, 0xee, 0x5f, 0x21, 0xb8, 0xb5, 0x42, 0x70, 0x87,
+	0x0b, 0x7e, 0xcf, 0x72, 0x3f, 0xbf, 0xa4, 0x3d, 0x7c, 0x06, 0x9d, 0xf2, 0xf5, 0xa1, 0xcf, 0x60,
+	0x43, 0x4f, 0x9c, 0xa6, 0x66, 0x74, 0x65, 0x73, 0xee, 0x5c, 0xf1, 0x60, 0x4f, 0x0c, 0xcc, 0x3c,
+	0xd9, 0x75, 0x59, 0xc5, 0x12, 0x8d, 0xa0, 0x31, 0xa5, 0xd4, 0xbe, 0xf4, 0x37, 0xae, 0x20, 0x3e,
+	0xa0, 0x14, 0x6b, 0xc8, 0xf0, 0xb9, 0x03, 0xf0, 0x5a, 0x05, 0xdd, 0x07, 0x58, 0x64, 0xc1, 0x9c,
+	0x85, 0xfe, 0x53, 0x5a, 0x6e, 0xd7, 0x7e, 0xc9, 0xd7, 0x7b, 0xed, 0xda, 0x8d, 0x2f, 0x85, 0xbe,
+	0x31, 0xe8, 0x2f, 0xe8, 0x12, 0x77, 0x17, 0x65, 0xa8, 0xd7, 0x2d, 0x11, 0x11, 0xbd, 0x6e, 0xdd,
+	0x1e, 0x8b, 0x88, 0x16, 0xeb, 0x96, 0xd8, 0x68, 0xf8, 0x5b, 0x1d, 0x3a, 0x65, 0x1a, 0x7d, 0x02,
+	0x6d, 0xc9, 0x78, 0x3c, 0xa7, 0xd6, 0xc8, 0x70, 0x85, 0x86, 0x7b, 0x62, 0x90, 0xc7, 0x35, 0x6c,
+	0x39, 0xe8, 0x23, 0x68, 0x25, 0xd9, 0x5c, 0x31, 0x6b, 0xe0, 0xcd, 0x55, 0xe4, 0xc7, 0x1a, 0x78,
+	0x5c, 0xc3, 0x05, 0xa3, 0x3f, 0x86, 0x76, 0x21, 0x87, 0x3e, 0x80, 0xa6, 0xf6, 0x66, 0x0c, 0xdc,
+	0x38, 0x7a, 0xeb, 0x82, 0x46, 0xf9, 0xc5, 0xba, 0x38, 0x0a, 0xad, 0x87, 0x0d, 0xa1, 0xff, 0xdc,
+	0x81, 0x96, 0x51, 0x45, 0x0f, 0xa1, 0x13, 0x30, 0x45, 0xd2, 0x94, 0x94, 0x0d, 0x7d, 0x6f, 0x55,
+	0x43, 0xef, 0x89, 0x64, 0x41, 0x42, 0x35, 0x61, 0x6a, 0xac, 0x29, 0xb8, 0x22, 0xa3, 0x8f, 0x01,
+	0xaa, 0xae, 0xea, 0x55, 0x6e, 0x5c, 0xd7, 0xd6, 0x6e, 0xd9, 0x56, 0x39, 0x69, 0x41, 0x43, 0x66,
+	0xc9, 0xf0, 0x57, 0x07, 0x1a, 0x0f, 0x28, 0x45, 0x21, 0xb4, 0x49, 0xa2, 0xf7, 0xd2, 0xbe, 0xad,
+	0xed, 0x7f, 0x38, 0x7a, 0x6d, 0x85, 0xf1, 0xc9, 0xdd, 0x17, 0xaf, 0x76, 0x6b, 0x3f, 0xfd, 0xbe,
+	0x3b, 0x8a, 0x99, 0x9a, 0x65, 0x81, 0x1b, 0x8a, 0xc4, 0xb3, 0xdf, 0xf1, 0xe2, 0xe7, 0x40, 0x46,
+	0x4f, 0x3d, 0xb5, 0x5c, 0x50, 0x69, 0x08, 0x12, 0x5b, 0x69, 0xb4, 0x03, 0xdd, 0x98, 0x48, 0x7f,
+	0xce, 0x12, 0xa6, 0xcc, 0x10, 0x9a, 0xb8, 0x13, 0x13, 0xf9, 0xa5, 0x3e, 0x23, 0x0c, 0xeb, 0x53,
+	0x4a, 0xfd, 0x38, 0x25, 0x5c, 0xd1, 0xd4, 0xec, 0xec, 0xc6, 0xe4, 0xf0, 0xcf, 0x57, 0xbb, 0x07,
+	0xff, 0xa1, 0xce, 0x38, 0x0c, 0xc7, 0x51, 0x94, 0x52, 0x29, 0x31, 0x4c, 0x29, 0x7d, 0x58, 0x88,
+	0x4c, 0x3e, 0x7d, 0x71, 0x36, 0x70, 0x5e, 0x9e, 0x0d, 0x9c, 0x3f, 0xce, 0x06, 0xce, 0x0f, 0xe7,
+	0x83, 0xda, 0xcb, 0xf3, 0x41, 0xed, 0x97, 0xf3, 0x41, 0xed, 0xc9, 0xfe, 0xf5, 0xa2, 0x9e, 0xca,
+	0x83, 0xb6, 0xd9, 0xd7, 0xf7, 0xff, 0x1e, 0x00, 0x71, 0xcd, 0x03, 0x80, 0x37, 0x07, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -1282,6 +1295,13 @@ func (m *Fee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeGranter) > 0 {
+		i -= len(m.FeeGranter)
+		copy(dAtA[i:], m.FeeGranter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeeGranter)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.GasLimit != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
 		i--
@@ -1540,6 +1560,10 @@ func (m *Fee) Size() (n int) {
 	if m.GasLimit != 0 {
 		n += 1 + sovTx(uint64(m.GasLimit))
 	}
+	l = len(m.FeeGranter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2904,6 +2928,40 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGranter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeGranter = append(m.FeeGranter[:0], dAtA[iNdEx:postIndex]...)
+			if m.FeeGranter == nil {
+				m.FeeGranter = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
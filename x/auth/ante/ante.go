@@ -8,22 +8,25 @@ import (
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
+// signer, or from the fee granter using its fee allowance. feegrantKeeper may
+// be nil if fee grants are not supported, and feeMarketKeeper may be nil if
+// there is no on-chain base fee.
 func NewAnteHandler(
-	ak AccountKeeper, bankKeeper types.BankKeeper,
+	ak AccountKeeper, bankKeeper types.BankKeeper, feegrantKeeper FeegrantKeeper, feeMarketKeeper FeeMarketKeeper,
 	sigGasConsumer SignatureVerificationGasConsumer,
 	signModeHandler signing.SignModeHandler,
 ) sdk.AnteHandler {
 	return sdk.ChainAnteDecorators(
 		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		NewMempoolFeeDecorator(),
+		NewBaseFeeDecorator(feeMarketKeeper),
 		NewValidateBasicDecorator(),
 		TxTimeoutHeightDecorator{},
 		NewValidateMemoDecorator(ak),
 		NewConsumeGasForTxSizeDecorator(ak),
 		NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
 		NewValidateSigCountDecorator(ak),
-		NewDeductFeeDecorator(ak, bankKeeper),
+		NewDeductFeeDecorator(ak, bankKeeper, feegrantKeeper),
 		NewSigGasConsumeDecorator(ak, sigGasConsumer),
 		NewSigVerificationDecorator(ak, signModeHandler),
 		NewIncrementSequenceDecorator(ak),
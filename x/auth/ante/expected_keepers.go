@@ -13,3 +13,14 @@ type AccountKeeper interface {
 	SetAccount(ctx sdk.Context, acc types.AccountI)
 	GetModuleAddress(moduleName string) sdk.AccAddress
 }
+
+// FeegrantKeeper defines the expected feegrant keeper.
+type FeegrantKeeper interface {
+	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
+}
+
+// FeeMarketKeeper defines the expected fee market keeper.
+type FeeMarketKeeper interface {
+	GetBaseFee(ctx sdk.Context) sdk.DecCoin
+	AddBlockBaseFee(ctx sdk.Context, fee sdk.Coin)
+}
@@ -58,19 +58,76 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	return next(ctx, tx, simulate)
 }
 
-// DeductFeeDecorator deducts fees from the first signer of the tx
-// If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
+// BaseFeeDecorator checks that the transaction's fee covers the base gas price
+// of the fee market for its whole gas limit, where the base fee is
+// ceil(baseGasPrice * gasLimit). Unlike the MempoolFeeDecorator, the check is
+// part of consensus and runs in DeliverTx as well as in CheckTx. In DeliverTx
+// the base fee is also recorded so that the fee market can burn it at the end
+// of the block.
+// The decorator is a no-op if the fee market keeper is nil, in simulation
+// mode, and for the genesis transactions.
+// CONTRACT: Tx must implement FeeTx to use BaseFeeDecorator
+type BaseFeeDecorator struct {
+	feeMarketKeeper FeeMarketKeeper
+}
+
+// NewBaseFeeDecorator creates a new BaseFeeDecorator. feeMarketKeeper may be
+// nil if there is no fee market.
+func NewBaseFeeDecorator(fmk FeeMarketKeeper) BaseFeeDecorator {
+	return BaseFeeDecorator{
+		feeMarketKeeper: fmk,
+	}
+}
+
+func (bfd BaseFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if bfd.feeMarketKeeper == nil || simulate || ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	baseGasPrice := bfd.feeMarketKeeper.GetBaseFee(ctx)
+	if !baseGasPrice.IsPositive() {
+		return next(ctx, tx, simulate)
+	}
+
+	glDec := sdk.NewDec(int64(feeTx.GetGas()))
+	baseFee := sdk.NewCoin(baseGasPrice.Denom, baseGasPrice.Amount.Mul(glDec).Ceil().RoundInt())
+
+	feeCoins := feeTx.GetFee()
+	if feeCoins.AmountOf(baseFee.Denom).LT(baseFee.Amount) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required base fee: %s", feeCoins, baseFee)
+	}
+
+	if !ctx.IsCheckTx() {
+		bfd.feeMarketKeeper.AddBlockBaseFee(ctx, baseFee)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// DeductFeeDecorator deducts fees from the first signer of the tx, or from the
+// fee granter if one is set, in which case the fee payer's allowance is used
+// and decremented.
+// If the fee payer does not have the funds to pay for the fees, return with InsufficientFunds error
 // Call next AnteHandler if fees successfully deducted
 // CONTRACT: Tx must implement FeeTx interface to use DeductFeeDecorator
 type DeductFeeDecorator struct {
-	ak         AccountKeeper
-	bankKeeper types.BankKeeper
+	ak             AccountKeeper
+	bankKeeper     types.BankKeeper
+	feegrantKeeper FeegrantKeeper
 }
 
-func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper) DeductFeeDecorator {
+// NewDeductFeeDecorator creates a new DeductFeeDecorator. feegrantKeeper may
+// be nil, in which case transactions with a fee granter are rejected.
+func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper) DeductFeeDecorator {
 	return DeductFeeDecorator{
-		ak:         ak,
-		bankKeeper: bk,
+		ak:             ak,
+		bankKeeper:     bk,
+		feegrantKeeper: fk,
 	}
 }
 
@@ -84,16 +141,37 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		panic(fmt.Sprintf("%s module account has not been set", types.FeeCollectorName))
 	}
 
+	fee := feeTx.GetFee()
 	feePayer := feeTx.FeePayer()
-	feePayerAcc := dfd.ak.GetAccount(ctx, feePayer)
+	feeGranter := feeTx.FeeGranter()
+
+	deductFeesFrom := feePayer
+
+	// if a fee granter was set, deduct the fees from the granter, using the
+	// allowance it gave to the fee payer
+	if feeGranter != nil {
+		if dfd.feegrantKeeper == nil {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee grants are not enabled")
+		}
+
+		if !feeGranter.Equals(feePayer) {
+			err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, fee, tx.GetMsgs())
+			if err != nil {
+				return ctx, sdkerrors.Wrapf(err, "%s not allowed to pay fees from %s", feePayer, feeGranter)
+			}
+		}
+
+		deductFeesFrom = feeGranter
+	}
 
-	if feePayerAcc == nil {
-		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", feePayer)
+	deductFeesFromAcc := dfd.ak.GetAccount(ctx, deductFeesFrom)
+	if deductFeesFromAcc == nil {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", deductFeesFrom)
 	}
 
 	// deduct the fees
-	if !feeTx.GetFee().IsZero() {
-		err = DeductFees(dfd.bankKeeper, ctx, feePayerAcc, feeTx.GetFee())
+	if !fee.IsZero() {
+		err = DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, fee)
 		if err != nil {
 			return ctx, err
 		}
@@ -1,12 +1,16 @@
 package ante_test
 
 import (
+	"errors"
+
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 
 	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant/types"
 )
 
 func (suite *AnteTestSuite) TestEnsureMempoolFees() {
@@ -61,6 +65,65 @@ func (suite *AnteTestSuite) TestEnsureMempoolFees() {
 	suite.Require().Nil(err, "Decorator should not have errored on fee higher than local gasPrice")
 }
 
+func (suite *AnteTestSuite) TestBaseFee() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	fmk := suite.app.FeeMarketKeeper
+	params := fmk.GetParams(suite.ctx)
+	params.FeeDenom = "atom"
+	fmk.SetParams(suite.ctx, params)
+
+	bfd := ante.NewBaseFeeDecorator(fmk)
+	antehandler := sdk.ChainAnteDecorators(bfd)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(addr1)
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(feeAmount)
+	suite.txBuilder.SetGasLimit(gasLimit)
+
+	privs, accNums, accSeqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	// no base fee is charged while it is zero
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+
+	// a base fee of 200atom is higher than the 150atom fee in both CheckTx and
+	// DeliverTx
+	fmk.SetBaseFeeAmount(suite.ctx, sdk.NewDecWithPrec(2, 3))
+	for _, checkTx := range []bool{true, false} {
+		_, err = antehandler(suite.ctx.WithIsCheckTx(checkTx), tx, false)
+		suite.Require().True(errors.Is(err, sdkerrors.ErrInsufficientFee))
+	}
+	suite.Require().True(fmk.GetBlockBaseFees(suite.ctx).IsZero())
+
+	// simulations are not charged
+	_, err = antehandler(suite.ctx, tx, true)
+	suite.Require().NoError(err)
+
+	// the base fee is only recorded in DeliverTx
+	fmk.SetBaseFeeAmount(suite.ctx, sdk.NewDecWithPrec(1, 3))
+	_, err = antehandler(suite.ctx.WithIsCheckTx(true), tx, false)
+	suite.Require().NoError(err)
+	suite.Require().True(fmk.GetBlockBaseFees(suite.ctx).IsZero())
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(100), fmk.GetBlockBaseFees(suite.ctx).Int64())
+
+	// the decorator is a no-op without a fee market
+	_, err = sdk.ChainAnteDecorators(ante.NewBaseFeeDecorator(nil))(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+}
+
 func (suite *AnteTestSuite) TestDeductFees() {
 	suite.SetupTest(true) // setup
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
@@ -85,7 +148,7 @@ func (suite *AnteTestSuite) TestDeductFees() {
 	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
 	suite.app.BankKeeper.SetBalances(suite.ctx, addr1, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(10))))
 
-	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper)
+	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil)
 	antehandler := sdk.ChainAnteDecorators(dfd)
 
 	_, err = antehandler(suite.ctx, tx, false)
@@ -100,3 +163,61 @@ func (suite *AnteTestSuite) TestDeductFees() {
 
 	suite.Require().Nil(err, "Tx errored after account has been set with sufficient funds")
 }
+
+func (suite *AnteTestSuite) TestDeductFeesWithGrant() {
+	suite.SetupTest(false) // setup
+
+	// keys and addresses
+	priv1, _, grantee := testdata.KeyTestPubAddr()
+	_, _, granter := testdata.KeyTestPubAddr()
+
+	feeAmount := testdata.NewTestFeeAmount()
+	granteeAcc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, grantee)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, granteeAcc)
+	granterAcc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, granter)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, granterAcc)
+	suite.app.BankKeeper.SetBalances(suite.ctx, granter, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(1000))))
+
+	createTx := func() sdk.Tx {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(grantee)))
+		suite.txBuilder.SetFeeAmount(feeAmount)
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+		suite.txBuilder.SetFeeGranter(granter)
+
+		privs, accNums, accSeqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+		tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+		suite.Require().NoError(err)
+
+		return tx
+	}
+
+	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.FeeGrantKeeper)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	_, err := antehandler(suite.ctx, createTx(), false)
+	suite.Require().True(errors.Is(err, feegranttypes.ErrNoAllowance), "Tx did not error without an allowance")
+
+	// grant an allowance covering a single fee
+	err = suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, granter, grantee, &feegranttypes.BasicAllowance{
+		SpendLimit: feeAmount,
+	})
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx, createTx(), false)
+	suite.Require().NoError(err, "Tx errored with a sufficient allowance")
+
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(850))), suite.app.BankKeeper.GetAllBalances(suite.ctx, granter))
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, grantee).IsZero())
+
+	// the allowance is used up and has been removed
+	_, err = suite.app.FeeGrantKeeper.GetAllowance(suite.ctx, granter, grantee)
+	suite.Require().Error(err)
+
+	_, err = antehandler(suite.ctx, createTx(), false)
+	suite.Require().Error(err, "Tx did not error after the allowance was used up")
+
+	dfd = ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil)
+	_, err = sdk.ChainAnteDecorators(dfd)(suite.ctx, createTx(), false)
+	suite.Require().Error(err, "Tx did not error without a feegrant keeper")
+}
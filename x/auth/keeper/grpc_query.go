@@ -12,6 +12,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 )
 
 var _ types.QueryServer = AccountKeeper{}
@@ -37,7 +38,12 @@ func (ak AccountKeeper) Account(c context.Context, req *types.QueryAccountReques
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
-	return &types.QueryAccountResponse{Account: acc}, nil
+	res := &types.QueryAccountResponse{Account: acc}
+	if vacc, ok := account.(vestexported.VestingAccount); ok {
+		res.LockedCoins = vacc.LockedCoins(ctx.BlockTime())
+	}
+
+	return res, nil
 }
 
 // Params returns parameters of auth module
@@ -2,10 +2,12 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 func (suite *KeeperTestSuite) TestGRPCQueryAccount() {
@@ -13,6 +15,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryAccount() {
 		req *types.QueryAccountRequest
 	)
 	_, _, addr := testdata.KeyTestPubAddr()
+	lockedCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
 
 	testCases := []struct {
 		msg       string
@@ -66,6 +69,24 @@ func (suite *KeeperTestSuite) TestGRPCQueryAccount() {
 				suite.Require().NoError(err)
 				suite.Require().NotNil(newAccount)
 				suite.Require().True(addr.Equals(newAccount.GetAddress()))
+				suite.Require().Empty(res.LockedCoins)
+			},
+		},
+		{
+			"success with vesting account",
+			func() {
+				bacc := types.NewBaseAccountWithAddress(addr)
+				vacc := vestingtypes.NewDelayedVestingAccount(bacc, lockedCoins, suite.ctx.BlockTime().Add(time.Hour).Unix())
+				suite.app.AccountKeeper.SetAccount(suite.ctx, vacc)
+				req = &types.QueryAccountRequest{Address: addr}
+			},
+			true,
+			func(res *types.QueryAccountResponse) {
+				var newAccount types.AccountI
+				err := suite.app.InterfaceRegistry().UnpackAny(res.Account, &newAccount)
+				suite.Require().NoError(err)
+				suite.Require().True(addr.Equals(newAccount.GetAddress()))
+				suite.Require().Equal(lockedCoins, res.LockedCoins)
 			},
 		},
 	}
@@ -1,6 +1,8 @@
 package signing
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
@@ -20,6 +22,28 @@ type SignModeHandler interface {
 	GetSignBytes(mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error)
 }
 
+// SignModeHandlerWithContext is a SignModeHandler which needs a context to
+// generate sign bytes, for instance to read chain state when rendering a Tx.
+// When verifying signatures on-chain, the context wraps the sdk.Context of the
+// transaction.
+type SignModeHandlerWithContext interface {
+	SignModeHandler
+
+	// GetSignBytesWithContext returns the sign bytes for the provided SignMode,
+	// SignerData and Tx, or an error
+	GetSignBytesWithContext(ctx context.Context, mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error)
+}
+
+// GetSignBytesWithContext returns the sign bytes generated by handler, passing
+// it ctx if it implements SignModeHandlerWithContext.
+func GetSignBytesWithContext(ctx context.Context, handler SignModeHandler, mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error) {
+	if handlerWithCtx, ok := handler.(SignModeHandlerWithContext); ok {
+		return handlerWithCtx.GetSignBytesWithContext(ctx, mode, data, tx)
+	}
+
+	return handler.GetSignBytes(mode, data, tx)
+}
+
 // SignerData is the specific information needed to sign a transaction that generally
 // isn't included in the transaction body itself
 type SignerData struct {
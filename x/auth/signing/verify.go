@@ -1,6 +1,7 @@
 package signing
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/tendermint/tendermint/crypto"
@@ -11,11 +12,11 @@ import (
 )
 
 // VerifySignature verifies a transaction signature contained in SignatureData abstracting over different signing modes
-// and single vs multi-signatures.
-func VerifySignature(pubKey crypto.PubKey, signerData SignerData, sigData signing.SignatureData, handler SignModeHandler, tx sdk.Tx) error {
+// and single vs multi-signatures. The context is passed to sign mode handlers implementing SignModeHandlerWithContext.
+func VerifySignature(ctx context.Context, pubKey crypto.PubKey, signerData SignerData, sigData signing.SignatureData, handler SignModeHandler, tx sdk.Tx) error {
 	switch data := sigData.(type) {
 	case *signing.SingleSignatureData:
-		signBytes, err := handler.GetSignBytes(data.SignMode, signerData, tx)
+		signBytes, err := GetSignBytesWithContext(ctx, handler, data.SignMode, signerData, tx)
 		if err != nil {
 			return err
 		}
@@ -30,7 +31,7 @@ func VerifySignature(pubKey crypto.PubKey, signerData SignerData, sigData signin
 			return fmt.Errorf("expected %T, got %T", (multisig.PubKey)(nil), pubKey)
 		}
 		err := multiPK.VerifyMultisignature(func(mode signing.SignMode) ([]byte, error) {
-			return handler.GetSignBytes(mode, signerData, tx)
+			return GetSignBytesWithContext(ctx, handler, mode, signerData, tx)
 		}, data)
 		if err != nil {
 			return err
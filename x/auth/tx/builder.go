@@ -40,8 +40,15 @@ type builder struct {
 var (
 	_ authsigning.Tx   = &builder{}
 	_ client.TxBuilder = &builder{}
+	_ ProtoTxProvider  = &builder{}
 )
 
+// ProtoTxProvider is a type which can provide the underlying protobuf Tx of a
+// transaction.
+type ProtoTxProvider interface {
+	GetProtoTx() *tx.Tx
+}
+
 func newBuilder(pubkeyCodec types.PublicKeyCodec) *builder {
 	return &builder{
 		tx: &tx.Tx{
@@ -204,6 +211,10 @@ func (t *builder) FeePayer() sdk.AccAddress {
 	return t.GetSigners()[0]
 }
 
+func (t *builder) FeeGranter() sdk.AccAddress {
+	return t.tx.AuthInfo.Fee.FeeGranter
+}
+
 func (t *builder) GetMemo() string {
 	return t.tx.Body.Memo
 }
@@ -295,6 +306,17 @@ func (t *builder) SetFeeAmount(coins sdk.Coins) {
 	t.authInfoBz = nil
 }
 
+func (t *builder) SetFeeGranter(feeGranter sdk.AccAddress) {
+	if t.tx.AuthInfo.Fee == nil {
+		t.tx.AuthInfo.Fee = &tx.Fee{}
+	}
+
+	t.tx.AuthInfo.Fee.FeeGranter = feeGranter
+
+	// set authInfoBz to nil because the cached authInfoBz no longer matches tx.AuthInfo
+	t.authInfoBz = nil
+}
+
 func (t *builder) SetSignatures(signatures ...signing.SignatureV2) error {
 	n := len(signatures)
 	signerInfos := make([]*tx.SignerInfo, n)
@@ -391,3 +413,8 @@ func (t *builder) setSignatures(sigs [][]byte) {
 func (t *builder) GetTx() authsigning.Tx {
 	return t
 }
+
+// GetProtoTx returns the underlying protobuf Tx.
+func (t *builder) GetProtoTx() *tx.Tx {
+	return t.tx
+}
@@ -24,11 +24,21 @@ type config struct {
 }
 
 // NewTxConfig returns a new protobuf TxConfig using the provided ProtoCodec, PublicKeyCodec and sign modes. The
-// first enabled sign mode will become the default sign mode.
+// first enabled sign mode will become the default sign mode. SIGN_MODE_TEXTUAL renders coins in their base denom;
+// use NewTxConfigWithTextual to render them using the bank denom metadata.
 func NewTxConfig(protoCodec *codec.ProtoCodec, pubkeyCodec types.PublicKeyCodec, enabledSignModes []signingtypes.SignMode) client.TxConfig {
+	return NewTxConfigWithTextual(protoCodec, pubkeyCodec, enabledSignModes, nil)
+}
+
+// NewTxConfigWithTextual returns a new protobuf TxConfig like NewTxConfig, where SIGN_MODE_TEXTUAL renders coins in
+// the display unit of the denom metadata returned by coinMetadataQueryFn.
+func NewTxConfigWithTextual(
+	protoCodec *codec.ProtoCodec, pubkeyCodec types.PublicKeyCodec, enabledSignModes []signingtypes.SignMode,
+	coinMetadataQueryFn CoinMetadataQueryFn,
+) client.TxConfig {
 	return &config{
 		pubkeyCodec: pubkeyCodec,
-		handler:     makeSignModeHandler(enabledSignModes),
+		handler:     makeSignModeHandler(enabledSignModes, coinMetadataQueryFn),
 		decoder:     DefaultTxDecoder(protoCodec, pubkeyCodec),
 		encoder:     DefaultTxEncoder(),
 		jsonDecoder: DefaultJSONTxDecoder(protoCodec, pubkeyCodec),
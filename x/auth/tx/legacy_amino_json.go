@@ -47,6 +47,11 @@ func (s signModeLegacyAminoJSONHandler) GetSignBytes(mode signingtypes.SignMode,
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "SIGN_MODE_LEGACY_AMINO_JSON does not support protobuf extension options.")
 	}
 
+	// StdSignDoc has no room for the fee granter, so it would not be signed over
+	if !protoTx.FeeGranter().Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "SIGN_MODE_LEGACY_AMINO_JSON does not support fee granters.")
+	}
+
 	// nolint: staticcheck
 	return types.StdSignBytes(
 		data.ChainID, data.AccountNumber, data.AccountSequence, protoTx.GetTimeoutHeight(),
@@ -81,6 +81,14 @@ func TestLegacyAminoJSONHandler_GetSignBytes(t *testing.T) {
 	tx = bldr.GetTx()
 	signBz, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signingData, tx)
 	require.Error(t, err)
+
+	// expect error with a fee granter
+	bldr = newBuilder(std.DefaultPublicKeyCodec{})
+	buildTx(t, bldr)
+	bldr.SetFeeGranter(addr1)
+	tx = bldr.GetTx()
+	signBz, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signingData, tx)
+	require.Error(t, err)
 }
 
 func TestLegacyAminoJSONHandler_DefaultMode(t *testing.T) {
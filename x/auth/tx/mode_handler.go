@@ -11,11 +11,12 @@ import (
 var DefaultSignModes = []signingtypes.SignMode{
 	signingtypes.SignMode_SIGN_MODE_DIRECT,
 	signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+	signingtypes.SignMode_SIGN_MODE_TEXTUAL,
 }
 
 // makeSignModeHandler returns the default protobuf SignModeHandler supporting
-// SIGN_MODE_DIRECT and SIGN_MODE_LEGACY_AMINO_JSON.
-func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler {
+// SIGN_MODE_DIRECT, SIGN_MODE_LEGACY_AMINO_JSON and SIGN_MODE_TEXTUAL.
+func makeSignModeHandler(modes []signingtypes.SignMode, coinMetadataQueryFn CoinMetadataQueryFn) signing.SignModeHandler {
 	if len(modes) < 1 {
 		panic(fmt.Errorf("no sign modes enabled"))
 	}
@@ -28,6 +29,8 @@ func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler
 			handlers[i] = signModeDirectHandler{}
 		case signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
 			handlers[i] = signModeLegacyAminoJSONHandler{}
+		case signingtypes.SignMode_SIGN_MODE_TEXTUAL:
+			handlers[i] = signModeTextualHandler{coinMetadataQueryFn: coinMetadataQueryFn}
 		default:
 			panic(fmt.Errorf("unsupported sign mode %+v", mode))
 		}
@@ -0,0 +1,261 @@
+package tx
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// maxTxsLimit is the maximum number of txs returned by GetTxsEvent, and the
+// number returned when no limit is supplied. It matches the maximum page size
+// of Tendermint's tx_search, which silently caps larger pages.
+const maxTxsLimit = 100
+
+// baseAppSimulateFn is the signature of the Baseapp#Simulate function.
+type baseAppSimulateFn func(txBytes []byte, tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error)
+
+// txServer is the server for the protobuf Tx service.
+type txServer struct {
+	clientCtx client.Context
+	simulate  baseAppSimulateFn
+}
+
+// NewTxServer creates a new Tx service server.
+func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn) txtypes.ServiceServer {
+	return txServer{
+		clientCtx: clientCtx,
+		simulate:  simulate,
+	}
+}
+
+var _ txtypes.ServiceServer = txServer{}
+
+// Simulate implements the ServiceServer.Simulate RPC method.
+func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*txtypes.SimulateResponse, error) {
+	if req == nil || req.Tx == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid empty tx")
+	}
+
+	txBytes, err := encodeProtoTx(req.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	theTx, err := s.clientCtx.TxConfig.TxDecoder()(txBytes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	gasInfo, result, err := s.simulate(txBytes, theTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txtypes.SimulateResponse{
+		GasInfo: &gasInfo,
+		Result:  result,
+	}, nil
+}
+
+// GetTx implements the ServiceServer.GetTx RPC method.
+func (s txServer) GetTx(ctx context.Context, req *txtypes.GetTxRequest) (*txtypes.GetTxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	hash, err := hex.DecodeString(req.Hash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx hash %s: %s", req.Hash, err)
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO: this may not always need to be proven
+	// https://github.com/cosmos/cosmos-sdk/issues/6807
+	resTx, err := node.Tx(hash, true)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	protoTx, txResp, err := s.formatTxResult(resTx, map[int64]*ctypes.ResultBlock{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &txtypes.GetTxResponse{
+		Tx:         protoTx,
+		TxResponse: txResp,
+	}, nil
+}
+
+// GetTxsEvent implements the ServiceServer.GetTxsEvent RPC method. Each event
+// takes the form of "{eventType}.{eventAttribute}={value}" and all events are
+// concatenated with an 'AND' operand.
+func (s txServer) GetTxsEvent(ctx context.Context, req *txtypes.GetTxsEventRequest) (*txtypes.GetTxsEventResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	if len(req.Events) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "must declare at least one event to search")
+	}
+
+	for _, event := range req.Events {
+		if strings.Count(event, "=") != 1 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid event %s; events must be of the format {eventType}.{eventAttribute}={value}", event)
+		}
+	}
+
+	var offset, limit uint64
+	if req.Pagination != nil {
+		if req.Pagination.Key != nil {
+			return nil, status.Error(codes.InvalidArgument, "key based pagination is not supported")
+		}
+
+		offset = req.Pagination.Offset
+		limit = req.Pagination.Limit
+	}
+
+	switch {
+	case limit == 0:
+		limit = maxTxsLimit
+
+	case limit > maxTxsLimit:
+		return nil, status.Errorf(codes.InvalidArgument, "limit %d exceeds the maximum of %d", limit, maxTxsLimit)
+	}
+
+	// Tendermint pages are 1-indexed and fixed size, so only offsets at the
+	// start of a page can be served.
+	if offset%limit != 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "offset %d must be a multiple of the limit %d", offset, limit)
+	}
+
+	page := int(offset/limit) + 1
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO: this may not always need to be proven
+	// https://github.com/cosmos/cosmos-sdk/issues/6807
+	resTxs, err := node.TxSearch(strings.Join(req.Events, " AND "), true, page, int(limit), "")
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	txs := make([]*txtypes.Tx, len(resTxs.Txs))
+	txResps := make([]*sdk.TxResponse, len(resTxs.Txs))
+	resBlocks := make(map[int64]*ctypes.ResultBlock)
+
+	for i, resTx := range resTxs.Txs {
+		txs[i], txResps[i], err = s.formatTxResult(resTx, resBlocks)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &txtypes.GetTxsEventResponse{
+		Txs:         txs,
+		TxResponses: txResps,
+		Pagination: &query.PageResponse{
+			Total: uint64(resTxs.TotalCount),
+		},
+	}, nil
+}
+
+// formatTxResult decodes the tx of a Tendermint ResultTx into its protobuf
+// representation and builds its TxResponse. Blocks fetched to retrieve the tx
+// timestamp are cached in resBlocks.
+func (s txServer) formatTxResult(resTx *ctypes.ResultTx, resBlocks map[int64]*ctypes.ResultBlock) (*txtypes.Tx, *sdk.TxResponse, error) {
+	theTx, err := s.clientCtx.TxConfig.TxDecoder()(resTx.Tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txProvider, ok := theTx.(ProtoTxProvider)
+	if !ok {
+		return nil, nil, status.Errorf(codes.Internal, "expected %T, got %T", (ProtoTxProvider)(nil), theTx)
+	}
+
+	resBlock, ok := resBlocks[resTx.Height]
+	if !ok {
+		node, err := s.clientCtx.GetNode()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		resBlock, err = node.Block(&resTx.Height)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		resBlocks[resTx.Height] = resBlock
+	}
+
+	protoTx := txProvider.GetProtoTx()
+
+	txResp := sdk.NewResponseResultTx(resTx, theTx, resBlock.Block.Time.Format(time.RFC3339))
+	txResp.Tx, err = codectypes.NewAnyWithValue(protoTx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return protoTx, txResp, nil
+}
+
+// encodeProtoTx encodes a protobuf Tx into the TxRaw bytes that are broadcast
+// to and signed over by the network.
+func encodeProtoTx(theTx *txtypes.Tx) ([]byte, error) {
+	if theTx.Body == nil || theTx.AuthInfo == nil {
+		return nil, status.Error(codes.InvalidArgument, "tx body and auth info cannot be empty")
+	}
+
+	bodyBz, err := theTx.Body.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	authInfoBz, err := theTx.AuthInfo.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	raw := &txtypes.TxRaw{
+		BodyBytes:     bodyBz,
+		AuthInfoBytes: authInfoBz,
+		Signatures:    theTx.Signatures,
+	}
+
+	return raw.Marshal()
+}
+
+// RegisterTxService registers the tx service on the gRPC router.
+func RegisterTxService(qrt gogogrpc.Server, clientCtx client.Context, simulateFn baseAppSimulateFn) {
+	txtypes.RegisterServiceServer(qrt, NewTxServer(clientCtx, simulateFn))
+}
+
+// RegisterGRPCGatewayRoutes mounts the tx service's GRPC-gateway routes on the
+// given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	if err := txtypes.RegisterServiceHandlerClient(context.Background(), mux, txtypes.NewServiceClient(clientConn)); err != nil {
+		panic(fmt.Errorf("failed to register tx service gateway routes: %w", err))
+	}
+}
@@ -0,0 +1,136 @@
+package tx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func TestTxServerSimulate(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	txConfig := NewTxConfig(codec.NewProtoCodec(interfaceRegistry), std.DefaultPublicKeyCodec{}, DefaultSignModes)
+
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	txBuilder.SetGasLimit(100000)
+	txBuilder.SetMemo("memo")
+
+	expTxBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	var simulatedTx sdk.Tx
+	simulate := func(txBytes []byte, tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error) {
+		require.Equal(t, expTxBytes, txBytes)
+		simulatedTx = tx
+		return sdk.GasInfo{GasWanted: 100000, GasUsed: 5000}, &sdk.Result{Log: "ok"}, nil
+	}
+
+	srv := NewTxServer(client.Context{}.WithTxConfig(txConfig), simulate)
+
+	_, err = srv.Simulate(context.Background(), &txtypes.SimulateRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	protoTx := txBuilder.GetTx().(ProtoTxProvider).GetProtoTx()
+	res, err := srv.Simulate(context.Background(), &txtypes.SimulateRequest{Tx: protoTx})
+	require.NoError(t, err)
+	require.Equal(t, uint64(5000), res.GasInfo.GasUsed)
+	require.Equal(t, "ok", res.Result.Log)
+	require.Equal(t, "memo", simulatedTx.(ProtoTxProvider).GetProtoTx().Body.Memo)
+}
+
+func TestTxServerInvalidRequests(t *testing.T) {
+	srv := NewTxServer(client.Context{}, nil)
+
+	testCases := []struct {
+		name string
+		call func() error
+	}{
+		{
+			"GetTx nil request",
+			func() error { _, err := srv.GetTx(context.Background(), nil); return err },
+		},
+		{
+			"GetTx invalid hash",
+			func() error {
+				_, err := srv.GetTx(context.Background(), &txtypes.GetTxRequest{Hash: "not-hex"})
+				return err
+			},
+		},
+		{
+			"GetTxsEvent no events",
+			func() error {
+				_, err := srv.GetTxsEvent(context.Background(), &txtypes.GetTxsEventRequest{})
+				return err
+			},
+		},
+		{
+			"GetTxsEvent malformed event",
+			func() error {
+				_, err := srv.GetTxsEvent(context.Background(), &txtypes.GetTxsEventRequest{Events: []string{"message.action"}})
+				return err
+			},
+		},
+		{
+			"GetTxsEvent key pagination",
+			func() error {
+				_, err := srv.GetTxsEvent(context.Background(), &txtypes.GetTxsEventRequest{
+					Events:     []string{"message.action='send'"},
+					Pagination: &query.PageRequest{Key: []byte("key")},
+				})
+				return err
+			},
+		},
+		{
+			"GetTxsEvent offset not at a page boundary",
+			func() error {
+				_, err := srv.GetTxsEvent(context.Background(), &txtypes.GetTxsEventRequest{
+					Events:     []string{"message.action='send'"},
+					Pagination: &query.PageRequest{Offset: 5, Limit: 10},
+				})
+				return err
+			},
+		},
+		{
+			"GetTxsEvent offset not at a default page boundary",
+			func() error {
+				_, err := srv.GetTxsEvent(context.Background(), &txtypes.GetTxsEventRequest{
+					Events:     []string{"message.action='send'"},
+					Pagination: &query.PageRequest{Offset: 50},
+				})
+				return err
+			},
+		},
+		{
+			"GetTxsEvent limit above the maximum",
+			func() error {
+				_, err := srv.GetTxsEvent(context.Background(), &txtypes.GetTxsEventRequest{
+					Events:     []string{"message.action='send'"},
+					Pagination: &query.PageRequest{Limit: 101},
+				})
+				return err
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, codes.InvalidArgument, status.Code(tc.call()))
+		})
+	}
+}
@@ -0,0 +1,268 @@
+package tx
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// CoinMetadataQueryFn returns the bank denom metadata of a denom, or nil if the
+// denom has no metadata. SIGN_MODE_TEXTUAL uses it to render coin amounts in
+// their display unit.
+type CoinMetadataQueryFn func(ctx context.Context, denom string) (*banktypes.Metadata, error)
+
+// BankKeeper defines the bank keeper methods needed to read denom metadata
+// from state.
+type BankKeeper interface {
+	GetDenomMetaData(ctx sdk.Context, denom string) banktypes.Metadata
+}
+
+// NewBankKeeperCoinMetadataQueryFn returns a CoinMetadataQueryFn reading denom
+// metadata from the bank module state. The context must wrap an sdk.Context,
+// which is the case when signatures are verified by the ante handler.
+func NewBankKeeperCoinMetadataQueryFn(bk BankKeeper) CoinMetadataQueryFn {
+	return func(ctx context.Context, denom string) (*banktypes.Metadata, error) {
+		sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context)
+		if !ok {
+			return nil, fmt.Errorf("cannot query the metadata of %s without an sdk.Context", denom)
+		}
+
+		metadata := bk.GetDenomMetaData(sdkCtx, denom)
+		if metadata.Base == "" {
+			return nil, nil
+		}
+
+		return &metadata, nil
+	}
+}
+
+// NewClientCoinMetadataQueryFn returns a CoinMetadataQueryFn reading denom
+// metadata from the bank module store of the node the client is connected to.
+func NewClientCoinMetadataQueryFn(clientCtx client.Context) CoinMetadataQueryFn {
+	return func(_ context.Context, denom string) (*banktypes.Metadata, error) {
+		// the keeper stores metadata in a prefix store of DenomMetadataKey(denom),
+		// keyed by the denom itself
+		key := append(banktypes.DenomMetadataKey(denom), denom...)
+
+		bz, _, err := clientCtx.QueryStore(key, banktypes.StoreKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(bz) == 0 {
+			return nil, nil
+		}
+
+		var metadata banktypes.Metadata
+		if err := metadata.Unmarshal(bz); err != nil {
+			return nil, err
+		}
+
+		return &metadata, nil
+	}
+}
+
+// signModeTextualHandler defines the SIGN_MODE_TEXTUAL SignModeHandler. It
+// renders a transaction into a list of human-readable screens, which hardware
+// wallets can display one by one, and signs over their textual encoding.
+type signModeTextualHandler struct {
+	coinMetadataQueryFn CoinMetadataQueryFn
+}
+
+var _ signing.SignModeHandlerWithContext = signModeTextualHandler{}
+
+// DefaultMode implements SignModeHandler.DefaultMode
+func (signModeTextualHandler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_TEXTUAL
+}
+
+// Modes implements SignModeHandler.Modes
+func (signModeTextualHandler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes
+func (h signModeTextualHandler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	return h.GetSignBytesWithContext(context.Background(), mode, data, tx)
+}
+
+// GetSignBytesWithContext implements SignModeHandlerWithContext.GetSignBytesWithContext
+func (h signModeTextualHandler) GetSignBytesWithContext(ctx context.Context, mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_TEXTUAL {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_TEXTUAL, mode)
+	}
+
+	protoTx, ok := tx.(*builder)
+	if !ok {
+		return nil, fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	}
+
+	screens, err := newTextualRenderer(ctx, h.coinMetadataQueryFn).renderTx(data, protoTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeScreens(screens), nil
+}
+
+// screen is a single line of the SIGN_MODE_TEXTUAL rendering of a transaction.
+// Expert screens carry details which wallets may only show on demand.
+type screen struct {
+	title   string
+	content string
+	indent  int
+	expert  bool
+}
+
+// renderTx renders the screens of a transaction for the given signer.
+func (r *textualRenderer) renderTx(data signing.SignerData, protoTx *builder) ([]screen, error) {
+	body := protoTx.tx.Body
+	authInfo := protoTx.tx.AuthInfo
+
+	screens := []screen{
+		{title: "Chain id", content: data.ChainID},
+		{title: "Account number", content: strconv.FormatUint(data.AccountNumber, 10)},
+		{title: "Sequence", content: strconv.FormatUint(data.AccountSequence, 10)},
+		{content: fmt.Sprintf("This transaction has %d Message(s)", len(body.Messages))},
+	}
+
+	for i, msg := range body.Messages {
+		msgScreens, err := r.renderAny(fmt.Sprintf("Message (%d/%d)", i+1, len(body.Messages)), msg, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		screens = append(screens, msgScreens...)
+		screens = append(screens, screen{content: "End of Message"})
+	}
+
+	if body.Memo != "" {
+		screens = append(screens, screen{title: "Memo", content: body.Memo})
+	}
+
+	fees := "none"
+	if authInfo.Fee != nil && !authInfo.Fee.Amount.Empty() {
+		var err error
+		fees, err = r.formatCoins(authInfo.Fee.Amount)
+		if err != nil {
+			return nil, err
+		}
+	}
+	screens = append(screens, screen{title: "Fees", content: fees})
+
+	if authInfo.Fee != nil && !authInfo.Fee.FeeGranter.Empty() {
+		screens = append(screens, screen{title: "Fee granter", content: authInfo.Fee.FeeGranter.String()})
+	}
+
+	if authInfo.Fee != nil {
+		screens = append(screens, screen{title: "Gas limit", content: strconv.FormatUint(authInfo.Fee.GasLimit, 10), expert: true})
+	}
+
+	if body.TimeoutHeight != 0 {
+		screens = append(screens, screen{title: "Timeout height", content: strconv.FormatUint(body.TimeoutHeight, 10)})
+	}
+
+	for i, opt := range body.ExtensionOptions {
+		optScreens, err := r.renderAny(fmt.Sprintf("Extension option (%d/%d)", i+1, len(body.ExtensionOptions)), opt, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		screens = append(screens, expertScreens(optScreens)...)
+	}
+
+	for i, opt := range body.NonCriticalExtensionOptions {
+		optScreens, err := r.renderAny(fmt.Sprintf("Non critical extension option (%d/%d)", i+1, len(body.NonCriticalExtensionOptions)), opt, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		screens = append(screens, expertScreens(optScreens)...)
+	}
+
+	// The rendering does not show every byte of the transaction, e.g. the
+	// signer infos, so the signature must also commit to the raw bytes to
+	// prevent malleability.
+	bodyHash := sha256.Sum256(protoTx.getBodyBytes())
+	authInfoHash := sha256.Sum256(protoTx.getAuthInfoBytes())
+	txHash := sha256.Sum256(append(bodyHash[:], authInfoHash[:]...))
+	screens = append(screens, screen{title: "Hash of raw bytes", content: fmt.Sprintf("%X", txHash), expert: true})
+
+	return screens, nil
+}
+
+func expertScreens(screens []screen) []screen {
+	for i := range screens {
+		screens[i].expert = true
+	}
+
+	return screens
+}
+
+// encodeScreens deterministically encodes screens into the SIGN_MODE_TEXTUAL
+// sign bytes. Every screen is written on its own line as
+// "[> ]*[*]title: content", where each "> " denotes a level of indentation and
+// "*" marks an expert screen. All characters except printable ASCII are
+// escaped, so that the sign bytes can be shown by any device.
+func encodeScreens(screens []screen) []byte {
+	var buf bytes.Buffer
+
+	for _, s := range screens {
+		buf.WriteString(strings.Repeat("> ", s.indent))
+
+		if s.expert {
+			buf.WriteByte('*')
+		}
+
+		switch {
+		case s.title == "":
+			buf.WriteString(escapeScreenText(s.content))
+		case s.content == "":
+			buf.WriteString(escapeScreenText(s.title))
+		default:
+			buf.WriteString(escapeScreenText(s.title))
+			buf.WriteString(": ")
+			buf.WriteString(escapeScreenText(s.content))
+		}
+
+		buf.WriteByte('\n')
+	}
+
+	return buf.Bytes()
+}
+
+func escapeScreenText(text string) string {
+	var sb strings.Builder
+
+	for i, w := 0, 0; i < len(text); i += w {
+		r, width := utf8.DecodeRuneInString(text[i:])
+		w = width
+
+		switch {
+		case r == '\\':
+			sb.WriteString(`\\`)
+		case r == '\n':
+			sb.WriteString(`\n`)
+		case r == utf8.RuneError && width == 1:
+			fmt.Fprintf(&sb, `\x%02X`, text[i])
+		case r >= 0x20 && r < 0x7f:
+			sb.WriteRune(r)
+		case r <= 0xffff:
+			fmt.Fprintf(&sb, `\u%04X`, r)
+		default:
+			fmt.Fprintf(&sb, `\U%08X`, r)
+		}
+	}
+
+	return sb.String()
+}
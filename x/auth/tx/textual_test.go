@@ -0,0 +1,121 @@
+package tx
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestTextualModeHandler(t *testing.T) {
+	_, _, fromAddr := testdata.KeyTestPubAddr()
+	_, _, toAddr := testdata.KeyTestPubAddr()
+
+	txBuilder := newBuilder(std.DefaultPublicKeyCodec{})
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000)))))
+	txBuilder.SetMemo("line1\nline2 é")
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uatom", 2000), sdk.NewInt64Coin("stake", 10)))
+	txBuilder.SetGasLimit(100000)
+	txBuilder.SetTimeoutHeight(10)
+
+	signerData := signing.SignerData{
+		ChainID:         "test-chain",
+		AccountNumber:   1,
+		AccountSequence: 2,
+	}
+
+	bodyHash := sha256.Sum256(txBuilder.getBodyBytes())
+	authInfoHash := sha256.Sum256(txBuilder.getAuthInfoBytes())
+	txHash := sha256.Sum256(append(bodyHash[:], authInfoHash[:]...))
+
+	render := func(amount, fees string) string {
+		return fmt.Sprintf(`Chain id: test-chain
+Account number: 1
+Sequence: 2
+This transaction has 1 Message(s)
+Message (1/1): /cosmos.bank.v1beta1.MsgSend
+> From address: %s
+> To address: %s
+> Amount: %s
+End of Message
+Memo: line1\nline2 \u00E9
+Fees: %s
+*Gas limit: 100000
+Timeout height: 10
+*Hash of raw bytes: %X
+`, fromAddr, toAddr, amount, fees, txHash)
+	}
+
+	t.Log("verify coins are rendered in their base denom without metadata")
+	handler := signModeTextualHandler{}
+	signBytes, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signerData, txBuilder)
+	require.NoError(t, err)
+	require.Equal(t, render("1500000 uatom", "10 stake, 2000 uatom"), string(signBytes))
+
+	t.Log("verify coins are rendered in their display unit with metadata")
+	atomMetadata := &banktypes.Metadata{
+		Base:    "uatom",
+		Display: "atom",
+		DenomUnits: []*banktypes.DenomUnits{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "matom", Exponent: 3},
+			{Denom: "atom", Exponent: 6},
+		},
+	}
+	handler = signModeTextualHandler{
+		coinMetadataQueryFn: func(_ context.Context, denom string) (*banktypes.Metadata, error) {
+			if denom == "uatom" {
+				return atomMetadata, nil
+			}
+			return nil, nil
+		},
+	}
+	signBytes, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signerData, txBuilder)
+	require.NoError(t, err)
+	require.Equal(t, render("1.5 atom", "10 stake, 0.002 atom"), string(signBytes))
+
+	t.Log("verify metadata query errors are returned")
+	handler = signModeTextualHandler{
+		coinMetadataQueryFn: func(_ context.Context, denom string) (*banktypes.Metadata, error) {
+			return nil, fmt.Errorf("query failed")
+		},
+	}
+	_, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signerData, txBuilder)
+	require.Error(t, err)
+
+	t.Log("verify other modes are rejected")
+	_, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, signerData, txBuilder)
+	require.Equal(t, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingtypes.SignMode_SIGN_MODE_DIRECT), err)
+}
+
+func TestShiftDecimalPoint(t *testing.T) {
+	testCases := []struct {
+		amount string
+		exp    int
+		expStr string
+	}{
+		{"1500000", 6, "1.5"},
+		{"1", 6, "0.000001"},
+		{"1000000", 6, "1"},
+		{"0", 6, "0"},
+		{"1.500000000000000000", 0, "1.5"},
+		{"1.250000000000000000", 3, "0.00125"},
+		{"0.5", -3, "500"},
+		{"12", -2, "1200"},
+		{"-1500", 3, "-1.5"},
+		{"-0.000", 0, "0"},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expStr, shiftDecimalPoint(tc.amount, tc.exp), "%s shifted by %d", tc.amount, tc.exp)
+	}
+}
@@ -0,0 +1,365 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// textualRenderer renders the values of a transaction into SIGN_MODE_TEXTUAL
+// screens. Denom metadata is cached for the lifetime of the renderer.
+type textualRenderer struct {
+	ctx                 context.Context
+	coinMetadataQueryFn CoinMetadataQueryFn
+	metadata            map[string]*banktypes.Metadata
+}
+
+func newTextualRenderer(ctx context.Context, coinMetadataQueryFn CoinMetadataQueryFn) *textualRenderer {
+	return &textualRenderer{
+		ctx:                 ctx,
+		coinMetadataQueryFn: coinMetadataQueryFn,
+		metadata:            make(map[string]*banktypes.Metadata),
+	}
+}
+
+// renderAny renders the type URL of a packed value followed by its fields.
+func (r *textualRenderer) renderAny(title string, any *codectypes.Any, indent int) ([]screen, error) {
+	screens := []screen{{title: title, content: any.TypeUrl, indent: indent}}
+
+	msg, ok := any.GetCachedValue().(proto.Message)
+	if !ok {
+		// the type is unknown to the interface registry, so only the raw bytes
+		// of the value can be shown
+		return append(screens, screen{title: "Value", content: fmt.Sprintf("%X", any.Value), indent: indent + 1, expert: true}), nil
+	}
+
+	fields, err := r.renderFields(reflect.ValueOf(msg), indent+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(screens, fields...), nil
+}
+
+// renderFields renders all non-default fields of a protobuf message in their
+// declaration order.
+func (r *textualRenderer) renderFields(v reflect.Value, indent int) ([]screen, error) {
+	v = reflect.Indirect(v)
+	t := v.Type()
+
+	var screens []screen
+
+	for i := 0; i < t.NumField(); i++ {
+		field, fv := t.Field(i), v.Field(i)
+
+		if field.Tag.Get("protobuf_oneof") != "" {
+			if fv.IsNil() {
+				continue
+			}
+
+			// a oneof is set to a wrapper struct holding the single set field
+			wrapper := fv.Elem().Elem()
+			field, fv = wrapper.Type().Field(0), wrapper.Field(0)
+		}
+
+		name := protoFieldName(field)
+		if name == "" || fv.IsZero() {
+			continue
+		}
+
+		fieldScreens, err := r.renderValue(fieldTitle(name), fv, indent)
+		if err != nil {
+			return nil, err
+		}
+
+		screens = append(screens, fieldScreens...)
+	}
+
+	return screens, nil
+}
+
+// renderValue renders a single field value.
+func (r *textualRenderer) renderValue(title string, v reflect.Value, indent int) ([]screen, error) {
+	valueScreen := func(content string) ([]screen, error) {
+		return []screen{{title: title, content: content, indent: indent}}, nil
+	}
+
+	switch value := v.Interface().(type) {
+	case sdk.Coins:
+		content, err := r.formatCoins(value)
+		if err != nil {
+			return nil, err
+		}
+		return valueScreen(content)
+
+	case sdk.Coin:
+		content, err := r.formatAmount(value.Denom, value.Amount.String())
+		if err != nil {
+			return nil, err
+		}
+		return valueScreen(content)
+
+	case *sdk.Coin:
+		return r.renderValue(title, v.Elem(), indent)
+
+	case sdk.DecCoins:
+		contents := make([]string, len(value))
+		for i, coin := range value {
+			content, err := r.formatAmount(coin.Denom, coin.Amount.String())
+			if err != nil {
+				return nil, err
+			}
+			contents[i] = content
+		}
+		return valueScreen(strings.Join(contents, ", "))
+
+	case sdk.DecCoin:
+		content, err := r.formatAmount(value.Denom, value.Amount.String())
+		if err != nil {
+			return nil, err
+		}
+		return valueScreen(content)
+
+	case *sdk.DecCoin:
+		return r.renderValue(title, v.Elem(), indent)
+
+	case *codectypes.Any:
+		return r.renderAny(title, value, indent)
+
+	case time.Time:
+		return valueScreen(value.UTC().Format(time.RFC3339Nano))
+
+	case *time.Time:
+		return valueScreen(value.UTC().Format(time.RFC3339Nano))
+
+	case time.Duration:
+		return valueScreen(value.String())
+
+	case *time.Duration:
+		return valueScreen(value.String())
+	}
+
+	if msg, ok := protoMessage(v); ok {
+		fields, err := r.renderFields(reflect.ValueOf(msg), indent+1)
+		if err != nil {
+			return nil, err
+		}
+
+		return append([]screen{{title: title, content: proto.MessageName(msg), indent: indent}}, fields...), nil
+	}
+
+	if v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8 {
+		var screens []screen
+		for i := 0; i < v.Len(); i++ {
+			elemScreens, err := r.renderValue(fmt.Sprintf("%s (%d/%d)", title, i+1, v.Len()), v.Index(i), indent)
+			if err != nil {
+				return nil, err
+			}
+
+			screens = append(screens, elemScreens...)
+		}
+
+		return screens, nil
+	}
+
+	// addresses, sdk.Int, sdk.Dec and enums
+	if stringer, ok := v.Interface().(fmt.Stringer); ok {
+		return valueScreen(stringer.String())
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return valueScreen(v.String())
+
+	case reflect.Bool:
+		if v.Bool() {
+			return valueScreen("True")
+		}
+		return valueScreen("False")
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return valueScreen(strconv.FormatInt(v.Int(), 10))
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return valueScreen(strconv.FormatUint(v.Uint(), 10))
+
+	case reflect.Float32, reflect.Float64:
+		return valueScreen(strconv.FormatFloat(v.Float(), 'f', -1, 64))
+
+	case reflect.Slice:
+		return valueScreen(fmt.Sprintf("%X", v.Bytes()))
+
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+
+		var screens []screen
+		for _, key := range keys {
+			entryScreens, err := r.renderValue(fmt.Sprintf("%s (%v)", title, key.Interface()), v.MapIndex(key), indent)
+			if err != nil {
+				return nil, err
+			}
+
+			screens = append(screens, entryScreens...)
+		}
+
+		return screens, nil
+
+	case reflect.Ptr:
+		return r.renderValue(title, v.Elem(), indent)
+	}
+
+	return nil, fmt.Errorf("SIGN_MODE_TEXTUAL cannot render field %s of type %s", title, v.Type())
+}
+
+// formatCoins renders coins separated by commas.
+func (r *textualRenderer) formatCoins(coins sdk.Coins) (string, error) {
+	contents := make([]string, len(coins))
+	for i, coin := range coins {
+		content, err := r.formatAmount(coin.Denom, coin.Amount.String())
+		if err != nil {
+			return "", err
+		}
+		contents[i] = content
+	}
+
+	return strings.Join(contents, ", "), nil
+}
+
+// formatAmount renders a decimal amount of denom in the display unit of its
+// denom metadata, or in denom if it has no usable metadata.
+func (r *textualRenderer) formatAmount(denom string, amount string) (string, error) {
+	metadata, err := r.coinMetadata(denom)
+	if err != nil {
+		return "", err
+	}
+
+	if metadata != nil {
+		var (
+			coinExp, displayExp     uint32
+			foundCoin, foundDisplay bool
+		)
+
+		for _, unit := range metadata.DenomUnits {
+			if unit.Denom == denom {
+				coinExp, foundCoin = unit.Exponent, true
+			}
+			if unit.Denom == metadata.Display {
+				displayExp, foundDisplay = unit.Exponent, true
+			}
+		}
+
+		if foundCoin && foundDisplay {
+			return shiftDecimalPoint(amount, int(displayExp)-int(coinExp)) + " " + metadata.Display, nil
+		}
+	}
+
+	return shiftDecimalPoint(amount, 0) + " " + denom, nil
+}
+
+func (r *textualRenderer) coinMetadata(denom string) (*banktypes.Metadata, error) {
+	if r.coinMetadataQueryFn == nil {
+		return nil, nil
+	}
+
+	if metadata, ok := r.metadata[denom]; ok {
+		return metadata, nil
+	}
+
+	metadata, err := r.coinMetadataQueryFn(r.ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	r.metadata[denom] = metadata
+
+	return metadata, nil
+}
+
+// shiftDecimalPoint moves the decimal point of a decimal string exp digits to
+// the left, or to the right if exp is negative, and trims superfluous zeros.
+func shiftDecimalPoint(amount string, exp int) string {
+	sign := ""
+	if strings.HasPrefix(amount, "-") {
+		sign, amount = "-", amount[1:]
+	}
+
+	intPart, fracPart := amount, ""
+	if i := strings.IndexByte(amount, '.'); i >= 0 {
+		intPart, fracPart = amount[:i], amount[i+1:]
+	}
+
+	digits := intPart + fracPart
+	point := len(intPart) - exp
+
+	if point < 1 {
+		digits = strings.Repeat("0", 1-point) + digits
+		point = 1
+	}
+
+	if point > len(digits) {
+		digits += strings.Repeat("0", point-len(digits))
+	}
+
+	intPart = strings.TrimLeft(digits[:point], "0")
+	fracPart = strings.TrimRight(digits[point:], "0")
+
+	if intPart == "" {
+		intPart = "0"
+	}
+
+	if intPart == "0" && fracPart == "" {
+		sign = ""
+	}
+
+	if fracPart == "" {
+		return sign + intPart
+	}
+
+	return sign + intPart + "." + fracPart
+}
+
+// protoMessage returns v, or its address, as a protobuf message.
+func protoMessage(v reflect.Value) (proto.Message, bool) {
+	if msg, ok := v.Interface().(proto.Message); ok && v.Kind() == reflect.Ptr {
+		return msg, true
+	}
+
+	if v.Kind() == reflect.Struct && v.CanAddr() {
+		msg, ok := v.Addr().Interface().(proto.Message)
+		return msg, ok
+	}
+
+	return nil, false
+}
+
+// protoFieldName returns the protobuf name of a struct field generated by
+// gogoproto, or an empty string if it is not a protobuf field.
+func protoFieldName(field reflect.StructField) string {
+	for _, part := range strings.Split(field.Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(part, "name=") {
+			return strings.TrimPrefix(part, "name=")
+		}
+	}
+
+	return ""
+}
+
+// fieldTitle converts a protobuf field name such as "from_address" into the
+// screen title "From address".
+func fieldTitle(name string) string {
+	title := strings.ReplaceAll(name, "_", " ")
+	return strings.ToUpper(title[:1]) + title[1:]
+}
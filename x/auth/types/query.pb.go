@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -82,6 +83,9 @@ func (m *QueryAccountRequest) GetAddress() github_com_cosmos_cosmos_sdk_types.Ac
 type QueryAccountResponse struct {
 	// account defines the account of the corresponding address.
 	Account *types.Any `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// locked_coins defines the coins locked by vesting at the time of the query.
+	// It is only set for vesting accounts.
+	LockedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=locked_coins,json=lockedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"locked_coins"`
 }
 
 func (m *QueryAccountResponse) Reset()         { *m = QueryAccountResponse{} }
@@ -124,6 +128,13 @@ func (m *QueryAccountResponse) GetAccount() *types.Any {
 	return nil
 }
 
+func (m *QueryAccountResponse) GetLockedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.LockedCoins
+	}
+	return nil
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -217,34 +228,38 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/query.proto", fileDescriptor_c451370b3929a27c) }
 
 var fileDescriptor_c451370b3929a27c = []byte{
-	// 428 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xbd, 0xce, 0xd3, 0x30,
-	0x14, 0x4d, 0x2a, 0x68, 0x91, 0xe9, 0xe4, 0x66, 0x80, 0x14, 0x52, 0x14, 0x86, 0xb6, 0x43, 0x6d,
-	0xb5, 0x4c, 0x48, 0x2c, 0x09, 0x13, 0x62, 0x29, 0x11, 0x13, 0x0b, 0x72, 0x52, 0x93, 0x56, 0xd0,
-	0x38, 0x8d, 0x1d, 0x44, 0x85, 0x90, 0x10, 0x2f, 0x00, 0x12, 0x2b, 0x8f, 0xc1, 0x43, 0x54, 0x4c,
-	0x95, 0x58, 0x98, 0x2a, 0xd4, 0x7e, 0x4f, 0xf1, 0x4d, 0x9f, 0x6a, 0xdf, 0x0c, 0x95, 0xf2, 0xfd,
-	0x4c, 0x89, 0xef, 0x3d, 0xf7, 0x9c, 0xe3, 0x73, 0x8d, 0x7a, 0x89, 0x90, 0x4b, 0x21, 0x29, 0x2b,
-	0xd5, 0x9c, 0x7e, 0x1c, 0xc7, 0x5c, 0xb1, 0x31, 0x5d, 0x95, 0xbc, 0x58, 0x93, 0xbc, 0x10, 0x4a,
-	0xe0, 0x8e, 0x01, 0x90, 0x23, 0x80, 0x00, 0xc0, 0x75, 0x52, 0x91, 0x0a, 0xdd, 0xa7, 0xc7, 0x3f,
-	0x03, 0x75, 0xef, 0xa7, 0x42, 0xa4, 0x1f, 0x38, 0xd5, 0xa7, 0xb8, 0x7c, 0x47, 0x59, 0x06, 0x2c,
-	0xee, 0x03, 0x68, 0xb1, 0x7c, 0x41, 0x59, 0x96, 0x09, 0xc5, 0xd4, 0x42, 0x64, 0x12, 0xba, 0x5e,
-	0x9d, 0x09, 0x2d, 0x08, 0xc4, 0xa6, 0xff, 0xd6, 0x28, 0x82, 0x21, 0x7d, 0xf0, 0x63, 0xd4, 0x79,
-	0x75, 0x74, 0x1b, 0x24, 0x89, 0x28, 0x33, 0x15, 0xf1, 0x55, 0xc9, 0xa5, 0xc2, 0x2f, 0x51, 0x8b,
-	0xcd, 0x66, 0x05, 0x97, 0xf2, 0x9e, 0xfd, 0xc8, 0x1e, 0xb4, 0xc3, 0xf1, 0xf9, 0xae, 0x37, 0x4a,
-	0x17, 0x6a, 0x5e, 0xc6, 0x24, 0x11, 0x4b, 0x20, 0x81, 0xcf, 0x48, 0xce, 0xde, 0x53, 0xb5, 0xce,
-	0xb9, 0x24, 0x41, 0x92, 0x04, 0x66, 0x30, 0xaa, 0x18, 0xfc, 0xd7, 0xc8, 0x39, 0xd5, 0x90, 0xb9,
-	0xc8, 0x24, 0xc7, 0xcf, 0x50, 0x8b, 0x99, 0x92, 0x16, 0xb9, 0x3b, 0x71, 0x88, 0xb9, 0x26, 0xa9,
-	0x12, 0x20, 0x41, 0xb6, 0x0e, 0xdb, 0x7f, 0x7e, 0x8f, 0xee, 0xc0, 0xec, 0x8b, 0xa8, 0x1a, 0xf1,
-	0x1d, 0x84, 0x35, 0xeb, 0x94, 0x15, 0x6c, 0x29, 0xc1, 0xb8, 0x3f, 0x85, 0xfb, 0x54, 0x55, 0x90,
-	0x7a, 0x8a, 0x9a, 0xb9, 0xae, 0x80, 0x52, 0x97, 0xd4, 0xac, 0x85, 0x98, 0xa1, 0xf0, 0xd6, 0x66,
-	0xd7, 0xb3, 0x22, 0x18, 0x98, 0xfc, 0x6a, 0xa0, 0xdb, 0x9a, 0x12, 0x7f, 0xb7, 0x51, 0x0b, 0x7c,
-	0xe0, 0x41, 0x2d, 0x41, 0x4d, 0x94, 0xee, 0xf0, 0x06, 0x48, 0xe3, 0xd2, 0xa7, 0xdf, 0xfe, 0x9e,
-	0xfd, 0x6c, 0x0c, 0x71, 0x9f, 0xd6, 0x2e, 0xd4, 0xa0, 0x25, 0xfd, 0x0c, 0xc1, 0x7e, 0xc1, 0x5f,
-	0x6d, 0xd4, 0x34, 0xa6, 0x71, 0xff, 0x72, 0x99, 0x93, 0x84, 0xdc, 0xc1, 0xf5, 0x40, 0xb0, 0xf3,
-	0x58, 0xdb, 0x79, 0x88, 0xbb, 0xb5, 0x76, 0x4c, 0x3c, 0xe1, 0xf3, 0xcd, 0xde, 0xb3, 0xb7, 0x7b,
-	0xcf, 0xfe, 0xbf, 0xf7, 0xec, 0x1f, 0x07, 0xcf, 0xda, 0x1e, 0x3c, 0xeb, 0xdf, 0xc1, 0xb3, 0xde,
-	0x0c, 0xaf, 0x7c, 0x2e, 0x9f, 0x0c, 0x9b, 0x7e, 0x35, 0x71, 0x53, 0x2f, 0xfc, 0xc9, 0x45, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xa6, 0x5e, 0x10, 0x0d, 0x4e, 0x03, 0x00, 0x00,
+	// 486 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0x8e, 0x03, 0x24, 0xe8, 0x92, 0xe9, 0x92, 0xa1, 0x75, 0xc1, 0xa9, 0xcc, 0x50, 0x67, 0xc8,
+	0x1d, 0x09, 0x13, 0x12, 0x4b, 0xdc, 0x09, 0xb1, 0x14, 0x8f, 0x2c, 0xd5, 0xd9, 0x39, 0x5c, 0xab,
+	0xcd, 0x9d, 0x9b, 0x3b, 0x23, 0x22, 0x84, 0x84, 0xf8, 0x03, 0x20, 0xb1, 0xf2, 0x0b, 0x98, 0xf9,
+	0x09, 0x0c, 0x15, 0x53, 0x25, 0x16, 0xa6, 0x82, 0x12, 0x7e, 0x05, 0x13, 0xf2, 0xdd, 0x0b, 0x92,
+	0x25, 0x43, 0x33, 0xd9, 0x77, 0xef, 0xfb, 0xde, 0xf7, 0x7d, 0xef, 0x1d, 0x1a, 0x24, 0x52, 0xcd,
+	0xa5, 0xa2, 0xac, 0xd0, 0x27, 0xf4, 0xc5, 0x38, 0xe6, 0x9a, 0x8d, 0xe9, 0x79, 0xc1, 0x17, 0x4b,
+	0x92, 0x2f, 0xa4, 0x96, 0xb8, 0x67, 0x01, 0xa4, 0x04, 0x10, 0x00, 0xb8, 0xfd, 0x54, 0xa6, 0xd2,
+	0xd4, 0x69, 0xf9, 0x67, 0xa1, 0xee, 0x6e, 0x2a, 0x65, 0x7a, 0xc6, 0xa9, 0x39, 0xc5, 0xc5, 0x73,
+	0xca, 0x04, 0x74, 0x71, 0xef, 0x40, 0x89, 0xe5, 0x19, 0x65, 0x42, 0x48, 0xcd, 0x74, 0x26, 0x85,
+	0x82, 0xaa, 0x57, 0x67, 0xc2, 0x08, 0x42, 0x63, 0x5b, 0x3f, 0xb6, 0x8a, 0x60, 0xa8, 0x4a, 0x8d,
+	0x99, 0xe2, 0x7f, 0xa9, 0x89, 0xcc, 0x84, 0xad, 0xfb, 0x31, 0xea, 0x3d, 0x2d, 0xd3, 0x4c, 0x93,
+	0x44, 0x16, 0x42, 0x47, 0xfc, 0xbc, 0xe0, 0x4a, 0xe3, 0x27, 0xa8, 0xcd, 0x66, 0xb3, 0x05, 0x57,
+	0x6a, 0xc7, 0xd9, 0x77, 0x82, 0x6e, 0x38, 0xfe, 0x7d, 0x35, 0x18, 0xa5, 0x99, 0x3e, 0x29, 0x62,
+	0x92, 0xc8, 0x39, 0x88, 0xc0, 0x67, 0xa4, 0x66, 0xa7, 0x54, 0x2f, 0x73, 0xae, 0xc8, 0x34, 0x49,
+	0xa6, 0x96, 0x18, 0x6d, 0x3a, 0xf8, 0x5f, 0x1c, 0xd4, 0xaf, 0x8a, 0xa8, 0x5c, 0x0a, 0xc5, 0xf1,
+	0x23, 0xd4, 0x66, 0xf6, 0xca, 0xa8, 0x74, 0x26, 0x7d, 0x62, 0xe7, 0x40, 0x36, 0x23, 0x22, 0x53,
+	0xb1, 0x0c, 0xbb, 0x5f, 0x3f, 0x8f, 0x6e, 0x03, 0xf7, 0x71, 0xb4, 0xa1, 0x60, 0x81, 0xba, 0x67,
+	0x32, 0x39, 0xe5, 0xb3, 0xe3, 0x32, 0x8f, 0xda, 0x69, 0xee, 0xdf, 0x08, 0x3a, 0x93, 0x5d, 0x02,
+	0xf9, 0xcb, 0xc4, 0x9b, 0x85, 0x90, 0x43, 0x99, 0x89, 0xf0, 0xfe, 0xc5, 0xd5, 0xa0, 0xf1, 0xe9,
+	0xc7, 0x20, 0xd8, 0x22, 0x47, 0x49, 0x50, 0x51, 0xc7, 0x0a, 0x98, 0x83, 0xdf, 0x47, 0xd8, 0xa4,
+	0x38, 0x62, 0x0b, 0x36, 0x57, 0x30, 0x29, 0xff, 0x08, 0xf5, 0x2a, 0xb7, 0x10, 0xed, 0x21, 0x6a,
+	0xe5, 0xe6, 0x06, 0x92, 0xed, 0x91, 0x9a, 0x77, 0x42, 0x2c, 0x29, 0xbc, 0x59, 0x1a, 0x8b, 0x80,
+	0x30, 0xf9, 0xd8, 0x44, 0xb7, 0x4c, 0x4b, 0xfc, 0xce, 0x41, 0x6d, 0xc8, 0x8d, 0x83, 0xda, 0x06,
+	0x35, 0xbb, 0x73, 0x87, 0x5b, 0x20, 0xad, 0x4b, 0x9f, 0xbe, 0xfd, 0xf6, 0xeb, 0x43, 0x73, 0x88,
+	0x0f, 0x68, 0xed, 0x0b, 0xb3, 0x68, 0x45, 0x5f, 0xc1, 0x26, 0x5f, 0xe3, 0x37, 0x0e, 0x6a, 0x59,
+	0xd3, 0xf8, 0xe0, 0xdf, 0x32, 0x95, 0x09, 0xb9, 0xc1, 0xf5, 0x40, 0xb0, 0x73, 0xcf, 0xd8, 0xb9,
+	0x8b, 0xf7, 0x6a, 0xed, 0xd8, 0xf1, 0x84, 0x87, 0x17, 0x2b, 0xcf, 0xb9, 0x5c, 0x79, 0xce, 0xcf,
+	0x95, 0xe7, 0xbc, 0x5f, 0x7b, 0x8d, 0xcb, 0xb5, 0xd7, 0xf8, 0xbe, 0xf6, 0x1a, 0xcf, 0x86, 0xff,
+	0xdd, 0xeb, 0x4b, 0xdb, 0xcd, 0xac, 0x37, 0x6e, 0x99, 0x07, 0xf6, 0xe0, 0xcf, 0x00, 0x6e, 0x4e,
+	0x14, 0x14, 0xdf, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -417,6 +432,20 @@ func (m *QueryAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LockedCoins) > 0 {
+		for iNdEx := len(m.LockedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.Account != nil {
 		{
 			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
@@ -522,6 +551,12 @@ func (m *QueryAccountResponse) Size() (n int) {
 		l = m.Account.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if len(m.LockedCoins) > 0 {
+		for _, e := range m.LockedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -703,6 +738,40 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedCoins = append(m.LockedCoins, types1.Coin{})
+			if err := m.LockedCoins[len(m.LockedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,296 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// Transaction command flags
+const (
+	FlagDelayed = "delayed"
+	FlagLockup  = "lockup"
+	FlagVesting = "vesting"
+	FlagDest    = "dest"
+)
+
+// GetTxCmd returns vesting module's transaction commands.
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Vesting transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewMsgCreateVestingAccountCmd(),
+		NewMsgCreatePeriodicVestingAccountCmd(),
+		NewMsgCreateClawbackVestingAccountCmd(),
+		NewMsgClawbackCmd(),
+	)
+
+	return txCmd
+}
+
+// NewMsgCreateVestingAccountCmd returns a CLI command handler for creating a
+// MsgCreateVestingAccount transaction.
+func NewMsgCreateVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-vesting-account [to_address] [amount] [end_time]",
+		Short: "Create a new vesting account funded with an allocation of tokens.",
+		Long: `Create a new vesting account funded with an allocation of tokens. The
+account can either be a delayed or continuous vesting account, which is determined
+by the '--delayed' flag. All vesting accounts created will have their start time
+set by the committed block's time. The end_time must be provided as a UNIX epoch
+timestamp.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			endTime, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			delayed, _ := cmd.Flags().GetBool(FlagDelayed)
+
+			msg := types.NewMsgCreateVestingAccount(clientCtx.GetFromAddress(), toAddr, amount, endTime, delayed)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagDelayed, false, "Create a delayed vesting account if true")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgCreatePeriodicVestingAccountCmd returns a CLI command handler for
+// creating a MsgCreatePeriodicVestingAccount transaction.
+func NewMsgCreatePeriodicVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-periodic-vesting-account [to_address] [periods_json_file]",
+		Short: "Create a new periodic vesting account funded with an allocation of tokens.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a new periodic vesting account funded with an allocation of
+tokens. The vesting schedule must be supplied via a JSON file, where the start
+time is a UNIX epoch timestamp and the length of each period is in seconds. The
+account is funded with the sum of the coins of all the periods.
+
+Example:
+$ %s tx %s create-periodic-vesting-account <to_address> <path/to/periods.json> --from=<key_or_address>
+
+Where periods.json contains:
+
+{
+  "start_time": 1625204910,
+  "periods": [
+    {"coins": "10stake", "length_seconds": 2592000},
+    {"coins": "10stake", "length_seconds": 2592000}
+  ]
+}
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			vestingData, err := ParseVestingDataJSON(args[1])
+			if err != nil {
+				return err
+			}
+
+			periods, err := vestingData.ToPeriods()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, vestingData.StartTime, periods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgCreateClawbackVestingAccountCmd returns a CLI command handler for
+// creating a MsgCreateClawbackVestingAccount transaction.
+func NewMsgCreateClawbackVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-account [to_address]",
+		Short: "Create a new vesting account whose unvested tokens can be clawed back by the sender.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a new clawback vesting account funded with an allocation of
+tokens. The lockup and vesting schedules are supplied via JSON files with the
+'--%s' and '--%s' flags, in the same format as for the periodic vesting
+accounts. At least one of them must be given, and both must have the same start
+time and total amount when both are given. The tokens are only spendable once
+they are both unlocked and vested. The sender of the transaction can claw back
+the unvested tokens at any time.
+
+Example:
+$ %s tx %s create-clawback-vesting-account <to_address> --lockup=<path/to/lockup.json> --vesting=<path/to/vesting.json> --from=<key_or_address>
+`,
+				FlagLockup, FlagVesting, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			lockupFile, _ := cmd.Flags().GetString(FlagLockup)
+			vestingFile, _ := cmd.Flags().GetString(FlagVesting)
+			if lockupFile == "" && vestingFile == "" {
+				return fmt.Errorf("at least one of --%s or --%s must be given", FlagLockup, FlagVesting)
+			}
+
+			var (
+				startTime                     int64
+				lockupPeriods, vestingPeriods types.Periods
+			)
+
+			if lockupFile != "" {
+				lockupData, err := ParseVestingDataJSON(lockupFile)
+				if err != nil {
+					return err
+				}
+
+				if lockupPeriods, err = lockupData.ToPeriods(); err != nil {
+					return err
+				}
+
+				startTime = lockupData.StartTime
+			}
+
+			if vestingFile != "" {
+				vestingData, err := ParseVestingDataJSON(vestingFile)
+				if err != nil {
+					return err
+				}
+
+				if vestingPeriods, err = vestingData.ToPeriods(); err != nil {
+					return err
+				}
+
+				if lockupFile != "" && vestingData.StartTime != startTime {
+					return fmt.Errorf("lockup start time %d and vesting start time %d must be equal", startTime, vestingData.StartTime)
+				}
+
+				startTime = vestingData.StartTime
+			}
+
+			msg := types.NewMsgCreateClawbackVestingAccount(clientCtx.GetFromAddress(), toAddr, startTime, lockupPeriods, vestingPeriods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagLockup, "", "Path to the JSON file of the lockup schedule")
+	cmd.Flags().String(FlagVesting, "", "Path to the JSON file of the vesting schedule")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgClawbackCmd returns a CLI command handler for creating a MsgClawback
+// transaction.
+func NewMsgClawbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [address]",
+		Short: "Transfer the unvested tokens of a clawback vesting account back to its funder.",
+		Long: `Transfer the unvested tokens of a clawback vesting account back to its
+funder, or to the address given with the '--dest' flag. The transaction must be
+sent by the funder of the account. Unvested tokens which are staked are clawed
+back by transferring the delegations themselves.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var dest sdk.AccAddress
+			if destArg, _ := cmd.Flags().GetString(FlagDest); destArg != "" {
+				if dest, err = sdk.AccAddressFromBech32(destArg); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), addr, dest)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDest, "", "Address receiving the clawed back tokens, defaults to the funder")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// VestingData defines a vesting or lockup schedule as read from a JSON file.
+type VestingData struct {
+	StartTime int64         `json:"start_time"`
+	Periods   []InputPeriod `json:"periods"`
+}
+
+// InputPeriod defines a single vesting period as read from a JSON file.
+type InputPeriod struct {
+	Coins  string `json:"coins"`
+	Length int64  `json:"length_seconds"`
+}
+
+// ParseVestingDataJSON reads and parses a vesting schedule from a JSON file.
+func ParseVestingDataJSON(path string) (VestingData, error) {
+	var data VestingData
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return data, err
+	}
+
+	if err := json.Unmarshal(contents, &data); err != nil {
+		return data, err
+	}
+
+	return data, nil
+}
+
+// ToPeriods converts the periods read from a JSON file into vesting periods.
+func (data VestingData) ToPeriods() (types.Periods, error) {
+	periods := make(types.Periods, 0, len(data.Periods))
+	for i, p := range data.Periods {
+		amount, err := sdk.ParseCoins(p.Coins)
+		if err != nil {
+			return nil, fmt.Errorf("invalid coins of period %d: %w", i, err)
+		}
+
+		periods = append(periods, types.Period{Length: p.Length, Amount: amount})
+	}
+
+	return periods, nil
+}
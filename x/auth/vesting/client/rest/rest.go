@@ -0,0 +1,14 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// RegisterHandlers registers the vesting REST transaction handlers to a
+// router.
+func RegisterHandlers(clientCtx client.Context, r *mux.Router) {
+	r.HandleFunc("/vesting/accounts/{address}", NewCreateVestingAccountRequestHandlerFn(clientCtx)).Methods("POST")
+	r.HandleFunc("/vesting/periodic_accounts/{address}", NewCreatePeriodicVestingAccountRequestHandlerFn(clientCtx)).Methods("POST")
+}
@@ -0,0 +1,96 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// CreateVestingAccountReq defines the properties of a create vesting account
+// request's body.
+type CreateVestingAccountReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Amount  sdk.Coins    `json:"amount" yaml:"amount"`
+	EndTime int64        `json:"end_time" yaml:"end_time"`
+	Delayed bool         `json:"delayed" yaml:"delayed"`
+}
+
+// CreatePeriodicVestingAccountReq defines the properties of a create periodic
+// vesting account request's body.
+type CreatePeriodicVestingAccountReq struct {
+	BaseReq        rest.BaseReq  `json:"base_req" yaml:"base_req"`
+	StartTime      int64         `json:"start_time" yaml:"start_time"`
+	VestingPeriods types.Periods `json:"vesting_periods" yaml:"vesting_periods"`
+}
+
+// NewCreateVestingAccountRequestHandlerFn returns an HTTP REST handler for
+// creating a MsgCreateVestingAccount transaction.
+func NewCreateVestingAccountRequestHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		toAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		var req CreateVestingAccountReq
+		if !rest.ReadRESTReq(w, r, clientCtx.JSONMarshaler, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		msg := types.NewMsgCreateVestingAccount(fromAddr, toAddr, req.Amount, req.EndTime, req.Delayed)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// NewCreatePeriodicVestingAccountRequestHandlerFn returns an HTTP REST handler
+// for creating a MsgCreatePeriodicVestingAccount transaction.
+func NewCreatePeriodicVestingAccountRequestHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		toAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		var req CreatePeriodicVestingAccountReq
+		if !rest.ReadRESTReq(w, r, clientCtx.JSONMarshaler, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		msg := types.NewMsgCreatePeriodicVestingAccount(fromAddr, toAddr, req.StartTime, req.VestingPeriods)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
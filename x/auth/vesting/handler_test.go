@@ -0,0 +1,364 @@
+package vesting_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+
+	handler sdk.Handler
+	app     *simapp.SimApp
+	ctx     sdk.Context
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(checkTx, abci.Header{Height: 1})
+	suite.handler = vesting.NewHandler(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+}
+
+func (suite *HandlerTestSuite) TestInvalidMsg() {
+	res, err := suite.handler(suite.ctx, testdata.NewTestMsg())
+	suite.Require().Error(err)
+	suite.Require().Nil(res)
+}
+
+func (suite *HandlerTestSuite) TestMsgCreateVestingAccount() {
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().UTC())
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("test", 1000))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+
+	acc1 := suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(ctx, acc1)
+	suite.Require().NoError(suite.app.BankKeeper.SetBalances(ctx, addr1, balances))
+
+	blockedAddr := suite.app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgCreateVestingAccount
+		expectErr bool
+	}{
+		{
+			name:      "create continuous vesting account",
+			msg:       types.NewMsgCreateVestingAccount(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("test", 100)), ctx.BlockTime().Unix()+10000, false),
+			expectErr: false,
+		},
+		{
+			name:      "create delayed vesting account",
+			msg:       types.NewMsgCreateVestingAccount(addr1, addr3, sdk.NewCoins(sdk.NewInt64Coin("test", 100)), ctx.BlockTime().Unix()+10000, true),
+			expectErr: false,
+		},
+		{
+			name:      "account already exists",
+			msg:       types.NewMsgCreateVestingAccount(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("test", 100)), ctx.BlockTime().Unix()+10000, false),
+			expectErr: true,
+		},
+		{
+			name:      "blocked recipient",
+			msg:       types.NewMsgCreateVestingAccount(addr1, blockedAddr, sdk.NewCoins(sdk.NewInt64Coin("test", 100)), ctx.BlockTime().Unix()+10000, false),
+			expectErr: true,
+		},
+		{
+			name:      "insufficient funds",
+			msg:       types.NewMsgCreateVestingAccount(addr1, sdk.AccAddress([]byte("addr4_______________")), sdk.NewCoins(sdk.NewInt64Coin("test", 10000)), ctx.BlockTime().Unix()+10000, false),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			cacheCtx, write := ctx.CacheContext()
+
+			res, err := suite.handler(cacheCtx, tc.msg)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().NotNil(res)
+			write()
+
+			toAddr := tc.msg.ToAddress
+			accI := suite.app.AccountKeeper.GetAccount(ctx, toAddr)
+			suite.Require().NotNil(accI)
+
+			if tc.msg.Delayed {
+				acc, ok := accI.(*types.DelayedVestingAccount)
+				suite.Require().True(ok)
+				suite.Require().Equal(tc.msg.Amount, acc.GetVestingCoins(ctx.BlockTime()))
+			} else {
+				acc, ok := accI.(*types.ContinuousVestingAccount)
+				suite.Require().True(ok)
+				suite.Require().Equal(ctx.BlockTime().Unix(), acc.StartTime)
+				suite.Require().Equal(tc.msg.Amount, acc.GetVestingCoins(ctx.BlockTime()))
+			}
+
+			suite.Require().Equal(tc.msg.Amount, suite.app.BankKeeper.GetAllBalances(ctx, toAddr))
+			suite.Require().True(suite.app.BankKeeper.SpendableCoins(ctx, toAddr).IsZero())
+		})
+	}
+
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 800)), suite.app.BankKeeper.GetAllBalances(ctx, addr1))
+}
+
+func (suite *HandlerTestSuite) TestMsgCreateVestingAccountRedirected() {
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().UTC())
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("test", 1000))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+	suite.fundAccount(ctx, addr1, balances)
+
+	// redirect the transfers to addr2 towards addr3
+	suite.app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if toAddr.Equals(addr2) {
+			return addr3, nil
+		}
+		return toAddr, nil
+	})
+	defer suite.app.BankKeeper.ClearSendRestriction()
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("test", 100))
+	msg := types.NewMsgCreateVestingAccount(addr1, addr2, amount, ctx.BlockTime().Unix()+10000, false)
+
+	cacheCtx, _ := ctx.CacheContext()
+	res, err := suite.handler(cacheCtx, msg)
+	suite.Require().True(errors.Is(err, banktypes.ErrSendRedirected))
+	suite.Require().Nil(res)
+
+	// the account would otherwise record as vesting the coins sent to addr3
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(cacheCtx, addr3).IsZero())
+	suite.Require().Equal(balances, suite.app.BankKeeper.GetAllBalances(cacheCtx, addr1))
+
+	msg = types.NewMsgCreateVestingAccount(addr1, addr3, amount, ctx.BlockTime().Unix()+10000, false)
+	_, err = suite.handler(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(amount, suite.app.BankKeeper.GetAllBalances(ctx, addr3))
+}
+
+func (suite *HandlerTestSuite) TestMsgCreatePeriodicVestingAccount() {
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().UTC())
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("test", 1000))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+
+	acc1 := suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(ctx, acc1)
+	suite.Require().NoError(suite.app.BankKeeper.SetBalances(ctx, addr1, balances))
+
+	startTime := ctx.BlockTime().Unix()
+	periods := types.Periods{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 50))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 150))},
+	}
+
+	msg := types.NewMsgCreatePeriodicVestingAccount(addr1, addr2, startTime, periods)
+	res, err := suite.handler(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	acc, ok := suite.app.AccountKeeper.GetAccount(ctx, addr2).(*types.PeriodicVestingAccount)
+	suite.Require().True(ok)
+
+	total := sdk.NewCoins(sdk.NewInt64Coin("test", 200))
+	suite.Require().Equal(total, acc.OriginalVesting)
+	suite.Require().Equal(startTime+200, acc.EndTime)
+	suite.Require().Equal([]types.Period(periods), acc.VestingPeriods)
+	suite.Require().Equal(total, suite.app.BankKeeper.GetAllBalances(ctx, addr2))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 800)), suite.app.BankKeeper.GetAllBalances(ctx, addr1))
+
+	// the first period is unlocked once its length has elapsed
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(150 * time.Second))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 50)), suite.app.BankKeeper.SpendableCoins(later, addr2))
+
+	// the recipient now exists
+	_, err = suite.handler(ctx, msg)
+	suite.Require().Error(err)
+}
+
+func (suite *HandlerTestSuite) TestMsgCreateClawbackVestingAccount() {
+	ctx := suite.ctx.WithBlockTime(time.Unix(1000, 0).UTC())
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+	suite.fundAccount(ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("test", 1000)))
+
+	startTime := ctx.BlockTime().Unix()
+	lockupPeriods := types.Periods{{Length: 50, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 300))}}
+	vestingPeriods := types.Periods{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 100))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 200))},
+	}
+
+	msg := types.NewMsgCreateClawbackVestingAccount(addr1, addr2, startTime, lockupPeriods, vestingPeriods)
+	_, err := suite.handler(ctx, msg)
+	suite.Require().NoError(err)
+
+	acc, ok := suite.app.AccountKeeper.GetAccount(ctx, addr2).(*types.ClawbackVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(addr1, acc.FunderAddress)
+	suite.Require().Equal(startTime+200, acc.EndTime)
+	suite.Require().NoError(acc.Validate())
+
+	// the coins are only spendable once both unlocked and vested
+	for _, tc := range []struct {
+		elapsed   int64
+		spendable int64
+	}{{0, 0}, {60, 0}, {100, 100}, {199, 100}, {200, 300}} {
+		later := ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(tc.elapsed) * time.Second))
+		suite.Require().Equal(sdk.NewInt(tc.spendable), suite.app.BankKeeper.SpendableCoins(later, addr2).AmountOf("test"), tc.elapsed)
+	}
+
+	// an empty vesting schedule vests the coins at the start time
+	msg = types.NewMsgCreateClawbackVestingAccount(addr1, addr3, startTime, lockupPeriods, nil)
+	_, err = suite.handler(ctx, msg)
+	suite.Require().NoError(err)
+
+	acc, ok = suite.app.AccountKeeper.GetAccount(ctx, addr3).(*types.ClawbackVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 300)), acc.OriginalVesting)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 300)), acc.GetVestedOnly(ctx.BlockTime()))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 400)), suite.app.BankKeeper.GetAllBalances(ctx, addr1))
+}
+
+func (suite *HandlerTestSuite) TestMsgClawback() {
+	ctx := suite.ctx.WithBlockTime(time.Unix(1000, 0).UTC())
+
+	funder := sdk.AccAddress([]byte("funder______________"))
+	addr := sdk.AccAddress([]byte("addr________________"))
+	dest := sdk.AccAddress([]byte("dest________________"))
+	suite.fundAccount(ctx, funder, sdk.NewCoins(sdk.NewInt64Coin("test", 1000)))
+
+	startTime := ctx.BlockTime().Unix()
+	lockupPeriods := types.Periods{{Length: 250, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 300))}}
+	vestingPeriods := types.Periods{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 100))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 100))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 100))},
+	}
+
+	_, err := suite.handler(ctx, types.NewMsgCreateClawbackVestingAccount(funder, addr, startTime, lockupPeriods, vestingPeriods))
+	suite.Require().NoError(err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(150 * time.Second))
+
+	// only the funder can claw back
+	_, err = suite.handler(ctx, types.NewMsgClawback(dest, addr, nil))
+	suite.Require().Error(err)
+
+	// only a clawback vesting account can be clawed back
+	_, err = suite.handler(ctx, types.NewMsgClawback(funder, funder, nil))
+	suite.Require().Error(err)
+
+	_, err = suite.handler(ctx, types.NewMsgClawback(funder, addr, dest))
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 200)), suite.app.BankKeeper.GetAllBalances(ctx, dest))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 100)), suite.app.BankKeeper.GetAllBalances(ctx, addr))
+
+	// the vested coins remain locked up
+	acc, ok := suite.app.AccountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().NoError(acc.Validate())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 100)), acc.OriginalVesting)
+	suite.Require().Equal(startTime+250, acc.EndTime)
+	suite.Require().True(suite.app.BankKeeper.SpendableCoins(ctx, addr).IsZero())
+
+	later := ctx.WithBlockTime(time.Unix(startTime+250, 0))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 100)), suite.app.BankKeeper.SpendableCoins(later, addr))
+
+	// nothing is left to claw back
+	_, err = suite.handler(later, types.NewMsgClawback(funder, addr, nil))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 100)), suite.app.BankKeeper.GetAllBalances(ctx, addr))
+}
+
+func (suite *HandlerTestSuite) TestMsgClawbackDelegated() {
+	ctx := suite.ctx.WithBlockTime(time.Unix(1000, 0).UTC())
+	bondDenom := suite.app.StakingKeeper.BondDenom(ctx)
+	stakingHandler := staking.NewHandler(suite.app.StakingKeeper)
+
+	// create a validator
+	pks := simapp.CreateTestPubKeys(1)
+	simapp.AddTestAddrsFromPubKeys(suite.app, ctx, pks, sdk.TokensFromConsensusPower(200))
+	valAddr := sdk.ValAddress(pks[0].Address())
+
+	_, err := stakingHandler(ctx, stakingtypes.NewMsgCreateValidator(
+		valAddr, pks[0], sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(100)), stakingtypes.Description{},
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	))
+	suite.Require().NoError(err)
+
+	funder := sdk.AccAddress([]byte("funder______________"))
+	addr := sdk.AccAddress([]byte("addr________________"))
+	suite.fundAccount(ctx, funder, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000)))
+
+	startTime := ctx.BlockTime().Unix()
+	vestingPeriods := types.Periods{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))},
+	}
+
+	_, err = suite.handler(ctx, types.NewMsgCreateClawbackVestingAccount(funder, addr, startTime, nil, vestingPeriods))
+	suite.Require().NoError(err)
+
+	// stake most of the unvested coins
+	_, err = stakingHandler(ctx, stakingtypes.NewMsgDelegate(addr, valAddr, sdk.NewInt64Coin(bondDenom, 150)))
+	suite.Require().NoError(err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(150 * time.Second))
+
+	_, err = suite.handler(ctx, types.NewMsgClawback(funder, addr, nil))
+	suite.Require().NoError(err)
+
+	// the balance is clawed back first, then the delegation
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(ctx, addr).IsZero())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 850)), suite.app.BankKeeper.GetAllBalances(ctx, funder))
+	suite.Require().Equal(sdk.NewInt(100), suite.app.StakingKeeper.GetDelegatorBonded(ctx, addr))
+	suite.Require().Equal(sdk.NewInt(50), suite.app.StakingKeeper.GetDelegatorBonded(ctx, funder))
+
+	// the remaining delegation is made of vested coins
+	acc, ok := suite.app.AccountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().NoError(acc.Validate())
+	suite.Require().True(acc.DelegatedVesting.IsZero())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100)), acc.DelegatedFree)
+}
+
+func (suite *HandlerTestSuite) fundAccount(ctx sdk.Context, addr sdk.AccAddress, balances sdk.Coins) {
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	suite.app.AccountKeeper.SetAccount(ctx, acc)
+	suite.Require().NoError(suite.app.BankKeeper.SetBalances(ctx, addr, balances))
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
@@ -0,0 +1,265 @@
+package vesting
+
+import (
+	"context"
+	"math"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+type msgServer struct {
+	keeper.AccountKeeper
+	types.BankKeeper
+	types.StakingKeeper
+}
+
+// NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
+// wrapping the corresponding AccountKeeper, BankKeeper and StakingKeeper.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, StakingKeeper: sk}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// CreateVestingAccount implements the Msg/CreateVestingAccount method
+func (s msgServer) CreateVestingAccount(goCtx context.Context, msg *types.MsgCreateVestingAccount) (*types.MsgCreateVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	baseAccount, err := s.newBaseAccount(ctx, msg.ToAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	baseVestingAccount := types.NewBaseVestingAccount(baseAccount, msg.Amount.Sort(), msg.EndTime)
+
+	var acc authtypes.AccountI
+	if msg.Delayed {
+		acc = types.NewDelayedVestingAccountRaw(baseVestingAccount)
+	} else {
+		acc = types.NewContinuousVestingAccountRaw(baseVestingAccount, ctx.BlockTime().Unix())
+	}
+
+	if err := s.fundAccount(ctx, acc, msg.FromAddress, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateVestingAccountResponse{}, nil
+}
+
+// CreatePeriodicVestingAccount implements the Msg/CreatePeriodicVestingAccount
+// method
+func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *types.MsgCreatePeriodicVestingAccount) (*types.MsgCreatePeriodicVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var totalCoins sdk.Coins
+	for _, period := range msg.VestingPeriods {
+		totalCoins = totalCoins.Add(period.Amount...)
+	}
+
+	baseAccount, err := s.newBaseAccount(ctx, msg.ToAddress, totalCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	acc := types.NewPeriodicVestingAccount(baseAccount, totalCoins.Sort(), msg.StartTime, msg.VestingPeriods)
+
+	if err := s.fundAccount(ctx, acc, msg.FromAddress, totalCoins); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
+}
+
+// CreateClawbackVestingAccount implements the Msg/CreateClawbackVestingAccount
+// method
+func (s msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// both schedules distribute the same coins, as checked by ValidateBasic
+	totalCoins := types.Periods(msg.VestingPeriods).TotalAmount()
+	if len(msg.VestingPeriods) == 0 {
+		totalCoins = types.Periods(msg.LockupPeriods).TotalAmount()
+	}
+
+	baseAccount, err := s.newBaseAccount(ctx, msg.ToAddress, totalCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	acc := types.NewClawbackVestingAccount(baseAccount, msg.FromAddress, totalCoins, msg.StartTime, msg.LockupPeriods, msg.VestingPeriods)
+
+	if err := s.fundAccount(ctx, acc, msg.FromAddress, totalCoins); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+// Clawback implements the Msg/Clawback method
+func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	acc := s.GetAccount(ctx, msg.Address)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", msg.Address)
+	}
+
+	va, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", msg.Address)
+	}
+
+	if !va.FunderAddress.Equals(msg.FunderAddress) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "clawback can only be requested by the funder %s", va.FunderAddress)
+	}
+
+	dest := msg.DestAddress
+	if len(dest) == 0 {
+		dest = msg.FunderAddress
+	}
+
+	if s.BlockedAddr(dest) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", dest)
+	}
+
+	if err := s.clawback(ctx, va, dest); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgClawbackResponse{}, nil
+}
+
+// clawback removes the coins of the account which are not vested yet and
+// transfers them to dest. The coins are taken from the balance of the account
+// first, then from its unbonding delegations and finally from its delegations,
+// which are transferred to dest as they are.
+func (s msgServer) clawback(ctx sdk.Context, va *types.ClawbackVestingAccount, dest sdk.AccAddress) error {
+	blockTime := ctx.BlockTime()
+	addr := va.GetAddress()
+
+	updatedAcc, toClawBack := va.ComputeClawback(blockTime.Unix())
+	if toClawBack.IsZero() {
+		return nil
+	}
+
+	bondDenom := s.BondDenom(ctx)
+	bonded := sdk.NewCoins(sdk.NewCoin(bondDenom, s.GetDelegatorBonded(ctx, addr)))
+	unbonding := sdk.NewCoins(sdk.NewCoin(bondDenom, s.GetDelegatorUnbonding(ctx, addr)))
+	unbonded := s.GetAllBalances(ctx, addr)
+
+	encumbered := updatedAcc.GetVestingCoins(blockTime)
+	toClawBack = updatedAcc.UpdateDelegation(encumbered, toClawBack, bonded, unbonding, unbonded)
+
+	// the account must be updated first so that the clawed back coins are no
+	// longer locked when sending them
+	s.SetAccount(ctx, updatedAcc)
+
+	toXfer := toClawBack.Min(s.SpendableCoins(ctx, addr))
+	if err := s.SendCoins(ctx, addr, dest, toXfer); err != nil {
+		return err
+	}
+
+	// the rest of the unvested coins can only be staked
+	want := toClawBack.Sub(toXfer).AmountOf(bondDenom)
+
+	for _, ubd := range s.GetUnbondingDelegations(ctx, addr, math.MaxUint16) {
+		if !want.IsPositive() {
+			break
+		}
+
+		want = want.Sub(s.TransferUnbonding(ctx, addr, dest, ubd.ValidatorAddress, want))
+	}
+
+	for _, delegation := range s.GetDelegatorDelegations(ctx, addr, math.MaxUint16) {
+		if !want.IsPositive() {
+			break
+		}
+
+		validator, found := s.GetValidator(ctx, delegation.ValidatorAddress)
+		if !found {
+			continue
+		}
+
+		wantShares, err := validator.SharesFromTokensTruncated(want)
+		if err != nil {
+			continue
+		}
+
+		transferred := s.TransferDelegation(ctx, addr, dest, delegation.ValidatorAddress, wantShares)
+
+		// round the transferred tokens up to never claw back more than needed
+		want = want.Sub(validator.TokensFromSharesRoundUp(transferred).Ceil().TruncateInt())
+	}
+
+	// any amount still wanted at this point was lost to slashing
+	return nil
+}
+
+// newBaseAccount checks that a vesting account holding the given amount can
+// be created at the address and returns the base account to wrap.
+func (s msgServer) newBaseAccount(ctx sdk.Context, addr sdk.AccAddress, amount sdk.Coins) (*authtypes.BaseAccount, error) {
+	if err := s.SendEnabledCoins(ctx, amount...); err != nil {
+		return nil, err
+	}
+
+	if s.BlockedAddr(addr) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", addr)
+	}
+
+	if acc := s.GetAccount(ctx, addr); acc != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", addr)
+	}
+
+	baseAccount, ok := s.NewAccountWithAddress(ctx, addr).(*authtypes.BaseAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type; expected: BaseAccount, got: %T", baseAccount)
+	}
+
+	return baseAccount, nil
+}
+
+// fundAccount stores the new vesting account and funds it from the sender. The
+// coins may not be redirected by the send restrictions, as the account records
+// them as its original vesting.
+func (s msgServer) fundAccount(ctx sdk.Context, acc authtypes.AccountI, fromAddr sdk.AccAddress, amount sdk.Coins) error {
+	s.SetAccount(ctx, acc)
+
+	defer func() {
+		telemetry.IncrCounter(1, "new", "account")
+
+		for _, a := range amount {
+			telemetry.SetGaugeWithLabels(
+				[]string{"tx", "msg", "create_vesting_account"},
+				float32(a.Amount.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+			)
+		}
+	}()
+
+	if err := s.SendCoinsNoRedirect(ctx, fromAddr, acc.GetAddress(), amount); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return nil
+}
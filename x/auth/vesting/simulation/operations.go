@@ -0,0 +1,176 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateVestingAccount         = "op_weight_msg_create_vesting_account"
+	OpWeightMsgCreatePeriodicVestingAccount = "op_weight_msg_create_periodic_vesting_account"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONMarshaler, ak keeper.AccountKeeper, bk types.BankKeeper,
+) simulation.WeightedOperations {
+
+	var weightMsgCreateVestingAccount, weightMsgCreatePeriodicVestingAccount int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateVestingAccount, &weightMsgCreateVestingAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateVestingAccount = simappparams.DefaultWeightMsgCreateVestingAccount
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreatePeriodicVestingAccount, &weightMsgCreatePeriodicVestingAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreatePeriodicVestingAccount = simappparams.DefaultWeightMsgCreatePeriodicVestingAccount
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateVestingAccount,
+			SimulateMsgCreateVestingAccount(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreatePeriodicVestingAccount,
+			SimulateMsgCreatePeriodicVestingAccount(ak, bk),
+		),
+	}
+}
+
+// SimulateMsgCreateVestingAccount generates a MsgCreateVestingAccount funding
+// a new continuous or delayed vesting account with random fields.
+func SimulateMsgCreateVestingAccount(ak keeper.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, toAddr, coins, skip := randomVestingFields(r, ctx, accs, ak, bk)
+		if skip {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateVestingAccount, "no spendable coins"), nil, nil
+		}
+
+		endTime := ctx.BlockTime().Unix() + int64(simtypes.RandIntBetween(r, 1, 60*60*24*365))
+		msg := types.NewMsgCreateVestingAccount(simAccount.Address, toAddr, coins, endTime, r.Intn(2) == 0)
+
+		if err := sendMsg(r, app, ak, bk, msg, msg.Amount, ctx, chainID, []crypto.PrivKey{simAccount.PrivKey}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgCreatePeriodicVestingAccount generates a
+// MsgCreatePeriodicVestingAccount funding a new periodic vesting account with
+// random fields.
+func SimulateMsgCreatePeriodicVestingAccount(ak keeper.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, toAddr, coins, skip := randomVestingFields(r, ctx, accs, ak, bk)
+		if skip {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreatePeriodicVestingAccount, "no spendable coins"), nil, nil
+		}
+
+		// split the coins in up to 4 periods, the last period holding the
+		// remaining coins
+		var periods types.Periods
+		remaining := coins
+		for i := r.Intn(4); i > 0; i-- {
+			amount := simtypes.RandSubsetCoins(r, remaining)
+			if amount.Empty() || amount.IsEqual(remaining) {
+				break
+			}
+
+			periods = append(periods, types.Period{Length: int64(simtypes.RandIntBetween(r, 1, 60*60*24*30)), Amount: amount})
+			remaining = remaining.Sub(amount)
+		}
+		periods = append(periods, types.Period{Length: int64(simtypes.RandIntBetween(r, 1, 60*60*24*30)), Amount: remaining})
+
+		msg := types.NewMsgCreatePeriodicVestingAccount(simAccount.Address, toAddr, ctx.BlockTime().Unix(), periods)
+
+		if err := sendMsg(r, app, ak, bk, msg, coins, ctx, chainID, []crypto.PrivKey{simAccount.PrivKey}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// randomVestingFields returns a random funder, the address of a new account
+// and a random amount of the spendable coins of the funder.
+func randomVestingFields(
+	r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, ak keeper.AccountKeeper, bk types.BankKeeper,
+) (simtypes.Account, sdk.AccAddress, sdk.Coins, bool) {
+	simAccount, _ := simtypes.RandomAcc(r, accs)
+	toAddr := simtypes.RandomAccounts(r, 1)[0].Address
+
+	if ak.GetAccount(ctx, simAccount.Address) == nil || ak.GetAccount(ctx, toAddr) != nil {
+		return simAccount, toAddr, nil, true
+	}
+
+	coins := simtypes.RandSubsetCoins(r, bk.SpendableCoins(ctx, simAccount.Address))
+	if coins.Empty() || bk.SendEnabledCoins(ctx, coins...) != nil {
+		return simAccount, toAddr, nil, true
+	}
+
+	return simAccount, toAddr, coins, false
+}
+
+// sendMsg delivers a transaction with a vesting message signed by the funder.
+// nolint: interfacer
+func sendMsg(
+	r *rand.Rand, app *baseapp.BaseApp, ak keeper.AccountKeeper, bk types.BankKeeper,
+	msg sdk.Msg, amount sdk.Coins, ctx sdk.Context, chainID string, privkeys []crypto.PrivKey,
+) error {
+
+	var (
+		fees sdk.Coins
+		err  error
+	)
+
+	account := ak.GetAccount(ctx, msg.GetSigners()[0])
+	spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+	coins, hasNeg := spendable.SafeSub(amount)
+	if !hasNeg {
+		fees, err = simtypes.RandomFees(r, ctx, coins)
+		if err != nil {
+			return err
+		}
+	}
+
+	txGen := simappparams.MakeEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		privkeys...,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = app.Deliver(tx)
+	return err
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 )
@@ -15,6 +16,11 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&ContinuousVestingAccount{}, "cosmos-sdk/ContinuousVestingAccount", nil)
 	cdc.RegisterConcrete(&DelayedVestingAccount{}, "cosmos-sdk/DelayedVestingAccount", nil)
 	cdc.RegisterConcrete(&PeriodicVestingAccount{}, "cosmos-sdk/PeriodicVestingAccount", nil)
+	cdc.RegisterConcrete(&ClawbackVestingAccount{}, "cosmos-sdk/ClawbackVestingAccount", nil)
+	cdc.RegisterConcrete(&MsgCreateVestingAccount{}, "cosmos-sdk/MsgCreateVestingAccount", nil)
+	cdc.RegisterConcrete(&MsgCreatePeriodicVestingAccount{}, "cosmos-sdk/MsgCreatePeriodicVestingAccount", nil)
+	cdc.RegisterConcrete(&MsgCreateClawbackVestingAccount{}, "cosmos-sdk/MsgCreateClawbackVestingAccount", nil)
+	cdc.RegisterConcrete(&MsgClawback{}, "cosmos-sdk/MsgClawback", nil)
 }
 
 // RegisterInterface associates protoName with AccountI and VestingAccount
@@ -26,6 +32,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&ContinuousVestingAccount{},
 		&DelayedVestingAccount{},
 		&PeriodicVestingAccount{},
+		&ClawbackVestingAccount{},
 	)
 
 	registry.RegisterImplementations(
@@ -33,6 +40,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&DelayedVestingAccount{},
 		&ContinuousVestingAccount{},
 		&PeriodicVestingAccount{},
+		&ClawbackVestingAccount{},
 	)
 
 	registry.RegisterImplementations(
@@ -40,10 +48,29 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&DelayedVestingAccount{},
 		&ContinuousVestingAccount{},
 		&PeriodicVestingAccount{},
+		&ClawbackVestingAccount{},
+	)
+
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreateVestingAccount{},
+		&MsgCreatePeriodicVestingAccount{},
+		&MsgCreateClawbackVestingAccount{},
+		&MsgClawback{},
 	)
 }
 
-var amino = codec.New()
+var (
+	amino = codec.New()
+
+	// ModuleCdc references the global x/auth/vesting module codec. Note, the
+	// codec should ONLY be used in certain instances of tests and for JSON
+	// encoding as Amino is still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to
+	// x/auth/vesting and defined at the application level.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
 
 func init() {
 	RegisterCodec(amino)
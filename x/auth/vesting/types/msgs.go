@@ -0,0 +1,259 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// vesting message types
+const (
+	TypeMsgCreateVestingAccount         = "msg_create_vesting_account"
+	TypeMsgCreatePeriodicVestingAccount = "msg_create_periodic_vesting_account"
+	TypeMsgCreateClawbackVestingAccount = "msg_create_clawback_vesting_account"
+	TypeMsgClawback                     = "msg_clawback"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateVestingAccount{}
+	_ sdk.Msg = &MsgCreatePeriodicVestingAccount{}
+	_ sdk.Msg = &MsgCreateClawbackVestingAccount{}
+	_ sdk.Msg = &MsgClawback{}
+)
+
+// NewMsgCreateVestingAccount returns a reference to a new MsgCreateVestingAccount.
+func NewMsgCreateVestingAccount(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, endTime int64, delayed bool) *MsgCreateVestingAccount {
+	return &MsgCreateVestingAccount{
+		FromAddress: fromAddr,
+		ToAddress:   toAddr,
+		Amount:      amount,
+		EndTime:     endTime,
+		Delayed:     delayed,
+	}
+}
+
+// Route returns the message route for a MsgCreateVestingAccount.
+func (msg MsgCreateVestingAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreateVestingAccount.
+func (msg MsgCreateVestingAccount) Type() string { return TypeMsgCreateVestingAccount }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateVestingAccount) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.FromAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+
+	if err := sdk.VerifyAddressFormat(msg.ToAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	if !msg.Amount.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	if msg.EndTime <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid end time")
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreateVestingAccount.
+func (msg MsgCreateVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the expected signers for a MsgCreateVestingAccount.
+func (msg MsgCreateVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// NewMsgCreatePeriodicVestingAccount returns a reference to a new
+// MsgCreatePeriodicVestingAccount.
+func NewMsgCreatePeriodicVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64, periods Periods) *MsgCreatePeriodicVestingAccount {
+	return &MsgCreatePeriodicVestingAccount{
+		FromAddress:    fromAddr,
+		ToAddress:      toAddr,
+		StartTime:      startTime,
+		VestingPeriods: periods,
+	}
+}
+
+// Route returns the message route for a MsgCreatePeriodicVestingAccount.
+func (msg MsgCreatePeriodicVestingAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreatePeriodicVestingAccount.
+func (msg MsgCreatePeriodicVestingAccount) Type() string {
+	return TypeMsgCreatePeriodicVestingAccount
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreatePeriodicVestingAccount) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.FromAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+
+	if err := sdk.VerifyAddressFormat(msg.ToAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+
+	if msg.StartTime < 1 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid start time of %d, length must be greater than 0", msg.StartTime)
+	}
+
+	if len(msg.VestingPeriods) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no vesting periods")
+	}
+
+	return validatePeriods("vesting", msg.VestingPeriods)
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreatePeriodicVestingAccount.
+func (msg MsgCreatePeriodicVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the expected signers for a MsgCreatePeriodicVestingAccount.
+func (msg MsgCreatePeriodicVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// NewMsgCreateClawbackVestingAccount returns a reference to a new
+// MsgCreateClawbackVestingAccount.
+func NewMsgCreateClawbackVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64, lockupPeriods, vestingPeriods Periods) *MsgCreateClawbackVestingAccount {
+	return &MsgCreateClawbackVestingAccount{
+		FromAddress:    fromAddr,
+		ToAddress:      toAddr,
+		StartTime:      startTime,
+		LockupPeriods:  lockupPeriods,
+		VestingPeriods: vestingPeriods,
+	}
+}
+
+// Route returns the message route for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Type() string {
+	return TypeMsgCreateClawbackVestingAccount
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateClawbackVestingAccount) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.FromAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+
+	if err := sdk.VerifyAddressFormat(msg.ToAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+
+	if msg.StartTime < 1 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid start time of %d, length must be greater than 0", msg.StartTime)
+	}
+
+	if len(msg.LockupPeriods) == 0 && len(msg.VestingPeriods) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no lockup or vesting periods")
+	}
+
+	if err := validatePeriods("lockup", msg.LockupPeriods); err != nil {
+		return err
+	}
+
+	if err := validatePeriods("vesting", msg.VestingPeriods); err != nil {
+		return err
+	}
+
+	// both schedules must distribute the same coins
+	if len(msg.LockupPeriods) > 0 && len(msg.VestingPeriods) > 0 {
+		lockupCoins := Periods(msg.LockupPeriods).TotalAmount()
+		vestingCoins := Periods(msg.VestingPeriods).TotalAmount()
+
+		if !lockupCoins.IsEqual(vestingCoins) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "lockup (%s) and vesting (%s) amounts must be equal", lockupCoins, vestingCoins)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the expected signers for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// NewMsgClawback returns a reference to a new MsgClawback. The clawed back
+// coins are sent to the funder if dest is empty.
+func NewMsgClawback(funder, addr, dest sdk.AccAddress) *MsgClawback {
+	return &MsgClawback{
+		FunderAddress: funder,
+		Address:       addr,
+		DestAddress:   dest,
+	}
+}
+
+// Route returns the message route for a MsgClawback.
+func (msg MsgClawback) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgClawback.
+func (msg MsgClawback) Type() string { return TypeMsgClawback }
+
+// ValidateBasic Implements Msg.
+func (msg MsgClawback) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.FunderAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid funder address: %s", err)
+	}
+
+	if err := sdk.VerifyAddressFormat(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account address: %s", err)
+	}
+
+	if len(msg.DestAddress) > 0 {
+		if err := sdk.VerifyAddressFormat(msg.DestAddress); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid destination address: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgClawback.
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the expected signers for a MsgClawback.
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FunderAddress}
+}
+
+// validatePeriods checks that every period of a schedule has a positive length
+// and amount.
+func validatePeriods(schedule string, periods []Period) error {
+	for i, period := range periods {
+		if period.Length < 1 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid period length of %d in %s period %d, length must be greater than 0", period.Length, schedule, i)
+		}
+
+		if !period.Amount.IsValid() || !period.Amount.IsAllPositive() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, fmt.Sprintf("%s period %d: %s", schedule, i, period.Amount))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func TestMsgCreateVestingAccountValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	amount := sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 100))
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgCreateVestingAccount
+		expPass bool
+	}{
+		{"valid continuous", types.NewMsgCreateVestingAccount(addr1, addr2, amount, 100, false), true},
+		{"valid delayed", types.NewMsgCreateVestingAccount(addr1, addr2, amount, 100, true), true},
+		{"empty from address", types.NewMsgCreateVestingAccount(nil, addr2, amount, 100, false), false},
+		{"invalid to address", types.NewMsgCreateVestingAccount(addr1, addr2[:5], amount, 100, false), false},
+		{"no coins", types.NewMsgCreateVestingAccount(addr1, addr2, sdk.Coins{}, 100, false), false},
+		{"zero coins", types.NewMsgCreateVestingAccount(addr1, addr2, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 0)}, 100, false), false},
+		{"zero end time", types.NewMsgCreateVestingAccount(addr1, addr2, amount, 0, false), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgCreatePeriodicVestingAccountValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	amount := sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 100))
+	periods := types.Periods{{Length: 10, Amount: amount}, {Length: 20, Amount: amount}}
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgCreatePeriodicVestingAccount
+		expPass bool
+	}{
+		{"valid", types.NewMsgCreatePeriodicVestingAccount(addr1, addr2, 1, periods), true},
+		{"empty from address", types.NewMsgCreatePeriodicVestingAccount(nil, addr2, 1, periods), false},
+		{"empty to address", types.NewMsgCreatePeriodicVestingAccount(addr1, nil, 1, periods), false},
+		{"invalid start time", types.NewMsgCreatePeriodicVestingAccount(addr1, addr2, 0, periods), false},
+		{"no periods", types.NewMsgCreatePeriodicVestingAccount(addr1, addr2, 1, nil), false},
+		{"zero period length", types.NewMsgCreatePeriodicVestingAccount(addr1, addr2, 1, types.Periods{{Length: 0, Amount: amount}}), false},
+		{"empty period amount", types.NewMsgCreatePeriodicVestingAccount(addr1, addr2, 1, types.Periods{{Length: 10}}), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgCreateVestingAccountGetSigners(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	amount := sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 100))
+
+	msg := types.NewMsgCreateVestingAccount(addr1, addr2, amount, 100, false)
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+	require.Equal(t, types.RouterKey, msg.Route())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+
+	pmsg := types.NewMsgCreatePeriodicVestingAccount(addr1, addr2, 1, types.Periods{{Length: 10, Amount: amount}})
+	require.Equal(t, []sdk.AccAddress{addr1}, pmsg.GetSigners())
+	require.NotPanics(t, func() { pmsg.GetSignBytes() })
+}
+
+func TestMsgCreateClawbackVestingAccountValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	amount := sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 100))
+	periods := types.Periods{{Length: 10, Amount: amount}, {Length: 20, Amount: amount}}
+	otherPeriods := types.Periods{{Length: 30, Amount: amount.Add(amount...)}}
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgCreateClawbackVestingAccount
+		expPass bool
+	}{
+		{"valid", types.NewMsgCreateClawbackVestingAccount(addr1, addr2, 1, otherPeriods, periods), true},
+		{"no lockup", types.NewMsgCreateClawbackVestingAccount(addr1, addr2, 1, nil, periods), true},
+		{"no vesting", types.NewMsgCreateClawbackVestingAccount(addr1, addr2, 1, periods, nil), true},
+		{"no schedule", types.NewMsgCreateClawbackVestingAccount(addr1, addr2, 1, nil, nil), false},
+		{"empty from address", types.NewMsgCreateClawbackVestingAccount(nil, addr2, 1, nil, periods), false},
+		{"empty to address", types.NewMsgCreateClawbackVestingAccount(addr1, nil, 1, nil, periods), false},
+		{"invalid start time", types.NewMsgCreateClawbackVestingAccount(addr1, addr2, 0, nil, periods), false},
+		{"mismatched amounts", types.NewMsgCreateClawbackVestingAccount(addr1, addr2, 1, periods[:1], periods), false},
+		{"zero lockup length", types.NewMsgCreateClawbackVestingAccount(addr1, addr2, 1, types.Periods{{Length: 0, Amount: amount}}, nil), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgClawbackValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+
+	require.NoError(t, types.NewMsgClawback(addr1, addr2, nil).ValidateBasic())
+	require.NoError(t, types.NewMsgClawback(addr1, addr2, addr3).ValidateBasic())
+	require.Error(t, types.NewMsgClawback(nil, addr2, addr3).ValidateBasic())
+	require.Error(t, types.NewMsgClawback(addr1, nil, addr3).ValidateBasic())
+	require.Error(t, types.NewMsgClawback(addr1, addr2, addr3[:5]).ValidateBasic())
+
+	msg := types.NewMsgClawback(addr1, addr2, nil)
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+}
@@ -5,11 +5,33 @@ import (
 	"strings"
 
 	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Periods stores all vesting periods passed as part of a PeriodicVestingAccount
 type Periods []Period
 
+// TotalLength returns the sum of the lengths of all the periods.
+func (vp Periods) TotalLength() int64 {
+	var total int64
+	for _, period := range vp {
+		total += period.Length
+	}
+
+	return total
+}
+
+// TotalAmount returns the sum of the amounts of all the periods.
+func (vp Periods) TotalAmount() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, period := range vp {
+		total = total.Add(period.Amount...)
+	}
+
+	return total
+}
+
 // String Period implements stringer interface
 func (p Period) String() string {
 	out, _ := yaml.Marshal(p)
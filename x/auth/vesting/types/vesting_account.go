@@ -17,6 +17,7 @@ var (
 	_ vestexported.VestingAccount = (*ContinuousVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*PeriodicVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
 )
 
 //-----------------------------------------------------------------------------
@@ -172,8 +173,10 @@ type vestingAccountYAML struct {
 	EndTime          int64          `json:"end_time" yaml:"end_time"`
 
 	// custom fields based on concrete vesting type which can be omitted
-	StartTime      int64   `json:"start_time,omitempty" yaml:"start_time,omitempty"`
-	VestingPeriods Periods `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+	StartTime      int64          `json:"start_time,omitempty" yaml:"start_time,omitempty"`
+	VestingPeriods Periods        `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+	FunderAddress  sdk.AccAddress `json:"funder_address,omitempty" yaml:"funder_address,omitempty"`
+	LockupPeriods  Periods        `json:"lockup_periods,omitempty" yaml:"lockup_periods,omitempty"`
 }
 
 func (bva BaseVestingAccount) String() string {
@@ -555,3 +558,256 @@ func (dva DelayedVestingAccount) String() string {
 	out, _ := dva.MarshalYAML()
 	return out.(string)
 }
+
+//-----------------------------------------------------------------------------
+// Clawback Vesting Account
+
+var _ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+var _ authtypes.GenesisAccount = (*ClawbackVestingAccount)(nil)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount funded by the
+// given funder. An empty lockup or vesting schedule is replaced by a single
+// period releasing all the original vesting coins at the start time.
+func NewClawbackVestingAccount(baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, originalVesting sdk.Coins, startTime int64, lockupPeriods, vestingPeriods Periods) *ClawbackVestingAccount {
+	if len(lockupPeriods) == 0 {
+		lockupPeriods = Periods{{Length: 0, Amount: originalVesting}}
+	}
+
+	if len(vestingPeriods) == 0 {
+		vestingPeriods = Periods{{Length: 0, Amount: originalVesting}}
+	}
+
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+		EndTime:         startTime + max64(lockupPeriods.TotalLength(), vestingPeriods.TotalLength()),
+	}
+
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder,
+		StartTime:          startTime,
+		LockupPeriods:      lockupPeriods,
+		VestingPeriods:     vestingPeriods,
+	}
+}
+
+// GetVestedCoins returns the total number of coins which are both vested and
+// unlocked. If no coins are vested, nil is returned.
+func (va ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	vested := va.GetVestedOnly(blockTime).Min(va.GetUnlockedOnly(blockTime))
+	if vested.IsZero() {
+		return nil
+	}
+
+	return vested
+}
+
+// GetVestingCoins returns the total number of coins which are still locked or
+// not vested yet. If no coins are vesting, nil is returned.
+func (va ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return va.OriginalVesting.Sub(va.GetVestedCoins(blockTime))
+}
+
+// GetVestedOnly returns the coins released by the vesting schedule, regardless
+// of the lockup schedule.
+func (va ClawbackVestingAccount) GetVestedOnly(blockTime time.Time) sdk.Coins {
+	return scheduledCoins(va.StartTime, va.VestingPeriods, blockTime)
+}
+
+// GetUnlockedOnly returns the coins released by the lockup schedule,
+// regardless of the vesting schedule.
+func (va ClawbackVestingAccount) GetUnlockedOnly(blockTime time.Time) sdk.Coins {
+	return scheduledCoins(va.StartTime, va.LockupPeriods, blockTime)
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked).
+func (va ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return va.BaseVestingAccount.LockedCoinsFromVesting(va.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (va *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	va.BaseVestingAccount.TrackDelegation(balance, va.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting and lockup start for a clawback
+// vesting account.
+func (va ClawbackVestingAccount) GetStartTime() int64 {
+	return va.StartTime
+}
+
+// GetVestingPeriods returns the vesting periods of a clawback vesting account.
+func (va ClawbackVestingAccount) GetVestingPeriods() Periods {
+	return va.VestingPeriods
+}
+
+// GetLockupPeriods returns the lockup periods of a clawback vesting account.
+func (va ClawbackVestingAccount) GetLockupPeriods() Periods {
+	return va.LockupPeriods
+}
+
+// ComputeClawback returns the account as it stands once the coins which are
+// not vested at clawbackTime are removed, along with the amount of these
+// coins. The vesting schedule is truncated to the vested periods and the
+// lockup schedule is capped to the vested coins, keeping its earliest periods.
+// The delegation bookkeeping is left untouched, see UpdateDelegation.
+func (va ClawbackVestingAccount) ComputeClawback(clawbackTime int64) (*ClawbackVestingAccount, sdk.Coins) {
+	vestingPeriods := Periods{}
+	vested, unvested := sdk.NewCoins(), sdk.NewCoins()
+
+	vestTime := va.StartTime
+	for _, period := range va.VestingPeriods {
+		vestTime += period.Length
+		if vestTime <= clawbackTime {
+			vestingPeriods = append(vestingPeriods, period)
+			vested = vested.Add(period.Amount...)
+		} else {
+			unvested = unvested.Add(period.Amount...)
+		}
+	}
+
+	lockupPeriods := Periods{}
+	remaining := vested
+	for _, period := range va.LockupPeriods {
+		if remaining.IsZero() {
+			break
+		}
+
+		amount := period.Amount.Min(remaining)
+		lockupPeriods = append(lockupPeriods, Period{Length: period.Length, Amount: amount})
+		remaining = remaining.Sub(amount)
+	}
+
+	bva := *va.BaseVestingAccount
+	bva.OriginalVesting = vested
+	bva.EndTime = va.StartTime + max64(lockupPeriods.TotalLength(), vestingPeriods.TotalLength())
+
+	va.BaseVestingAccount = &bva
+	va.LockupPeriods = lockupPeriods
+	va.VestingPeriods = vestingPeriods
+
+	return &va, unvested
+}
+
+// UpdateDelegation updates the delegation bookkeeping of the account for the
+// clawback of toClawBack, given the coins the account currently has bonded,
+// unbonding and unbonded (i.e. its balance), and the coins which are still
+// encumbered by the lockup or vesting schedule once the clawback is applied.
+// The unbonded coins are clawed back first. It returns the amount to claw
+// back, which is capped to the funds of the account as some of them may have
+// been slashed.
+func (va *ClawbackVestingAccount) UpdateDelegation(encumbered, toClawBack, bonded, unbonding, unbonded sdk.Coins) sdk.Coins {
+	delegated := bonded.Add(unbonding...)
+	oldDelegated := va.DelegatedVesting.Add(va.DelegatedFree...)
+	slashed := oldDelegated.Sub(oldDelegated.Min(delegated))
+	total := delegated.Add(unbonded...)
+
+	toClawBack = toClawBack.Min(total)
+
+	// the slashed coins are kept in the bookkeeping, as they are for any
+	// vesting account
+	newDelegated := delegated.Min(total.Sub(toClawBack)).Add(slashed...)
+	va.DelegatedVesting = encumbered.Min(newDelegated)
+	va.DelegatedFree = newDelegated.Sub(va.DelegatedVesting)
+
+	return toClawBack
+}
+
+// Validate checks for errors on the account fields
+func (va ClawbackVestingAccount) Validate() error {
+	if va.GetStartTime() > va.GetEndTime() {
+		return errors.New("vesting start-time cannot be after end-time")
+	}
+
+	lockupEnd := va.StartTime + Periods(va.LockupPeriods).TotalLength()
+	vestingEnd := va.StartTime + Periods(va.VestingPeriods).TotalLength()
+	if max64(lockupEnd, vestingEnd) != va.EndTime {
+		return errors.New("vesting end time does not match length of all lockup and vesting periods")
+	}
+
+	for _, periods := range [][]Period{va.LockupPeriods, va.VestingPeriods} {
+		for _, p := range periods {
+			if p.Length < 0 {
+				return errors.New("period length cannot be negative")
+			}
+		}
+	}
+
+	if !Periods(va.LockupPeriods).TotalAmount().IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in lockup periods")
+	}
+
+	if !Periods(va.VestingPeriods).TotalAmount().IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
+	}
+
+	return va.BaseVestingAccount.Validate()
+}
+
+func (va ClawbackVestingAccount) String() string {
+	out, _ := va.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ClawbackVestingAccount.
+func (va ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	alias := vestingAccountYAML{
+		Address:          va.Address,
+		AccountNumber:    va.AccountNumber,
+		Sequence:         va.Sequence,
+		OriginalVesting:  va.OriginalVesting,
+		DelegatedFree:    va.DelegatedFree,
+		DelegatedVesting: va.DelegatedVesting,
+		EndTime:          va.EndTime,
+		StartTime:        va.StartTime,
+		VestingPeriods:   va.VestingPeriods,
+		FunderAddress:    va.FunderAddress,
+		LockupPeriods:    va.LockupPeriods,
+	}
+
+	pk := va.GetPubKey()
+	if pk != nil {
+		pks, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pk)
+		if err != nil {
+			return nil, err
+		}
+
+		alias.PubKey = pks
+	}
+
+	bz, err := yaml.Marshal(alias)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(bz), err
+}
+
+// scheduledCoins returns the sum of the amounts of the periods of a schedule
+// starting at startTime which are over at blockTime.
+func scheduledCoins(startTime int64, periods Periods, blockTime time.Time) sdk.Coins {
+	coins := sdk.NewCoins()
+
+	periodEnd := startTime
+	for _, period := range periods {
+		periodEnd += period.Length
+		if blockTime.Unix() < periodEnd {
+			break
+		}
+
+		coins = coins.Add(period.Amount...)
+	}
+
+	return coins
+}
+
+func max64(i, j int64) int64 {
+	if i > j {
+		return i
+	}
+
+	return j
+}
@@ -707,3 +707,152 @@ func TestDelayedVestingAccountMarshal(t *testing.T) {
 	_, err = app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
 	require.NotNil(t, err)
 }
+
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, funder := testdata.KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	require.Equal(t, now.Add(18*time.Hour).Unix(), va.EndTime)
+	require.NoError(t, va.Validate())
+
+	// require no coins vested in the very beginning of the vesting schedule
+	require.Nil(t, va.GetVestedCoins(now))
+	require.Equal(t, origCoins, va.GetVestingCoins(now))
+
+	// require the vested coins to stay locked up
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, va.GetVestedOnly(now.Add(6*time.Hour)))
+	require.Nil(t, va.GetVestedCoins(now.Add(6*time.Hour)))
+
+	// require the vested coins to be released at the end of the lockup
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, va.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, va.LockedCoins(now.Add(12*time.Hour)))
+
+	// require all coins vested at the end of the vesting schedule
+	require.Equal(t, origCoins, va.GetVestedCoins(now.Add(18*time.Hour)))
+	require.True(t, va.GetVestingCoins(now.Add(18*time.Hour)).IsZero())
+
+	// require empty schedules to release the coins at the start time
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), nil, nil)
+	require.Equal(t, now.Unix(), va.EndTime)
+	require.NoError(t, va.Validate())
+	require.Equal(t, origCoins, va.GetVestedCoins(now))
+}
+
+func TestComputeClawbackClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(1 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)}},
+		types.Period{Length: int64(1 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 40)}},
+		types.Period{Length: int64(10 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 40)}},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, funder := testdata.KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+
+	// nothing is vested at the start
+	updated, unvested := va.ComputeClawback(now.Unix())
+	require.Equal(t, origCoins, unvested)
+	require.True(t, updated.OriginalVesting.IsZero())
+	require.Empty(t, updated.VestingPeriods)
+	require.Empty(t, updated.LockupPeriods)
+	require.Equal(t, now.Unix(), updated.EndTime)
+	require.NoError(t, updated.Validate())
+
+	// the lockup is capped to the vested coins, keeping its earliest periods
+	updated, unvested = va.ComputeClawback(now.Add(12 * time.Hour).Unix())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, unvested)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 75)}, updated.OriginalVesting)
+	require.Equal(t, []types.Period(vestingPeriods[:2]), updated.VestingPeriods)
+	require.Equal(t, []types.Period{
+		{Length: int64(1 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)}},
+		{Length: int64(1 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 40)}},
+		{Length: int64(10 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 15)}},
+	}, updated.LockupPeriods)
+	require.Equal(t, now.Add(12*time.Hour).Unix(), updated.EndTime)
+	require.NoError(t, updated.Validate())
+
+	// the original account is left untouched
+	require.Equal(t, origCoins, va.OriginalVesting)
+	require.Equal(t, now.Add(18*time.Hour).Unix(), va.EndTime)
+
+	// nothing is left to claw back once everything is vested
+	_, unvested = va.ComputeClawback(now.Add(18 * time.Hour).Unix())
+	require.True(t, unvested.IsZero())
+}
+
+func TestUpdateDelegationClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+	coins := func(amt int64) sdk.Coins { return sdk.Coins{sdk.NewInt64Coin(stakeDenom, amt)} }
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, funder := testdata.KeyTestPubAddr()
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	va := types.NewClawbackVestingAccount(bacc, funder, coins(100), now.Unix(), nil, vestingPeriods)
+
+	// delegate 80 coins, which got slashed down to 70
+	va.TrackDelegation(now, coins(100), coins(80))
+	require.Equal(t, coins(80), va.DelegatedVesting)
+
+	updated, toClawBack := va.ComputeClawback(now.Add(6 * time.Hour).Unix())
+	require.Equal(t, coins(50), toClawBack)
+
+	encumbered := updated.GetVestingCoins(now.Add(6 * time.Hour))
+	toClawBack = updated.UpdateDelegation(encumbered, toClawBack, coins(60), coins(10), coins(20))
+	require.Equal(t, coins(50), toClawBack)
+
+	// 20 coins are clawed back from the balance and 30 from the delegation,
+	// and the slashed coins stay in the bookkeeping
+	require.True(t, updated.DelegatedVesting.IsZero())
+	require.Equal(t, coins(50), updated.DelegatedFree)
+
+	// the clawback is capped to the funds of the account
+	updated, toClawBack = va.ComputeClawback(now.Unix())
+	toClawBack = updated.UpdateDelegation(updated.GetVestingCoins(now), toClawBack, coins(60), coins(10), coins(20))
+	require.Equal(t, coins(90), toClawBack)
+	require.Equal(t, coins(10), updated.DelegatedFree)
+}
+
+func TestClawbackVestingAccountMarshal(t *testing.T) {
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubkey.Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 5))
+	baseAcc := authtypes.NewBaseAccount(addr, pubkey, 10, 50)
+	_, _, funder := testdata.KeyTestPubAddr()
+
+	acc := types.NewClawbackVestingAccount(baseAcc, funder, coins, time.Now().Unix(), types.Periods{types.Period{1800, coins}}, types.Periods{types.Period{3600, coins}})
+
+	bz, err := app.AccountKeeper.MarshalAccount(acc)
+	require.Nil(t, err)
+
+	acc2, err := app.AccountKeeper.UnmarshalAccount(bz)
+	require.Nil(t, err)
+	require.IsType(t, &types.ClawbackVestingAccount{}, acc2)
+	require.Equal(t, acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(t, err)
+}
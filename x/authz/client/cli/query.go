@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// GetQueryCmd returns the cli query commands for the authz module
+func GetQueryCmd() *cobra.Command {
+	authzQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the authz module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	authzQueryCmd.AddCommand(
+		GetCmdQueryGrants(),
+	)
+
+	return authzQueryCmd
+}
+
+// GetCmdQueryGrants implements the query grants command.
+func GetCmdQueryGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grants [granter-addr] [grantee-addr] [msg-type-url]?",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Query the grants given by a granter to a grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the grants given by a granter to a grantee, optionally
+restricted to the grant of a single Msg type.
+
+Examples:
+$ %s query %s grants cosmos1skj.. cosmos1skjwj..
+$ %s query %s grants cosmos1skj.. cosmos1skjwj.. /cosmos.bank.v1beta1.MsgSend
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			var msgTypeURL string
+			if len(args) == 3 {
+				msgTypeURL = args[2]
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Grants(
+				context.Background(),
+				&types.QueryGrantsRequest{
+					Granter:    granter,
+					Grantee:    grantee,
+					MsgTypeUrl: msgTypeURL,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "grants")
+
+	return cmd
+}
@@ -0,0 +1,287 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// Flags for the authz tx commands
+const (
+	FlagSpendLimit        = "spend-limit"
+	FlagMsgType           = "msg-type"
+	FlagExpiration        = "expiration"
+	FlagAllowedValidators = "allowed-validators"
+	FlagDenyValidators    = "deny-validators"
+)
+
+// Authorization types accepted by the grant command
+const (
+	authorizationSend       = "send"
+	authorizationGeneric    = "generic"
+	authorizationDelegate   = "delegate"
+	authorizationUndelegate = "unbond"
+	authorizationRedelegate = "redelegate"
+)
+
+// GetTxCmd returns the transaction commands for the authz module
+func GetTxCmd() *cobra.Command {
+	authzTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Authorization transactions subcommands",
+		Long:                       "Authorize and revoke access to execute transactions on behalf of your address",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	authzTxCmd.AddCommand(
+		NewCmdGrantAuthorization(),
+		NewCmdRevokeAuthorization(),
+		NewCmdExecAuthorization(),
+	)
+
+	return authzTxCmd
+}
+
+// NewCmdGrantAuthorization implements the grant command.
+func NewCmdGrantAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] [authorization-type] --from [granter]",
+		Short: "Grant authorization to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant authorization to an address to execute a transaction on your behalf.
+The authorization type is one of "%s", "%s", "%s", "%s" or "%s".
+
+Examples:
+$ %s tx %s grant cosmos1skjw.. send --spend-limit=1000stake --from=cosmos1skl..
+$ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1beta1.MsgVote --from=cosmos1sk..
+$ %s tx %s grant cosmos1skjw.. delegate --allowed-validators=cosmosvaloper1sk.. --from=cosmos1sk..
+`,
+				authorizationSend, authorizationGeneric, authorizationDelegate, authorizationUndelegate, authorizationRedelegate,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			authorization, err := parseAuthorization(cmd, args[1])
+			if err != nil {
+				return err
+			}
+
+			exp, err := cmd.Flags().GetInt64(FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, time.Unix(exp, 0))
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagSpendLimit, "", "Spend limit of a send authorization, or max tokens of a staking authorization")
+	cmd.Flags().String(FlagMsgType, "", "Msg type URL of a generic authorization")
+	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Validators a staking authorization is restricted to")
+	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Validators a staking authorization excludes")
+	cmd.Flags().Int64(FlagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "Expiration time of the grant as a UNIX timestamp, one year from now by default")
+
+	return cmd
+}
+
+func parseAuthorization(cmd *cobra.Command, authorizationType string) (exported.Authorization, error) {
+	limit, err := cmd.Flags().GetString(FlagSpendLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	switch authorizationType {
+	case authorizationSend:
+		spendLimit, err := sdk.ParseCoins(limit)
+		if err != nil {
+			return nil, err
+		}
+
+		if !spendLimit.IsAllPositive() {
+			return nil, fmt.Errorf("spend limit must be positive")
+		}
+
+		return types.NewSendAuthorization(spendLimit), nil
+
+	case authorizationGeneric:
+		msgType, err := cmd.Flags().GetString(FlagMsgType)
+		if err != nil {
+			return nil, err
+		}
+
+		return types.NewGenericAuthorization(msgType), nil
+
+	case authorizationDelegate, authorizationUndelegate, authorizationRedelegate:
+		allowed, err := parseValidators(cmd, FlagAllowedValidators)
+		if err != nil {
+			return nil, err
+		}
+
+		denied, err := parseValidators(cmd, FlagDenyValidators)
+		if err != nil {
+			return nil, err
+		}
+
+		var maxTokens *sdk.Coin
+		if limit != "" {
+			coin, err := sdk.ParseCoin(limit)
+			if err != nil {
+				return nil, err
+			}
+			maxTokens = &coin
+		}
+
+		authzType := types.AuthorizationTypeDelegate
+		switch authorizationType {
+		case authorizationUndelegate:
+			authzType = types.AuthorizationTypeUndelegate
+		case authorizationRedelegate:
+			authzType = types.AuthorizationTypeRedelegate
+		}
+
+		return types.NewStakeAuthorization(allowed, denied, authzType, maxTokens)
+
+	default:
+		return nil, fmt.Errorf("invalid authorization type %s", authorizationType)
+	}
+}
+
+func parseValidators(cmd *cobra.Command, flag string) ([]sdk.ValAddress, error) {
+	bechAddrs, err := cmd.Flags().GetStringSlice(flag)
+	if err != nil {
+		return nil, err
+	}
+
+	validators := make([]sdk.ValAddress, len(bechAddrs))
+	for i, bechAddr := range bechAddrs {
+		validators[i], err = sdk.ValAddressFromBech32(bechAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return validators, nil
+}
+
+// NewCmdRevokeAuthorization implements the revoke command.
+func NewCmdRevokeAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [grantee] [msg-type-url] --from [granter]",
+		Short: "Revoke authorization",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the authorization to execute a Msg type granted to an address.
+
+Example:
+$ %s tx %s revoke cosmos1skj.. /cosmos.bank.v1beta1.MsgSend --from=cosmos1skj..
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevoke(clientCtx.GetFromAddress(), grantee, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdExecAuthorization implements the exec command.
+func NewCmdExecAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [tx-json-file] --from [grantee]",
+		Short: "Execute transaction on behalf of granter account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Execute the messages of a transaction generated with --generate-only on
+behalf of their signers, using the authorizations they granted to the grantee.
+
+Example:
+$ %s tx bank send cosmos1skj.. cosmos1skjwj.. 1000stake --generate-only > tx.json
+$ %s tx %s exec tx.json --from=cosmos1skjwj..
+`,
+				version.AppName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgExec(clientCtx.GetFromAddress(), theTx.GetMsgs())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,39 @@
+package exported
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Authorization represents the permission a granter gives a grantee to execute
+// a single Msg type on its behalf. Implementations decide which instances of
+// that Msg they accept and may restrict themselves as they are used.
+type Authorization interface {
+	proto.Message
+
+	// MsgTypeURL returns the type URL of the Msg the authorization applies to,
+	// e.g. "/cosmos.bank.v1beta1.MsgSend".
+	MsgTypeURL() string
+
+	// Accept determines whether the grantee may execute msg on behalf of the
+	// granter. It returns an error if the msg is rejected.
+	Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error)
+
+	// ValidateBasic does a simple validation check that doesn't require access
+	// to any other information.
+	ValidateBasic() error
+}
+
+// AcceptResponse is the result of Authorization.Accept.
+type AcceptResponse struct {
+	// Accept is true if the msg may be executed.
+	Accept bool
+
+	// Delete is true if the authorization is exhausted and must be removed.
+	Delete bool
+
+	// Updated holds the authorization replacing the current one once the msg
+	// is executed, or nil if it is unchanged.
+	Updated Authorization
+}
@@ -0,0 +1,44 @@
+package authz
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// InitGenesis initializes the authz module's state from a provided genesis
+// state. Grants which expired before the genesis time are dropped.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs *types.GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
+	}
+
+	for _, entry := range gs.Authorization {
+		if !entry.Expiration.After(ctx.BlockTime()) {
+			continue
+		}
+
+		if err := k.Grant(ctx, entry.Grantee, entry.Granter, entry.GetAuthorization(), entry.Expiration); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the authz module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	var entries []types.GrantAuthorization
+
+	k.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant types.Grant) bool {
+		entries = append(entries, types.GrantAuthorization{
+			Granter:       granter,
+			Grantee:       grantee,
+			Authorization: grant.Authorization,
+			Expiration:    grant.Expiration,
+		})
+		return false
+	})
+
+	return types.NewGenesisState(entries)
+}
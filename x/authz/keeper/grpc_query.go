@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Grants implements the Query/Grants gRPC method
+func (k Keeper) Grants(c context.Context, req *types.QueryGrantsRequest) (*types.QueryGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Granter.Empty() {
+		return nil, status.Errorf(codes.InvalidArgument, "empty granter address")
+	}
+
+	if req.Grantee.Empty() {
+		return nil, status.Errorf(codes.InvalidArgument, "empty grantee address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.MsgTypeUrl != "" {
+		authorization, expiration := k.GetCleanAuthorization(ctx, req.Grantee, req.Granter, req.MsgTypeUrl)
+		if authorization == nil {
+			return nil, status.Errorf(codes.NotFound, "no authorization found for %s type", req.MsgTypeUrl)
+		}
+
+		grant, err := types.NewGrant(authorization, expiration)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+
+		return &types.QueryGrantsResponse{Grants: []*types.Grant{&grant}}, nil
+	}
+
+	var grants []*types.Grant
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GrantStoreKeyPrefix(req.Granter, req.Grantee))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		grant, err := k.unmarshalGrant(value)
+		if err != nil {
+			return err
+		}
+
+		grants = append(grants, &grant)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGrantsResponse{Grants: grants, Pagination: pageRes}, nil
+}
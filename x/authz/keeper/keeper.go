@@ -0,0 +1,216 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// Keeper defines the authz module's keeper. It stores the grants given by
+// granters and dispatches the messages executed by grantees on their behalf.
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryMarshaler
+	router   *baseapp.MsgServiceRouter
+}
+
+// NewKeeper constructs a new authz Keeper. Messages executed on behalf of a
+// granter are routed through router.
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, router *baseapp.MsgServiceRouter) Keeper {
+	return Keeper{
+		storeKey: storeKey,
+		cdc:      cdc,
+		router:   router,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// DispatchActions executes msgs on behalf of their signers. A msg signed by
+// the grantee itself is executed directly, any other msg must be accepted by
+// an authorization its signer granted to the grantee. The result data of each
+// msg is returned.
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
+	msgResults, err := k.router.DispatchMsgs(ctx, msgs, func(msg sdk.Msg) error {
+		signers := msg.GetSigners()
+		if len(signers) != 1 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "authorization can be given only to messages with a single signer, got %d", len(signers))
+		}
+
+		// a grantee may always execute its own messages
+		granter := signers[0]
+		if granter.Equals(grantee) {
+			return nil
+		}
+
+		return k.acceptMsg(ctx, granter, grantee, msg)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([][]byte, len(msgResults))
+	for i, msgResult := range msgResults {
+		results[i] = msgResult.Data
+	}
+
+	return results, nil
+}
+
+// acceptMsg checks that msg is accepted by the authorization granter gave to
+// grantee, and updates or deletes the authorization accordingly.
+func (k Keeper) acceptMsg(ctx sdk.Context, granter, grantee sdk.AccAddress, msg sdk.Msg) error {
+	msgTypeURL := sdk.MsgTypeURL(msg)
+
+	authorization, expiration := k.GetCleanAuthorization(ctx, grantee, granter, msgTypeURL)
+	if authorization == nil {
+		return sdkerrors.Wrapf(types.ErrNoAuthorizationFound, "%s to %s for %s", granter, grantee, msgTypeURL)
+	}
+
+	resp, err := authorization.Accept(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	if !resp.Accept {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s rejected by the authorization", msgTypeURL)
+	}
+
+	switch {
+	case resp.Delete:
+		return k.Revoke(ctx, grantee, granter, msgTypeURL)
+	case resp.Updated != nil:
+		return k.update(ctx, grantee, granter, resp.Updated, expiration)
+	}
+
+	return nil
+}
+
+// Grant stores authorization from granter to grantee until expiration,
+// overwriting any grant for the same Msg type.
+func (k Keeper) Grant(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization exported.Authorization, expiration time.Time) error {
+	if !expiration.After(ctx.BlockTime()) {
+		return types.ErrInvalidExpiration
+	}
+
+	grant, err := types.NewGrant(authorization, expiration)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.MarshalBinaryBare(&grant)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GrantKey(granter, grantee, authorization.MsgTypeURL()), bz)
+
+	return nil
+}
+
+// update replaces the authorization of an existing grant, keeping its
+// expiration.
+func (k Keeper) update(ctx sdk.Context, grantee, granter sdk.AccAddress, updated exported.Authorization, expiration time.Time) error {
+	grant, err := types.NewGrant(updated, expiration)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.MarshalBinaryBare(&grant)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GrantKey(granter, grantee, updated.MsgTypeURL()), bz)
+
+	return nil
+}
+
+// Revoke removes the authorization for msgTypeURL granted to grantee by
+// granter.
+func (k Keeper) Revoke(ctx sdk.Context, grantee, granter sdk.AccAddress, msgTypeURL string) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GrantKey(granter, grantee, msgTypeURL)
+
+	if !store.Has(key) {
+		return sdkerrors.Wrapf(types.ErrNoAuthorizationFound, "%s to %s for %s", granter, grantee, msgTypeURL)
+	}
+
+	store.Delete(key)
+
+	return nil
+}
+
+// GetCleanAuthorization returns the unexpired authorization for msgTypeURL
+// granted to grantee by granter together with its expiration. An expired
+// authorization is deleted and nil is returned.
+func (k Keeper) GetCleanAuthorization(ctx sdk.Context, grantee, granter sdk.AccAddress, msgTypeURL string) (exported.Authorization, time.Time) {
+	grant, found := k.getGrant(ctx, types.GrantKey(granter, grantee, msgTypeURL))
+	if !found {
+		return nil, time.Time{}
+	}
+
+	if !grant.Expiration.After(ctx.BlockTime()) {
+		ctx.KVStore(k.storeKey).Delete(types.GrantKey(granter, grantee, msgTypeURL))
+		return nil, time.Time{}
+	}
+
+	return grant.GetAuthorization(), grant.Expiration
+}
+
+// IterateGrants iterates over all the grants and calls cb with the granter,
+// the grantee and the grant. The iteration stops if cb returns true.
+func (k Keeper) IterateGrants(ctx sdk.Context, cb func(granter, grantee sdk.AccAddress, grant types.Grant) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GrantKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		granter, grantee, _ := types.ParseGrantKey(iterator.Key())
+
+		grant, err := k.unmarshalGrant(iterator.Value())
+		if err != nil {
+			panic(err)
+		}
+
+		if cb(granter, grantee, grant) {
+			break
+		}
+	}
+}
+
+func (k Keeper) getGrant(ctx sdk.Context, key []byte) (types.Grant, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return types.Grant{}, false
+	}
+
+	grant, err := k.unmarshalGrant(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	return grant, true
+}
+
+func (k Keeper) unmarshalGrant(bz []byte) (types.Grant, error) {
+	var grant types.Grant
+	if err := k.cdc.UnmarshalBinaryBare(bz, &grant); err != nil {
+		return types.Grant{}, err
+	}
+
+	return grant, nil
+}
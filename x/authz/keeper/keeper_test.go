@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.AuthzKeeper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func (suite *KeeperTestSuite) TestGrantAndRevoke() {
+	app, ctx := suite.app, suite.ctx
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+	sendMsgType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	authorization, _ := app.AuthzKeeper.GetCleanAuthorization(ctx, grantee, granter, sendMsgType)
+	suite.Require().Nil(authorization)
+
+	suite.T().Log("verify a grant cannot expire in the past")
+	sendAuthz := types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	err := app.AuthzKeeper.Grant(ctx, grantee, granter, sendAuthz, ctx.BlockTime().Add(-time.Hour))
+	suite.Require().Error(err)
+
+	suite.T().Log("verify a grant can be read until it expires")
+	expiration := ctx.BlockTime().Add(time.Hour)
+	suite.Require().NoError(app.AuthzKeeper.Grant(ctx, grantee, granter, sendAuthz, expiration))
+
+	authorization, exp := app.AuthzKeeper.GetCleanAuthorization(ctx, grantee, granter, sendMsgType)
+	suite.Require().Equal(sendAuthz, authorization)
+	suite.Require().True(expiration.Equal(exp))
+
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granter, grantee, sendMsgType)
+	suite.Require().Nil(authorization)
+
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx.WithBlockTime(expiration), grantee, granter, sendMsgType)
+	suite.Require().Nil(authorization)
+
+	suite.T().Log("verify an expired grant is deleted")
+	suite.Require().Error(app.AuthzKeeper.Revoke(ctx, grantee, granter, sendMsgType))
+
+	suite.T().Log("verify a grant can be revoked")
+	suite.Require().NoError(app.AuthzKeeper.Grant(ctx, grantee, granter, sendAuthz, expiration))
+	suite.Require().NoError(app.AuthzKeeper.Revoke(ctx, grantee, granter, sendMsgType))
+
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, grantee, granter, sendMsgType)
+	suite.Require().Nil(authorization)
+}
+
+func (suite *KeeperTestSuite) TestDispatchActions() {
+	app, ctx := suite.app, suite.ctx
+	granter, grantee, recipient := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	sendMsgType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	msgs := []sdk.Msg{banktypes.NewMsgSend(granter, recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)))}
+
+	suite.T().Log("verify msgs cannot be executed without a grant")
+	_, err := app.AuthzKeeper.DispatchActions(ctx, grantee, msgs)
+	suite.Require().Error(err)
+
+	suite.T().Log("verify msgs above the spend limit are rejected")
+	sendAuthz := types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	suite.Require().NoError(app.AuthzKeeper.Grant(ctx, grantee, granter, sendAuthz, ctx.BlockTime().Add(time.Hour)))
+
+	_, err = app.AuthzKeeper.DispatchActions(ctx, grantee, []sdk.Msg{
+		banktypes.NewMsgSend(granter, recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", 200))),
+	})
+	suite.Require().Error(err)
+
+	suite.T().Log("verify msgs within the spend limit are executed and reduce it")
+	recipientBalance := app.BankKeeper.GetBalance(ctx, recipient, "stake")
+
+	results, err := app.AuthzKeeper.DispatchActions(ctx, grantee, msgs)
+	suite.Require().NoError(err)
+	suite.Require().Len(results, 1)
+	suite.Require().Equal(recipientBalance.Add(sdk.NewInt64Coin("stake", 60)), app.BankKeeper.GetBalance(ctx, recipient, "stake"))
+
+	authorization, _ := app.AuthzKeeper.GetCleanAuthorization(ctx, grantee, granter, sendMsgType)
+	suite.Require().Equal(types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 40))), authorization)
+
+	suite.T().Log("verify an exhausted authorization is deleted")
+	_, err = app.AuthzKeeper.DispatchActions(ctx, grantee, []sdk.Msg{
+		banktypes.NewMsgSend(granter, recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", 40))),
+	})
+	suite.Require().NoError(err)
+
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, grantee, granter, sendMsgType)
+	suite.Require().Nil(authorization)
+
+	suite.T().Log("verify the grantee can execute its own msgs")
+	_, err = app.AuthzKeeper.DispatchActions(ctx, grantee, []sdk.Msg{
+		banktypes.NewMsgSend(grantee, recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryGrants() {
+	app, ctx := suite.app, suite.ctx
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+
+	_, err := suite.queryClient.Grants(gocontext.Background(), &types.QueryGrantsRequest{Granter: granter})
+	suite.Require().Error(err)
+
+	res, err := suite.queryClient.Grants(gocontext.Background(), &types.QueryGrantsRequest{Granter: granter, Grantee: grantee})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Grants)
+
+	sendAuthz := types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	voteAuthz := types.NewGenericAuthorization("/cosmos.gov.v1beta1.MsgVote")
+	expiration := ctx.BlockTime().Add(time.Hour)
+	suite.Require().NoError(app.AuthzKeeper.Grant(ctx, grantee, granter, sendAuthz, expiration))
+	suite.Require().NoError(app.AuthzKeeper.Grant(ctx, grantee, granter, voteAuthz, expiration))
+
+	res, err = suite.queryClient.Grants(gocontext.Background(), &types.QueryGrantsRequest{Granter: granter, Grantee: grantee})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Grants, 2)
+
+	res, err = suite.queryClient.Grants(gocontext.Background(), &types.QueryGrantsRequest{
+		Granter: granter, Grantee: grantee, MsgTypeUrl: voteAuthz.MsgTypeURL(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Grants, 1)
+	suite.Require().Equal(voteAuthz, res.Grants[0].GetAuthorization())
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
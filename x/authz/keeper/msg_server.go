@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the authz MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// Grant implements the Msg/Grant method
+func (k msgServer) Grant(goCtx context.Context, msg *types.MsgGrant) (*types.MsgGrantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorization := msg.Grant.GetAuthorization()
+	if authorization == nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidAuthorization, "missing authorization")
+	}
+
+	if err := k.Keeper.Grant(ctx, msg.Grantee, msg.Granter, authorization, msg.Grant.Expiration); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, authorization.MsgTypeURL()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+		),
+	})
+
+	return &types.MsgGrantResponse{}, nil
+}
+
+// Revoke implements the Msg/Revoke method
+func (k msgServer) Revoke(goCtx context.Context, msg *types.MsgRevoke) (*types.MsgRevokeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.Revoke(ctx, msg.Grantee, msg.Granter, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevoke,
+			sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msg.MsgTypeUrl),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+		),
+	})
+
+	return &types.MsgRevokeResponse{}, nil
+}
+
+// Exec implements the Msg/Exec method
+func (k msgServer) Exec(goCtx context.Context, msg *types.MsgExec) (*types.MsgExecResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := k.DispatchActions(ctx, msg.Grantee, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeExec,
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Grantee.String()),
+		),
+	})
+
+	return &types.MsgExecResponse{Results: results}, nil
+}
@@ -0,0 +1,142 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestSendAuthorization(t *testing.T) {
+	ctx := sdk.NewContext(nil, abci.Header{}, false, log.NewNopLogger())
+	_, _, from := testdata.KeyTestPubAddr()
+	_, _, to := testdata.KeyTestPubAddr()
+
+	authorization := types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	require.NoError(t, authorization.ValidateBasic())
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", authorization.MsgTypeURL())
+
+	resp, err := authorization.Accept(ctx, banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("stake", 30))))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Equal(t, types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 70))), resp.Updated)
+
+	resp, err = authorization.Accept(ctx, banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	require.NoError(t, err)
+	require.True(t, resp.Delete)
+
+	_, err = authorization.Accept(ctx, banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("atom", 1))))
+	require.Error(t, err)
+
+	require.Error(t, types.NewSendAuthorization(nil).ValidateBasic())
+}
+
+func TestStakeAuthorization(t *testing.T) {
+	ctx := sdk.NewContext(nil, abci.Header{}, false, log.NewNopLogger())
+	_, _, delegator := testdata.KeyTestPubAddr()
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	val1, val2 := sdk.ValAddress(addr1), sdk.ValAddress(addr2)
+	coin := func(amount int64) sdk.Coin { return sdk.NewInt64Coin("stake", amount) }
+	maxTokens := coin(100)
+
+	_, err := types.NewStakeAuthorization(nil, nil, types.AuthorizationTypeDelegate, nil)
+	require.Error(t, err)
+
+	_, err = types.NewStakeAuthorization([]sdk.ValAddress{val1}, []sdk.ValAddress{val2}, types.AuthorizationTypeDelegate, nil)
+	require.Error(t, err)
+
+	testCases := []struct {
+		name      string
+		allowed   []sdk.ValAddress
+		denied    []sdk.ValAddress
+		authzType types.AuthorizationType
+		maxTokens *sdk.Coin
+		msg       sdk.Msg
+		expErr    bool
+		expDelete bool
+		expLeft   *sdk.Coin
+	}{
+		{
+			"allowed validator within max tokens",
+			[]sdk.ValAddress{val1}, nil, types.AuthorizationTypeDelegate, &maxTokens,
+			stakingtypes.NewMsgDelegate(delegator, val1, coin(60)),
+			false, false, &sdk.Coin{Denom: "stake", Amount: sdk.NewInt(40)},
+		},
+		{
+			"allowed validator exhausting max tokens",
+			[]sdk.ValAddress{val1}, nil, types.AuthorizationTypeDelegate, &maxTokens,
+			stakingtypes.NewMsgDelegate(delegator, val1, coin(100)),
+			false, true, nil,
+		},
+		{
+			"allowed validator above max tokens",
+			[]sdk.ValAddress{val1}, nil, types.AuthorizationTypeDelegate, &maxTokens,
+			stakingtypes.NewMsgDelegate(delegator, val1, coin(101)),
+			true, false, nil,
+		},
+		{
+			"validator not allowed",
+			[]sdk.ValAddress{val1}, nil, types.AuthorizationTypeDelegate, nil,
+			stakingtypes.NewMsgDelegate(delegator, val2, coin(1)),
+			true, false, nil,
+		},
+		{
+			"validator denied",
+			nil, []sdk.ValAddress{val1}, types.AuthorizationTypeDelegate, nil,
+			stakingtypes.NewMsgDelegate(delegator, val1, coin(1)),
+			true, false, nil,
+		},
+		{
+			"validator not denied without max tokens",
+			nil, []sdk.ValAddress{val1}, types.AuthorizationTypeDelegate, nil,
+			stakingtypes.NewMsgDelegate(delegator, val2, coin(1000)),
+			false, false, nil,
+		},
+		{
+			"wrong msg type",
+			[]sdk.ValAddress{val1}, nil, types.AuthorizationTypeDelegate, nil,
+			stakingtypes.NewMsgUndelegate(delegator, val1, coin(1)),
+			true, false, nil,
+		},
+		{
+			"undelegate from allowed validator",
+			[]sdk.ValAddress{val1}, nil, types.AuthorizationTypeUndelegate, nil,
+			stakingtypes.NewMsgUndelegate(delegator, val1, coin(1)),
+			false, false, nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			authorization, err := types.NewStakeAuthorization(tc.allowed, tc.denied, tc.authzType, tc.maxTokens)
+			require.NoError(t, err)
+			require.NoError(t, authorization.ValidateBasic())
+
+			resp, err := authorization.Accept(ctx, tc.msg)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, resp.Accept)
+			require.Equal(t, tc.expDelete, resp.Delete)
+
+			if tc.expLeft != nil {
+				require.Equal(t, tc.expLeft, resp.Updated.(*types.StakeAuthorization).MaxTokens)
+			} else {
+				require.Nil(t, resp.Updated)
+			}
+		})
+	}
+}
@@ -0,0 +1,1412 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/authz/v1beta1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
+	_ "github.com/regen-network/cosmos-proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AuthorizationType defines the staking Msg a StakeAuthorization applies to.
+type AuthorizationType int32
+
+const (
+	// AUTHORIZATION_TYPE_UNSPECIFIED specifies an unknown authorization type
+	AuthorizationTypeUnspecified AuthorizationType = 0
+	// AUTHORIZATION_TYPE_DELEGATE defines an authorization for MsgDelegate
+	AuthorizationTypeDelegate AuthorizationType = 1
+	// AUTHORIZATION_TYPE_UNDELEGATE defines an authorization for MsgUndelegate
+	AuthorizationTypeUndelegate AuthorizationType = 2
+	// AUTHORIZATION_TYPE_REDELEGATE defines an authorization for MsgBeginRedelegate
+	AuthorizationTypeRedelegate AuthorizationType = 3
+)
+
+var AuthorizationType_name = map[int32]string{
+	0: "AUTHORIZATION_TYPE_UNSPECIFIED",
+	1: "AUTHORIZATION_TYPE_DELEGATE",
+	2: "AUTHORIZATION_TYPE_UNDELEGATE",
+	3: "AUTHORIZATION_TYPE_REDELEGATE",
+}
+
+var AuthorizationType_value = map[string]int32{
+	"AUTHORIZATION_TYPE_UNSPECIFIED": 0,
+	"AUTHORIZATION_TYPE_DELEGATE":    1,
+	"AUTHORIZATION_TYPE_UNDELEGATE":  2,
+	"AUTHORIZATION_TYPE_REDELEGATE":  3,
+}
+
+func (x AuthorizationType) String() string {
+	return proto.EnumName(AuthorizationType_name, int32(x))
+}
+
+func (AuthorizationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{0}
+}
+
+// GenericAuthorization gives the grantee unrestricted permissions to execute
+// the provided Msg on behalf of the granter's account.
+type GenericAuthorization struct {
+	// msg_type_url identifies the Msg type the grantee may execute, e.g.
+	// "/cosmos.gov.v1beta1.MsgVote".
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+}
+
+func (m *GenericAuthorization) Reset()         { *m = GenericAuthorization{} }
+func (m *GenericAuthorization) String() string { return proto.CompactTextString(m) }
+func (*GenericAuthorization) ProtoMessage()    {}
+func (*GenericAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{0}
+}
+func (m *GenericAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenericAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenericAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenericAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenericAuthorization.Merge(m, src)
+}
+func (m *GenericAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenericAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenericAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenericAuthorization proto.InternalMessageInfo
+
+func (m *GenericAuthorization) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+// SendAuthorization allows the grantee to spend up to spend_limit coins from
+// the granter's account with bank MsgSend.
+type SendAuthorization struct {
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit" yaml:"spend_limit"`
+}
+
+func (m *SendAuthorization) Reset()         { *m = SendAuthorization{} }
+func (m *SendAuthorization) String() string { return proto.CompactTextString(m) }
+func (*SendAuthorization) ProtoMessage()    {}
+func (*SendAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{1}
+}
+func (m *SendAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SendAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendAuthorization.Merge(m, src)
+}
+func (m *SendAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendAuthorization proto.InternalMessageInfo
+
+func (m *SendAuthorization) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+// StakeAuthorization allows the grantee to delegate, undelegate or redelegate
+// the granter's tokens to or from a restricted set of validators.
+type StakeAuthorization struct {
+	// max_tokens specifies the maximum amount of tokens that can be staked by
+	// the grantee. An empty value means no limit.
+	MaxTokens *types.Coin `protobuf:"bytes,1,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty" yaml:"max_tokens"`
+	// validators is either an allow list, where only the listed validators may
+	// be used, or a deny list, where any but the listed validators may be used.
+	//
+	// Types that are valid to be assigned to Validators:
+	//	*StakeAuthorization_AllowList
+	//	*StakeAuthorization_DenyList
+	Validators isStakeAuthorization_Validators `protobuf_oneof:"validators"`
+	// authorization_type defines the staking Msg the grantee may execute.
+	AuthorizationType AuthorizationType `protobuf:"varint,4,opt,name=authorization_type,json=authorizationType,proto3,enum=cosmos.authz.v1beta1.AuthorizationType" json:"authorization_type,omitempty" yaml:"authorization_type"`
+}
+
+func (m *StakeAuthorization) Reset()         { *m = StakeAuthorization{} }
+func (m *StakeAuthorization) String() string { return proto.CompactTextString(m) }
+func (*StakeAuthorization) ProtoMessage()    {}
+func (*StakeAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{2}
+}
+func (m *StakeAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakeAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakeAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakeAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeAuthorization.Merge(m, src)
+}
+func (m *StakeAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakeAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeAuthorization proto.InternalMessageInfo
+
+type isStakeAuthorization_Validators interface {
+	isStakeAuthorization_Validators()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type StakeAuthorization_AllowList struct {
+	AllowList *StakeAuthorization_Validators `protobuf:"bytes,2,opt,name=allow_list,json=allowList,proto3,oneof" json:"allow_list,omitempty" yaml:"allow_list"`
+}
+type StakeAuthorization_DenyList struct {
+	DenyList *StakeAuthorization_Validators `protobuf:"bytes,3,opt,name=deny_list,json=denyList,proto3,oneof" json:"deny_list,omitempty" yaml:"deny_list"`
+}
+
+func (*StakeAuthorization_AllowList) isStakeAuthorization_Validators() {}
+func (*StakeAuthorization_DenyList) isStakeAuthorization_Validators()  {}
+
+func (m *StakeAuthorization) GetValidators() isStakeAuthorization_Validators {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *StakeAuthorization) GetMaxTokens() *types.Coin {
+	if m != nil {
+		return m.MaxTokens
+	}
+	return nil
+}
+
+func (m *StakeAuthorization) GetAllowList() *StakeAuthorization_Validators {
+	if x, ok := m.GetValidators().(*StakeAuthorization_AllowList); ok {
+		return x.AllowList
+	}
+	return nil
+}
+
+func (m *StakeAuthorization) GetDenyList() *StakeAuthorization_Validators {
+	if x, ok := m.GetValidators().(*StakeAuthorization_DenyList); ok {
+		return x.DenyList
+	}
+	return nil
+}
+
+func (m *StakeAuthorization) GetAuthorizationType() AuthorizationType {
+	if m != nil {
+		return m.AuthorizationType
+	}
+	return AuthorizationTypeUnspecified
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*StakeAuthorization) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*StakeAuthorization_AllowList)(nil),
+		(*StakeAuthorization_DenyList)(nil),
+	}
+}
+
+// Validators defines a list of validator addresses.
+type StakeAuthorization_Validators struct {
+	Address []github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,rep,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"address,omitempty"`
+}
+
+func (m *StakeAuthorization_Validators) Reset()         { *m = StakeAuthorization_Validators{} }
+func (m *StakeAuthorization_Validators) String() string { return proto.CompactTextString(m) }
+func (*StakeAuthorization_Validators) ProtoMessage()    {}
+func (*StakeAuthorization_Validators) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{2, 0}
+}
+func (m *StakeAuthorization_Validators) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakeAuthorization_Validators) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakeAuthorization_Validators.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakeAuthorization_Validators) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeAuthorization_Validators.Merge(m, src)
+}
+func (m *StakeAuthorization_Validators) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakeAuthorization_Validators) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeAuthorization_Validators.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeAuthorization_Validators proto.InternalMessageInfo
+
+func (m *StakeAuthorization_Validators) GetAddress() []github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+// Grant gives permissions to execute the Msg type specified by the
+// authorization until the expiration time.
+type Grant struct {
+	Authorization *types1.Any `protobuf:"bytes,1,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	Expiration    time.Time   `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration"`
+}
+
+func (m *Grant) Reset()         { *m = Grant{} }
+func (m *Grant) String() string { return proto.CompactTextString(m) }
+func (*Grant) ProtoMessage()    {}
+func (*Grant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{3}
+}
+func (m *Grant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Grant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Grant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Grant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Grant.Merge(m, src)
+}
+func (m *Grant) XXX_Size() int {
+	return m.Size()
+}
+func (m *Grant) XXX_DiscardUnknown() {
+	xxx_messageInfo_Grant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Grant proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("cosmos.authz.v1beta1.AuthorizationType", AuthorizationType_name, AuthorizationType_value)
+	proto.RegisterType((*GenericAuthorization)(nil), "cosmos.authz.v1beta1.GenericAuthorization")
+	proto.RegisterType((*SendAuthorization)(nil), "cosmos.authz.v1beta1.SendAuthorization")
+	proto.RegisterType((*StakeAuthorization)(nil), "cosmos.authz.v1beta1.StakeAuthorization")
+	proto.RegisterType((*StakeAuthorization_Validators)(nil), "cosmos.authz.v1beta1.StakeAuthorization.Validators")
+	proto.RegisterType((*Grant)(nil), "cosmos.authz.v1beta1.Grant")
+}
+
+func init() { proto.RegisterFile("cosmos/authz/v1beta1/authz.proto", fileDescriptor_544dc2e84b61c637) }
+
+var fileDescriptor_544dc2e84b61c637 = []byte{
+	// 755 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xcf, 0x4f, 0xdb, 0x48,
+	0x1c, 0xc5, 0x6d, 0xc8, 0xee, 0x92, 0x01, 0x56, 0xc4, 0x9b, 0xd5, 0x26, 0x66, 0xb1, 0x2d, 0x5f,
+	0x1a, 0x21, 0xe1, 0x08, 0x38, 0x95, 0x43, 0xa5, 0x98, 0x04, 0x88, 0x4a, 0x01, 0x99, 0x04, 0x09,
+	0x2e, 0xd6, 0x24, 0x1e, 0x1c, 0x2b, 0xb6, 0x27, 0xf2, 0x4c, 0x68, 0xc2, 0xb1, 0x27, 0xc4, 0x89,
+	0x7f, 0x00, 0xa9, 0x6a, 0x0f, 0x95, 0xda, 0x43, 0x2f, 0xfd, 0x23, 0x50, 0x4f, 0xa8, 0xa7, 0x9e,
+	0x42, 0x05, 0xff, 0x41, 0x8e, 0x3d, 0x55, 0xfe, 0x91, 0x90, 0x90, 0x14, 0x55, 0x3d, 0xc5, 0xe3,
+	0x99, 0xf7, 0x79, 0x2f, 0xdf, 0xbc, 0x51, 0x80, 0x54, 0xc5, 0xc4, 0xc1, 0x24, 0x0b, 0x9b, 0xb4,
+	0x76, 0x9a, 0x3d, 0x59, 0xae, 0x20, 0x0a, 0x97, 0xc3, 0x95, 0xd2, 0xf0, 0x30, 0xc5, 0x5c, 0x32,
+	0x3c, 0xa1, 0x84, 0xef, 0xa2, 0x13, 0x7c, 0x3a, 0x7c, 0xab, 0x07, 0x67, 0xb2, 0xd1, 0x91, 0x60,
+	0xc1, 0x0b, 0x11, 0xb2, 0x02, 0x09, 0xea, 0x13, 0xab, 0xd8, 0x72, 0xa3, 0xfd, 0xa4, 0x89, 0x4d,
+	0x1c, 0xea, 0xfc, 0xa7, 0xe8, 0x6d, 0xda, 0xc4, 0xd8, 0xb4, 0x51, 0x36, 0x58, 0x55, 0x9a, 0xc7,
+	0x59, 0xe8, 0xb6, 0xa3, 0x2d, 0xf1, 0xe1, 0x16, 0xb5, 0x1c, 0x44, 0x28, 0x74, 0x1a, 0xe1, 0x01,
+	0xd9, 0x00, 0xc9, 0x4d, 0xe4, 0x22, 0xcf, 0xaa, 0xe6, 0x9a, 0xb4, 0x86, 0x3d, 0xeb, 0x14, 0x52,
+	0x0b, 0xbb, 0xdc, 0x53, 0x30, 0xe3, 0x10, 0x53, 0xa7, 0xed, 0x06, 0xd2, 0x9b, 0x9e, 0x9d, 0x62,
+	0x25, 0x36, 0x13, 0x57, 0xff, 0xeb, 0x76, 0xc4, 0x7f, 0xda, 0xd0, 0xb1, 0xd7, 0xe4, 0xc1, 0x5d,
+	0x59, 0x03, 0x0e, 0x31, 0x4b, 0xed, 0x06, 0x2a, 0x7b, 0xf6, 0x5a, 0xe2, 0xcb, 0xa7, 0xa5, 0xd9,
+	0x21, 0x9a, 0xfc, 0x81, 0x05, 0x89, 0x7d, 0xe4, 0x1a, 0xc3, 0x1e, 0xaf, 0x58, 0x30, 0x4d, 0x1a,
+	0xc8, 0x35, 0x74, 0xdb, 0x72, 0x2c, 0x9a, 0x62, 0xa5, 0xc9, 0xcc, 0xf4, 0x4a, 0x5a, 0x89, 0x46,
+	0xe2, 0x0f, 0xa1, 0x37, 0x34, 0x65, 0x1d, 0x5b, 0xae, 0xba, 0x71, 0xd5, 0x11, 0x99, 0x6e, 0x47,
+	0xe4, 0xc2, 0x08, 0x03, 0x5a, 0xf9, 0xfd, 0x8d, 0x98, 0x31, 0x2d, 0x5a, 0x6b, 0x56, 0x94, 0x2a,
+	0x76, 0xa2, 0xa9, 0x46, 0x1f, 0x4b, 0xc4, 0xa8, 0x67, 0xfd, 0xac, 0x24, 0xc0, 0x10, 0x0d, 0x04,
+	0xca, 0x6d, 0x5f, 0x38, 0x2e, 0xed, 0xc7, 0x18, 0xe0, 0xf6, 0x29, 0xac, 0xa3, 0xe1, 0xb8, 0xbb,
+	0x00, 0x38, 0xb0, 0xa5, 0x53, 0x5c, 0x47, 0x2e, 0x09, 0x06, 0xf2, 0x68, 0xd8, 0x7f, 0xbb, 0x1d,
+	0x31, 0x11, 0xcd, 0xaa, 0x2f, 0x93, 0xb5, 0xb8, 0x03, 0x5b, 0xa5, 0xe0, 0x99, 0xab, 0x03, 0x00,
+	0x6d, 0x1b, 0xbf, 0xd4, 0x6d, 0x8b, 0xd0, 0xd4, 0x44, 0x00, 0x5c, 0x55, 0xc6, 0x75, 0x46, 0x19,
+	0x8d, 0xa3, 0x1c, 0x40, 0xdb, 0x32, 0x20, 0xc5, 0x1e, 0x19, 0xb4, 0xba, 0x07, 0xca, 0x5b, 0x8c,
+	0x16, 0x0f, 0x96, 0xdb, 0x16, 0xa1, 0x5c, 0x0d, 0xc4, 0x0d, 0xe4, 0xb6, 0x43, 0xaf, 0xc9, 0xdf,
+	0xf7, 0x4a, 0x76, 0x3b, 0xe2, 0x5c, 0xe8, 0xd5, 0xe7, 0xf9, 0x56, 0x53, 0xfe, 0x2a, 0x70, 0x6a,
+	0x02, 0x0e, 0x0e, 0xaa, 0x83, 0x9a, 0xa4, 0x62, 0x12, 0x9b, 0xf9, 0x7b, 0xe5, 0xc9, 0x78, 0xcb,
+	0x21, 0x37, 0xbf, 0x47, 0xea, 0x42, 0xb7, 0x23, 0xa6, 0xa3, 0xaf, 0x34, 0x02, 0x93, 0xb5, 0x04,
+	0x7c, 0xa8, 0xe0, 0x0f, 0x01, 0xb8, 0x8f, 0xc9, 0x3d, 0x07, 0x7f, 0x41, 0xc3, 0xf0, 0x10, 0x21,
+	0x41, 0xad, 0x66, 0xd4, 0xe5, 0xef, 0x1d, 0x71, 0xe9, 0x17, 0x1a, 0x72, 0x00, 0xed, 0x5c, 0x28,
+	0xd4, 0x7a, 0x84, 0x31, 0x1d, 0x51, 0x67, 0x00, 0x38, 0xe9, 0xbb, 0xc9, 0x6f, 0x58, 0xf0, 0xc7,
+	0xa6, 0x07, 0x5d, 0xca, 0xbd, 0x00, 0xb3, 0x43, 0xd1, 0xa2, 0x9e, 0x24, 0x95, 0xf0, 0x22, 0x2a,
+	0xbd, 0x8b, 0xa8, 0xe4, 0xdc, 0xb6, 0x9a, 0xf8, 0xfc, 0x90, 0xab, 0x0d, 0xab, 0xb9, 0x3c, 0x00,
+	0xa8, 0xd5, 0xb0, 0xbc, 0x90, 0x15, 0x56, 0x84, 0x1f, 0x61, 0x95, 0x7a, 0x97, 0x5a, 0x9d, 0xf2,
+	0x6f, 0xc8, 0xc5, 0x8d, 0xc8, 0x6a, 0x03, 0xba, 0xb5, 0xd8, 0xd9, 0x6b, 0x91, 0x59, 0x7c, 0x37,
+	0x01, 0x12, 0x23, 0x83, 0xe6, 0xf2, 0x40, 0xc8, 0x95, 0x4b, 0x5b, 0xbb, 0x5a, 0xf1, 0x28, 0x57,
+	0x2a, 0xee, 0xee, 0xe8, 0xa5, 0xc3, 0xbd, 0x82, 0x5e, 0xde, 0xd9, 0xdf, 0x2b, 0xac, 0x17, 0x37,
+	0x8a, 0x85, 0xfc, 0x1c, 0xc3, 0x4b, 0xe7, 0x97, 0xd2, 0xff, 0x23, 0xd2, 0xb2, 0x4b, 0x1a, 0xa8,
+	0x6a, 0x1d, 0x5b, 0xc8, 0xe0, 0x9e, 0x81, 0xf9, 0x31, 0x94, 0x7c, 0x61, 0xbb, 0xb0, 0x99, 0x2b,
+	0x15, 0xe6, 0x58, 0x7e, 0xe1, 0xfc, 0x52, 0x4a, 0x8f, 0x20, 0xf2, 0xc8, 0x46, 0x26, 0xa4, 0x88,
+	0x53, 0xc1, 0xc2, 0xd8, 0x14, 0x7d, 0xc2, 0x04, 0x2f, 0x9e, 0x5f, 0x4a, 0xf3, 0x63, 0x42, 0x18,
+	0x8f, 0x33, 0xb4, 0x42, 0x9f, 0x31, 0xf9, 0x13, 0x86, 0x86, 0x7a, 0x0c, 0x3e, 0x76, 0xf6, 0x56,
+	0x60, 0xd4, 0xfc, 0xd5, 0xad, 0xc0, 0x5e, 0xdf, 0x0a, 0xec, 0xb7, 0x5b, 0x81, 0xbd, 0xb8, 0x13,
+	0x98, 0xeb, 0x3b, 0x81, 0xf9, 0x7a, 0x27, 0x30, 0x47, 0x8b, 0x8f, 0x36, 0xa8, 0x15, 0xfd, 0x17,
+	0x04, 0x4d, 0xaa, 0xfc, 0x19, 0xfc, 0x3e, 0xab, 0x3f, 0x06, 0x00, 0x44, 0xa3, 0x37, 0xae, 0x28,
+	0x06, 0x00, 0x00,
+}
+
+func (m *GenericAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenericAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenericAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakeAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakeAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakeAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuthorizationType != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.AuthorizationType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Validators != nil {
+		{
+			size := m.Validators.Size()
+			i -= size
+			if _, err := m.Validators.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.MaxTokens != nil {
+		{
+			size, err := m.MaxTokens.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakeAuthorization_AllowList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakeAuthorization_AllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AllowList != nil {
+		{
+			size, err := m.AllowList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StakeAuthorization_DenyList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakeAuthorization_DenyList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DenyList != nil {
+		{
+			size, err := m.DenyList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StakeAuthorization_Validators) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakeAuthorization_Validators) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakeAuthorization_Validators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		for iNdEx := len(m.Address) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Address[iNdEx])
+			copy(dAtA[i:], m.Address[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Address[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Grant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Grant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintAuthz(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if m.Authorization != nil {
+		{
+			size, err := m.Authorization.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenericAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *SendAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StakeAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxTokens != nil {
+		l = m.MaxTokens.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Validators != nil {
+		n += m.Validators.Size()
+	}
+	if m.AuthorizationType != 0 {
+		n += 1 + sovAuthz(uint64(m.AuthorizationType))
+	}
+	return n
+}
+
+func (m *StakeAuthorization_AllowList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AllowList != nil {
+		l = m.AllowList.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+func (m *StakeAuthorization_DenyList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DenyList != nil {
+		l = m.DenyList.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+func (m *StakeAuthorization_Validators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		for _, b := range m.Address {
+			l = len(b)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Grant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Authorization != nil {
+		l = m.Authorization.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovAuthz(uint64(l))
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenericAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenericAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakeAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakeAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakeAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxTokens == nil {
+				m.MaxTokens = &types.Coin{}
+			}
+			if err := m.MaxTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &StakeAuthorization_Validators{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Validators = &StakeAuthorization_AllowList{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenyList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &StakeAuthorization_Validators{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Validators = &StakeAuthorization_DenyList{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizationType", wireType)
+			}
+			m.AuthorizationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthorizationType |= AuthorizationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakeAuthorization_Validators) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validators: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validators: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address, make([]byte, postIndex-iNdEx))
+			copy(m.Address[len(m.Address)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Grant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Grant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Grant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Authorization == nil {
+				m.Authorization = &types1.Any{}
+			}
+			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
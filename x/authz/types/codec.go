@@ -0,0 +1,56 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+)
+
+// RegisterCodec registers all the necessary types and interfaces for the
+// authz module.
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterInterface((*exported.Authorization)(nil), nil)
+	cdc.RegisterConcrete(&MsgGrant{}, "cosmos-sdk/MsgGrant", nil)
+	cdc.RegisterConcrete(&MsgRevoke{}, "cosmos-sdk/MsgRevoke", nil)
+	cdc.RegisterConcrete(&MsgExec{}, "cosmos-sdk/MsgExec", nil)
+	cdc.RegisterConcrete(&GenericAuthorization{}, "cosmos-sdk/GenericAuthorization", nil)
+	cdc.RegisterConcrete(&SendAuthorization{}, "cosmos-sdk/SendAuthorization", nil)
+	cdc.RegisterConcrete(&StakeAuthorization{}, "cosmos-sdk/StakeAuthorization", nil)
+}
+
+// RegisterInterfaces registers the authz Msgs and the Authorization interface
+// with its implementations.
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgGrant{},
+		&MsgRevoke{},
+		&MsgExec{},
+	)
+	registry.RegisterInterface(
+		"cosmos.authz.v1beta1.Authorization",
+		(*exported.Authorization)(nil),
+		&GenericAuthorization{},
+		&SendAuthorization{},
+		&StakeAuthorization{},
+	)
+}
+
+var (
+	amino = codec.New()
+
+	// ModuleCdc references the global x/authz module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding as Amino is
+	// still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/authz and
+	// defined at the application level.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
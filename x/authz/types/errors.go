@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/authz module sentinel errors
+var (
+	ErrNoAuthorizationFound = sdkerrors.Register(ModuleName, 2, "authorization not found")
+	ErrInvalidExpiration    = sdkerrors.Register(ModuleName, 3, "expiration time of authorization should be more than current time")
+	ErrGranteeIsGranter     = sdkerrors.Register(ModuleName, 4, "grantee and granter should be different")
+	ErrInvalidAuthorization = sdkerrors.Register(ModuleName, 5, "invalid authorization")
+)
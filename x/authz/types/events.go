@@ -0,0 +1,13 @@
+package types
+
+// authz module events
+const (
+	EventTypeGrant  = "grant"
+	EventTypeRevoke = "revoke"
+	EventTypeExec   = "exec"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyGranter    = "granter"
+	AttributeKeyGrantee    = "grantee"
+	AttributeKeyMsgTypeURL = "msg_type_url"
+)
@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+)
+
+var _ exported.Authorization = &GenericAuthorization{}
+
+// NewGenericAuthorization creates a new GenericAuthorization for the Msg type
+// identified by msgTypeURL.
+func NewGenericAuthorization(msgTypeURL string) *GenericAuthorization {
+	return &GenericAuthorization{
+		MsgTypeUrl: msgTypeURL,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a GenericAuthorization) MsgTypeURL() string {
+	return a.MsgTypeUrl
+}
+
+// Accept implements Authorization.Accept. Any msg of the authorized type is
+// accepted.
+func (a GenericAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (exported.AcceptResponse, error) {
+	if sdk.MsgTypeURL(msg) != a.MsgTypeUrl {
+		return exported.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %s, got %s", a.MsgTypeUrl, sdk.MsgTypeURL(msg))
+	}
+
+	return exported.AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a GenericAuthorization) ValidateBasic() error {
+	if a.MsgTypeUrl == "" {
+		return sdkerrors.Wrap(ErrInvalidAuthorization, "msg type URL cannot be empty")
+	}
+
+	return nil
+}
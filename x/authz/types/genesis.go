@@ -0,0 +1,93 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+)
+
+var (
+	_ types.UnpackInterfacesMessage = GenesisState{}
+	_ types.UnpackInterfacesMessage = GrantAuthorization{}
+)
+
+// NewGenesisState creates a new genesis state for the authz module.
+func NewGenesisState(entries []GrantAuthorization) *GenesisState {
+	return &GenesisState{
+		Authorization: entries,
+	}
+}
+
+// DefaultGenesisState returns the authz module's default genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Authorization: []GrantAuthorization{},
+	}
+}
+
+// NewGrantAuthorization creates a new GrantAuthorization of authorization from
+// granter to grantee expiring at expiration.
+func NewGrantAuthorization(granter, grantee sdk.AccAddress, authorization exported.Authorization, expiration time.Time) (GrantAuthorization, error) {
+	any, err := packAuthorization(authorization)
+	if err != nil {
+		return GrantAuthorization{}, err
+	}
+
+	return GrantAuthorization{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: any,
+		Expiration:    expiration,
+	}, nil
+}
+
+// GetAuthorization returns the cached Authorization of the entry, or nil if
+// it has not been unpacked.
+func (ga GrantAuthorization) GetAuthorization() exported.Authorization {
+	return unpackedAuthorization(ga.Authorization)
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	for _, entry := range gs.Authorization {
+		if entry.Granter.Empty() || entry.Grantee.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "granter and grantee cannot be empty")
+		}
+
+		if entry.Granter.Equals(entry.Grantee) {
+			return ErrGranteeIsGranter
+		}
+
+		authorization := entry.GetAuthorization()
+		if authorization == nil {
+			return sdkerrors.Wrap(ErrInvalidAuthorization, "missing authorization")
+		}
+
+		if err := authorization.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, entry := range gs.Authorization {
+		if err := entry.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (ga GrantAuthorization) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var authorization exported.Authorization
+	return unpacker.UnpackAny(ga.Authorization, &authorization)
+}
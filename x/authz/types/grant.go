@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	proto "github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+)
+
+var _ types.UnpackInterfacesMessage = Grant{}
+
+// NewGrant returns a new Grant of authorization expiring at expiration.
+func NewGrant(authorization exported.Authorization, expiration time.Time) (Grant, error) {
+	any, err := packAuthorization(authorization)
+	if err != nil {
+		return Grant{}, err
+	}
+
+	return Grant{
+		Authorization: any,
+		Expiration:    expiration,
+	}, nil
+}
+
+// GetAuthorization returns the cached Authorization of the grant, or nil if it
+// has not been unpacked.
+func (g Grant) GetAuthorization() exported.Authorization {
+	return unpackedAuthorization(g.Authorization)
+}
+
+// ValidateBasic performs a basic validation of the grant.
+func (g Grant) ValidateBasic() error {
+	authorization := g.GetAuthorization()
+	if authorization == nil {
+		return sdkerrors.Wrap(ErrInvalidAuthorization, "missing authorization")
+	}
+
+	return authorization.ValidateBasic()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g Grant) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var authorization exported.Authorization
+	return unpacker.UnpackAny(g.Authorization, &authorization)
+}
+
+func packAuthorization(authorization exported.Authorization) (*types.Any, error) {
+	msg, ok := authorization.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("cannot proto marshal %T", authorization)
+	}
+
+	return types.NewAnyWithValue(msg)
+}
+
+func unpackedAuthorization(any *types.Any) exported.Authorization {
+	if any == nil {
+		return nil
+	}
+
+	authorization, ok := any.GetCachedValue().(exported.Authorization)
+	if !ok {
+		return nil
+	}
+
+	return authorization
+}
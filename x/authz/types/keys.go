@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "authz"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	GrantKeyPrefix = []byte{0x01}
+)
+
+// GrantKey returns the store key of the grant of msgTypeURL given by granter
+// to grantee:
+// 0x01 | granter (20 bytes) | grantee (20 bytes) | msgTypeURL
+func GrantKey(granter, grantee sdk.AccAddress, msgTypeURL string) []byte {
+	return append(GrantStoreKeyPrefix(granter, grantee), msgTypeURL...)
+}
+
+// GrantStoreKeyPrefix returns the prefix of the store keys of all grants given
+// by granter to grantee.
+func GrantStoreKeyPrefix(granter, grantee sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(GrantKeyPrefix)+len(granter)+len(grantee))
+	key = append(key, GrantKeyPrefix...)
+	key = append(key, granter...)
+	return append(key, grantee...)
+}
+
+// ParseGrantKey returns the granter, grantee and Msg type URL of a grant store
+// key.
+func ParseGrantKey(key []byte) (granter, grantee sdk.AccAddress, msgTypeURL string) {
+	key = key[len(GrantKeyPrefix):]
+	granter = sdk.AccAddress(key[:sdk.AddrLen])
+	grantee = sdk.AccAddress(key[sdk.AddrLen : 2*sdk.AddrLen])
+	return granter, grantee, string(key[2*sdk.AddrLen:])
+}
@@ -0,0 +1,253 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+)
+
+// Message types for the authz module
+const (
+	TypeMsgGrant  = "grant"
+	TypeMsgRevoke = "revoke"
+	TypeMsgExec   = "exec"
+)
+
+var (
+	_ sdk.Msg = &MsgGrant{}
+	_ sdk.Msg = &MsgRevoke{}
+	_ sdk.Msg = &MsgExec{}
+
+	_ types.UnpackInterfacesMessage = MsgGrant{}
+	_ types.UnpackInterfacesMessage = MsgExec{}
+)
+
+// NewMsgGrant creates a new MsgGrant granting authorization to grantee on the
+// account of granter until expiration.
+func NewMsgGrant(granter, grantee sdk.AccAddress, authorization exported.Authorization, expiration time.Time) (*MsgGrant, error) {
+	grant, err := NewGrant(authorization, expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgGrant{
+		Granter: granter,
+		Grantee: grantee,
+		Grant:   grant,
+	}, nil
+}
+
+// Route returns the MsgGrant's route.
+func (msg MsgGrant) Route() string { return RouterKey }
+
+// Type returns the MsgGrant's type.
+func (msg MsgGrant) Type() string { return TypeMsgGrant }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgGrant.
+func (msg MsgGrant) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+
+	if msg.Granter.Equals(msg.Grantee) {
+		return ErrGranteeIsGranter
+	}
+
+	return msg.Grant.ValidateBasic()
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when
+// submitting a MsgGrant message.
+func (msg MsgGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the granter, the single expected signer of a MsgGrant.
+func (msg MsgGrant) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrant) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return msg.Grant.UnpackInterfaces(unpacker)
+}
+
+// NewMsgRevoke creates a new MsgRevoke revoking the authorization of
+// msgTypeURL granted to grantee by granter.
+func NewMsgRevoke(granter, grantee sdk.AccAddress, msgTypeURL string) *MsgRevoke {
+	return &MsgRevoke{
+		Granter:    granter,
+		Grantee:    grantee,
+		MsgTypeUrl: msgTypeURL,
+	}
+}
+
+// Route returns the MsgRevoke's route.
+func (msg MsgRevoke) Route() string { return RouterKey }
+
+// Type returns the MsgRevoke's type.
+func (msg MsgRevoke) Type() string { return TypeMsgRevoke }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgRevoke.
+func (msg MsgRevoke) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+
+	if msg.Granter.Equals(msg.Grantee) {
+		return ErrGranteeIsGranter
+	}
+
+	if msg.MsgTypeUrl == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing msg type URL")
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when
+// submitting a MsgRevoke message.
+func (msg MsgRevoke) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the granter, the single expected signer of a MsgRevoke.
+func (msg MsgRevoke) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// NewMsgExec creates a new MsgExec executing msgs on behalf of their signers
+// using the authorizations granted to grantee.
+func NewMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) (*MsgExec, error) {
+	anys := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := types.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		anys[i] = any
+	}
+
+	return &MsgExec{
+		Grantee: grantee,
+		Msgs:    anys,
+	}, nil
+}
+
+// GetMessages returns the unpacked messages of the MsgExec.
+func (msg MsgExec) GetMessages() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msg.Msgs))
+	for i, any := range msg.Msgs {
+		m, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "message %s contains an unknown sdk.Msg", any.TypeUrl)
+		}
+
+		msgs[i] = m
+	}
+
+	return msgs, nil
+}
+
+// Route returns the MsgExec's route.
+func (msg MsgExec) Route() string { return RouterKey }
+
+// Type returns the MsgExec's type.
+func (msg MsgExec) Type() string { return TypeMsgExec }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgExec
+// and each of its messages.
+func (msg MsgExec) ValidateBasic() error {
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+
+	if len(msg.Msgs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "messages cannot be empty")
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	for _, m := range msgs {
+		if len(m.GetSigners()) != 1 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "authorization can be given only to messages with a single signer, got %d for %s", len(m.GetSigners()), proto.MessageName(m))
+		}
+
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when
+// submitting a MsgExec message. The sign bytes of the executed messages are
+// embedded as they are, so that they do not need to be registered with the
+// authz amino codec.
+func (msg MsgExec) GetSignBytes() []byte {
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		panic(err)
+	}
+
+	signBytes := make([]json.RawMessage, len(msgs))
+	for i, m := range msgs {
+		signBytes[i] = m.GetSignBytes()
+	}
+
+	type value struct {
+		Grantee sdk.AccAddress    `json:"grantee"`
+		Msgs    []json.RawMessage `json:"msgs"`
+	}
+
+	// mirror the amino JSON encoding of the other authz msgs
+	bz, err := json.Marshal(struct {
+		Type  string `json:"type"`
+		Value value  `json:"value"`
+	}{
+		Type:  "cosmos-sdk/MsgExec",
+		Value: value{Grantee: msg.Grantee, Msgs: signBytes},
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal MsgExec sign bytes: %w", err))
+	}
+
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the grantee, the single expected signer of a MsgExec.
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Grantee}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgExec) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, any := range msg.Msgs {
+		var m sdk.Msg
+		if err := unpacker.UnpackAny(any, &m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ types.UnpackInterfacesMessage = QueryGrantsResponse{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (res QueryGrantsResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, grant := range res.Grants {
+		if err := grant.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
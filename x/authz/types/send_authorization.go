@@ -0,0 +1,57 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var _ exported.Authorization = &SendAuthorization{}
+
+// NewSendAuthorization creates a new SendAuthorization allowing the grantee to
+// send up to spendLimit from the granter's account.
+func NewSendAuthorization(spendLimit sdk.Coins) *SendAuthorization {
+	return &SendAuthorization{
+		SpendLimit: spendLimit,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a SendAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&banktypes.MsgSend{})
+}
+
+// Accept implements Authorization.Accept. A MsgSend is accepted if its amount
+// does not exceed the remaining spend limit, which is reduced accordingly. The
+// authorization is deleted once the spend limit is exhausted.
+func (a SendAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (exported.AcceptResponse, error) {
+	msgSend, ok := msg.(*banktypes.MsgSend)
+	if !ok {
+		return exported.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", &banktypes.MsgSend{}, msg)
+	}
+
+	limitLeft, isNegative := a.SpendLimit.SafeSub(msgSend.Amount)
+	if isNegative {
+		return exported.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "requested amount %s is more than the spend limit %s", msgSend.Amount, a.SpendLimit)
+	}
+
+	if limitLeft.IsZero() {
+		return exported.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return exported.AcceptResponse{Accept: true, Updated: NewSendAuthorization(limitLeft)}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a SendAuthorization) ValidateBasic() error {
+	if a.SpendLimit.Empty() {
+		return sdkerrors.Wrap(ErrInvalidAuthorization, "spend limit cannot be empty")
+	}
+
+	if !a.SpendLimit.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, a.SpendLimit.String())
+	}
+
+	return nil
+}
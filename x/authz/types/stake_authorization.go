@@ -0,0 +1,132 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var _ exported.Authorization = &StakeAuthorization{}
+
+// NewStakeAuthorization creates a new StakeAuthorization for the staking Msg
+// of authzType. Exactly one of allowed and denied must be non-empty. A nil
+// amount means that the grantee may stake an unlimited amount of tokens.
+func NewStakeAuthorization(allowed []sdk.ValAddress, denied []sdk.ValAddress, authzType AuthorizationType, amount *sdk.Coin) (*StakeAuthorization, error) {
+	if len(allowed) == 0 && len(denied) == 0 {
+		return nil, sdkerrors.Wrap(ErrInvalidAuthorization, "both allowed and denied validator lists cannot be empty")
+	}
+
+	if len(allowed) > 0 && len(denied) > 0 {
+		return nil, sdkerrors.Wrap(ErrInvalidAuthorization, "cannot set both allowed and denied validator lists")
+	}
+
+	a := StakeAuthorization{
+		MaxTokens:         amount,
+		AuthorizationType: authzType,
+	}
+
+	if len(allowed) > 0 {
+		a.Validators = &StakeAuthorization_AllowList{AllowList: &StakeAuthorization_Validators{Address: allowed}}
+	} else {
+		a.Validators = &StakeAuthorization_DenyList{DenyList: &StakeAuthorization_Validators{Address: denied}}
+	}
+
+	return &a, nil
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a StakeAuthorization) MsgTypeURL() string {
+	switch a.AuthorizationType {
+	case AuthorizationTypeDelegate:
+		return sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+	case AuthorizationTypeUndelegate:
+		return sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{})
+	case AuthorizationTypeRedelegate:
+		return sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{})
+	default:
+		return ""
+	}
+}
+
+// Accept implements Authorization.Accept. A staking Msg of the authorized type
+// is accepted if its validator is allowed and its amount does not exceed the
+// remaining max tokens, which are reduced accordingly.
+func (a StakeAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (exported.AcceptResponse, error) {
+	var (
+		validator sdk.ValAddress
+		amount    sdk.Coin
+	)
+
+	switch msg := msg.(type) {
+	case *stakingtypes.MsgDelegate:
+		validator, amount = msg.ValidatorAddress, msg.Amount
+	case *stakingtypes.MsgUndelegate:
+		validator, amount = msg.ValidatorAddress, msg.Amount
+	case *stakingtypes.MsgBeginRedelegate:
+		validator, amount = msg.ValidatorDstAddress, msg.Amount
+	default:
+		return exported.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "unknown staking msg type %T", msg)
+	}
+
+	if sdk.MsgTypeURL(msg) != a.MsgTypeURL() {
+		return exported.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %s, got %s", a.MsgTypeURL(), sdk.MsgTypeURL(msg))
+	}
+
+	if allowList := a.GetAllowList(); allowList != nil && !containsValidator(allowList.Address, validator) {
+		return exported.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot delegate/undelegate to %s validator", validator)
+	}
+
+	if denyList := a.GetDenyList(); denyList != nil && containsValidator(denyList.Address, validator) {
+		return exported.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot delegate/undelegate to %s validator", validator)
+	}
+
+	if a.MaxTokens == nil {
+		return exported.AcceptResponse{Accept: true}, nil
+	}
+
+	if a.MaxTokens.Denom != amount.Denom || a.MaxTokens.IsLT(amount) {
+		return exported.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "requested amount %s is more than max tokens %s", amount, a.MaxTokens)
+	}
+
+	limitLeft := a.MaxTokens.Sub(amount)
+	if limitLeft.IsZero() {
+		return exported.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	updated := a
+	updated.MaxTokens = &limitLeft
+
+	return exported.AcceptResponse{Accept: true, Updated: &updated}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a StakeAuthorization) ValidateBasic() error {
+	if a.MaxTokens != nil && !a.MaxTokens.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, a.MaxTokens.String())
+	}
+
+	if a.MaxTokens != nil && a.MaxTokens.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidAuthorization, "max tokens should be positive")
+	}
+
+	if a.MsgTypeURL() == "" {
+		return sdkerrors.Wrap(ErrInvalidAuthorization, "unknown authorization type")
+	}
+
+	if a.GetAllowList() == nil && a.GetDenyList() == nil {
+		return sdkerrors.Wrap(ErrInvalidAuthorization, "either an allowed or a denied validator list must be set")
+	}
+
+	return nil
+}
+
+func containsValidator(validators []sdk.ValAddress, validator sdk.ValAddress) bool {
+	for _, v := range validators {
+		if v.Equals(validator) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -144,7 +144,9 @@ func (s *IntegrationTestSuite) TestGetCmdQueryTotalSupply() {
 				Supply: sdk.NewCoins(
 					sdk.NewCoin(fmt.Sprintf("%stoken", val.Moniker), s.cfg.AccountTokens),
 					sdk.NewCoin(s.cfg.BondDenom, s.cfg.StakingTokens.Add(sdk.NewInt(10))),
-				)},
+				),
+				Pagination: &query.PageResponse{Total: 0},
+			},
 		},
 		{
 			name: "total supply of a specific denomination",
@@ -32,7 +32,10 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetBalancesCmd(),
+		GetSpendableBalancesCmd(),
 		GetCmdQueryTotalSupply(),
+		GetCmdDenomsMetadata(),
+		GetCmdDenomOwners(),
 	)
 
 	return cmd
@@ -104,6 +107,55 @@ Example:
 	return cmd
 }
 
+func GetSpendableBalancesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spendable-balances [address]",
+		Short: "Query for account spendable balances by address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the spendable balance of an account, i.e. its balance minus the coins locked by vesting.
+
+Example:
+  $ %s query %s spendable-balances [address]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQuerySpendableBalancesRequest(addr, pageReq)
+			res, err := queryClient.SpendableBalances(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "spendable balances")
+
+	return cmd
+}
+
 func GetCmdQueryTotalSupply() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "total",
@@ -135,7 +187,12 @@ To query for the total supply of a specific coin denomination use:
 			queryClient := types.NewQueryClient(clientCtx)
 
 			if denom == "" {
-				res, err := queryClient.TotalSupply(context.Background(), &types.QueryTotalSupplyRequest{})
+				pageReq, err := client.ReadPageRequest(cmd.Flags())
+				if err != nil {
+					return err
+				}
+
+				res, err := queryClient.TotalSupply(context.Background(), &types.QueryTotalSupplyRequest{Pagination: pageReq})
 				if err != nil {
 					return err
 				}
@@ -154,6 +211,115 @@ To query for the total supply of a specific coin denomination use:
 
 	cmd.Flags().String(FlagDenom, "", "The specific balance denomination to query for")
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "total supply")
+
+	return cmd
+}
+
+func GetCmdDenomsMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-metadata",
+		Short: "Query the client metadata for coin denominations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the client metadata for all the registered coin denominations
+
+Example:
+  To query for the client metadata of all coin denominations use:
+  $ %s query %s denom-metadata
+
+To query for the client metadata of a specific coin denomination use:
+  $ %s query %s denom-metadata --denom=[denom]
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if denom == "" {
+				pageReq, err := client.ReadPageRequest(cmd.Flags())
+				if err != nil {
+					return err
+				}
+
+				res, err := queryClient.DenomsMetadata(context.Background(), types.NewQueryDenomsMetadataRequest(pageReq))
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintOutput(res)
+			}
+
+			res, err := queryClient.DenomMetadata(context.Background(), types.NewQueryDenomMetadataRequest(denom))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.Metadata)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "The specific denomination to query client metadata for")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denoms metadata")
+
+	return cmd
+}
+
+func GetCmdDenomOwners() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-owners [denom]",
+		Short: "Query the accounts holding a coin denomination",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the accounts holding a given coin denomination, together with their balance.
+
+Example:
+  $ %s query %s denom-owners [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if err := sdk.ValidateDenom(args[0]); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomOwners(context.Background(), types.NewQueryDenomOwnersRequest(args[0], pageReq))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denom owners")
 
 	return cmd
 }
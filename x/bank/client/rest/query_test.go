@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 type IntegrationTestSuite struct {
@@ -24,10 +25,29 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	cfg := network.DefaultConfig()
 	cfg.NumValidators = 1
 
+	var bankGenesis types.GenesisState
+	s.Require().NoError(cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &bankGenesis))
+
+	bankGenesis.DenomMetadata = []types.Metadata{
+		{
+			Description: "The native staking token of the Cosmos Hub.",
+			DenomUnits: []*types.DenomUnits{
+				{Denom: "uatom", Exponent: 0, Aliases: []string{"microatom"}},
+				{Denom: "atom", Exponent: 6},
+			},
+			Base:    "uatom",
+			Display: "atom",
+		},
+	}
+
+	bankGenesisBz, err := cfg.Codec.MarshalJSON(&bankGenesis)
+	s.Require().NoError(err)
+	cfg.GenesisState[types.ModuleName] = bankGenesisBz
+
 	s.cfg = cfg
 	s.network = network.New(s.T(), cfg)
 
-	_, err := s.network.WaitForHeight(1)
+	_, err = s.network.WaitForHeight(1)
 	s.Require().NoError(err)
 }
 
@@ -130,6 +150,26 @@ func (s *IntegrationTestSuite) TestTotalSupplyHandlerFn() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestDenomMetadataGRPCGateway() {
+	val := s.network.Validators[0]
+	baseURL := val.APIAddress
+
+	resp, err := rest.GetRequest(fmt.Sprintf("%s/cosmos/bank/v1beta1/denoms_metadata", baseURL))
+	s.Require().NoError(err)
+
+	var denomsMetadata types.QueryDenomsMetadataResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(resp, &denomsMetadata))
+	s.Require().Len(denomsMetadata.Metadatas, 1)
+	s.Require().Equal("uatom", denomsMetadata.Metadatas[0].Base)
+
+	resp, err = rest.GetRequest(fmt.Sprintf("%s/cosmos/bank/v1beta1/denoms_metadata/uatom", baseURL))
+	s.Require().NoError(err)
+
+	var denomMetadata types.QueryDenomMetadataResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(resp, &denomMetadata))
+	s.Require().Equal(denomsMetadata.Metadatas[0], denomMetadata.Metadata)
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
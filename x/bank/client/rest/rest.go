@@ -1,11 +1,34 @@
 package rest
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
 	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
+// SetDenomMetadataProposalReq defines a set denom metadata proposal request body.
+type SetDenomMetadataProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Metadata    types.Metadata `json:"metadata" yaml:"metadata"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
 // RegisterHandlers registers all x/bank transaction and query HTTP REST handlers
 // on the provided mux router.
 func RegisterHandlers(clientCtx client.Context, r *mux.Router) {
@@ -14,3 +37,46 @@ func RegisterHandlers(clientCtx client.Context, r *mux.Router) {
 	r.HandleFunc("/bank/total", totalSupplyHandlerFn(clientCtx)).Methods("GET")
 	r.HandleFunc("/bank/total/{denom}", supplyOfHandlerFn(clientCtx)).Methods("GET")
 }
+
+// RegisterGRPCGatewayRoutes mounts the x/bank query service's GRPC-gateway
+// routes on the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientConn)); err != nil {
+		panic(fmt.Errorf("failed to register bank query service gateway routes: %w", err))
+	}
+}
+
+// SetDenomMetadataProposalRESTHandler returns a ProposalRESTHandler that
+// exposes the set denom metadata REST handler with a given sub-route.
+func SetDenomMetadataProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set_denom_metadata",
+		Handler:  postSetDenomMetadataProposalHandlerFn(clientCtx),
+	}
+}
+
+func postSetDenomMetadataProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SetDenomMetadataProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewSetDenomMetadataProposal(req.Title, req.Description, req.Metadata)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
@@ -16,19 +16,30 @@ func (suite *IntegrationTestSuite) TestExportGenesis() {
 		suite.Require().NoError(err)
 	}
 
-	totalSupply := types.NewSupply(sdk.NewCoins(sdk.NewInt64Coin("test", 400000000)))
-	app.BankKeeper.SetSupply(ctx, totalSupply)
+	totalSupply := sdk.NewCoins(sdk.NewInt64Coin("test", 400000000))
+	app.BankKeeper.SetSupply(ctx, totalSupply[0])
 	app.BankKeeper.SetParams(ctx, types.DefaultParams())
 
 	exportGenesis := app.BankKeeper.ExportGenesis(ctx)
 
 	suite.Require().Len(exportGenesis.Params.SendEnabled, 0)
 	suite.Require().Equal(types.DefaultParams().DefaultSendEnabled, exportGenesis.Params.DefaultSendEnabled)
-	suite.Require().Equal(totalSupply.GetTotal(), exportGenesis.Supply)
+	suite.Require().Equal(totalSupply, exportGenesis.Supply)
 	suite.Require().Equal(expectedBalances, exportGenesis.Balances)
 	suite.Require().Equal(expectedMetadata, exportGenesis.DenomMetadata)
 }
 
+func (suite *IntegrationTestSuite) TestInitGenesis() {
+	app, ctx := suite.app, suite.ctx
+
+	expectedMetadata := suite.getTestMetadata()
+	genesis := types.NewGenesisState(types.DefaultParams(), nil, nil, expectedMetadata)
+
+	app.BankKeeper.InitGenesis(ctx, *genesis)
+
+	suite.Require().Equal(expectedMetadata, app.BankKeeper.ExportGenesis(ctx).DenomMetadata)
+}
+
 func (suite *IntegrationTestSuite) getTestBalances() []types.Balance {
 	addr2, _ := sdk.AccAddressFromBech32("cosmos1f9xjhxm0plzrh9cskf4qee4pc2xwp0n0556gh0")
 	addr1, _ := sdk.AccAddressFromBech32("cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh")
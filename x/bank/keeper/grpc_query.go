@@ -69,12 +69,62 @@ func (q BaseKeeper) AllBalances(c context.Context, req *types.QueryAllBalancesRe
 	return &types.QueryAllBalancesResponse{Balances: balances, Pagination: pageRes}, nil
 }
 
+// SpendableBalances implements the Query/SpendableBalances gRPC method. The
+// coins locked by vesting at the current block time are subtracted from the
+// balance of each denom. The fully locked denoms are left out, so that the
+// balances are valid coins, and a page may hold fewer coins than its limit.
+func (q BaseKeeper) SpendableBalances(c context.Context, req *types.QuerySpendableBalancesRequest) (*types.QuerySpendableBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr := req.Address
+	if addr.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	locked := q.LockedCoins(ctx, addr)
+
+	balances := sdk.Coins{}
+	store := ctx.KVStore(q.storeKey)
+	balancesStore := prefix.NewStore(store, types.BalancesPrefix)
+	accountStore := prefix.NewStore(balancesStore, addr.Bytes())
+
+	pageRes, err := query.Paginate(accountStore, req.Pagination, func(_, value []byte) error {
+		var balance sdk.Coin
+		if err := q.cdc.UnmarshalBinaryBare(value, &balance); err != nil {
+			return err
+		}
+
+		spendable := balance.Amount.Sub(locked.AmountOf(balance.Denom))
+		if spendable.IsPositive() {
+			balances = append(balances, sdk.NewCoin(balance.Denom, spendable))
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySpendableBalancesResponse{Balances: balances, Pagination: pageRes}, nil
+}
+
 // TotalSupply implements the Query/TotalSupply gRPC method
-func (q BaseKeeper) TotalSupply(c context.Context, _ *types.QueryTotalSupplyRequest) (*types.QueryTotalSupplyResponse, error) {
+func (q BaseKeeper) TotalSupply(c context.Context, req *types.QueryTotalSupplyRequest) (*types.QueryTotalSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
-	totalSupply := q.GetSupply(ctx).GetTotal()
+	totalSupply, pageRes, err := q.GetPaginatedTotalSupply(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	return &types.QueryTotalSupplyResponse{Supply: totalSupply}, nil
+	return &types.QueryTotalSupplyResponse{Supply: totalSupply, Pagination: pageRes}, nil
 }
 
 // SupplyOf implements the Query/SupplyOf gRPC method
@@ -88,7 +138,84 @@ func (q BaseKeeper) SupplyOf(c context.Context, req *types.QuerySupplyOfRequest)
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	supply := q.GetSupply(ctx).GetTotal().AmountOf(req.Denom)
+	supply := q.GetSupply(ctx, req.Denom)
+
+	return &types.QuerySupplyOfResponse{Amount: supply}, nil
+}
+
+// DenomMetadata implements the Query/DenomMetadata gRPC method
+func (q BaseKeeper) DenomMetadata(c context.Context, req *types.QueryDenomMetadataRequest) (*types.QueryDenomMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !q.HasDenomMetaData(ctx, req.Denom) {
+		return nil, status.Errorf(codes.NotFound, "client metadata for denom %s", req.Denom)
+	}
+
+	return &types.QueryDenomMetadataResponse{Metadata: q.GetDenomMetaData(ctx, req.Denom)}, nil
+}
+
+// DenomsMetadata implements the Query/DenomsMetadata gRPC method
+func (q BaseKeeper) DenomsMetadata(c context.Context, req *types.QueryDenomsMetadataRequest) (*types.QueryDenomsMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.DenomMetadataPrefix)
+
+	metadatas := []types.Metadata{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var metadata types.Metadata
+		if err := q.cdc.UnmarshalBinaryBare(value, &metadata); err != nil {
+			return err
+		}
+
+		metadatas = append(metadatas, metadata)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomsMetadataResponse{Metadatas: metadatas, Pagination: pageRes}, nil
+}
+
+// DenomOwners implements the Query/DenomOwners gRPC method
+func (q BaseKeeper) DenomOwners(c context.Context, req *types.QueryDenomOwnersRequest) (*types.QueryDenomOwnersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.CreateDenomAddressPrefix(req.Denom))
+
+	denomOwners := []*types.DenomOwner{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		address := sdk.AccAddress(key)
+
+		denomOwners = append(denomOwners, &types.DenomOwner{
+			Address: address,
+			Balance: q.GetBalance(ctx, address, req.Denom),
+		})
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	return &types.QuerySupplyOfResponse{Amount: sdk.NewCoin(req.Denom, supply)}, nil
+	return &types.QueryDenomOwnersResponse{DenomOwners: denomOwners, Pagination: pageRes}, nil
 }
@@ -2,11 +2,15 @@ package keeper_test
 
 import (
 	gocontext "context"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -82,16 +86,99 @@ func (suite *IntegrationTestSuite) TestQueryAllBalances() {
 	suite.Nil(res.Pagination.NextKey)
 }
 
+func (suite *IntegrationTestSuite) TestSpendableBalances() {
+	app, ctx := suite.app, suite.ctx
+	_, _, addr := testdata.KeyTestPubAddr()
+	ctx = ctx.WithBlockTime(time.Now())
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.BankKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	_, err := queryClient.SpendableBalances(gocontext.Background(), &types.QuerySpendableBalancesRequest{})
+	suite.Require().Error(err)
+
+	pageReq := &query.PageRequest{
+		Key:        nil,
+		Limit:      2,
+		CountTotal: false,
+	}
+	req := types.NewQuerySpendableBalancesRequest(addr, pageReq)
+
+	res, err := queryClient.SpendableBalances(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+	suite.True(res.Balances.IsZero())
+
+	fooCoins := newFooCoin(50)
+	barCoins := newBarCoin(30)
+
+	origCoins := sdk.NewCoins(fooCoins, barCoins)
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	acc = vestingtypes.NewContinuousVestingAccount(
+		acc.(*authtypes.BaseAccount),
+		sdk.NewCoins(fooCoins),
+		ctx.BlockTime().Unix(),
+		ctx.BlockTime().Add(time.Hour).Unix(),
+	)
+
+	app.AccountKeeper.SetAccount(ctx, acc)
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, acc.GetAddress(), origCoins))
+
+	// move time forward for some tokens to vest
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(30 * time.Minute))
+	queryHelper = baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.BankKeeper)
+	queryClient = types.NewQueryClient(queryHelper)
+
+	res, err = queryClient.SpendableBalances(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+	suite.Require().Equal(sdk.NewCoins(barCoins, newFooCoin(25)), res.Balances)
+
+	// the whole balance of a denom can be locked
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, acc.GetAddress(), sdk.NewCoins(newFooCoin(20), barCoins)))
+
+	res, err = queryClient.SpendableBalances(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(barCoins), res.Balances)
+	suite.Require().True(res.Balances.IsValid())
+}
+
 func (suite *IntegrationTestSuite) TestQueryTotalSupply() {
 	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
-	expectedTotalSupply := types.NewSupply(sdk.NewCoins(sdk.NewInt64Coin("test", 400000000)))
-	app.BankKeeper.SetSupply(ctx, expectedTotalSupply)
+	expectedTotalSupply := sdk.NewCoins(sdk.NewInt64Coin("test", 400000000))
+	app.BankKeeper.SetSupply(ctx, expectedTotalSupply[0])
 
 	res, err := queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{})
 	suite.Require().NoError(err)
 	suite.Require().NotNil(res)
 
-	suite.Require().Equal(expectedTotalSupply.Total, res.Supply)
+	suite.Require().Equal(expectedTotalSupply, res.Supply)
+}
+
+func (suite *IntegrationTestSuite) TestQueryTotalSupplyPagination() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	expectedTotalSupply := sdk.NewCoins(
+		sdk.NewInt64Coin("test1", 100),
+		sdk.NewInt64Coin("test2", 200),
+		sdk.NewInt64Coin("test3", 300),
+	)
+	for _, coin := range expectedTotalSupply {
+		app.BankKeeper.SetSupply(ctx, coin)
+	}
+
+	pageReq := &query.PageRequest{Limit: 2, CountTotal: true}
+	res, err := queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{Pagination: pageReq})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedTotalSupply[:2], res.Supply)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}
+	res, err = queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{Pagination: pageReq})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedTotalSupply[2:], res.Supply)
+	suite.Require().Nil(res.Pagination.NextKey)
 }
 
 func (suite *IntegrationTestSuite) TestQueryTotalSupplyOf() {
@@ -99,8 +186,8 @@ func (suite *IntegrationTestSuite) TestQueryTotalSupplyOf() {
 
 	test1Supply := sdk.NewInt64Coin("test1", 4000000)
 	test2Supply := sdk.NewInt64Coin("test2", 700000000)
-	expectedTotalSupply := types.NewSupply(sdk.NewCoins(test1Supply, test2Supply))
-	app.BankKeeper.SetSupply(ctx, expectedTotalSupply)
+	app.BankKeeper.SetSupply(ctx, test1Supply)
+	app.BankKeeper.SetSupply(ctx, test2Supply)
 
 	_, err := queryClient.SupplyOf(gocontext.Background(), &types.QuerySupplyOfRequest{})
 	suite.Require().Error(err)
@@ -111,3 +198,98 @@ func (suite *IntegrationTestSuite) TestQueryTotalSupplyOf() {
 
 	suite.Require().Equal(test1Supply, res.Amount)
 }
+
+func (suite *IntegrationTestSuite) TestQueryDenomMetadata() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	_, err := queryClient.DenomMetadata(gocontext.Background(), &types.QueryDenomMetadataRequest{})
+	suite.Require().Error(err)
+
+	req := types.NewQueryDenomMetadataRequest("uatom")
+	_, err = queryClient.DenomMetadata(gocontext.Background(), req)
+	suite.Require().Error(err)
+
+	expMetadata := suite.getTestMetadata()[0]
+	app.BankKeeper.SetDenomMetaData(ctx, expMetadata)
+
+	res, err := queryClient.DenomMetadata(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+	suite.Require().Equal(expMetadata, res.Metadata)
+}
+
+func (suite *IntegrationTestSuite) TestQueryDenomsMetadata() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	res, err := queryClient.DenomsMetadata(gocontext.Background(), types.NewQueryDenomsMetadataRequest(nil))
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Metadatas)
+
+	expMetadata := suite.getTestMetadata()
+	for _, metadata := range expMetadata {
+		app.BankKeeper.SetDenomMetaData(ctx, metadata)
+	}
+
+	pageReq := &query.PageRequest{
+		Limit:      1,
+		CountTotal: true,
+	}
+	res, err = queryClient.DenomsMetadata(gocontext.Background(), types.NewQueryDenomsMetadataRequest(pageReq))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Metadata{expMetadata[0]}, res.Metadatas)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	suite.T().Log("query second page with nextkey")
+	pageReq = &query.PageRequest{
+		Key:   res.Pagination.NextKey,
+		Limit: 1,
+	}
+	res, err = queryClient.DenomsMetadata(gocontext.Background(), types.NewQueryDenomsMetadataRequest(pageReq))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Metadata{expMetadata[1]}, res.Metadatas)
+	suite.Require().Nil(res.Pagination.NextKey)
+}
+
+func (suite *IntegrationTestSuite) TestQueryDenomOwners() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	_, err := queryClient.DenomOwners(gocontext.Background(), &types.QueryDenomOwnersRequest{})
+	suite.Require().Error(err)
+
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+		sdk.AccAddress([]byte("addr2_______________")),
+		sdk.AccAddress([]byte("addr3_______________")),
+	}
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addrs[0], sdk.NewCoins(newFooCoin(10), newBarCoin(20))))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addrs[1], sdk.NewCoins(newFooCoin(30))))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addrs[2], sdk.NewCoins(newBarCoin(40))))
+
+	req := &types.QueryDenomOwnersRequest{
+		Denom:      fooDenom,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	}
+	res, err := queryClient.DenomOwners(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.DenomOwner{{Address: addrs[0], Balance: newFooCoin(10)}}, res.DenomOwners)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}
+	res, err = queryClient.DenomOwners(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.DenomOwner{{Address: addrs[1], Balance: newFooCoin(30)}}, res.DenomOwners)
+	suite.Require().Nil(res.Pagination.NextKey)
+
+	res, err = queryClient.DenomOwners(gocontext.Background(), &types.QueryDenomOwnersRequest{Denom: barDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.DenomOwner{
+		{Address: addrs[0], Balance: newBarCoin(20)},
+		{Address: addrs[2], Balance: newBarCoin(40)},
+	}, res.DenomOwners)
+
+	res, err = queryClient.DenomOwners(gocontext.Background(), &types.QueryDenomOwnersRequest{Denom: "unknown"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.DenomOwners)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -11,12 +12,18 @@ import (
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "nonnegative-outstanding", NonnegativeBalanceInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "total-supply", TotalSupply(k))
+	ir.RegisterRoute(types.ModuleName, "denom-owners", DenomOwnersInvariant(k))
 }
 
 // AllInvariants runs all invariants of the X/bank module.
 func AllInvariants(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		return TotalSupply(k)(ctx)
+		res, stop := TotalSupply(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return DenomOwnersInvariant(k)(ctx)
 	}
 }
 
@@ -50,19 +57,80 @@ func NonnegativeBalanceInvariant(k ViewKeeper) sdk.Invariant {
 func TotalSupply(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		expectedTotal := sdk.Coins{}
-		supply := k.GetSupply(ctx)
+		supply := sdk.Coins{}
+		k.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+			supply = supply.Add(coin)
+			return false
+		})
 
 		k.IterateAllBalances(ctx, func(_ sdk.AccAddress, balance sdk.Coin) bool {
 			expectedTotal = expectedTotal.Add(balance)
 			return false
 		})
 
-		broken := !expectedTotal.IsEqual(supply.GetTotal())
+		broken := !expectedTotal.IsEqual(supply)
 
 		return sdk.FormatInvariant(types.ModuleName, "total supply",
 			fmt.Sprintf(
 				"\tsum of accounts coins: %v\n"+
 					"\tsupply.Total:          %v\n",
-				expectedTotal, supply.GetTotal())), broken
+				expectedTotal, supply)), broken
 	}
 }
+
+// DenomOwnersInvariant checks that the denom to address index references
+// exactly the accounts holding a non-zero balance of each denomination.
+func DenomOwnersInvariant(k ViewKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		indexed := make(map[string]bool)
+		k.IterateAllDenomOwners(ctx, func(addr sdk.AccAddress, denom string) bool {
+			indexed[denomOwnerKey(addr, denom)] = true
+			return false
+		})
+
+		k.IterateAllBalances(ctx, func(addr sdk.AccAddress, balance sdk.Coin) bool {
+			key := denomOwnerKey(addr, balance.Denom)
+
+			switch {
+			case balance.IsZero() && indexed[key]:
+				count++
+				msg += fmt.Sprintf("\t%s is indexed as a %s owner with a zero balance\n", addr, balance.Denom)
+
+			case !balance.IsZero() && !indexed[key]:
+				count++
+				msg += fmt.Sprintf("\t%s holds %s but is not indexed as a %s owner\n", addr, balance, balance.Denom)
+			}
+
+			delete(indexed, key)
+			return false
+		})
+
+		// sort the remaining entries to keep the invariant message deterministic
+		remaining := make([]string, 0, len(indexed))
+		for key := range indexed {
+			remaining = append(remaining, key)
+		}
+		sort.Strings(remaining)
+
+		for _, key := range remaining {
+			count++
+			msg += fmt.Sprintf("\t%s is indexed without a balance\n", key)
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "denom-owners",
+			fmt.Sprintf("amount of inconsistent denom owner index entries found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+func denomOwnerKey(addr sdk.AccAddress, denom string) string {
+	return fmt.Sprintf("%s/%s", denom, addr)
+}
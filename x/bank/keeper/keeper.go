@@ -8,9 +8,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
-	"github.com/cosmos/cosmos-sdk/x/bank/exported"
+	v040 "github.com/cosmos/cosmos-sdk/x/bank/legacy/v0_40"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -24,11 +25,16 @@ type Keeper interface {
 
 	InitGenesis(sdk.Context, types.GenesisState)
 	ExportGenesis(sdk.Context) *types.GenesisState
+	MigrateStore(ctx sdk.Context) error
 
-	GetSupply(ctx sdk.Context) exported.SupplyI
-	SetSupply(ctx sdk.Context, supply exported.SupplyI)
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+	HasSupply(ctx sdk.Context, denom string) bool
+	SetSupply(ctx sdk.Context, coin sdk.Coin)
+	GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
+	IterateTotalSupply(ctx sdk.Context, cb func(sdk.Coin) bool)
 
 	GetDenomMetaData(ctx sdk.Context, denom string) types.Metadata
+	HasDenomMetaData(ctx sdk.Context, denom string) bool
 	SetDenomMetaData(ctx sdk.Context, denomMetaData types.Metadata)
 	IterateAllDenomMetaData(ctx sdk.Context, cb func(types.Metadata) bool)
 
@@ -42,8 +48,6 @@ type Keeper interface {
 
 	DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
-	MarshalSupply(supplyI exported.SupplyI) ([]byte, error)
-	UnmarshalSupply(bz []byte) (exported.SupplyI, error)
 
 	types.QueryServer
 }
@@ -153,31 +157,103 @@ func (k BaseKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAdd
 	return nil
 }
 
-// GetSupply retrieves the Supply from store
-func (k BaseKeeper) GetSupply(ctx sdk.Context) exported.SupplyI {
+// MigrateStore migrates the x/bank store in place from its v0.39 layout, see
+// v040.MigrateStore. It is meant to be called from an upgrade handler.
+func (k BaseKeeper) MigrateStore(ctx sdk.Context) error {
+	return v040.MigrateStore(ctx, k.storeKey, k.cdc)
+}
+
+// GetSupply retrieves the total supply of a denom from store. A zero coin is
+// returned if the denom has no supply.
+func (k BaseKeeper) GetSupply(ctx sdk.Context, denom string) sdk.Coin {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.SupplyKey)
+	supplyStore := prefix.NewStore(store, types.SupplyKey)
+
+	bz := supplyStore.Get([]byte(denom))
 	if bz == nil {
-		panic("stored supply should not have been nil")
+		return sdk.NewCoin(denom, sdk.ZeroInt())
 	}
 
-	supply, err := k.UnmarshalSupply(bz)
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(fmt.Errorf("unable to unmarshal supply value %v", err))
+	}
+
+	return sdk.NewCoin(denom, amount)
+}
+
+// HasSupply checks if the supply of a denom exists in store.
+func (k BaseKeeper) HasSupply(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	supplyStore := prefix.NewStore(store, types.SupplyKey)
+
+	return supplyStore.Has([]byte(denom))
+}
+
+// SetSupply sets the total supply of a denom to store. The supply of a denom
+// is removed from store when it is zero.
+func (k BaseKeeper) SetSupply(ctx sdk.Context, coin sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	supplyStore := prefix.NewStore(store, types.SupplyKey)
+
+	if coin.IsZero() {
+		supplyStore.Delete([]byte(coin.Denom))
+		return
+	}
+
+	bz, err := coin.Amount.Marshal()
 	if err != nil {
 		panic(err)
 	}
 
-	return supply
+	supplyStore.Set([]byte(coin.Denom), bz)
 }
 
-// SetSupply sets the Supply to store
-func (k BaseKeeper) SetSupply(ctx sdk.Context, supply exported.SupplyI) {
+// GetPaginatedTotalSupply queries for the supply, ignoring 0 coins, with a
+// given pagination.
+func (k BaseKeeper) GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error) {
 	store := ctx.KVStore(k.storeKey)
-	bz, err := k.MarshalSupply(supply)
+	supplyStore := prefix.NewStore(store, types.SupplyKey)
+
+	supply := sdk.NewCoins()
+
+	pageRes, err := query.Paginate(supplyStore, pagination, func(key, value []byte) error {
+		var amount sdk.Int
+		if err := amount.Unmarshal(value); err != nil {
+			return fmt.Errorf("unable to convert amount string to Int %v", err)
+		}
+
+		// `Add` omits the 0 coins addition to the `supply`.
+		supply = supply.Add(sdk.NewCoin(string(key), amount))
+		return nil
+	})
 	if err != nil {
-		panic(err)
+		return nil, nil, err
 	}
 
-	store.Set(types.SupplyKey, bz)
+	return supply, pageRes, nil
+}
+
+// IterateTotalSupply iterates over the total supply of every denom, sorted by
+// denom, and calls the given callback with it. If true is returned from the
+// callback, iteration is halted.
+func (k BaseKeeper) IterateTotalSupply(ctx sdk.Context, cb func(sdk.Coin) bool) {
+	store := ctx.KVStore(k.storeKey)
+	supplyStore := prefix.NewStore(store, types.SupplyKey)
+
+	iterator := supplyStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(fmt.Errorf("unable to unmarshal supply value %v", err))
+		}
+
+		if cb(sdk.NewCoin(string(iterator.Key()), amount)) {
+			break
+		}
+	}
 }
 
 // GetDenomMetaData retrieves the denomination metadata
@@ -193,6 +269,14 @@ func (k BaseKeeper) GetDenomMetaData(ctx sdk.Context, denom string) types.Metada
 	return metadata
 }
 
+// HasDenomMetaData checks if the denomination metadata exists in store.
+func (k BaseKeeper) HasDenomMetaData(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	store = prefix.NewStore(store, types.DenomMetadataKey(denom))
+
+	return store.Has([]byte(denom))
+}
+
 // GetAllDenomMetaData retrieves all denominations metadata
 func (k BaseKeeper) GetAllDenomMetaData(ctx sdk.Context) []types.Metadata {
 	denomMetaData := make([]types.Metadata, 0)
@@ -336,10 +420,10 @@ func (k BaseKeeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins)
 	}
 
 	// update total supply
-	supply := k.GetSupply(ctx)
-	supply.Inflate(amt)
-
-	k.SetSupply(ctx, supply)
+	for _, coin := range amt {
+		supply := k.GetSupply(ctx, coin.Denom)
+		k.SetSupply(ctx, supply.Add(coin))
+	}
 
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("minted %s from %s module account", amt.String(), moduleName))
@@ -365,9 +449,10 @@ func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins)
 	}
 
 	// update total supply
-	supply := k.GetSupply(ctx)
-	supply.Deflate(amt)
-	k.SetSupply(ctx, supply)
+	for _, coin := range amt {
+		supply := k.GetSupply(ctx, coin.Denom)
+		k.SetSupply(ctx, supply.Sub(coin))
+	}
 
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("burned %s from %s module account", amt.String(), moduleName))
@@ -404,22 +489,3 @@ func (k BaseKeeper) trackUndelegation(ctx sdk.Context, addr sdk.AccAddress, amt
 
 	return nil
 }
-
-// MarshalSupply marshals a Supply interface. If the given type implements
-// the Marshaler interface, it is treated as a Proto-defined message and
-// serialized that way. Otherwise, it falls back on the internal Amino codec.
-func (k BaseKeeper) MarshalSupply(supplyI exported.SupplyI) ([]byte, error) {
-	return codec.MarshalAny(k.cdc, supplyI)
-}
-
-// UnmarshalSupply returns a Supply interface from raw encoded supply
-// bytes of a Proto-based Supply type. An error is returned upon decoding
-// failure.
-func (k BaseKeeper) UnmarshalSupply(bz []byte) (exported.SupplyI, error) {
-	var evi exported.SupplyI
-	if err := codec.UnmarshalAny(k.cdc, &evi, bz); err != nil {
-		return nil, err
-	}
-
-	return evi, nil
-}
@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
@@ -89,10 +90,43 @@ func (suite *IntegrationTestSuite) TestSupply() {
 	initTokens := sdk.TokensFromConsensusPower(initialPower)
 
 	totalSupply := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens))
-	app.BankKeeper.SetSupply(ctx, types.NewSupply(totalSupply))
+	for _, coin := range totalSupply {
+		app.BankKeeper.SetSupply(ctx, coin)
+	}
 
-	total := app.BankKeeper.GetSupply(ctx).GetTotal()
+	total, _, err := app.BankKeeper.GetPaginatedTotalSupply(ctx, &query.PageRequest{})
+	suite.Require().NoError(err)
 	suite.Require().Equal(totalSupply, total)
+	suite.Require().Equal(totalSupply[0], app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+	suite.Require().True(app.BankKeeper.HasSupply(ctx, sdk.DefaultBondDenom))
+
+	// a zero supply removes the denom from the store
+	app.BankKeeper.SetSupply(ctx, sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()))
+	suite.Require().False(app.BankKeeper.HasSupply(ctx, sdk.DefaultBondDenom))
+	suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()), app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+}
+
+func (suite *IntegrationTestSuite) TestIterateTotalSupply() {
+	app, ctx := suite.app, suite.ctx
+
+	totalSupply := sdk.NewCoins(newBarCoin(30), newFooCoin(20), sdk.NewInt64Coin("zoo", 10))
+	for _, coin := range totalSupply {
+		app.BankKeeper.SetSupply(ctx, coin)
+	}
+
+	var supply sdk.Coins
+	app.BankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		supply = append(supply, coin)
+		return false
+	})
+	suite.Require().Equal(totalSupply, supply)
+
+	supply = nil
+	app.BankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		supply = append(supply, coin)
+		return true
+	})
+	suite.Require().Equal(totalSupply[:1], supply)
 }
 
 func (suite *IntegrationTestSuite) TestSupply_SendCoins() {
@@ -120,7 +154,7 @@ func (suite *IntegrationTestSuite) TestSupply_SendCoins() {
 	baseAcc := authKeeper.NewAccountWithAddress(ctx, authtypes.NewModuleAddress("baseAcc"))
 	suite.Require().NoError(keeper.SetBalances(ctx, holderAcc.GetAddress(), initCoins))
 
-	keeper.SetSupply(ctx, types.NewSupply(initCoins))
+	keeper.SetSupply(ctx, initCoins[0])
 	authKeeper.SetModuleAccount(ctx, holderAcc)
 	authKeeper.SetModuleAccount(ctx, burnerAcc)
 	authKeeper.SetAccount(ctx, baseAcc)
@@ -185,7 +219,7 @@ func (suite *IntegrationTestSuite) TestSupply_MintCoins() {
 	authKeeper.SetModuleAccount(ctx, multiPermAcc)
 	authKeeper.SetModuleAccount(ctx, randomPermAcc)
 
-	initialSupply := keeper.GetSupply(ctx)
+	initialSupply := keeper.GetSupply(ctx, sdk.DefaultBondDenom)
 
 	suite.Require().Panics(func() { keeper.MintCoins(ctx, "", initCoins) }, "no module account")                // nolint:errcheck
 	suite.Require().Panics(func() { keeper.MintCoins(ctx, authtypes.Burner, initCoins) }, "invalid permission") // nolint:errcheck
@@ -199,16 +233,16 @@ func (suite *IntegrationTestSuite) TestSupply_MintCoins() {
 	suite.Require().NoError(err)
 
 	suite.Require().Equal(initCoins, getCoinsByName(ctx, keeper, authKeeper, authtypes.Minter))
-	suite.Require().Equal(initialSupply.GetTotal().Add(initCoins...), keeper.GetSupply(ctx).GetTotal())
+	suite.Require().Equal(initialSupply.Add(initCoins[0]), keeper.GetSupply(ctx, sdk.DefaultBondDenom))
 
 	// test same functionality on module account with multiple permissions
-	initialSupply = keeper.GetSupply(ctx)
+	initialSupply = keeper.GetSupply(ctx, sdk.DefaultBondDenom)
 
 	err = keeper.MintCoins(ctx, multiPermAcc.GetName(), initCoins)
 	suite.Require().NoError(err)
 
 	suite.Require().Equal(initCoins, getCoinsByName(ctx, keeper, authKeeper, multiPermAcc.GetName()))
-	suite.Require().Equal(initialSupply.GetTotal().Add(initCoins...), keeper.GetSupply(ctx).GetTotal())
+	suite.Require().Equal(initialSupply.Add(initCoins[0]), keeper.GetSupply(ctx, sdk.DefaultBondDenom))
 	suite.Require().Panics(func() { keeper.MintCoins(ctx, authtypes.Burner, initCoins) }) // nolint:errcheck
 }
 
@@ -235,27 +269,25 @@ func (suite *IntegrationTestSuite) TestSupply_BurnCoins() {
 	)
 
 	suite.Require().NoError(keeper.SetBalances(ctx, burnerAcc.GetAddress(), initCoins))
-	keeper.SetSupply(ctx, types.NewSupply(initCoins))
+	keeper.SetSupply(ctx, initCoins[0])
 	authKeeper.SetModuleAccount(ctx, burnerAcc)
 
-	initialSupply := keeper.GetSupply(ctx)
-	initialSupply.Inflate(initCoins)
+	initialSupply := keeper.GetSupply(ctx, sdk.DefaultBondDenom).Add(initCoins[0])
 	keeper.SetSupply(ctx, initialSupply)
 
-	suite.Require().Panics(func() { keeper.BurnCoins(ctx, "", initCoins) }, "no module account")                        // nolint:errcheck
-	suite.Require().Panics(func() { keeper.BurnCoins(ctx, authtypes.Minter, initCoins) }, "invalid permission")         // nolint:errcheck
-	suite.Require().Panics(func() { keeper.BurnCoins(ctx, randomPerm, initialSupply.GetTotal()) }, "random permission") // nolint:errcheck
-	err := keeper.BurnCoins(ctx, authtypes.Burner, initialSupply.GetTotal())
+	suite.Require().Panics(func() { keeper.BurnCoins(ctx, "", initCoins) }, "no module account")                           // nolint:errcheck
+	suite.Require().Panics(func() { keeper.BurnCoins(ctx, authtypes.Minter, initCoins) }, "invalid permission")            // nolint:errcheck
+	suite.Require().Panics(func() { keeper.BurnCoins(ctx, randomPerm, sdk.NewCoins(initialSupply)) }, "random permission") // nolint:errcheck
+	err := keeper.BurnCoins(ctx, authtypes.Burner, sdk.NewCoins(initialSupply))
 	suite.Require().Error(err, "insufficient coins")
 
 	err = keeper.BurnCoins(ctx, authtypes.Burner, initCoins)
 	suite.Require().NoError(err)
 	suite.Require().Equal(sdk.Coins(nil), getCoinsByName(ctx, keeper, authKeeper, authtypes.Burner))
-	suite.Require().Equal(initialSupply.GetTotal().Sub(initCoins), keeper.GetSupply(ctx).GetTotal())
+	suite.Require().Equal(initialSupply.Sub(initCoins[0]), keeper.GetSupply(ctx, sdk.DefaultBondDenom))
 
 	// test same functionality on module account with multiple permissions
-	initialSupply = keeper.GetSupply(ctx)
-	initialSupply.Inflate(initCoins)
+	initialSupply = keeper.GetSupply(ctx, sdk.DefaultBondDenom).Add(initCoins[0])
 	keeper.SetSupply(ctx, initialSupply)
 
 	suite.Require().NoError(keeper.SetBalances(ctx, multiPermAcc.GetAddress(), initCoins))
@@ -264,7 +296,7 @@ func (suite *IntegrationTestSuite) TestSupply_BurnCoins() {
 	err = keeper.BurnCoins(ctx, multiPermAcc.GetName(), initCoins)
 	suite.Require().NoError(err)
 	suite.Require().Equal(sdk.Coins(nil), getCoinsByName(ctx, keeper, authKeeper, multiPermAcc.GetName()))
-	suite.Require().Equal(initialSupply.GetTotal().Sub(initCoins), keeper.GetSupply(ctx).GetTotal())
+	suite.Require().Equal(initialSupply.Sub(initCoins[0]), keeper.GetSupply(ctx, sdk.DefaultBondDenom))
 }
 
 func (suite *IntegrationTestSuite) TestSendCoinsNewAccount() {
@@ -431,6 +463,63 @@ func (suite *IntegrationTestSuite) TestValidateBalance() {
 	suite.Require().Error(app.BankKeeper.ValidateBalance(ctx, addr2))
 }
 
+func (suite *IntegrationTestSuite) TestDenomOwnersIndex() {
+	app, ctx := suite.app, suite.ctx
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr2, sdk.NewCoins(newFooCoin(10))))
+
+	owners := func() map[string][]string {
+		res := make(map[string][]string)
+		app.BankKeeper.IterateAllDenomOwners(ctx, func(addr sdk.AccAddress, denom string) bool {
+			res[denom] = append(res[denom], addr.String())
+			return false
+		})
+		return res
+	}
+
+	suite.Require().Equal(map[string][]string{
+		barDenom: {addr1.String()},
+		fooDenom: {addr1.String(), addr2.String()},
+	}, owners())
+
+	_, broken := keeper.DenomOwnersInvariant(app.BankKeeper)(ctx)
+	suite.Require().False(broken)
+
+	// a zero balance removes the account from the index
+	suite.Require().NoError(app.BankKeeper.SetBalance(ctx, addr1, newBarCoin(0)))
+	suite.Require().Equal(map[string][]string{
+		fooDenom: {addr1.String(), addr2.String()},
+	}, owners())
+
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr2, sdk.NewCoins()))
+	suite.Require().Equal(map[string][]string{
+		fooDenom: {addr1.String()},
+	}, owners())
+
+	_, broken = keeper.DenomOwnersInvariant(app.BankKeeper)(ctx)
+	suite.Require().False(broken)
+
+	// an index entry without a balance breaks the invariant
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	store.Set(types.DenomAddressKey(barDenom, addr2), []byte{0})
+
+	msg, broken := keeper.DenomOwnersInvariant(app.BankKeeper)(ctx)
+	suite.Require().True(broken)
+	suite.Require().Contains(msg, "indexed without a balance")
+	store.Delete(types.DenomAddressKey(barDenom, addr2))
+
+	// a balance without an index entry breaks the invariant
+	store.Delete(types.DenomAddressKey(fooDenom, addr1))
+
+	msg, broken = keeper.DenomOwnersInvariant(app.BankKeeper)(ctx)
+	suite.Require().True(broken)
+	suite.Require().Contains(msg, "is not indexed")
+}
+
 func (suite *IntegrationTestSuite) TestBalance() {
 	app, ctx := suite.app, suite.ctx
 	addr := sdk.AccAddress([]byte("addr1"))
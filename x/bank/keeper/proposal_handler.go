@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// HandleSetDenomMetadataProposal is a handler for executing a passed set denom
+// metadata proposal. It adds the metadata of the proposal, or replaces the
+// existing metadata of its base denom.
+func HandleSetDenomMetadataProposal(ctx sdk.Context, k Keeper, p *types.SetDenomMetadataProposal) error {
+	if err := p.Metadata.Validate(); err != nil {
+		return err
+	}
+
+	k.SetDenomMetaData(ctx, p.Metadata)
+
+	ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName)).Info(fmt.Sprintf("set the metadata of denom %s", p.Metadata.Base))
+	return nil
+}
@@ -3,10 +3,10 @@ package keeper
 import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
-	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -74,13 +74,21 @@ func queryTotalSupply(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQu
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	totalSupply := k.GetSupply(ctx).GetTotal()
+	totalSupply := sdk.Coins{}
 
-	start, end := client.Paginate(len(totalSupply), params.Page, params.Limit, 100)
-	if start < 0 || end < 0 {
-		totalSupply = sdk.Coins{}
-	} else {
-		totalSupply = totalSupply[start:end]
+	// an invalid page yields an empty result, as in client.Paginate
+	if params.Page > 0 {
+		limit := params.Limit
+		if limit <= 0 {
+			limit = 100
+		}
+
+		pageReq := &query.PageRequest{Offset: uint64((params.Page - 1) * limit), Limit: uint64(limit)}
+
+		totalSupply, _, err = k.GetPaginatedTotalSupply(ctx, pageReq)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
 	}
 
 	res, err := legacyQuerierCdc.MarshalJSON(totalSupply)
@@ -99,8 +107,7 @@ func querySupplyOf(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQueri
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	amount := k.GetSupply(ctx).GetTotal().AmountOf(params.Denom)
-	supply := sdk.NewCoin(params.Denom, amount)
+	supply := k.GetSupply(ctx, params.Denom)
 
 	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, supply)
 	if err != nil {
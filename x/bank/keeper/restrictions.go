@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// sendRestriction holds the SendRestrictionFn chain of a send keeper.
+type sendRestriction struct {
+	fn types.SendRestrictionFn
+}
+
+func (r *sendRestriction) append(restriction types.SendRestrictionFn) {
+	r.fn = r.fn.Then(restriction)
+}
+
+func (r *sendRestriction) prepend(restriction types.SendRestrictionFn) {
+	r.fn = restriction.Then(r.fn)
+}
+
+func (r *sendRestriction) clear() {
+	r.fn = nil
+}
+
+func (r *sendRestriction) isSet() bool {
+	return r.fn != nil
+}
+
+// apply runs the send restrictions, if any, and returns the recipient the
+// coins must be sent to.
+func (r *sendRestriction) apply(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if r.fn == nil {
+		return toAddr, nil
+	}
+
+	newToAddr, err := r.fn(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return nil, err
+	}
+
+	if newToAddr.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "send restriction returned an empty recipient")
+	}
+
+	return newToAddr, nil
+}
+
+// sendHooks holds the BankHooks of a send keeper.
+type sendHooks struct {
+	hooks types.BankHooks
+}
+
+func (h *sendHooks) isSet() bool {
+	return h.hooks != nil
+}
+
+func (h *sendHooks) beforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if h.hooks == nil {
+		return nil
+	}
+
+	return h.hooks.BeforeSend(ctx, fromAddr, toAddr, amt)
+}
+
+func (h *sendHooks) afterSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) {
+	if h.hooks != nil {
+		h.hooks.AfterSend(ctx, fromAddr, toAddr, amt)
+	}
+}
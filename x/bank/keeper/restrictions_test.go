@@ -0,0 +1,311 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+type sendCall struct {
+	hook     string
+	from, to sdk.AccAddress
+	amt      sdk.Coins
+}
+
+// mockBankHooks records the calls made to the bank hooks.
+type mockBankHooks struct {
+	calls     []sendCall
+	beforeErr error
+}
+
+func (h *mockBankHooks) BeforeSend(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	h.calls = append(h.calls, sendCall{"before", fromAddr, toAddr, amt})
+	return h.beforeErr
+}
+
+func (h *mockBankHooks) AfterSend(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) {
+	h.calls = append(h.calls, sendCall{"after", fromAddr, toAddr, amt})
+}
+
+func (suite *IntegrationTestSuite) TestSendCoinsWithRestrictions() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, balances))
+
+	errRestricted := errors.New("restricted")
+	var order []string
+
+	// reject any transfer of bar coins
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		order = append(order, "reject")
+		if !amt.AmountOf(barDenom).IsZero() {
+			return nil, errRestricted
+		}
+		return toAddr, nil
+	})
+	// redirect the transfers to addr2 towards addr3
+	app.BankKeeper.PrependSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		order = append(order, "redirect")
+		if toAddr.Equals(addr2) {
+			return addr3, nil
+		}
+		return toAddr, nil
+	})
+	defer app.BankKeeper.ClearSendRestriction()
+
+	err := app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newBarCoin(10)))
+	suite.Require().True(errors.Is(err, errRestricted))
+	suite.Require().Equal([]string{"redirect", "reject"}, order)
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, addr1))
+
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(90), newBarCoin(50)), app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr2).IsZero())
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10)), app.BankKeeper.GetAllBalances(ctx, addr3))
+	suite.Require().NotNil(app.AccountKeeper.GetAccount(ctx, addr3))
+
+	// a restriction must not drop the recipient
+	app.BankKeeper.ClearSendRestriction()
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		return nil, nil
+	})
+	suite.Require().Error(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(10))))
+
+	app.BankKeeper.ClearSendRestriction()
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newBarCoin(10))))
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(10)), app.BankKeeper.GetAllBalances(ctx, addr2))
+}
+
+func (suite *IntegrationTestSuite) TestInputOutputCoinsWithRestrictions() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+	addr4 := sdk.AccAddress([]byte("addr4_______________"))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, balances))
+
+	var senders []sdk.AccAddress
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		senders = append(senders, fromAddr)
+		switch {
+		case toAddr.Equals(addr4):
+			return nil, errors.New("restricted")
+		case toAddr.Equals(addr2):
+			return addr3, nil
+		default:
+			return toAddr, nil
+		}
+	})
+	defer app.BankKeeper.ClearSendRestriction()
+
+	// a rejected output aborts the whole multi-send
+	inputs := []types.Input{{Address: addr1, Coins: sdk.NewCoins(newFooCoin(20))}}
+	outputs := []types.Output{
+		{Address: addr2, Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: addr4, Coins: sdk.NewCoins(newFooCoin(10))},
+	}
+	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().Equal([]sdk.AccAddress{addr1, addr1}, senders)
+
+	outputs[1].Address = addr1
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal(addr2, outputs[0].Address, "the outputs of the caller must not be modified")
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(90), newBarCoin(50)), app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr2).IsZero())
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10)), app.BankKeeper.GetAllBalances(ctx, addr3))
+
+	// the sender is unknown with more than one input
+	senders = nil
+	inputs = []types.Input{
+		{Address: addr1, Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: addr3, Coins: sdk.NewCoins(newFooCoin(10))},
+	}
+	outputs = []types.Output{{Address: addr1, Coins: sdk.NewCoins(newFooCoin(20))}}
+	err := app.BankKeeper.InputOutputCoins(ctx, inputs, outputs)
+	suite.Require().True(errors.Is(err, types.ErrMultipleSenders))
+	suite.Require().Empty(senders)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10)), app.BankKeeper.GetAllBalances(ctx, addr3))
+
+	app.BankKeeper.ClearSendRestriction()
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(100), newBarCoin(50)), app.BankKeeper.GetAllBalances(ctx, addr1))
+}
+
+func (suite *IntegrationTestSuite) TestMultiSendWithSenderRestriction() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	frozen := sdk.AccAddress([]byte("frozen______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(newFooCoin(100))))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, frozen, sdk.NewCoins(newFooCoin(100))))
+
+	errFrozen := errors.New("frozen account")
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if fromAddr.Equals(frozen) {
+			return nil, errFrozen
+		}
+		return toAddr, nil
+	})
+	defer app.BankKeeper.ClearSendRestriction()
+
+	_, err := msgServer.Send(sdk.WrapSDKContext(ctx), types.NewMsgSend(frozen, addr3, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().True(errors.Is(err, errFrozen))
+
+	// adding another input must not hide the frozen sender
+	msg := types.NewMsgMultiSend(
+		[]types.Input{
+			types.NewInput(addr1, sdk.NewCoins(newFooCoin(10))),
+			types.NewInput(frozen, sdk.NewCoins(newFooCoin(10))),
+		},
+		[]types.Output{types.NewOutput(addr3, sdk.NewCoins(newFooCoin(20)))},
+	)
+	_, err = msgServer.MultiSend(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().Error(err)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(100)), app.BankKeeper.GetAllBalances(ctx, frozen))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr3).IsZero())
+}
+
+func (suite *IntegrationTestSuite) TestSendRestrictionToBlockedAddr() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100))
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	blocked := authtypes.NewModuleAddress(minttypes.ModuleName)
+	suite.Require().True(app.BankKeeper.BlockedAddr(blocked))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, balances))
+
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		return blocked, nil
+	})
+	defer app.BankKeeper.ClearSendRestriction()
+
+	amt := sdk.NewCoins(newFooCoin(10))
+	err := app.BankKeeper.SendCoins(ctx, addr1, addr2, amt)
+	suite.Require().True(errors.Is(err, sdkerrors.ErrUnauthorized))
+
+	inputs := []types.Input{{Address: addr1, Coins: amt}}
+	outputs := []types.Output{{Address: addr2, Coins: amt}}
+	err = app.BankKeeper.InputOutputCoins(ctx, inputs, outputs)
+	suite.Require().True(errors.Is(err, sdkerrors.ErrUnauthorized))
+
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, blocked).IsZero())
+
+	// a blocked recipient which is not redirected is left to the callers, e.g.
+	// to send coins to a module account
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, blocked, amt))
+}
+
+func (suite *IntegrationTestSuite) TestSendCoinsNoRedirect() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100))
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, balances))
+
+	// redirect the transfers to addr2 towards addr3
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if toAddr.Equals(addr2) {
+			return addr3, nil
+		}
+		return toAddr, nil
+	})
+	defer app.BankKeeper.ClearSendRestriction()
+
+	amt := sdk.NewCoins(newFooCoin(10))
+	err := app.BankKeeper.SendCoinsNoRedirect(ctx, addr1, addr2, amt)
+	suite.Require().True(errors.Is(err, types.ErrSendRedirected))
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr3).IsZero())
+
+	suite.Require().NoError(app.BankKeeper.SendCoinsNoRedirect(ctx, addr1, addr3, amt))
+	suite.Require().Equal(amt, app.BankKeeper.GetAllBalances(ctx, addr3))
+}
+
+func (suite *IntegrationTestSuite) TestSendHooks() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, balances))
+
+	hooks1, hooks2 := &mockBankHooks{}, &mockBankHooks{}
+	app.BankKeeper.SetHooks(types.NewMultiBankHooks(hooks1, hooks2))
+	suite.Require().Panics(func() { app.BankKeeper.SetHooks(hooks1) })
+
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		return addr3, nil
+	})
+	defer app.BankKeeper.ClearSendRestriction()
+
+	amt := sdk.NewCoins(newFooCoin(10))
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, amt))
+
+	expCalls := []sendCall{
+		{"before", addr1, addr3, amt},
+		{"after", addr1, addr3, amt},
+	}
+	suite.Require().Equal(expCalls, hooks1.calls)
+	suite.Require().Equal(expCalls, hooks2.calls)
+
+	inputs := []types.Input{{Address: addr1, Coins: amt}}
+	outputs := []types.Output{{Address: addr2, Coins: amt}}
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal(append(expCalls, expCalls...), hooks1.calls)
+
+	// an error returned before the send aborts it and stops the following hooks
+	hooks1.calls, hooks2.calls = nil, nil
+	hooks1.beforeErr = errors.New("rejected")
+
+	suite.Require().Error(app.BankKeeper.SendCoins(ctx, addr1, addr2, amt))
+	suite.Require().Equal([]sendCall{{"before", addr1, addr3, amt}}, hooks1.calls)
+	suite.Require().Empty(hooks2.calls)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(80), newBarCoin(50)), app.BankKeeper.GetAllBalances(ctx, addr1))
+
+	// with several inputs, each output is funded by the inputs in order and the
+	// hooks are called once per pair of input and output
+	app.BankKeeper.ClearSendRestriction()
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr2, sdk.NewCoins(newFooCoin(30), newBarCoin(10))))
+	hooks1.calls, hooks2.calls, hooks1.beforeErr = nil, nil, nil
+
+	inputs = []types.Input{
+		{Address: addr1, Coins: sdk.NewCoins(newFooCoin(20))},
+		{Address: addr2, Coins: sdk.NewCoins(newFooCoin(30), newBarCoin(10))},
+	}
+	outputs = []types.Output{
+		{Address: addr3, Coins: sdk.NewCoins(newFooCoin(25), newBarCoin(5))},
+		{Address: addr1, Coins: sdk.NewCoins(newFooCoin(25), newBarCoin(5))},
+	}
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+
+	expCalls = []sendCall{
+		{"before", addr1, addr3, sdk.NewCoins(newFooCoin(20))},
+		{"before", addr2, addr3, sdk.NewCoins(newFooCoin(5), newBarCoin(5))},
+		{"before", addr2, addr1, sdk.NewCoins(newFooCoin(25), newBarCoin(5))},
+		{"after", addr1, addr3, sdk.NewCoins(newFooCoin(20))},
+		{"after", addr2, addr3, sdk.NewCoins(newFooCoin(5), newBarCoin(5))},
+		{"after", addr2, addr1, sdk.NewCoins(newFooCoin(25), newBarCoin(5))},
+	}
+	suite.Require().Equal(expCalls, hooks1.calls)
+	suite.Require().Equal(expCalls, hooks2.calls)
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr2).IsZero())
+}
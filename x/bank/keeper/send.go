@@ -17,6 +17,7 @@ type SendKeeper interface {
 
 	InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsNoRedirect(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 
 	SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, error)
 	AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, error)
@@ -31,6 +32,11 @@ type SendKeeper interface {
 	SendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
 
 	BlockedAddr(addr sdk.AccAddress) bool
+
+	AppendSendRestriction(restriction types.SendRestrictionFn)
+	PrependSendRestriction(restriction types.SendRestrictionFn)
+	ClearSendRestriction()
+	SetHooks(bh types.BankHooks)
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -47,6 +53,11 @@ type BaseSendKeeper struct {
 
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
+
+	// the send restriction and hooks are held by pointer so that every copy of
+	// the keeper handed to other modules sees the ones registered by the app
+	sendRestriction *sendRestriction
+	hooks           *sendHooks
 }
 
 func NewBaseSendKeeper(
@@ -54,15 +65,44 @@ func NewBaseSendKeeper(
 ) BaseSendKeeper {
 
 	return BaseSendKeeper{
-		BaseViewKeeper: NewBaseViewKeeper(cdc, storeKey, ak),
-		cdc:            cdc,
-		ak:             ak,
-		storeKey:       storeKey,
-		paramSpace:     paramSpace,
-		blockedAddrs:   blockedAddrs,
+		BaseViewKeeper:  NewBaseViewKeeper(cdc, storeKey, ak),
+		cdc:             cdc,
+		ak:              ak,
+		storeKey:        storeKey,
+		paramSpace:      paramSpace,
+		blockedAddrs:    blockedAddrs,
+		sendRestriction: &sendRestriction{},
+		hooks:           &sendHooks{},
 	}
 }
 
+// AppendSendRestriction adds the provided SendRestrictionFn to run after the
+// previously registered restrictions.
+func (k BaseSendKeeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.append(restriction)
+}
+
+// PrependSendRestriction adds the provided SendRestrictionFn to run before the
+// previously registered restrictions.
+func (k BaseSendKeeper) PrependSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.prepend(restriction)
+}
+
+// ClearSendRestriction removes all the registered send restrictions.
+func (k BaseSendKeeper) ClearSendRestriction() {
+	k.sendRestriction.clear()
+}
+
+// SetHooks sets the bank hooks called around every transfer of coins. Use
+// types.NewMultiBankHooks to subscribe more than one module.
+func (k BaseSendKeeper) SetHooks(bh types.BankHooks) {
+	if k.hooks.hooks != nil {
+		panic("cannot set bank hooks twice")
+	}
+
+	k.hooks.hooks = bh
+}
+
 // GetParams returns the total set of bank parameters.
 func (k BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -77,6 +117,11 @@ func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
 // InputOutputCoins performs multi-send functionality. It accepts a series of
 // inputs that correspond to a series of outputs. It returns an error if the
 // inputs and outputs don't lineup or if any single transfer of tokens fails.
+// The send restrictions are applied to every output, with the address of the
+// input as sender. As the outputs cannot be attributed to one of several
+// inputs, a multi-send with more than one input is rejected when any send
+// restriction is registered. The hooks are called for every pair of input and
+// output, see multiSendTransfers.
 func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
 	// Safety check ensuring that when sending coins the keeper must maintain the
 	// Check supply invariant and validity of Coins.
@@ -84,6 +129,33 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		return err
 	}
 
+	if len(inputs) > 1 && k.sendRestriction.isSet() {
+		return sdkerrors.Wrapf(types.ErrMultipleSenders, "got %d inputs", len(inputs))
+	}
+
+	// apply the restrictions to all the outputs before moving any coin, so that
+	// a rejected output aborts the whole multi-send
+	outputs = append([]types.Output(nil), outputs...)
+	for i, out := range outputs {
+		toAddr, err := k.applySendRestriction(ctx, inputs[0].Address, out.Address, out.Coins)
+		if err != nil {
+			return err
+		}
+
+		outputs[i].Address = toAddr
+	}
+
+	var transfers []transfer
+	if k.hooks.isSet() {
+		transfers = multiSendTransfers(inputs, outputs)
+	}
+
+	for _, t := range transfers {
+		if err := k.hooks.beforeSend(ctx, t.from, t.to, t.amt); err != nil {
+			return err
+		}
+	}
+
 	for _, in := range inputs {
 		_, err := k.SubtractCoins(ctx, in.Address, in.Coins)
 		if err != nil {
@@ -123,12 +195,86 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		}
 	}
 
+	for _, t := range transfers {
+		k.hooks.afterSend(ctx, t.from, t.to, t.amt)
+	}
+
 	return nil
 }
 
+// transfer is a move of coins from the input of a multi-send to one of its
+// outputs.
+type transfer struct {
+	from, to sdk.AccAddress
+	amt      sdk.Coins
+}
+
+// multiSendTransfers splits a multi-send into the transfers from its inputs to
+// its outputs. The coins of each denom are matched in order: the first input
+// funds the first outputs until its coins run out, then the second input funds
+// the rest, and so on. The pairs of input and output which no coins are
+// matched for are left out. With a single input, there is one transfer per
+// output.
+func multiSendTransfers(inputs []types.Input, outputs []types.Output) []transfer {
+	unfunded := make([]sdk.Coins, len(outputs))
+	for i, out := range outputs {
+		unfunded[i] = out.Coins
+	}
+
+	var transfers []transfer
+	for _, in := range inputs {
+		left := in.Coins
+		for i, out := range outputs {
+			amt := left.Min(unfunded[i])
+			if amt.Empty() {
+				continue
+			}
+
+			transfers = append(transfers, transfer{in.Address, out.Address, amt})
+			left = left.Sub(amt)
+			unfunded[i] = unfunded[i].Sub(amt)
+		}
+	}
+
+	return transfers
+}
+
 // SendCoins transfers amt coins from a sending account to a receiving account.
-// An error is returned upon failure.
+// The send restrictions may reject the transfer or change its recipient. An
+// error is returned upon failure.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	toAddr, err := k.applySendRestriction(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
+	return k.sendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// SendCoinsNoRedirect transfers amt coins from a sending account to a receiving
+// account like SendCoins, but fails if the send restrictions change the
+// recipient. It is used by the callers which account for the coins received by
+// the recipient.
+func (k BaseSendKeeper) SendCoinsNoRedirect(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	newToAddr, err := k.applySendRestriction(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
+	if !newToAddr.Equals(toAddr) {
+		return sdkerrors.Wrapf(types.ErrSendRedirected, "coins sent to %s redirected to %s", toAddr, newToAddr)
+	}
+
+	return k.sendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// sendCoins transfers amt coins to a recipient the send restrictions were
+// already applied to.
+func (k BaseSendKeeper) sendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.hooks.beforeSend(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTransfer,
@@ -162,9 +308,27 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 		k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, toAddr))
 	}
 
+	k.hooks.afterSend(ctx, fromAddr, toAddr, amt)
+
 	return nil
 }
 
+// applySendRestriction runs the send restrictions and returns the recipient the
+// coins must be sent to. A restriction may not redirect the coins to a blocked
+// address, which the callers only checked for the original recipient.
+func (k BaseSendKeeper) applySendRestriction(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	newToAddr, err := k.sendRestriction.apply(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return nil, err
+	}
+
+	if !newToAddr.Equals(toAddr) && k.BlockedAddr(newToAddr) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive transactions", newToAddr)
+	}
+
+	return newToAddr, nil
+}
+
 // SubtractCoins removes amt coins the account by the given address. An error is
 // returned if the resulting balance is negative or the initial amount is invalid.
 func (k BaseSendKeeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, error) {
@@ -235,6 +399,7 @@ func (k BaseSendKeeper) ClearBalances(ctx sdk.Context, addr sdk.AccAddress) {
 
 	for _, key := range keys {
 		accountStore.Delete(key)
+		store.Delete(types.DenomAddressKey(string(key), addr))
 	}
 }
 
@@ -254,7 +419,9 @@ func (k BaseSendKeeper) SetBalances(ctx sdk.Context, addr sdk.AccAddress, balanc
 	return nil
 }
 
-// SetBalance sets the coin balance for an account by address.
+// SetBalance sets the coin balance for an account by address. The denom to
+// address index is updated so that it only references accounts holding a
+// non-zero balance of the denomination.
 func (k BaseSendKeeper) SetBalance(ctx sdk.Context, addr sdk.AccAddress, balance sdk.Coin) error {
 	if !balance.IsValid() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, balance.String())
@@ -267,6 +434,13 @@ func (k BaseSendKeeper) SetBalance(ctx sdk.Context, addr sdk.AccAddress, balance
 	bz := k.cdc.MustMarshalBinaryBare(&balance)
 	accountStore.Set([]byte(balance.Denom), bz)
 
+	denomAddrKey := types.DenomAddressKey(balance.Denom, addr)
+	if balance.IsZero() {
+		store.Delete(denomAddrKey)
+	} else {
+		store.Set(denomAddrKey, []byte{0})
+	}
+
 	return nil
 }
 
@@ -29,6 +29,7 @@ type ViewKeeper interface {
 
 	IterateAccountBalances(ctx sdk.Context, addr sdk.AccAddress, cb func(coin sdk.Coin) (stop bool))
 	IterateAllBalances(ctx sdk.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
+	IterateAllDenomOwners(ctx sdk.Context, cb func(address sdk.AccAddress, denom string) (stop bool))
 }
 
 // BaseViewKeeper implements a read only keeper implementation of ViewKeeper.
@@ -155,6 +156,25 @@ func (k BaseViewKeeper) IterateAllBalances(ctx sdk.Context, cb func(sdk.AccAddre
 	}
 }
 
+// IterateAllDenomOwners iterates over the denom to address index and provides
+// every indexed account and denomination to a callback. If true is returned
+// from the callback, iteration is halted.
+func (k BaseViewKeeper) IterateAllDenomOwners(ctx sdk.Context, cb func(sdk.AccAddress, string) bool) {
+	store := ctx.KVStore(k.storeKey)
+	denomAddrStore := prefix.NewStore(store, types.DenomAddressPrefix)
+
+	iterator := denomAddrStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		address, denom := types.AddressAndDenomFromDenomAddressStore(iterator.Key())
+
+		if cb(address, denom) {
+			break
+		}
+	}
+}
+
 // LockedCoins returns all the coins that are not spendable (i.e. locked) for an
 // account by address. For standard accounts, the result will always be no coins.
 // For vesting accounts, LockedCoins is delegated to the concrete vesting account
@@ -0,0 +1,91 @@
+package v040
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/exported"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// MigrateStore performs in-place store migrations of x/bank. The migration
+// includes:
+//
+// - Replacing the single Any-encoded total supply stored under the SupplyKey
+// by one sdk.Int entry per denom, keyed by 0x00 | denom.
+// - Building the denom to address index of all the non-zero balances.
+//
+// Both steps are idempotent, so it is safe to call it from an upgrade handler
+// more than once.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryMarshaler) error {
+	store := ctx.KVStore(storeKey)
+
+	if err := migrateSupply(store, cdc); err != nil {
+		return err
+	}
+
+	return migrateDenomAddressIndex(store, cdc)
+}
+
+func migrateSupply(store sdk.KVStore, cdc codec.BinaryMarshaler) error {
+	bz := store.Get(types.SupplyKey)
+	if bz == nil {
+		return nil
+	}
+
+	var supply exported.SupplyI
+	if err := codec.UnmarshalAny(cdc, &supply, bz); err != nil {
+		return err
+	}
+
+	// delete the legacy total supply before writing the per denom entries as
+	// they share the same prefix
+	store.Delete(types.SupplyKey)
+
+	supplyStore := prefix.NewStore(store, types.SupplyKey)
+	for _, coin := range supply.GetTotal() {
+		if coin.IsZero() {
+			continue
+		}
+
+		amount, err := coin.Amount.Marshal()
+		if err != nil {
+			return err
+		}
+
+		supplyStore.Set([]byte(coin.Denom), amount)
+	}
+
+	return nil
+}
+
+func migrateDenomAddressIndex(store sdk.KVStore, cdc codec.BinaryMarshaler) error {
+	balancesStore := prefix.NewStore(store, types.BalancesPrefix)
+
+	// collect the index keys first as the store must not be written to while
+	// it is being iterated over
+	var keys [][]byte
+
+	iterator := balancesStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		var balance sdk.Coin
+		if err := cdc.UnmarshalBinaryBare(iterator.Value(), &balance); err != nil {
+			iterator.Close()
+			return err
+		}
+
+		if balance.IsZero() {
+			continue
+		}
+
+		address := types.AddressFromBalancesStore(iterator.Key())
+		keys = append(keys, types.DenomAddressKey(balance.Denom, address))
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Set(key, []byte{0})
+	}
+
+	return nil
+}
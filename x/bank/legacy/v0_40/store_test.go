@@ -0,0 +1,94 @@
+package v040_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	v040bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v0_40"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	storeKey := app.GetKey(types.StoreKey)
+	store := ctx.KVStore(storeKey)
+
+	// wipe the supply written by the genesis of the test app
+	iterator := sdk.KVStorePrefixIterator(store, types.SupplyKey)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	// no legacy supply is a no-op
+	require.NoError(t, v040bank.MigrateStore(ctx, storeKey, app.AppCodec()))
+
+	total := sdk.NewCoins(sdk.NewInt64Coin("bar", 20), sdk.NewInt64Coin("foo", 10))
+	bz, err := codec.MarshalAny(app.AppCodec(), types.NewSupply(total))
+	require.NoError(t, err)
+	store.Set(types.SupplyKey, bz)
+
+	require.NoError(t, v040bank.MigrateStore(ctx, storeKey, app.AppCodec()))
+	require.False(t, store.Has(types.SupplyKey))
+
+	for _, coin := range total {
+		require.Equal(t, coin, app.BankKeeper.GetSupply(ctx, coin.Denom))
+	}
+
+	supply, _, err := app.BankKeeper.GetPaginatedTotalSupply(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, total, supply)
+
+	// invalid legacy supply bytes are reported
+	store.Set(types.SupplyKey, []byte{0x1})
+	require.Error(t, v040bank.MigrateStore(ctx, storeKey, app.AppCodec()))
+}
+
+func TestMigrateStoreDenomAddressIndex(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	storeKey := app.GetKey(types.StoreKey)
+	store := ctx.KVStore(storeKey)
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+
+	// write balances the way they were stored before the index existed
+	balancesStore := prefix.NewStore(store, types.BalancesPrefix)
+	for _, b := range []struct {
+		addr    sdk.AccAddress
+		balance sdk.Coin
+	}{
+		{addr1, sdk.NewInt64Coin("foo", 10)},
+		{addr1, sdk.NewInt64Coin("bar", 0)},
+		{addr2, sdk.NewInt64Coin("foo", 20)},
+	} {
+		balance := b.balance
+		accountStore := prefix.NewStore(balancesStore, b.addr)
+		accountStore.Set([]byte(balance.Denom), app.AppCodec().MustMarshalBinaryBare(&balance))
+	}
+
+	_, broken := bankkeeper.DenomOwnersInvariant(app.BankKeeper)(ctx)
+	require.True(t, broken)
+
+	require.NoError(t, v040bank.MigrateStore(ctx, storeKey, app.AppCodec()))
+
+	require.True(t, store.Has(types.DenomAddressKey("foo", addr1)))
+	require.True(t, store.Has(types.DenomAddressKey("foo", addr2)))
+	require.False(t, store.Has(types.DenomAddressKey("bar", addr1)))
+
+	_, broken = bankkeeper.DenomOwnersInvariant(app.BankKeeper)(ctx)
+	require.False(t, broken)
+}
@@ -0,0 +1,49 @@
+package bank_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func testMetadata(display string) types.Metadata {
+	return types.Metadata{
+		Description: "The native staking token of the Cosmos Hub.",
+		DenomUnits: []*types.DenomUnits{
+			{Denom: "uatom", Exponent: 0, Aliases: []string{"microatom"}},
+			{Denom: "matom", Exponent: 3, Aliases: []string{"milliatom"}},
+			{Denom: "atom", Exponent: 6},
+		},
+		Base:    "uatom",
+		Display: display,
+	}
+}
+
+func TestSetDenomMetadataProposalHandler(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	hdlr := bank.NewSetDenomMetadataProposalHandler(app.BankKeeper)
+
+	// add the metadata of a new denom
+	metadata := testMetadata("atom")
+	require.NoError(t, hdlr(ctx, types.NewSetDenomMetadataProposal("Test", "description", metadata)))
+	require.Equal(t, metadata, app.BankKeeper.GetDenomMetaData(ctx, "uatom"))
+
+	// update the metadata of an existing denom
+	metadata = testMetadata("matom")
+	require.NoError(t, hdlr(ctx, types.NewSetDenomMetadataProposal("Test", "description", metadata)))
+	require.Equal(t, metadata, app.BankKeeper.GetDenomMetaData(ctx, "uatom"))
+
+	// invalid metadata is rejected
+	require.Error(t, hdlr(ctx, types.NewSetDenomMetadataProposal("Test", "description", testMetadata("katom"))))
+	require.Equal(t, metadata, app.BankKeeper.GetDenomMetaData(ctx, "uatom"))
+
+	// other proposal types are rejected
+	require.Error(t, hdlr(ctx, govtypes.NewTextProposal("Test", "description")))
+}
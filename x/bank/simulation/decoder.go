@@ -4,32 +4,30 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
-	"github.com/cosmos/cosmos-sdk/x/bank/exported"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
-type SupplyUnmarshaller interface {
-	UnmarshalSupply([]byte) (exported.SupplyI, error)
-}
-
 // NewDecodeStore returns a function closure that unmarshals the KVPair's values
 // to the corresponding types.
-func NewDecodeStore(cdc SupplyUnmarshaller) func(kvA, kvB kv.Pair) string {
+func NewDecodeStore(_ codec.Marshaler) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
 		switch {
 		case bytes.Equal(kvA.Key[:1], types.SupplyKey):
-			supplyA, err := cdc.UnmarshalSupply(kvA.Value)
-			if err != nil {
+			denom := string(kvA.Key[1:])
+
+			var supplyA, supplyB sdk.Int
+			if err := supplyA.Unmarshal(kvA.Value); err != nil {
 				panic(err)
 			}
 
-			supplyB, err := cdc.UnmarshalSupply(kvB.Value)
-			if err != nil {
+			if err := supplyB.Unmarshal(kvB.Value); err != nil {
 				panic(err)
 			}
 
-			return fmt.Sprintf("%v\n%v", supplyA, supplyB)
+			return fmt.Sprintf("%v\n%v", sdk.NewCoin(denom, supplyA), sdk.NewCoin(denom, supplyB))
 
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
@@ -239,6 +239,9 @@ func (m *Output) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 
 // Supply represents a struct that passively keeps track of the total supply
 // amounts in the network.
+//
+// Deprecated: the supply is stored per denomination. Supply is only used to
+// decode the total supply of stores which have not been migrated yet.
 type Supply struct {
 	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
 }
@@ -407,6 +410,46 @@ func (m *Metadata) GetDisplay() string {
 	return ""
 }
 
+// SetDenomMetadataProposal is a gov Content type to add or update the metadata
+// of a coin denomination.
+type SetDenomMetadataProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Metadata    Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *SetDenomMetadataProposal) Reset()      { *m = SetDenomMetadataProposal{} }
+func (*SetDenomMetadataProposal) ProtoMessage() {}
+func (*SetDenomMetadataProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{7}
+}
+func (m *SetDenomMetadataProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetDenomMetadataProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetDenomMetadataProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetDenomMetadataProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDenomMetadataProposal.Merge(m, src)
+}
+func (m *SetDenomMetadataProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetDenomMetadataProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDenomMetadataProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetDenomMetadataProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
@@ -415,49 +458,53 @@ func init() {
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnits)(nil), "cosmos.bank.v1beta1.DenomUnits")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*SetDenomMetadataProposal)(nil), "cosmos.bank.v1beta1.SetDenomMetadataProposal")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 586 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbf, 0x6f, 0xd3, 0x4e,
-	0x14, 0xf7, 0x35, 0x69, 0x9a, 0x5e, 0xfa, 0x5d, 0xfc, 0xcd, 0xe0, 0x46, 0xc2, 0x36, 0x96, 0x90,
-	0x52, 0x44, 0x1c, 0x02, 0x62, 0xc9, 0x44, 0x53, 0x2a, 0x54, 0x21, 0x04, 0x72, 0x85, 0x84, 0x60,
-	0x88, 0x2e, 0xbe, 0x6b, 0xb0, 0x6a, 0xdf, 0x59, 0xb9, 0x33, 0x6a, 0xfe, 0x03, 0x46, 0x46, 0x06,
-	0x86, 0xcc, 0x8c, 0x88, 0x15, 0xe6, 0x8e, 0x15, 0x2c, 0x4c, 0x01, 0x25, 0x0b, 0x73, 0x47, 0x26,
-	0x74, 0x77, 0xce, 0x8f, 0x4a, 0x01, 0x75, 0x60, 0x61, 0x8a, 0xdf, 0x7b, 0x9f, 0xf7, 0xf9, 0x7c,
-	0xf2, 0xde, 0xb3, 0xa1, 0x1d, 0x32, 0x9e, 0x30, 0xde, 0xec, 0x21, 0x7a, 0xdc, 0x7c, 0xd9, 0xea,
-	0x11, 0x81, 0x5a, 0x2a, 0xf0, 0xd3, 0x01, 0x13, 0xcc, 0xfc, 0x5f, 0xd7, 0x7d, 0x95, 0xca, 0xeb,
-	0xb5, 0x6a, 0x9f, 0xf5, 0x99, 0xaa, 0x37, 0xe5, 0x93, 0x86, 0xd6, 0xb6, 0x35, 0xb4, 0xab, 0x0b,
-	0x79, 0x9f, 0x2e, 0x2d, 0x54, 0x38, 0x99, 0xab, 0x84, 0x2c, 0xa2, 0xba, 0xee, 0x7d, 0x01, 0xb0,
-	0xf4, 0x18, 0x0d, 0x50, 0xc2, 0xcd, 0x23, 0xb8, 0xc5, 0x09, 0xc5, 0x5d, 0x42, 0x51, 0x2f, 0x26,
-	0xd8, 0x02, 0x6e, 0xa1, 0x5e, 0xb9, 0xe5, 0xfa, 0x2b, 0x7c, 0xf8, 0x87, 0x84, 0xe2, 0x7d, 0x8d,
-	0xeb, 0x5c, 0x3d, 0x1f, 0x3b, 0x57, 0x86, 0x28, 0x89, 0xdb, 0xde, 0x72, 0xff, 0x0d, 0x96, 0x44,
-	0x82, 0x24, 0xa9, 0x18, 0x7a, 0x41, 0x85, 0x2f, 0xf0, 0xe6, 0x73, 0x58, 0xc5, 0xe4, 0x08, 0x65,
-	0xb1, 0xe8, 0x5e, 0xd0, 0x5b, 0x73, 0x41, 0xbd, 0xdc, 0xd9, 0x39, 0x1f, 0x3b, 0xd7, 0x34, 0xdb,
-	0x2a, 0xd4, 0x32, 0xab, 0x99, 0x03, 0x96, 0xcc, 0xb4, 0x8b, 0x6f, 0x46, 0x8e, 0xe1, 0xdd, 0x87,
-	0x95, 0xa5, 0xa4, 0x59, 0x85, 0xeb, 0x98, 0x50, 0x96, 0x58, 0xc0, 0x05, 0xf5, 0xcd, 0x40, 0x07,
-	0xa6, 0x05, 0x37, 0x2e, 0x48, 0x07, 0xb3, 0xb0, 0x5d, 0x96, 0x24, 0x3f, 0x46, 0x0e, 0xf0, 0x3e,
-	0x02, 0xb8, 0x7e, 0x40, 0xd3, 0x4c, 0x98, 0x0f, 0xe0, 0x06, 0xc2, 0x78, 0x40, 0x38, 0x57, 0x2c,
-	0x5b, 0x9d, 0xd6, 0xcf, 0xb1, 0xd3, 0xe8, 0x47, 0xe2, 0x45, 0xd6, 0xf3, 0x43, 0x96, 0xe4, 0x63,
-	0xcf, 0x7f, 0x1a, 0x1c, 0x1f, 0x37, 0xc5, 0x30, 0x25, 0xdc, 0xdf, 0x0d, 0xc3, 0x5d, 0xdd, 0x18,
-	0xcc, 0x18, 0x4c, 0x04, 0xd7, 0xe5, 0x0e, 0xb8, 0xb5, 0xa6, 0x66, 0xbc, 0xbd, 0x98, 0x31, 0x27,
-	0xf3, 0x19, 0xef, 0xb1, 0x88, 0x76, 0x6e, 0x9e, 0x8e, 0x1d, 0xe3, 0xdd, 0x37, 0xa7, 0x7e, 0x09,
-	0x25, 0xd9, 0xc0, 0x03, 0xcd, 0xdc, 0x2e, 0x2a, 0xff, 0x9f, 0x00, 0x2c, 0x3d, 0xca, 0xc4, 0xbf,
-	0xfb, 0x07, 0xde, 0x03, 0x58, 0x3a, 0xcc, 0xd2, 0x34, 0x1e, 0x4a, 0x4d, 0xc1, 0x04, 0x8a, 0xf3,
-	0xc3, 0xfc, 0xbb, 0x9a, 0x8a, 0xb9, 0xbd, 0xff, 0x6a, 0xe4, 0x18, 0xb3, 0xe5, 0x7f, 0xfe, 0xd0,
-	0xb8, 0x73, 0xfd, 0x8f, 0x0c, 0x27, 0xfa, 0xe5, 0x25, 0x27, 0x29, 0x1b, 0x08, 0x82, 0x7d, 0x6d,
-	0xf4, 0xc0, 0x7b, 0x0a, 0xe1, 0x3d, 0x79, 0x62, 0x4f, 0x68, 0x24, 0xf8, 0x6f, 0xae, 0xaf, 0x06,
-	0xcb, 0xb2, 0x8f, 0x12, 0x2a, 0xd4, 0xf9, 0xfd, 0x17, 0xcc, 0x63, 0x79, 0x99, 0x28, 0x8e, 0x10,
-	0x27, 0xdc, 0x2a, 0xb8, 0x85, 0xfa, 0x66, 0x30, 0x0b, 0xbd, 0xb7, 0x00, 0x96, 0x1f, 0x12, 0x81,
-	0x30, 0x12, 0xc8, 0x74, 0x61, 0x05, 0x13, 0x1e, 0x0e, 0xa2, 0x54, 0x44, 0x8c, 0xe6, 0xf4, 0xcb,
-	0x29, 0xf3, 0xae, 0x44, 0x50, 0x96, 0x74, 0x33, 0xe9, 0x24, 0x5f, 0x96, 0xb3, 0xf2, 0x8d, 0x5e,
-	0x18, 0x0e, 0x20, 0x5e, 0x98, 0x37, 0x61, 0x51, 0xce, 0xd7, 0x2a, 0x28, 0x72, 0xf5, 0x2c, 0xed,
-	0xe1, 0x88, 0xa7, 0x31, 0x1a, 0x5a, 0x45, 0x95, 0x9e, 0x85, 0x9d, 0xbd, 0xd3, 0x89, 0x0d, 0xce,
-	0x26, 0x36, 0xf8, 0x3e, 0xb1, 0xc1, 0xeb, 0xa9, 0x6d, 0x9c, 0x4d, 0x6d, 0xe3, 0xeb, 0xd4, 0x36,
-	0x9e, 0xed, 0x5c, 0x66, 0x90, 0x6a, 0x23, 0xbd, 0x92, 0xfa, 0x32, 0xdd, 0xfe, 0x15, 0x00, 0x00,
-	0xff, 0xff, 0xe3, 0xc7, 0xfe, 0xdc, 0x21, 0x05, 0x00, 0x00,
+	// 635 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbd, 0x6f, 0xd3, 0x40,
+	0x1c, 0xcd, 0x35, 0x69, 0x9a, 0x5e, 0xca, 0x62, 0x3a, 0xb8, 0x95, 0x6a, 0x07, 0x4b, 0x48, 0x29,
+	0xa2, 0x0e, 0x2d, 0x62, 0xf1, 0x02, 0x75, 0xa9, 0x50, 0x85, 0x10, 0x95, 0x2b, 0x24, 0x04, 0x43,
+	0x74, 0xf1, 0x5d, 0x8b, 0x55, 0xfb, 0xce, 0xf2, 0x9d, 0x51, 0xf3, 0x1f, 0x30, 0x32, 0x32, 0x80,
+	0xd4, 0x99, 0x11, 0xb1, 0xc2, 0xdc, 0xb1, 0x82, 0x85, 0x29, 0xa0, 0x76, 0x61, 0xee, 0xc8, 0x84,
+	0xee, 0xce, 0x4e, 0x52, 0x08, 0xa8, 0x0b, 0x03, 0x53, 0xfc, 0xfb, 0x7a, 0xef, 0xfd, 0x3e, 0x2e,
+	0xd0, 0x0a, 0x19, 0x4f, 0x18, 0xef, 0xf4, 0x10, 0xdd, 0xef, 0x3c, 0x5f, 0xed, 0x11, 0x81, 0x56,
+	0x95, 0xe1, 0xa6, 0x19, 0x13, 0xcc, 0xb8, 0xac, 0xe3, 0xae, 0x72, 0x15, 0xf1, 0xc5, 0xf9, 0x3d,
+	0xb6, 0xc7, 0x54, 0xbc, 0x23, 0xbf, 0x74, 0xea, 0xe2, 0x82, 0x4e, 0xed, 0xea, 0x40, 0x51, 0xa7,
+	0x43, 0x23, 0x16, 0x4e, 0x86, 0x2c, 0x21, 0x8b, 0xa8, 0x8e, 0x3b, 0x9f, 0x01, 0xac, 0x6f, 0xa3,
+	0x0c, 0x25, 0xdc, 0xd8, 0x85, 0x73, 0x9c, 0x50, 0xdc, 0x25, 0x14, 0xf5, 0x62, 0x82, 0x4d, 0xd0,
+	0xaa, 0xb6, 0x9b, 0x6b, 0x2d, 0x77, 0x82, 0x0e, 0x77, 0x87, 0x50, 0xbc, 0xa9, 0xf3, 0xfc, 0x2b,
+	0x67, 0x03, 0x7b, 0xa9, 0x8f, 0x92, 0xd8, 0x73, 0xc6, 0xeb, 0xaf, 0xb3, 0x24, 0x12, 0x24, 0x49,
+	0x45, 0xdf, 0x09, 0x9a, 0x7c, 0x94, 0x6f, 0x3c, 0x85, 0xf3, 0x98, 0xec, 0xa2, 0x3c, 0x16, 0xdd,
+	0x73, 0x7c, 0x53, 0x2d, 0xd0, 0x6e, 0xf8, 0xcb, 0x67, 0x03, 0xfb, 0xaa, 0x46, 0x9b, 0x94, 0x35,
+	0x8e, 0x6a, 0x14, 0x09, 0x63, 0x62, 0xbc, 0xda, 0xab, 0x43, 0xbb, 0xe2, 0xdc, 0x83, 0xcd, 0x31,
+	0xa7, 0x31, 0x0f, 0xa7, 0x31, 0xa1, 0x2c, 0x31, 0x41, 0x0b, 0xb4, 0x67, 0x03, 0x6d, 0x18, 0x26,
+	0x9c, 0x39, 0x47, 0x1d, 0x94, 0xa6, 0xd7, 0x90, 0x20, 0xdf, 0x0f, 0x6d, 0xe0, 0x7c, 0x00, 0x70,
+	0x7a, 0x8b, 0xa6, 0xb9, 0x30, 0xee, 0xc3, 0x19, 0x84, 0x71, 0x46, 0x38, 0x57, 0x28, 0x73, 0xfe,
+	0xea, 0x8f, 0x81, 0xbd, 0xb2, 0x17, 0x89, 0x67, 0x79, 0xcf, 0x0d, 0x59, 0x52, 0x8c, 0xbd, 0xf8,
+	0x59, 0xe1, 0x78, 0xbf, 0x23, 0xfa, 0x29, 0xe1, 0xee, 0x7a, 0x18, 0xae, 0xeb, 0xc2, 0xa0, 0x44,
+	0x30, 0x10, 0x9c, 0x96, 0x3b, 0xe0, 0xe6, 0x94, 0x9a, 0xf1, 0xc2, 0x68, 0xc6, 0x9c, 0x0c, 0x67,
+	0xbc, 0xc1, 0x22, 0xea, 0xdf, 0x38, 0x1a, 0xd8, 0x95, 0xb7, 0x5f, 0xed, 0xf6, 0x05, 0x98, 0x64,
+	0x01, 0x0f, 0x34, 0xb2, 0x57, 0x53, 0xfa, 0x3f, 0x02, 0x58, 0x7f, 0x98, 0x8b, 0xff, 0xb7, 0x81,
+	0x77, 0x00, 0xd6, 0x77, 0xf2, 0x34, 0x8d, 0xfb, 0x92, 0x53, 0x30, 0x81, 0x62, 0x13, 0xfc, 0x03,
+	0x4e, 0x85, 0xec, 0x6d, 0xbe, 0x38, 0xb4, 0x2b, 0xe5, 0xf2, 0x3f, 0xbd, 0x5f, 0xb9, 0x75, 0xed,
+	0xaf, 0x08, 0x07, 0xfa, 0xf1, 0x92, 0x83, 0x94, 0x65, 0x82, 0x60, 0x57, 0x0b, 0xdd, 0x72, 0x1e,
+	0x43, 0x78, 0x57, 0x9e, 0xd8, 0x23, 0x1a, 0x09, 0xfe, 0x87, 0xeb, 0x5b, 0x84, 0x0d, 0x59, 0x47,
+	0x09, 0x15, 0xea, 0xfc, 0x2e, 0x05, 0x43, 0x5b, 0x5e, 0x26, 0x8a, 0x23, 0xc4, 0x09, 0x37, 0xab,
+	0xad, 0x6a, 0x7b, 0x36, 0x28, 0x4d, 0xe7, 0x35, 0x80, 0x8d, 0x07, 0x44, 0x20, 0x8c, 0x04, 0x32,
+	0x5a, 0xb0, 0x89, 0x09, 0x0f, 0xb3, 0x28, 0x15, 0x11, 0xa3, 0x05, 0xfc, 0xb8, 0xcb, 0xb8, 0x23,
+	0x33, 0x28, 0x4b, 0xba, 0xb9, 0x54, 0x52, 0x2c, 0xcb, 0x9e, 0xf8, 0xa2, 0x47, 0x82, 0x03, 0x88,
+	0x47, 0xe2, 0x0d, 0x58, 0x93, 0xf3, 0x35, 0xab, 0x0a, 0x5c, 0x7d, 0x4b, 0x79, 0x38, 0xe2, 0x69,
+	0x8c, 0xfa, 0x66, 0x4d, 0xb9, 0x4b, 0xd3, 0x79, 0x03, 0xa0, 0xb9, 0x43, 0x84, 0xc2, 0x2a, 0x65,
+	0x6e, 0x67, 0x2c, 0x65, 0x1c, 0xc5, 0x72, 0x0e, 0x22, 0x12, 0x31, 0x29, 0xe7, 0xa0, 0x8c, 0x5f,
+	0x9b, 0x98, 0xfa, 0xbd, 0x89, 0xdb, 0xb0, 0x91, 0x14, 0x58, 0x4a, 0x46, 0x73, 0x6d, 0x69, 0x62,
+	0x07, 0x25, 0xa1, 0x5f, 0x93, 0xeb, 0x0f, 0x86, 0x45, 0x5e, 0xa3, 0xdc, 0xaa, 0xbf, 0x71, 0x74,
+	0x62, 0x81, 0xe3, 0x13, 0x0b, 0x7c, 0x3b, 0xb1, 0xc0, 0xcb, 0x53, 0xab, 0x72, 0x7c, 0x6a, 0x55,
+	0xbe, 0x9c, 0x5a, 0x95, 0x27, 0xcb, 0x17, 0x59, 0xb4, 0xba, 0x98, 0x5e, 0x5d, 0xfd, 0x73, 0xde,
+	0xfc, 0x39, 0x00, 0x50, 0x70, 0xa2, 0x3e, 0xc1, 0x05, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -894,6 +941,53 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SetDenomMetadataProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetDenomMetadataProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetDenomMetadataProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBank(v)
 	base := offset
@@ -1041,6 +1135,25 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
+func (m *SetDenomMetadataProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovBank(uint64(l))
+	return n
+}
+
 func sovBank(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1907,6 +2020,156 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SetDenomMetadataProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetDenomMetadataProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetDenomMetadataProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBank(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
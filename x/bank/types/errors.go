@@ -10,4 +10,6 @@ var (
 	ErrNoOutputs           = sdkerrors.Register(ModuleName, 3, "no outputs to send transaction")
 	ErrInputOutputMismatch = sdkerrors.Register(ModuleName, 4, "sum inputs != sum outputs")
 	ErrSendDisabled        = sdkerrors.Register(ModuleName, 5, "send transactions are disabled")
+	ErrMultipleSenders     = sdkerrors.Register(ModuleName, 6, "multiple senders not allowed with send restrictions")
+	ErrSendRedirected      = sdkerrors.Register(ModuleName, 7, "send redirected by send restrictions")
 )
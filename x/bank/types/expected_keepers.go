@@ -25,3 +25,15 @@ type AccountKeeper interface {
 	GetModuleAccount(ctx sdk.Context, moduleName string) types.ModuleAccountI
 	SetModuleAccount(ctx sdk.Context, macc types.ModuleAccountI)
 }
+
+// BankHooks defines the hooks other modules can subscribe to in order to be
+// notified of the transfers of coins between accounts. The recipient given to
+// the hooks is the one resulting from the send restrictions. The hooks are
+// called for every pair of input and output of a multi-send, with the coins of
+// the output funded by the input.
+type BankHooks interface {
+	// BeforeSend is called before coins are moved; returning an error aborts the transfer.
+	BeforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	// AfterSend is called once the coins have been moved.
+	AfterSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins)
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ BankHooks = MultiBankHooks{}
+
+// MultiBankHooks combines multiple bank hooks, all hook functions are run in
+// array sequence.
+type MultiBankHooks []BankHooks
+
+// NewMultiBankHooks returns a BankHooks running the given hooks in order.
+func NewMultiBankHooks(hooks ...BankHooks) MultiBankHooks {
+	return hooks
+}
+
+// BeforeSend runs the BeforeSend hook of every hook in order and returns the
+// first error encountered.
+func (h MultiBankHooks) BeforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	for i := range h {
+		if err := h[i].BeforeSend(ctx, fromAddr, toAddr, amt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AfterSend runs the AfterSend hook of every hook in order.
+func (h MultiBankHooks) AfterSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) {
+	for i := range h {
+		h[i].AfterSend(ctx, fromAddr, toAddr, amt)
+	}
+}
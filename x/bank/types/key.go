@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -25,6 +26,7 @@ var (
 	BalancesPrefix      = []byte("balances")
 	SupplyKey           = []byte{0x00}
 	DenomMetadataPrefix = []byte{0x1}
+	DenomAddressPrefix  = []byte{0x03}
 )
 
 // DenomMetadataKey returns the denomination metadata key.
@@ -33,6 +35,35 @@ func DenomMetadataKey(denom string) []byte {
 	return append(DenomMetadataPrefix, d...)
 }
 
+// CreateDenomAddressPrefix creates a prefix for a reverse index of denomination
+// to account balance for that denomination. The denom is null terminated so
+// that a denom can never be the prefix of another one.
+func CreateDenomAddressPrefix(denom string) []byte {
+	key := make([]byte, len(DenomAddressPrefix)+len(denom)+1)
+	copy(key, DenomAddressPrefix)
+	copy(key[len(DenomAddressPrefix):], denom)
+	return key
+}
+
+// DenomAddressKey returns the reverse index key of an account holding a balance
+// of the given denomination.
+func DenomAddressKey(denom string, addr sdk.AccAddress) []byte {
+	return append(CreateDenomAddressPrefix(denom), addr.Bytes()...)
+}
+
+// AddressAndDenomFromDenomAddressStore returns the account address and the
+// denomination from a key of the denom to address index. The key must not
+// contain the prefix DenomAddressPrefix as the prefix store iterator discards
+// the actual prefix.
+func AddressAndDenomFromDenomAddressStore(key []byte) (sdk.AccAddress, string) {
+	sep := bytes.IndexByte(key, 0x00)
+	if sep <= 0 || sep == len(key)-1 {
+		panic(fmt.Sprintf("unexpected denom address key %X; expected a null terminated denom followed by an address", key))
+	}
+
+	return sdk.AccAddress(key[sep+1:]), string(key[:sep])
+}
+
 // AddressFromBalancesStore returns an account address from a balances prefix
 // store. The key must not contain the perfix BalancesPrefix as the prefix store
 // iterator discards the actual prefix.
@@ -24,3 +24,19 @@ func TestAddressFromBalancesStore(t *testing.T) {
 	res := types.AddressFromBalancesStore(key)
 	require.Equal(t, res, addr)
 }
+
+func TestAddressAndDenomFromDenomAddressStore(t *testing.T) {
+	addr, err := sdk.AccAddressFromBech32("cosmos1n88uc38xhjgxzw9nwre4ep2c8ga4fjxcar6mn7")
+	require.NoError(t, err)
+
+	key := types.DenomAddressKey("stake", addr)
+	require.Equal(t, types.DenomAddressPrefix, key[:1])
+
+	resAddr, resDenom := types.AddressAndDenomFromDenomAddressStore(key[1:])
+	require.Equal(t, addr, resAddr)
+	require.Equal(t, "stake", resDenom)
+
+	require.Panics(t, func() { types.AddressAndDenomFromDenomAddressStore([]byte("stake")) })
+	require.Panics(t, func() { types.AddressAndDenomFromDenomAddressStore([]byte("stake\x00")) })
+	require.Panics(t, func() { types.AddressAndDenomFromDenomAddressStore(cloneAppend([]byte{0x00}, addr.Bytes())) })
+}
@@ -0,0 +1,184 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMetadataValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		metadata types.Metadata
+		expErr   bool
+	}{
+		{
+			"non-empty coins",
+			types.Metadata{
+				Description: "The native staking token of the Cosmos Hub.",
+				DenomUnits: []*types.DenomUnits{
+					{Denom: "uatom", Exponent: 0, Aliases: []string{"microatom"}},
+					{Denom: "matom", Exponent: 3, Aliases: []string{"milliatom"}},
+					{Denom: "atom", Exponent: 6, Aliases: nil},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			false,
+		},
+		{"empty metadata", types.Metadata{}, true},
+		{
+			"invalid base denom",
+			types.Metadata{
+				Base: "",
+			},
+			true,
+		},
+		{
+			"invalid display denom",
+			types.Metadata{
+				Base:    "uatom",
+				Display: "",
+			},
+			true,
+		},
+		{
+			"no denom units",
+			types.Metadata{
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"duplicate denom unit",
+			types.Metadata{
+				Description: "The native staking token of the Cosmos Hub.",
+				DenomUnits: []*types.DenomUnits{
+					{Denom: "uatom", Exponent: 0, Aliases: []string{"microatom"}},
+					{Denom: "uatom", Exponent: 1, Aliases: []string{"microatom"}},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"invalid denom unit",
+			types.Metadata{
+				Description: "The native staking token of the Cosmos Hub.",
+				DenomUnits: []*types.DenomUnits{
+					{Denom: "uatom", Exponent: 0, Aliases: []string{"microatom"}},
+					{Denom: "", Exponent: 1, Aliases: []string{"microatom"}},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"invalid denom unit alias",
+			types.Metadata{
+				Description: "The native staking token of the Cosmos Hub.",
+				DenomUnits: []*types.DenomUnits{
+					{Denom: "uatom", Exponent: 0, Aliases: []string{""}},
+				},
+				Base:    "uatom",
+				Display: "uatom",
+			},
+			true,
+		},
+		{
+			"duplicate denom unit alias",
+			types.Metadata{
+				Description: "The native staking token of the Cosmos Hub.",
+				DenomUnits: []*types.DenomUnits{
+					{Denom: "uatom", Exponent: 0, Aliases: []string{"microatom", "microatom"}},
+				},
+				Base:    "uatom",
+				Display: "uatom",
+			},
+			true,
+		},
+		{
+			"base denom is not the first unit",
+			types.Metadata{
+				Description: "The native staking token of the Cosmos Hub.",
+				DenomUnits: []*types.DenomUnits{
+					{Denom: "atom", Exponent: 6},
+					{Denom: "uatom", Exponent: 0},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"base denom exponent is not 0",
+			types.Metadata{
+				Description: "The native staking token of the Cosmos Hub.",
+				DenomUnits: []*types.DenomUnits{
+					{Denom: "uatom", Exponent: 1},
+					{Denom: "atom", Exponent: 6},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"denom units not sorted",
+			types.Metadata{
+				Description: "The native staking token of the Cosmos Hub.",
+				DenomUnits: []*types.DenomUnits{
+					{Denom: "uatom", Exponent: 0},
+					{Denom: "atom", Exponent: 6},
+					{Denom: "matom", Exponent: 3},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"no display denom unit",
+			types.Metadata{
+				Description: "The native staking token of the Cosmos Hub.",
+				DenomUnits: []*types.DenomUnits{
+					{Denom: "uatom", Exponent: 0},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.metadata.Validate()
+
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSetDenomMetadataProposalValidateBasic(t *testing.T) {
+	metadata := types.Metadata{
+		DenomUnits: []*types.DenomUnits{{Denom: "uatom", Exponent: 0}},
+		Base:       "uatom",
+		Display:    "uatom",
+	}
+
+	require.NoError(t, types.NewSetDenomMetadataProposal("Test", "description", metadata).ValidateBasic())
+	require.Error(t, types.NewSetDenomMetadataProposal("", "description", metadata).ValidateBasic())
+	require.Error(t, types.NewSetDenomMetadataProposal("Test", "description", types.Metadata{}).ValidateBasic())
+}
@@ -23,6 +23,26 @@ func NewQueryAllBalancesRequest(addr sdk.AccAddress, req *query.PageRequest) *Qu
 	return &QueryAllBalancesRequest{Address: addr, Pagination: req}
 }
 
+// NewQuerySpendableBalancesRequest creates a new instance of QuerySpendableBalancesRequest.
+func NewQuerySpendableBalancesRequest(addr sdk.AccAddress, req *query.PageRequest) *QuerySpendableBalancesRequest {
+	return &QuerySpendableBalancesRequest{Address: addr, Pagination: req}
+}
+
+// NewQueryDenomMetadataRequest creates a new instance of QueryDenomMetadataRequest.
+func NewQueryDenomMetadataRequest(denom string) *QueryDenomMetadataRequest {
+	return &QueryDenomMetadataRequest{Denom: denom}
+}
+
+// NewQueryDenomsMetadataRequest creates a new instance of QueryDenomsMetadataRequest.
+func NewQueryDenomsMetadataRequest(req *query.PageRequest) *QueryDenomsMetadataRequest {
+	return &QueryDenomsMetadataRequest{Pagination: req}
+}
+
+// NewQueryDenomOwnersRequest creates a new instance of QueryDenomOwnersRequest.
+func NewQueryDenomOwnersRequest(denom string, req *query.PageRequest) *QueryDenomOwnersRequest {
+	return &QueryDenomOwnersRequest{Denom: denom, Pagination: req}
+}
+
 // QueryTotalSupplyParams defines the params for the following queries:
 //
 // - 'custom/bank/totalSupply'
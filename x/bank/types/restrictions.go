@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendRestrictionFn defines a restriction applied to a transfer of coins. It
+// is given the sender, the intended recipient and the amount being sent, and
+// returns the address the coins should actually be sent to. Returning an error
+// rejects the transfer.
+//
+// The sender is never empty: as the coins of an output cannot be attributed to
+// one of several inputs, multi-sends with more than one input are rejected with
+// ErrMultipleSenders when a restriction is set.
+type SendRestrictionFn func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (newToAddr sdk.AccAddress, err error)
+
+// NoOpSendRestrictionFn is a SendRestrictionFn which accepts every transfer
+// and never changes its recipient.
+func NoOpSendRestrictionFn(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+	return toAddr, nil
+}
+
+// Then returns a SendRestrictionFn which runs r and then second, giving second
+// the recipient returned by r. The first error returned aborts the chain.
+func (r SendRestrictionFn) Then(second SendRestrictionFn) SendRestrictionFn {
+	if second == nil {
+		return r
+	}
+
+	if r == nil {
+		return second
+	}
+
+	return func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		newToAddr, err := r(ctx, fromAddr, toAddr, amt)
+		if err != nil {
+			return newToAddr, err
+		}
+
+		return second(ctx, fromAddr, newToAddr, amt)
+	}
+}
+
+// ComposeSendRestrictions combines the given restrictions into a single one
+// which runs them in order. Nil restrictions are skipped and nil is returned
+// when none are left.
+func ComposeSendRestrictions(restrictions ...SendRestrictionFn) SendRestrictionFn {
+	var composed SendRestrictionFn
+	for _, r := range restrictions {
+		composed = composed.Then(r)
+	}
+
+	return composed
+}
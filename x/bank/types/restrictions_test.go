@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestComposeSendRestrictions(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+	amt := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	var calls []string
+	redirect := func(name string, from, to sdk.AccAddress) types.SendRestrictionFn {
+		return func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+			calls = append(calls, name)
+			if toAddr.Equals(from) {
+				return to, nil
+			}
+			return toAddr, nil
+		}
+	}
+	reject := func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "reject")
+		return nil, errors.New("rejected")
+	}
+
+	require.Nil(t, types.ComposeSendRestrictions())
+	require.Nil(t, types.ComposeSendRestrictions(nil, nil))
+
+	toAddr, err := types.SendRestrictionFn(types.NoOpSendRestrictionFn)(sdk.Context{}, addr1, addr2, amt)
+	require.NoError(t, err)
+	require.Equal(t, addr2, toAddr)
+
+	// every restriction is given the recipient returned by the previous one
+	composed := types.ComposeSendRestrictions(redirect("first", addr1, addr2), nil, redirect("second", addr2, addr3))
+	toAddr, err = composed(sdk.Context{}, addr3, addr1, amt)
+	require.NoError(t, err)
+	require.Equal(t, addr3, toAddr)
+	require.Equal(t, []string{"first", "second"}, calls)
+
+	// an error stops the chain
+	calls = nil
+	composed = types.ComposeSendRestrictions(redirect("first", addr1, addr2), reject, redirect("second", addr2, addr3))
+	_, err = composed(sdk.Context{}, addr3, addr1, amt)
+	require.Error(t, err)
+	require.Equal(t, []string{"first", "reject"}, calls)
+}
@@ -5,10 +5,15 @@ package types
 
 import (
 	bytes "bytes"
+	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -86,33 +91,74 @@ func (m *MsgVerifyInvariant) GetInvariantRoute() string {
 	return ""
 }
 
+// MsgVerifyInvariantResponse defines the Msg/VerifyInvariant response type.
+type MsgVerifyInvariantResponse struct {
+}
+
+func (m *MsgVerifyInvariantResponse) Reset()         { *m = MsgVerifyInvariantResponse{} }
+func (m *MsgVerifyInvariantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVerifyInvariantResponse) ProtoMessage()    {}
+func (*MsgVerifyInvariantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61276163172fe867, []int{1}
+}
+func (m *MsgVerifyInvariantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVerifyInvariantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVerifyInvariantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVerifyInvariantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVerifyInvariantResponse.Merge(m, src)
+}
+func (m *MsgVerifyInvariantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVerifyInvariantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVerifyInvariantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVerifyInvariantResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgVerifyInvariant)(nil), "cosmos.crisis.v1beta1.MsgVerifyInvariant")
+	proto.RegisterType((*MsgVerifyInvariantResponse)(nil), "cosmos.crisis.v1beta1.MsgVerifyInvariantResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/crisis/v1beta1/tx.proto", fileDescriptor_61276163172fe867) }
 
 var fileDescriptor_61276163172fe867 = []byte{
-	// 296 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2e, 0xca, 0x2c, 0xce, 0x2c, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49,
-	0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x85, 0xc8, 0xeb, 0x41,
-	0xe4, 0xf5, 0xa0, 0xf2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x15, 0xfa, 0x20, 0x16, 0x44,
-	0xb1, 0x52, 0x03, 0x13, 0x97, 0x90, 0x6f, 0x71, 0x7a, 0x58, 0x6a, 0x51, 0x66, 0x5a, 0xa5, 0x67,
-	0x5e, 0x59, 0x62, 0x51, 0x66, 0x62, 0x5e, 0x89, 0x90, 0x27, 0x17, 0x5b, 0x71, 0x6a, 0x5e, 0x4a,
-	0x6a, 0x91, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x8f, 0x93, 0xe1, 0xaf, 0x7b, 0xf2, 0xba, 0xe9, 0x99,
-	0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x30, 0x27, 0x80, 0x29, 0xdd, 0xe2, 0x94,
-	0x6c, 0xfd, 0x92, 0xca, 0x82, 0xd4, 0x62, 0x3d, 0xc7, 0xe4, 0x64, 0xc7, 0x94, 0x94, 0xa2, 0xd4,
-	0xe2, 0xe2, 0x20, 0xa8, 0x01, 0x42, 0x21, 0x5c, 0xa2, 0x99, 0x30, 0x73, 0xe3, 0x73, 0xf3, 0x53,
-	0x4a, 0x73, 0x52, 0xe3, 0xf3, 0x12, 0x73, 0x53, 0x25, 0x98, 0x14, 0x18, 0x35, 0x38, 0x9d, 0x14,
-	0x3e, 0xdd, 0x93, 0x97, 0xa9, 0x4c, 0xcc, 0xcd, 0xb1, 0x52, 0xc2, 0xaa, 0x4c, 0x29, 0x48, 0x18,
-	0x2e, 0xee, 0x0b, 0x16, 0xf6, 0x4b, 0xcc, 0x4d, 0x15, 0x72, 0xe6, 0xe2, 0x47, 0x28, 0x2f, 0xca,
-	0x2f, 0x2d, 0x49, 0x95, 0x60, 0x06, 0x9b, 0x27, 0xf5, 0xe9, 0x9e, 0xbc, 0x18, 0xba, 0x79, 0x60,
-	0x05, 0x4a, 0x41, 0x7c, 0x70, 0x91, 0x20, 0x90, 0x80, 0x15, 0xcb, 0x8b, 0x05, 0xf2, 0x8c, 0x4e,
-	0xae, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7,
-	0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x8d, 0xd7, 0xc7, 0x15,
-	0xb0, 0x18, 0x00, 0x7b, 0x3d, 0x89, 0x0d, 0x1c, 0xa0, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xb0, 0xb6, 0x10, 0xbd, 0x9f, 0x01, 0x00, 0x00,
+	// 337 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0x4f, 0xc2, 0x40,
+	0x1c, 0xc5, 0x39, 0x30, 0x24, 0x5e, 0x8c, 0x24, 0x55, 0x0c, 0x69, 0xc8, 0x95, 0x74, 0xc2, 0x18,
+	0xae, 0xa9, 0x6e, 0x6c, 0x60, 0x1c, 0x18, 0x70, 0x68, 0x8c, 0x83, 0x0b, 0x29, 0xed, 0x59, 0x1b,
+	0xb9, 0x1e, 0xb9, 0xff, 0x41, 0x60, 0xf3, 0x23, 0xf8, 0x11, 0xfc, 0x38, 0x8e, 0x8c, 0x4e, 0xc4,
+	0xc0, 0xe2, 0xcc, 0xe8, 0x64, 0x38, 0x28, 0x26, 0x40, 0x8c, 0x53, 0x9b, 0xf7, 0x7e, 0x79, 0x77,
+	0x79, 0xef, 0x30, 0x09, 0x04, 0x70, 0x01, 0x4e, 0x20, 0x63, 0x88, 0xc1, 0x19, 0xba, 0x5d, 0xa6,
+	0x7c, 0xd7, 0x51, 0x23, 0xda, 0x97, 0x42, 0x09, 0xa3, 0xb8, 0xf2, 0xe9, 0xca, 0xa7, 0x6b, 0xdf,
+	0x3c, 0x8d, 0x44, 0x24, 0x34, 0xe1, 0x2c, 0xff, 0x56, 0xb0, 0xfd, 0x92, 0xc5, 0x46, 0x1b, 0xa2,
+	0x7b, 0x26, 0xe3, 0xc7, 0x71, 0x2b, 0x19, 0xfa, 0x32, 0xf6, 0x13, 0x65, 0xb4, 0x70, 0x1e, 0x58,
+	0x12, 0x32, 0x59, 0x42, 0x15, 0x54, 0x3d, 0x6a, 0xba, 0xdf, 0x53, 0xab, 0x16, 0xc5, 0xea, 0x69,
+	0xd0, 0xa5, 0x81, 0xe0, 0x4e, 0x7a, 0x05, 0xfd, 0xa9, 0x41, 0xf8, 0xec, 0xa8, 0x71, 0x9f, 0x01,
+	0x6d, 0x04, 0x41, 0x23, 0x0c, 0x25, 0x03, 0xf0, 0xd6, 0x01, 0xc6, 0x1d, 0x2e, 0xc6, 0x69, 0x6e,
+	0x87, 0x8b, 0x70, 0xd0, 0x63, 0x9d, 0xc4, 0xe7, 0xac, 0x94, 0xad, 0xa0, 0xea, 0x61, 0xb3, 0xb2,
+	0x98, 0x5a, 0xe5, 0xb1, 0xcf, 0x7b, 0x75, 0x7b, 0x2f, 0x66, 0x7b, 0x27, 0x1b, 0xbd, 0xad, 0xe5,
+	0x5b, 0x9f, 0x33, 0xe3, 0x1a, 0x17, 0x7e, 0x71, 0x29, 0x06, 0x8a, 0x95, 0x72, 0x3a, 0xcf, 0x5c,
+	0x4c, 0xad, 0xb3, 0xed, 0x3c, 0x0d, 0xd8, 0xde, 0xf1, 0x46, 0xf1, 0x96, 0x42, 0xfd, 0xe0, 0xeb,
+	0xcd, 0x42, 0x76, 0x19, 0x9b, 0xbb, 0x0d, 0x78, 0x0c, 0xfa, 0x22, 0x01, 0x76, 0x39, 0xc4, 0xb9,
+	0x36, 0x44, 0x86, 0xc0, 0x85, 0xed, 0x8e, 0xce, 0xe9, 0xde, 0xa2, 0xe9, 0x6e, 0x98, 0xe9, 0xfe,
+	0x1b, 0x4d, 0xcf, 0x6d, 0xde, 0xbc, 0xcf, 0x08, 0x9a, 0xcc, 0x08, 0xfa, 0x9c, 0x11, 0xf4, 0x3a,
+	0x27, 0x99, 0xc9, 0x9c, 0x64, 0x3e, 0xe6, 0x24, 0xf3, 0x70, 0xf1, 0xe7, 0x0e, 0xa3, 0xf4, 0x5d,
+	0xe8, 0x41, 0xba, 0x79, 0x3d, 0xf3, 0xd5, 0xcf, 0x00, 0x8e, 0xf7, 0xf8, 0xa0, 0x35, 0x02, 0x00,
+	0x00,
 }
 
 func (this *MsgVerifyInvariant) Equal(that interface{}) bool {
@@ -145,6 +191,89 @@ func (this *MsgVerifyInvariant) Equal(that interface{}) bool {
 	}
 	return true
 }
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// VerifyInvariant defines a method to verify a particular invariance.
+	VerifyInvariant(ctx context.Context, in *MsgVerifyInvariant, opts ...grpc.CallOption) (*MsgVerifyInvariantResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) VerifyInvariant(ctx context.Context, in *MsgVerifyInvariant, opts ...grpc.CallOption) (*MsgVerifyInvariantResponse, error) {
+	out := new(MsgVerifyInvariantResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.crisis.v1beta1.Msg/VerifyInvariant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// VerifyInvariant defines a method to verify a particular invariance.
+	VerifyInvariant(context.Context, *MsgVerifyInvariant) (*MsgVerifyInvariantResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) VerifyInvariant(ctx context.Context, req *MsgVerifyInvariant) (*MsgVerifyInvariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyInvariant not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_VerifyInvariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVerifyInvariant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VerifyInvariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.crisis.v1beta1.Msg/VerifyInvariant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VerifyInvariant(ctx, req.(*MsgVerifyInvariant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.crisis.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "VerifyInvariant",
+			Handler:    _Msg_VerifyInvariant_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/crisis/v1beta1/tx.proto",
+}
+
 func (m *MsgVerifyInvariant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -189,6 +318,29 @@ func (m *MsgVerifyInvariant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgVerifyInvariantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVerifyInvariantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVerifyInvariantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -221,6 +373,15 @@ func (m *MsgVerifyInvariant) Size() (n int) {
 	return n
 }
 
+func (m *MsgVerifyInvariantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -378,6 +539,59 @@ func (m *MsgVerifyInvariant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgVerifyInvariantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVerifyInvariantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVerifyInvariantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
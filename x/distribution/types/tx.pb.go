@@ -5,11 +5,16 @@ package types
 
 import (
 	bytes "bytes"
+	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -79,6 +84,43 @@ func (m *MsgSetWithdrawAddress) GetWithdrawAddress() github_com_cosmos_cosmos_sd
 	return nil
 }
 
+// MsgSetWithdrawAddressResponse defines the Msg/SetWithdrawAddress response type.
+type MsgSetWithdrawAddressResponse struct {
+}
+
+func (m *MsgSetWithdrawAddressResponse) Reset()         { *m = MsgSetWithdrawAddressResponse{} }
+func (m *MsgSetWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetWithdrawAddressResponse) ProtoMessage()    {}
+func (*MsgSetWithdrawAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{1}
+}
+func (m *MsgSetWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetWithdrawAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetWithdrawAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetWithdrawAddressResponse.Merge(m, src)
+}
+func (m *MsgSetWithdrawAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetWithdrawAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetWithdrawAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetWithdrawAddressResponse proto.InternalMessageInfo
+
 // msg struct for delegation withdraw from a single validator
 type MsgWithdrawDelegatorReward struct {
 	DelegatorAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
@@ -89,7 +131,7 @@ func (m *MsgWithdrawDelegatorReward) Reset()         { *m = MsgWithdrawDelegator
 func (m *MsgWithdrawDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawDelegatorReward) ProtoMessage()    {}
 func (*MsgWithdrawDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{1}
+	return fileDescriptor_ed4f433d965e58ca, []int{2}
 }
 func (m *MsgWithdrawDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -132,6 +174,43 @@ func (m *MsgWithdrawDelegatorReward) GetValidatorAddress() github_com_cosmos_cos
 	return nil
 }
 
+// MsgWithdrawDelegatorRewardResponse defines the Msg/WithdrawDelegatorReward response type.
+type MsgWithdrawDelegatorRewardResponse struct {
+}
+
+func (m *MsgWithdrawDelegatorRewardResponse) Reset()         { *m = MsgWithdrawDelegatorRewardResponse{} }
+func (m *MsgWithdrawDelegatorRewardResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawDelegatorRewardResponse) ProtoMessage()    {}
+func (*MsgWithdrawDelegatorRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{3}
+}
+func (m *MsgWithdrawDelegatorRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawDelegatorRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawDelegatorRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawDelegatorRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawDelegatorRewardResponse.Merge(m, src)
+}
+func (m *MsgWithdrawDelegatorRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawDelegatorRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawDelegatorRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawDelegatorRewardResponse proto.InternalMessageInfo
+
 // msg struct for validator withdraw
 type MsgWithdrawValidatorCommission struct {
 	ValidatorAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
@@ -141,7 +220,7 @@ func (m *MsgWithdrawValidatorCommission) Reset()         { *m = MsgWithdrawValid
 func (m *MsgWithdrawValidatorCommission) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawValidatorCommission) ProtoMessage()    {}
 func (*MsgWithdrawValidatorCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{2}
+	return fileDescriptor_ed4f433d965e58ca, []int{4}
 }
 func (m *MsgWithdrawValidatorCommission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -177,6 +256,45 @@ func (m *MsgWithdrawValidatorCommission) GetValidatorAddress() github_com_cosmos
 	return nil
 }
 
+// MsgWithdrawValidatorCommissionResponse defines the Msg/WithdrawValidatorCommission response type.
+type MsgWithdrawValidatorCommissionResponse struct {
+}
+
+func (m *MsgWithdrawValidatorCommissionResponse) Reset() {
+	*m = MsgWithdrawValidatorCommissionResponse{}
+}
+func (m *MsgWithdrawValidatorCommissionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawValidatorCommissionResponse) ProtoMessage()    {}
+func (*MsgWithdrawValidatorCommissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{5}
+}
+func (m *MsgWithdrawValidatorCommissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawValidatorCommissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawValidatorCommissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawValidatorCommissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawValidatorCommissionResponse.Merge(m, src)
+}
+func (m *MsgWithdrawValidatorCommissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawValidatorCommissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawValidatorCommissionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawValidatorCommissionResponse proto.InternalMessageInfo
+
 // MsgFundCommunityPool defines a Msg type that allows an account to directly
 // fund the community pool.
 type MsgFundCommunityPool struct {
@@ -188,7 +306,7 @@ func (m *MsgFundCommunityPool) Reset()         { *m = MsgFundCommunityPool{} }
 func (m *MsgFundCommunityPool) String() string { return proto.CompactTextString(m) }
 func (*MsgFundCommunityPool) ProtoMessage()    {}
 func (*MsgFundCommunityPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{3}
+	return fileDescriptor_ed4f433d965e58ca, []int{6}
 }
 func (m *MsgFundCommunityPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -231,11 +349,52 @@ func (m *MsgFundCommunityPool) GetDepositor() github_com_cosmos_cosmos_sdk_types
 	return nil
 }
 
+// MsgFundCommunityPoolResponse defines the Msg/FundCommunityPool response type.
+type MsgFundCommunityPoolResponse struct {
+}
+
+func (m *MsgFundCommunityPoolResponse) Reset()         { *m = MsgFundCommunityPoolResponse{} }
+func (m *MsgFundCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundCommunityPoolResponse) ProtoMessage()    {}
+func (*MsgFundCommunityPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{7}
+}
+func (m *MsgFundCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundCommunityPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundCommunityPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundCommunityPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundCommunityPoolResponse.Merge(m, src)
+}
+func (m *MsgFundCommunityPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundCommunityPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundCommunityPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundCommunityPoolResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddress")
+	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddressResponse")
 	proto.RegisterType((*MsgWithdrawDelegatorReward)(nil), "cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward")
+	proto.RegisterType((*MsgWithdrawDelegatorRewardResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawDelegatorRewardResponse")
 	proto.RegisterType((*MsgWithdrawValidatorCommission)(nil), "cosmos.distribution.v1beta1.MsgWithdrawValidatorCommission")
+	proto.RegisterType((*MsgWithdrawValidatorCommissionResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawValidatorCommissionResponse")
 	proto.RegisterType((*MsgFundCommunityPool)(nil), "cosmos.distribution.v1beta1.MsgFundCommunityPool")
+	proto.RegisterType((*MsgFundCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.MsgFundCommunityPoolResponse")
 }
 
 func init() {
@@ -243,36 +402,43 @@ func init() {
 }
 
 var fileDescriptor_ed4f433d965e58ca = []byte{
-	// 449 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x94, 0xb1, 0x6e, 0xd4, 0x30,
-	0x1c, 0xc6, 0xe3, 0x20, 0x55, 0xc2, 0x20, 0xd1, 0x46, 0x45, 0x1c, 0x87, 0xe4, 0x54, 0x11, 0xc3,
-	0x2d, 0x4d, 0x08, 0x6c, 0x6c, 0xbd, 0x22, 0x26, 0xa2, 0xa2, 0x43, 0x2a, 0x12, 0x0b, 0x72, 0x62,
-	0x2b, 0xb5, 0x48, 0xf2, 0x3f, 0xc5, 0x4e, 0xd3, 0xeb, 0x33, 0x30, 0x30, 0x30, 0xf2, 0x00, 0x88,
-	0x27, 0xe9, 0xc0, 0xd0, 0x91, 0x29, 0xa0, 0xdc, 0x1b, 0x74, 0x64, 0x42, 0x49, 0x9c, 0xd0, 0x6b,
-	0x11, 0x2a, 0x0c, 0x30, 0x25, 0x8a, 0x3f, 0x7f, 0xbf, 0x2f, 0x7f, 0xeb, 0x33, 0xbe, 0x1f, 0x81,
-	0x4c, 0x41, 0x7a, 0x4c, 0x48, 0x95, 0x8b, 0xb0, 0x50, 0x02, 0x32, 0xef, 0xd0, 0x0f, 0xb9, 0xa2,
-	0xbe, 0xa7, 0x8e, 0xdc, 0x79, 0x0e, 0x0a, 0xac, 0x7b, 0x9d, 0xca, 0x3d, 0xaf, 0x72, 0xb5, 0x6a,
-	0xbc, 0x19, 0x43, 0x0c, 0xad, 0xce, 0x6b, 0xde, 0xba, 0x2d, 0x63, 0xa2, 0x8d, 0x43, 0x2a, 0xf9,
-	0x60, 0x18, 0x81, 0xc8, 0xba, 0x75, 0xe7, 0xad, 0x89, 0x6f, 0x07, 0x32, 0x7e, 0xc1, 0xd5, 0x4b,
-	0xa1, 0x0e, 0x58, 0x4e, 0xcb, 0x1d, 0xc6, 0x72, 0x2e, 0xa5, 0x75, 0x8c, 0x37, 0x18, 0x4f, 0x78,
-	0x4c, 0x15, 0xe4, 0xaf, 0x69, 0xf7, 0x71, 0x84, 0xb6, 0xd0, 0xe4, 0xe6, 0x34, 0x38, 0xab, 0xec,
-	0xd1, 0x82, 0xa6, 0xc9, 0x63, 0xe7, 0x92, 0xc4, 0xf9, 0x5e, 0xd9, 0xdb, 0xb1, 0x50, 0x07, 0x45,
-	0xe8, 0x46, 0x90, 0x7a, 0x9a, 0xdf, 0x3d, 0xb6, 0x25, 0x7b, 0xe3, 0xa9, 0xc5, 0x9c, 0x4b, 0x77,
-	0x27, 0x8a, 0x34, 0x69, 0xb6, 0x3e, 0x98, 0xf4, 0xec, 0x12, 0xaf, 0x97, 0x3a, 0xce, 0x80, 0x36,
-	0x5b, 0xf4, 0xb3, 0xb3, 0xca, 0xbe, 0xd3, 0xa1, 0x2f, 0x2a, 0xfe, 0x82, 0x7c, 0xab, 0x5c, 0xfd,
-	0x69, 0xe7, 0xbd, 0x89, 0xc7, 0x81, 0x8c, 0xfb, 0x59, 0x3c, 0xe9, 0x83, 0xcd, 0x78, 0x49, 0x73,
-	0xf6, 0x5f, 0x67, 0x72, 0x8c, 0x37, 0x0e, 0x69, 0x22, 0xd8, 0x0a, 0xdb, 0xbc, 0xc8, 0xbe, 0x24,
-	0xb9, 0x2a, 0x7b, 0x9f, 0x26, 0x03, 0x7b, 0x30, 0xe9, 0xc7, 0xf2, 0x01, 0x61, 0x72, 0x6e, 0x2c,
-	0xfb, 0xfd, 0xfa, 0x2e, 0xa4, 0xa9, 0x90, 0x52, 0x40, 0xf6, 0xeb, 0x78, 0xe8, 0xdf, 0xc4, 0xfb,
-	0x8c, 0xf0, 0x66, 0x20, 0xe3, 0xa7, 0x45, 0xc6, 0x9a, 0x44, 0x45, 0x26, 0xd4, 0xe2, 0x39, 0x40,
-	0x62, 0x45, 0x78, 0x8d, 0xa6, 0x50, 0x64, 0x6a, 0x84, 0xb6, 0xae, 0x4d, 0x6e, 0x3c, 0xbc, 0xeb,
-	0xea, 0x06, 0x35, 0x75, 0xe8, 0x9b, 0xe3, 0xee, 0x82, 0xc8, 0xa6, 0x0f, 0x4e, 0x2a, 0xdb, 0xf8,
-	0xf4, 0xd5, 0x9e, 0x5c, 0x21, 0x4c, 0xb3, 0x41, 0xce, 0xb4, 0xb5, 0xb5, 0x87, 0xaf, 0x33, 0x3e,
-	0x07, 0x29, 0x14, 0xe4, 0xfa, 0x40, 0xfc, 0x3f, 0x3f, 0xf0, 0x9f, 0x1e, 0xd3, 0xbd, 0x8f, 0x35,
-	0x41, 0x27, 0x35, 0x41, 0xa7, 0x35, 0x41, 0xdf, 0x6a, 0x82, 0xde, 0x2d, 0x89, 0x71, 0xba, 0x24,
-	0xc6, 0x97, 0x25, 0x31, 0x5e, 0xf9, 0xbf, 0xf5, 0x3d, 0x5a, 0xbd, 0x42, 0x5a, 0x4c, 0xb8, 0xd6,
-	0x76, 0xfd, 0xd1, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xce, 0x95, 0x71, 0x58, 0x66, 0x04, 0x00,
-	0x00,
+	// 566 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x4f, 0x6b, 0x13, 0x41,
+	0x14, 0xcf, 0xa4, 0x52, 0x70, 0x14, 0x6c, 0x96, 0x4a, 0xe3, 0x56, 0x67, 0xcb, 0x52, 0x24, 0x97,
+	0xee, 0x9a, 0x78, 0x10, 0xeb, 0x41, 0x9a, 0x88, 0x27, 0x43, 0x25, 0x42, 0x05, 0x2f, 0x32, 0xbb,
+	0x33, 0x6c, 0x07, 0x77, 0x77, 0xc2, 0xce, 0x6c, 0xb7, 0xe9, 0x4d, 0xf0, 0xe8, 0x41, 0xd0, 0xa3,
+	0xe0, 0x55, 0xfc, 0x24, 0x39, 0x78, 0xe8, 0xd1, 0x53, 0x94, 0xe4, 0x1b, 0xf4, 0xe8, 0x49, 0x92,
+	0xfd, 0x63, 0xd3, 0x6c, 0xd2, 0xa4, 0x82, 0x3d, 0x25, 0x64, 0x7e, 0xff, 0xde, 0xcb, 0x7b, 0x33,
+	0x70, 0xd3, 0xe6, 0xc2, 0xe3, 0xc2, 0x24, 0x4c, 0xc8, 0x80, 0x59, 0xa1, 0x64, 0xdc, 0x37, 0x0f,
+	0xaa, 0x16, 0x95, 0xb8, 0x6a, 0xca, 0x43, 0xa3, 0x1d, 0x70, 0xc9, 0x95, 0xf5, 0x18, 0x65, 0x9c,
+	0x46, 0x19, 0x09, 0x4a, 0x5d, 0x75, 0xb8, 0xc3, 0x47, 0x38, 0x73, 0xf8, 0x2d, 0xa6, 0xa8, 0x28,
+	0x11, 0xb6, 0xb0, 0xa0, 0x99, 0xa0, 0xcd, 0x99, 0x1f, 0x9f, 0xeb, 0xef, 0x8b, 0xf0, 0x66, 0x53,
+	0x38, 0x2f, 0xa8, 0x7c, 0xc9, 0xe4, 0x3e, 0x09, 0x70, 0xb4, 0x43, 0x48, 0x40, 0x85, 0x50, 0x8e,
+	0x60, 0x89, 0x50, 0x97, 0x3a, 0x58, 0xf2, 0xe0, 0x35, 0x8e, 0x7f, 0x2c, 0x83, 0x0d, 0x50, 0xb9,
+	0x5e, 0x6f, 0x9e, 0xf4, 0xb4, 0x72, 0x07, 0x7b, 0xee, 0xb6, 0x3e, 0x01, 0xd1, 0x7f, 0xf7, 0xb4,
+	0x2d, 0x87, 0xc9, 0xfd, 0xd0, 0x32, 0x6c, 0xee, 0x99, 0x89, 0x7f, 0xfc, 0xb1, 0x25, 0xc8, 0x1b,
+	0x53, 0x76, 0xda, 0x54, 0x18, 0x3b, 0xb6, 0x9d, 0x38, 0xb5, 0x56, 0x32, 0x91, 0xd4, 0x3b, 0x82,
+	0x2b, 0x51, 0x12, 0x27, 0xb3, 0x2e, 0x8e, 0xac, 0x9f, 0x9d, 0xf4, 0xb4, 0xb5, 0xd8, 0xfa, 0x2c,
+	0xe2, 0x02, 0xce, 0x37, 0xa2, 0xf1, 0xa2, 0x75, 0x0d, 0xde, 0xc9, 0xed, 0x46, 0x8b, 0x8a, 0x36,
+	0xf7, 0x05, 0xd5, 0x3f, 0x15, 0xa1, 0xda, 0x14, 0x4e, 0x7a, 0xfc, 0x24, 0x4d, 0xde, 0xa2, 0x11,
+	0x0e, 0xc8, 0xa5, 0x36, 0xed, 0x08, 0x96, 0x0e, 0xb0, 0xcb, 0xc8, 0x98, 0x77, 0xf1, 0xac, 0xf7,
+	0x04, 0x64, 0x5e, 0xef, 0x3d, 0xec, 0x66, 0xde, 0x99, 0x48, 0xda, 0xb7, 0x4d, 0xa8, 0x4f, 0xef,
+	0x4a, 0xd6, 0xbc, 0xcf, 0x00, 0xa2, 0x53, 0xb0, 0xbd, 0x54, 0xa5, 0xc1, 0x3d, 0x8f, 0x09, 0xc1,
+	0xb8, 0x9f, 0x5f, 0x04, 0xf8, 0x3f, 0x45, 0x54, 0xe0, 0xdd, 0xd9, 0xe9, 0xb2, 0x42, 0xbe, 0x03,
+	0xb8, 0xda, 0x14, 0xce, 0xd3, 0xd0, 0x27, 0xc3, 0xd3, 0xd0, 0x67, 0xb2, 0xf3, 0x9c, 0x73, 0x57,
+	0xb1, 0xe1, 0x32, 0xf6, 0x78, 0xe8, 0xcb, 0x32, 0xd8, 0x58, 0xaa, 0x5c, 0xab, 0xdd, 0x32, 0x92,
+	0x95, 0x1d, 0xee, 0x5f, 0xba, 0xaa, 0x46, 0x83, 0x33, 0xbf, 0x7e, 0xaf, 0xdb, 0xd3, 0x0a, 0xdf,
+	0x7e, 0x6a, 0x95, 0x39, 0x62, 0x0f, 0x09, 0xa2, 0x95, 0x48, 0x2b, 0xbb, 0xf0, 0x2a, 0xa1, 0x6d,
+	0x2e, 0x98, 0xe4, 0x41, 0xf2, 0x07, 0x57, 0x17, 0x1f, 0xa0, 0xbf, 0x1a, 0x3a, 0x82, 0xb7, 0xf3,
+	0xaa, 0x49, 0xcb, 0xad, 0x75, 0xaf, 0xc0, 0xa5, 0xa6, 0x70, 0x94, 0x77, 0x00, 0x2a, 0x39, 0x37,
+	0x45, 0xcd, 0x98, 0x71, 0x2f, 0x19, 0xb9, 0xfb, 0xa4, 0x6e, 0x2f, 0xce, 0x49, 0xe3, 0x28, 0x1f,
+	0x01, 0x5c, 0x9b, 0xb6, 0x80, 0x0f, 0xce, 0xd3, 0x9d, 0x42, 0x54, 0x1f, 0x5f, 0x90, 0x98, 0xa5,
+	0xfa, 0x02, 0xe0, 0xfa, 0xac, 0xc9, 0x7e, 0x34, 0xaf, 0x41, 0x0e, 0x59, 0x6d, 0xfc, 0x03, 0x39,
+	0x4b, 0xf8, 0x16, 0xc0, 0xd2, 0xe4, 0xc8, 0x56, 0xcf, 0x93, 0x9e, 0xa0, 0xa8, 0x0f, 0x17, 0xa6,
+	0xa4, 0x19, 0xea, 0xbb, 0x5f, 0xfb, 0x08, 0x74, 0xfb, 0x08, 0x1c, 0xf7, 0x11, 0xf8, 0xd5, 0x47,
+	0xe0, 0xc3, 0x00, 0x15, 0x8e, 0x07, 0xa8, 0xf0, 0x63, 0x80, 0x0a, 0xaf, 0xaa, 0x33, 0x47, 0xf8,
+	0x70, 0xfc, 0x79, 0x1c, 0x4d, 0xb4, 0xb5, 0x3c, 0x7a, 0xc7, 0xee, 0xff, 0x19, 0x00, 0xdd, 0x54,
+	0x9f, 0xbd, 0x42, 0x07, 0x00, 0x00,
 }
 
 func (this *MsgSetWithdrawAddress) Equal(that interface{}) bool {
@@ -302,6 +468,27 @@ func (this *MsgSetWithdrawAddress) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgSetWithdrawAddressResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetWithdrawAddressResponse)
+	if !ok {
+		that2, ok := that.(MsgSetWithdrawAddressResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 func (this *MsgWithdrawDelegatorReward) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -329,6 +516,27 @@ func (this *MsgWithdrawDelegatorReward) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgWithdrawDelegatorRewardResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgWithdrawDelegatorRewardResponse)
+	if !ok {
+		that2, ok := that.(MsgWithdrawDelegatorRewardResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 func (this *MsgWithdrawValidatorCommission) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -353,6 +561,27 @@ func (this *MsgWithdrawValidatorCommission) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgWithdrawValidatorCommissionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgWithdrawValidatorCommissionResponse)
+	if !ok {
+		that2, ok := that.(MsgWithdrawValidatorCommissionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 func (this *MsgFundCommunityPool) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -385,6 +614,232 @@ func (this *MsgFundCommunityPool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgFundCommunityPoolResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgFundCommunityPoolResponse)
+	if !ok {
+		that2, ok := that.(MsgFundCommunityPoolResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SetWithdrawAddress defines a method to change the withdraw address
+	// for a delegator (or validator self-delegation).
+	SetWithdrawAddress(ctx context.Context, in *MsgSetWithdrawAddress, opts ...grpc.CallOption) (*MsgSetWithdrawAddressResponse, error)
+	// WithdrawDelegatorReward defines a method to withdraw rewards of delegator
+	// from a single validator.
+	WithdrawDelegatorReward(ctx context.Context, in *MsgWithdrawDelegatorReward, opts ...grpc.CallOption) (*MsgWithdrawDelegatorRewardResponse, error)
+	// WithdrawValidatorCommission defines a method to withdraw the
+	// full commission to the validator address.
+	WithdrawValidatorCommission(ctx context.Context, in *MsgWithdrawValidatorCommission, opts ...grpc.CallOption) (*MsgWithdrawValidatorCommissionResponse, error)
+	// FundCommunityPool defines a method to allow an account to directly
+	// fund the community pool.
+	FundCommunityPool(ctx context.Context, in *MsgFundCommunityPool, opts ...grpc.CallOption) (*MsgFundCommunityPoolResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetWithdrawAddress(ctx context.Context, in *MsgSetWithdrawAddress, opts ...grpc.CallOption) (*MsgSetWithdrawAddressResponse, error) {
+	out := new(MsgSetWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/SetWithdrawAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawDelegatorReward(ctx context.Context, in *MsgWithdrawDelegatorReward, opts ...grpc.CallOption) (*MsgWithdrawDelegatorRewardResponse, error) {
+	out := new(MsgWithdrawDelegatorRewardResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/WithdrawDelegatorReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawValidatorCommission(ctx context.Context, in *MsgWithdrawValidatorCommission, opts ...grpc.CallOption) (*MsgWithdrawValidatorCommissionResponse, error) {
+	out := new(MsgWithdrawValidatorCommissionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/WithdrawValidatorCommission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundCommunityPool(ctx context.Context, in *MsgFundCommunityPool, opts ...grpc.CallOption) (*MsgFundCommunityPoolResponse, error) {
+	out := new(MsgFundCommunityPoolResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/FundCommunityPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SetWithdrawAddress defines a method to change the withdraw address
+	// for a delegator (or validator self-delegation).
+	SetWithdrawAddress(context.Context, *MsgSetWithdrawAddress) (*MsgSetWithdrawAddressResponse, error)
+	// WithdrawDelegatorReward defines a method to withdraw rewards of delegator
+	// from a single validator.
+	WithdrawDelegatorReward(context.Context, *MsgWithdrawDelegatorReward) (*MsgWithdrawDelegatorRewardResponse, error)
+	// WithdrawValidatorCommission defines a method to withdraw the
+	// full commission to the validator address.
+	WithdrawValidatorCommission(context.Context, *MsgWithdrawValidatorCommission) (*MsgWithdrawValidatorCommissionResponse, error)
+	// FundCommunityPool defines a method to allow an account to directly
+	// fund the community pool.
+	FundCommunityPool(context.Context, *MsgFundCommunityPool) (*MsgFundCommunityPoolResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetWithdrawAddress(ctx context.Context, req *MsgSetWithdrawAddress) (*MsgSetWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWithdrawAddress not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawDelegatorReward(ctx context.Context, req *MsgWithdrawDelegatorReward) (*MsgWithdrawDelegatorRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawDelegatorReward not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawValidatorCommission(ctx context.Context, req *MsgWithdrawValidatorCommission) (*MsgWithdrawValidatorCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawValidatorCommission not implemented")
+}
+func (*UnimplementedMsgServer) FundCommunityPool(ctx context.Context, req *MsgFundCommunityPool) (*MsgFundCommunityPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundCommunityPool not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetWithdrawAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/SetWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetWithdrawAddress(ctx, req.(*MsgSetWithdrawAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawDelegatorReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawDelegatorReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawDelegatorReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/WithdrawDelegatorReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawDelegatorReward(ctx, req.(*MsgWithdrawDelegatorReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawValidatorCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawValidatorCommission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawValidatorCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/WithdrawValidatorCommission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawValidatorCommission(ctx, req.(*MsgWithdrawValidatorCommission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundCommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundCommunityPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundCommunityPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/FundCommunityPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundCommunityPool(ctx, req.(*MsgFundCommunityPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.distribution.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetWithdrawAddress",
+			Handler:    _Msg_SetWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "WithdrawDelegatorReward",
+			Handler:    _Msg_WithdrawDelegatorReward_Handler,
+		},
+		{
+			MethodName: "WithdrawValidatorCommission",
+			Handler:    _Msg_WithdrawValidatorCommission_Handler,
+		},
+		{
+			MethodName: "FundCommunityPool",
+			Handler:    _Msg_FundCommunityPool_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/distribution/v1beta1/tx.proto",
+}
+
 func (m *MsgSetWithdrawAddress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -422,6 +877,29 @@ func (m *MsgSetWithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgWithdrawDelegatorReward) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -459,6 +937,29 @@ func (m *MsgWithdrawDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgWithdrawDelegatorRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawDelegatorRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawDelegatorRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgWithdrawValidatorCommission) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -489,6 +990,29 @@ func (m *MsgWithdrawValidatorCommission) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgWithdrawValidatorCommissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawValidatorCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawValidatorCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgFundCommunityPool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -533,6 +1057,29 @@ func (m *MsgFundCommunityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgFundCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundCommunityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -561,6 +1108,15 @@ func (m *MsgSetWithdrawAddress) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgWithdrawDelegatorReward) Size() (n int) {
 	if m == nil {
 		return 0
@@ -578,6 +1134,15 @@ func (m *MsgWithdrawDelegatorReward) Size() (n int) {
 	return n
 }
 
+func (m *MsgWithdrawDelegatorRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgWithdrawValidatorCommission) Size() (n int) {
 	if m == nil {
 		return 0
@@ -591,6 +1156,15 @@ func (m *MsgWithdrawValidatorCommission) Size() (n int) {
 	return n
 }
 
+func (m *MsgWithdrawValidatorCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgFundCommunityPool) Size() (n int) {
 	if m == nil {
 		return 0
@@ -610,6 +1184,15 @@ func (m *MsgFundCommunityPool) Size() (n int) {
 	return n
 }
 
+func (m *MsgFundCommunityPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -737,6 +1320,59 @@ func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -858,6 +1494,59 @@ func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgWithdrawDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawDelegatorRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawDelegatorRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -945,6 +1634,59 @@ func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgWithdrawValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawValidatorCommissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawValidatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1066,6 +1808,59 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -114,7 +115,10 @@ func (suite *HandlerTestSuite) TestMsgSubmitEvidence() {
 			suite.Require().NotNil(res, "expected non-nil result; tc #%d", i)
 
 			msg := tc.msg.(exported.MsgSubmitEvidence)
-			suite.Require().Equal(msg.GetEvidence().Hash().Bytes(), res.Data, "invalid hash; tc #%d", i)
+
+			var resData types.MsgSubmitEvidenceResponse
+			suite.Require().NoError(proto.Unmarshal(res.Data, &resData))
+			suite.Require().Equal(msg.GetEvidence().Hash().Bytes(), resData.Hash, "invalid hash; tc #%d", i)
 		}
 	}
 }
@@ -0,0 +1,140 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// GetQueryCmd returns the cli query commands for the feegrant module
+func GetQueryCmd() *cobra.Command {
+	feegrantQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the feegrant module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feegrantQueryCmd.AddCommand(
+		GetCmdQueryFeeGrant(),
+		GetCmdQueryFeeGrants(),
+	)
+
+	return feegrantQueryCmd
+}
+
+// GetCmdQueryFeeGrant implements the query grant command.
+func GetCmdQueryFeeGrant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [granter] [grantee]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the fee allowance given by a granter to a grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the fee allowance given by a granter to a grantee.
+
+Example:
+$ %s query %s grant cosmos1skj.. cosmos1skjwj..
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Allowance(
+				context.Background(),
+				&types.QueryAllowanceRequest{
+					Granter: granter,
+					Grantee: grantee,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res.Allowance)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryFeeGrants implements the query grants command.
+func GetCmdQueryFeeGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grants [grantee]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query all the fee allowances given to a grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the fee allowances given to a grantee.
+
+Example:
+$ %s query %s grants cosmos1skjwj..
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Allowances(
+				context.Background(),
+				&types.QueryAllowancesRequest{
+					Grantee:    grantee,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "grants")
+
+	return cmd
+}
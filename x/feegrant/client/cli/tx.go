@@ -0,0 +1,216 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// Flags for the feegrant tx commands
+const (
+	FlagSpendLimit      = "spend-limit"
+	FlagExpiration      = "expiration"
+	FlagPeriod          = "period"
+	FlagPeriodLimit     = "period-limit"
+	FlagAllowedMessages = "allowed-messages"
+)
+
+// GetTxCmd returns the transaction commands for the feegrant module
+func GetTxCmd() *cobra.Command {
+	feegrantTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Fee grant transactions subcommands",
+		Long:                       "Grant and revoke fee allowances for an address",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feegrantTxCmd.AddCommand(
+		NewCmdFeeGrant(),
+		NewCmdRevokeFeegrant(),
+	)
+
+	return feegrantTxCmd
+}
+
+// NewCmdFeeGrant implements the grant command.
+func NewCmdFeeGrant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] --from [granter]",
+		Short: "Grant a fee allowance to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant an allowance to an address to pay the fees of its transactions
+from your account. The allowance may be limited in amount and time, refilled
+every period, and restricted to some Msg types.
+
+Examples:
+$ %s tx %s grant cosmos1skjw.. --spend-limit=1000stake --expiration=2022-01-30T15:04:05Z --from=cosmos1skl..
+$ %s tx %s grant cosmos1skjw.. --spend-limit=1000stake --period=3600 --period-limit=10stake --from=cosmos1skl..
+$ %s tx %s grant cosmos1skjw.. --allowed-messages=/cosmos.gov.v1beta1.MsgVote --from=cosmos1skl..
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			allowance, err := parseAllowance(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgGrantAllowance(allowance, clientCtx.GetFromAddress(), grantee)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagSpendLimit, "", "Maximum amount of tokens the grantee can spend, unlimited if empty")
+	cmd.Flags().String(FlagExpiration, "", "Expiration time of the allowance in RFC3339 format, never if empty")
+	cmd.Flags().Int64(FlagPeriod, 0, "Period in seconds after which the period limit is reset")
+	cmd.Flags().String(FlagPeriodLimit, "", "Maximum amount of tokens the grantee can spend within a period")
+	cmd.Flags().StringSlice(FlagAllowedMessages, []string{}, "Msg type URLs the allowance is restricted to")
+
+	return cmd
+}
+
+func parseAllowance(cmd *cobra.Command) (exported.FeeAllowanceI, error) {
+	limit, err := cmd.Flags().GetString(FlagSpendLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	spendLimit, err := sdk.ParseCoins(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	exp, err := cmd.Flags().GetString(FlagExpiration)
+	if err != nil {
+		return nil, err
+	}
+
+	basic := types.BasicAllowance{SpendLimit: spendLimit}
+
+	if exp != "" {
+		expiration, err := time.Parse(time.RFC3339, exp)
+		if err != nil {
+			return nil, err
+		}
+		basic.Expiration = &expiration
+	}
+
+	var allowance exported.FeeAllowanceI = &basic
+
+	period, err := cmd.Flags().GetInt64(FlagPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	periodLimitStr, err := cmd.Flags().GetString(FlagPeriodLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	if period > 0 || periodLimitStr != "" {
+		periodLimit, err := sdk.ParseCoins(periodLimitStr)
+		if err != nil {
+			return nil, err
+		}
+
+		if period <= 0 || periodLimit.Empty() {
+			return nil, fmt.Errorf("both --%s and --%s must be set for a periodic allowance", FlagPeriod, FlagPeriodLimit)
+		}
+
+		periodDuration := time.Duration(period) * time.Second
+
+		allowance = &types.PeriodicAllowance{
+			Basic:            basic,
+			Period:           periodDuration,
+			PeriodReset:      time.Now().Add(periodDuration),
+			PeriodSpendLimit: periodLimit,
+			PeriodCanSpend:   periodLimit,
+		}
+	}
+
+	allowedMsgs, err := cmd.Flags().GetStringSlice(FlagAllowedMessages)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(allowedMsgs) > 0 {
+		return types.NewAllowedMsgAllowance(allowance, allowedMsgs)
+	}
+
+	return allowance, nil
+}
+
+// NewCmdRevokeFeegrant implements the revoke command.
+func NewCmdRevokeFeegrant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [grantee] --from [granter]",
+		Short: "Revoke a fee allowance",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the fee allowance granted to an address.
+
+Example:
+$ %s tx %s revoke cosmos1skj.. --from=cosmos1skj..
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeAllowance(clientCtx.GetFromAddress(), grantee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,27 @@
+package exported
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeAllowanceI is implemented by any fee allowance a granter may give a
+// grantee to pay the fees of its transactions.
+type FeeAllowanceI interface {
+	proto.Message
+
+	// Accept checks whether the allowance can pay fee for a transaction made of
+	// msgs, and updates the allowance accordingly, e.g. by lowering its spend
+	// limit. Accept therefore requires a pointer receiver and must only be
+	// called on a copy of the stored allowance, which is persisted if it
+	// succeeds.
+	//
+	// If remove is true, the allowance is exhausted or expired and must be
+	// deleted from state.
+	Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (remove bool, err error)
+
+	// ValidateBasic does a simple validation check that doesn't require access
+	// to any other information.
+	ValidateBasic() error
+}
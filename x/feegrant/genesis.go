@@ -0,0 +1,43 @@
+package feegrant
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// InitGenesis initializes the feegrant module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs *types.GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
+	}
+
+	for _, grant := range gs.Allowances {
+		allowance, err := grant.GetGrant()
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.GrantAllowance(ctx, grant.Granter, grant.Grantee, allowance); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the feegrant module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	grants := []types.Grant{}
+
+	err := k.IterateAllFeeAllowances(ctx, func(grant types.Grant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(grants)
+}
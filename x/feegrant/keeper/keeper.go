@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// Keeper defines the feegrant module's keeper. It stores the fee allowances
+// given by granters and charges them when grantees use them to pay fees.
+type Keeper struct {
+	cdc        codec.BinaryMarshaler
+	storeKey   sdk.StoreKey
+	authKeeper types.AccountKeeper
+}
+
+// NewKeeper constructs a new feegrant Keeper.
+func NewKeeper(cdc codec.BinaryMarshaler, storeKey sdk.StoreKey, ak types.AccountKeeper) Keeper {
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		authKeeper: ak,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GrantAllowance stores allowance from granter to grantee, overwriting any
+// existing one. The grantee account is created if it doesn't exist yet, so
+// that it can sign transactions paid by the granter.
+func (k Keeper) GrantAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, allowance exported.FeeAllowanceI) error {
+	if k.authKeeper.GetAccount(ctx, grantee) == nil {
+		granteeAcc := k.authKeeper.NewAccountWithAddress(ctx, grantee)
+		k.authKeeper.SetAccount(ctx, granteeAcc)
+	}
+
+	if err := k.setGrant(ctx, granter, grantee, allowance); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// RevokeAllowance removes the allowance given by granter to grantee.
+func (k Keeper) RevokeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.FeeAllowanceKey(granter, grantee)
+
+	if !store.Has(key) {
+		return sdkerrors.Wrapf(types.ErrNoAllowance, "fee-grant not found from %s to %s", granter, grantee)
+	}
+
+	store.Delete(key)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetAllowance returns the allowance given by granter to grantee, or an error
+// if there is none.
+func (k Keeper) GetAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) (exported.FeeAllowanceI, error) {
+	grant, err := k.getGrant(ctx, granter, grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	return grant.GetGrant()
+}
+
+// IterateAllFeeAllowances iterates over all the allowances and calls cb with
+// each of them. The iteration stops if cb returns true.
+func (k Keeper) IterateAllFeeAllowances(ctx sdk.Context, cb func(grant types.Grant) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.FeeAllowanceKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		grant, err := k.unmarshalGrant(iterator.Value())
+		if err != nil {
+			return err
+		}
+
+		if cb(grant) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// UseGrantedFees checks that the allowance given by granter to grantee can
+// pay fee for a transaction made of msgs, and updates the allowance
+// accordingly. An allowance which is used up or expired is deleted.
+func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	grant, err := k.getGrant(ctx, granter, grantee)
+	if err != nil {
+		return err
+	}
+
+	allowance, err := grant.GetGrant()
+	if err != nil {
+		return err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if remove {
+		ctx.KVStore(k.storeKey).Delete(types.FeeAllowanceKey(granter, grantee))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUseFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	if remove {
+		return nil
+	}
+
+	// the allowance was updated by Accept and must be saved
+	return k.setGrant(ctx, granter, grantee, allowance)
+}
+
+func (k Keeper) setGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, allowance exported.FeeAllowanceI) error {
+	grant, err := types.NewGrant(granter, grantee, allowance)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.MarshalBinaryBare(&grant)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.FeeAllowanceKey(granter, grantee), bz)
+
+	return nil
+}
+
+func (k Keeper) getGrant(ctx sdk.Context, granter, grantee sdk.AccAddress) (types.Grant, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.FeeAllowanceKey(granter, grantee))
+	if bz == nil {
+		return types.Grant{}, sdkerrors.Wrapf(types.ErrNoAllowance, "fee-grant not found from %s to %s", granter, grantee)
+	}
+
+	return k.unmarshalGrant(bz)
+}
+
+func (k Keeper) unmarshalGrant(bz []byte) (types.Grant, error) {
+	var grant types.Grant
+	if err := k.cdc.UnmarshalBinaryBare(bz, &grant); err != nil {
+		return types.Grant{}, err
+	}
+
+	return grant, nil
+}
@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.FeeGrantKeeper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func (suite *KeeperTestSuite) TestGrantAndRevokeAllowance() {
+	app, ctx := suite.app, suite.ctx
+	granter, grantee, other := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	basic := &types.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 100))}
+
+	_, err := app.FeeGrantKeeper.GetAllowance(ctx, granter, grantee)
+	suite.Require().Error(err)
+
+	suite.T().Log("verify an allowance can be granted, read and revoked")
+	suite.Require().NoError(app.FeeGrantKeeper.GrantAllowance(ctx, granter, grantee, basic))
+	suite.Require().NoError(app.FeeGrantKeeper.GrantAllowance(ctx, other, grantee, basic))
+
+	allowance, err := app.FeeGrantKeeper.GetAllowance(ctx, granter, grantee)
+	suite.Require().NoError(err)
+	suite.Require().Equal(basic, allowance)
+
+	suite.Require().NoError(app.FeeGrantKeeper.RevokeAllowance(ctx, granter, grantee))
+	suite.Require().Error(app.FeeGrantKeeper.RevokeAllowance(ctx, granter, grantee))
+
+	_, err = app.FeeGrantKeeper.GetAllowance(ctx, granter, grantee)
+	suite.Require().Error(err)
+
+	_, err = app.FeeGrantKeeper.GetAllowance(ctx, other, grantee)
+	suite.Require().NoError(err)
+
+	suite.T().Log("verify the grantee account is created with the allowance")
+	newGrantee := sdk.AccAddress([]byte("new-grantee-address-"))
+	suite.Require().Nil(app.AccountKeeper.GetAccount(ctx, newGrantee))
+	suite.Require().NoError(app.FeeGrantKeeper.GrantAllowance(ctx, granter, newGrantee, basic))
+	suite.Require().NotNil(app.AccountKeeper.GetAccount(ctx, newGrantee))
+}
+
+func (suite *KeeperTestSuite) TestUseGrantedFees() {
+	app, ctx := suite.app, suite.ctx
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+	atom := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("atom", amount)) }
+
+	suite.Require().Error(app.FeeGrantKeeper.UseGrantedFees(ctx, granter, grantee, atom(10), nil))
+
+	expiration := ctx.BlockTime().Add(time.Hour)
+	suite.Require().NoError(app.FeeGrantKeeper.GrantAllowance(ctx, granter, grantee, &types.BasicAllowance{
+		SpendLimit: atom(100),
+		Expiration: &expiration,
+	}))
+
+	suite.T().Log("verify a fee over the limit leaves the allowance untouched")
+	suite.Require().Error(app.FeeGrantKeeper.UseGrantedFees(ctx, granter, grantee, atom(101), nil))
+
+	suite.T().Log("verify used fees are deducted from the allowance")
+	suite.Require().NoError(app.FeeGrantKeeper.UseGrantedFees(ctx, granter, grantee, atom(40), nil))
+
+	allowance, err := app.FeeGrantKeeper.GetAllowance(ctx, granter, grantee)
+	suite.Require().NoError(err)
+	suite.Require().Equal(atom(60), allowance.(*types.BasicAllowance).SpendLimit)
+
+	suite.T().Log("verify an expired allowance is removed")
+	expiredCtx := ctx.WithBlockTime(expiration)
+	suite.Require().Error(app.FeeGrantKeeper.UseGrantedFees(expiredCtx, granter, grantee, atom(10), nil))
+
+	_, err = app.FeeGrantKeeper.GetAllowance(ctx, granter, grantee)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestMsgServer() {
+	app, ctx := suite.app, suite.ctx
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+	msgServer := keeper.NewMsgServerImpl(app.FeeGrantKeeper)
+
+	msg, err := types.NewMsgGrantAllowance(&types.BasicAllowance{}, granter, grantee)
+	suite.Require().NoError(err)
+
+	_, err = msgServer.GrantAllowance(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+
+	suite.T().Log("verify an existing allowance cannot be overwritten")
+	_, err = msgServer.GrantAllowance(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().Error(err)
+
+	_, err = msgServer.RevokeAllowance(sdk.WrapSDKContext(ctx), types.NewMsgRevokeAllowance(granter, grantee))
+	suite.Require().NoError(err)
+
+	_, err = msgServer.RevokeAllowance(sdk.WrapSDKContext(ctx), types.NewMsgRevokeAllowance(granter, grantee))
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueries() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	granter, grantee, other := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	basic := &types.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 100))}
+
+	_, err := queryClient.Allowance(gocontext.Background(), &types.QueryAllowanceRequest{Granter: granter, Grantee: grantee})
+	suite.Require().Error(err)
+
+	suite.Require().NoError(app.FeeGrantKeeper.GrantAllowance(ctx, granter, grantee, basic))
+	suite.Require().NoError(app.FeeGrantKeeper.GrantAllowance(ctx, other, grantee, basic))
+
+	res, err := queryClient.Allowance(gocontext.Background(), &types.QueryAllowanceRequest{Granter: granter, Grantee: grantee})
+	suite.Require().NoError(err)
+	allowance, err := res.Allowance.GetGrant()
+	suite.Require().NoError(err)
+	suite.Require().Equal(basic, allowance)
+
+	allRes, err := queryClient.Allowances(gocontext.Background(), &types.QueryAllowancesRequest{Grantee: grantee})
+	suite.Require().NoError(err)
+	suite.Require().Len(allRes.Allowances, 2)
+
+	allRes, err = queryClient.Allowances(gocontext.Background(), &types.QueryAllowancesRequest{Grantee: granter})
+	suite.Require().NoError(err)
+	suite.Require().Empty(allRes.Allowances)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,131 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func TestBasicAllowance(t *testing.T) {
+	now := time.Now().UTC()
+	ctx := sdk.NewContext(nil, abci.Header{Time: now}, false, log.NewNopLogger())
+	atom := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("atom", amount)) }
+	later := now.Add(time.Hour)
+
+	testCases := []struct {
+		name      string
+		allowance *types.BasicAllowance
+		fee       sdk.Coins
+		accept    bool
+		remove    bool
+		remaining sdk.Coins
+	}{
+		{"no limit", &types.BasicAllowance{}, atom(1000), true, false, nil},
+		{"under limit", &types.BasicAllowance{SpendLimit: atom(100)}, atom(30), true, false, atom(70)},
+		{"exact limit", &types.BasicAllowance{SpendLimit: atom(100)}, atom(100), true, true, nil},
+		{"over limit", &types.BasicAllowance{SpendLimit: atom(100)}, atom(101), false, false, nil},
+		{"other denom", &types.BasicAllowance{SpendLimit: atom(100)}, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), false, false, nil},
+		{"not expired", &types.BasicAllowance{SpendLimit: atom(100), Expiration: &later}, atom(10), true, false, atom(90)},
+		{"expired", &types.BasicAllowance{SpendLimit: atom(100), Expiration: &now}, atom(10), false, true, nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.allowance.ValidateBasic())
+
+			remove, err := tc.allowance.Accept(ctx, tc.fee, nil)
+			require.Equal(t, tc.remove, remove)
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			if !remove && tc.remaining != nil {
+				require.Equal(t, tc.remaining, tc.allowance.SpendLimit)
+			}
+		})
+	}
+
+	require.Error(t, (&types.BasicAllowance{SpendLimit: sdk.Coins{sdk.NewInt64Coin("atom", 0)}}).ValidateBasic())
+}
+
+func TestPeriodicAllowance(t *testing.T) {
+	now := time.Now().UTC()
+	ctx := sdk.NewContext(nil, abci.Header{Time: now}, false, log.NewNopLogger())
+	atom := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("atom", amount)) }
+
+	allowance := &types.PeriodicAllowance{
+		Basic:            types.BasicAllowance{SpendLimit: atom(100)},
+		Period:           time.Hour,
+		PeriodSpendLimit: atom(30),
+		PeriodCanSpend:   atom(30),
+		PeriodReset:      now.Add(time.Hour),
+	}
+	require.NoError(t, allowance.ValidateBasic())
+
+	t.Log("verify fees are limited within a period")
+	remove, err := allowance.Accept(ctx, atom(20), nil)
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Equal(t, atom(10), allowance.PeriodCanSpend)
+	require.Equal(t, atom(80), allowance.Basic.SpendLimit)
+
+	_, err = allowance.Accept(ctx, atom(20), nil)
+	require.Error(t, err)
+
+	t.Log("verify the period limit is reset after the period")
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	allowance.Basic.SpendLimit = atom(80)
+	allowance.PeriodCanSpend = atom(10)
+	remove, err = allowance.Accept(ctx, atom(20), nil)
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Equal(t, atom(10), allowance.PeriodCanSpend)
+	require.Equal(t, atom(60), allowance.Basic.SpendLimit)
+	require.Equal(t, now.Add(2*time.Hour), allowance.PeriodReset)
+
+	t.Log("verify the period limit is capped by the spend limit")
+	ctx = ctx.WithBlockTime(now.Add(5 * time.Hour))
+	allowance.Basic.SpendLimit = atom(20)
+	remove, err = allowance.Accept(ctx, atom(20), nil)
+	require.NoError(t, err)
+	require.True(t, remove)
+	require.Equal(t, now.Add(6*time.Hour), allowance.PeriodReset)
+
+	t.Log("verify invalid periods are rejected")
+	allowance.Period = 0
+	require.Error(t, allowance.ValidateBasic())
+}
+
+func TestAllowedMsgAllowance(t *testing.T) {
+	ctx := sdk.NewContext(nil, abci.Header{Time: time.Now().UTC()}, false, log.NewNopLogger())
+	atom := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("atom", amount)) }
+	_, _, from := testdata.KeyTestPubAddr()
+	_, _, to := testdata.KeyTestPubAddr()
+
+	allowance, err := types.NewAllowedMsgAllowance(&types.BasicAllowance{SpendLimit: atom(100)}, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+	require.NoError(t, err)
+	require.NoError(t, allowance.ValidateBasic())
+
+	send := banktypes.NewMsgSend(from, to, atom(1))
+	remove, err := allowance.Accept(ctx, atom(40), []sdk.Msg{send})
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Equal(t, &types.BasicAllowance{SpendLimit: atom(60)}, allowance.GetAllowance())
+
+	_, err = allowance.Accept(ctx, atom(10), []sdk.Msg{send, testdata.NewTestMsg(from)})
+	require.True(t, types.ErrMessageNotAllowed.Is(err))
+
+	allowance.AllowedMessages = nil
+	require.Error(t, allowance.ValidateBasic())
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+)
+
+var _ exported.FeeAllowanceI = (*BasicAllowance)(nil)
+
+// Accept can use fee payment requested as well as timestamp of the current
+// block to determine whether or not to process this. It checks the expiration
+// and subtracts fee from the spend limit.
+//
+// If remove is true (regardless of the error), the allowance is either expired
+// or used up and must be deleted from state.
+func (a *BasicAllowance) Accept(ctx sdk.Context, fee sdk.Coins, _ []sdk.Msg) (bool, error) {
+	if a.Expiration != nil && !ctx.BlockTime().Before(*a.Expiration) {
+		return true, sdkerrors.Wrap(ErrFeeLimitExpired, "basic allowance")
+	}
+
+	if a.SpendLimit != nil {
+		left, invalid := a.SpendLimit.SafeSub(fee)
+		if invalid {
+			return false, sdkerrors.Wrap(ErrFeeLimitExceeded, "basic allowance")
+		}
+
+		a.SpendLimit = left
+		return left.IsZero(), nil
+	}
+
+	return false, nil
+}
+
+// ValidateBasic implements FeeAllowanceI.ValidateBasic
+func (a BasicAllowance) ValidateBasic() error {
+	if a.SpendLimit != nil {
+		if !a.SpendLimit.IsValid() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "send amount is invalid: %s", a.SpendLimit)
+		}
+		if !a.SpendLimit.IsAllPositive() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "spend limit must be positive")
+		}
+	}
+
+	if a.Expiration != nil && a.Expiration.Unix() < 0 {
+		return sdkerrors.Wrap(ErrInvalidDuration, "expiration time cannot be negative")
+	}
+
+	return nil
+}
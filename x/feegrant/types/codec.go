@@ -0,0 +1,54 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+)
+
+// RegisterCodec registers all the necessary types and interfaces for the
+// feegrant module.
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterInterface((*exported.FeeAllowanceI)(nil), nil)
+	cdc.RegisterConcrete(&MsgGrantAllowance{}, "cosmos-sdk/MsgGrantAllowance", nil)
+	cdc.RegisterConcrete(&MsgRevokeAllowance{}, "cosmos-sdk/MsgRevokeAllowance", nil)
+	cdc.RegisterConcrete(&BasicAllowance{}, "cosmos-sdk/BasicAllowance", nil)
+	cdc.RegisterConcrete(&PeriodicAllowance{}, "cosmos-sdk/PeriodicAllowance", nil)
+	cdc.RegisterConcrete(&AllowedMsgAllowance{}, "cosmos-sdk/AllowedMsgAllowance", nil)
+}
+
+// RegisterInterfaces registers the feegrant Msgs and the FeeAllowanceI
+// interface with its implementations.
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgGrantAllowance{},
+		&MsgRevokeAllowance{},
+	)
+	registry.RegisterInterface(
+		"cosmos.feegrant.v1beta1.FeeAllowanceI",
+		(*exported.FeeAllowanceI)(nil),
+		&BasicAllowance{},
+		&PeriodicAllowance{},
+		&AllowedMsgAllowance{},
+	)
+}
+
+var (
+	amino = codec.New()
+
+	// ModuleCdc references the global x/feegrant module codec. Note, the codec
+	// should ONLY be used in certain instances of tests and for JSON encoding as
+	// Amino is still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/feegrant
+	// and defined at the application level.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/feegrant module sentinel errors
+var (
+	// ErrFeeLimitExceeded is returned when the fee exceeds the allowance
+	ErrFeeLimitExceeded = sdkerrors.Register(ModuleName, 2, "fee limit exceeded")
+	// ErrFeeLimitExpired is returned when the allowance has expired
+	ErrFeeLimitExpired = sdkerrors.Register(ModuleName, 3, "fee allowance expired")
+	// ErrInvalidDuration is returned when the period of an allowance is invalid
+	ErrInvalidDuration = sdkerrors.Register(ModuleName, 4, "invalid duration")
+	// ErrNoAllowance is returned when there is no allowance for the granter and grantee
+	ErrNoAllowance = sdkerrors.Register(ModuleName, 5, "no allowance")
+	// ErrNoMessages is returned when a message filter allows no messages
+	ErrNoMessages = sdkerrors.Register(ModuleName, 6, "allowed messages are empty")
+	// ErrMessageNotAllowed is returned when a message is not allowed by the allowance
+	ErrMessageNotAllowed = sdkerrors.Register(ModuleName, 7, "message not allowed")
+	// ErrGranteeIsGranter is returned when an allowance is granted to its granter
+	ErrGranteeIsGranter = sdkerrors.Register(ModuleName, 8, "grantee and granter should be different")
+)
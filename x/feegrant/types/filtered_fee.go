@@ -0,0 +1,115 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+)
+
+var (
+	_ exported.FeeAllowanceI        = (*AllowedMsgAllowance)(nil)
+	_ types.UnpackInterfacesMessage = (*AllowedMsgAllowance)(nil)
+)
+
+// NewAllowedMsgAllowance creates an AllowedMsgAllowance restricting allowance
+// to pay the fees of transactions made only of messages with the given type
+// URLs.
+func NewAllowedMsgAllowance(allowance exported.FeeAllowanceI, allowedMsgs []string) (*AllowedMsgAllowance, error) {
+	any, err := packFeeAllowance(allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AllowedMsgAllowance{
+		Allowance:       any,
+		AllowedMessages: allowedMsgs,
+	}, nil
+}
+
+// GetAllowance returns the cached inner allowance, or nil if it has not been
+// unpacked.
+func (a *AllowedMsgAllowance) GetAllowance() exported.FeeAllowanceI {
+	return unpackedFeeAllowance(a.Allowance)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *AllowedMsgAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance exported.FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// Accept checks that every message is allowed, then delegates to the inner
+// allowance and stores its updated state.
+func (a *AllowedMsgAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	if !a.allMsgTypesAllowed(msgs) {
+		return false, sdkerrors.Wrap(ErrMessageNotAllowed, "message does not exist in allowed messages")
+	}
+
+	allowance := a.GetAllowance()
+	if allowance == nil {
+		return false, sdkerrors.Wrap(sdkerrors.ErrInvalidType, "failed to get allowance")
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err != nil {
+		return remove, err
+	}
+
+	a.Allowance, err = packFeeAllowance(allowance)
+	if err != nil {
+		return false, err
+	}
+
+	return remove, nil
+}
+
+func (a *AllowedMsgAllowance) allMsgTypesAllowed(msgs []sdk.Msg) bool {
+	allowed := make(map[string]bool, len(a.AllowedMessages))
+	for _, msgTypeURL := range a.AllowedMessages {
+		allowed[msgTypeURL] = true
+	}
+
+	for _, msg := range msgs {
+		if !allowed[sdk.MsgTypeURL(msg)] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ValidateBasic implements FeeAllowanceI.ValidateBasic
+func (a *AllowedMsgAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "allowance should not be empty")
+	}
+
+	if len(a.AllowedMessages) == 0 {
+		return ErrNoMessages
+	}
+
+	allowance := a.GetAllowance()
+	if allowance == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidType, "failed to get allowance")
+	}
+
+	return allowance.ValidateBasic()
+}
+
+func packFeeAllowance(allowance exported.FeeAllowanceI) (*types.Any, error) {
+	return types.NewAnyWithValue(allowance)
+}
+
+func unpackedFeeAllowance(any *types.Any) exported.FeeAllowanceI {
+	if any == nil {
+		return nil
+	}
+
+	allowance, ok := any.GetCachedValue().(exported.FeeAllowanceI)
+	if !ok {
+		return nil
+	}
+
+	return allowance
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ types.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates a new genesis state for the feegrant module.
+func NewGenesisState(entries []Grant) *GenesisState {
+	return &GenesisState{
+		Allowances: entries,
+	}
+}
+
+// DefaultGenesisState returns the feegrant module's default genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Allowances: []Grant{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	for _, grant := range gs.Allowances {
+		if err := grant.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, grant := range gs.Allowances {
+		if err := grant.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
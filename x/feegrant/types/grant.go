@@ -0,0 +1,63 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+)
+
+var _ types.UnpackInterfacesMessage = Grant{}
+
+// NewGrant creates a new Grant of allowance from granter to grantee.
+func NewGrant(granter, grantee sdk.AccAddress, allowance exported.FeeAllowanceI) (Grant, error) {
+	any, err := packFeeAllowance(allowance)
+	if err != nil {
+		return Grant{}, err
+	}
+
+	return Grant{
+		Granter:   granter,
+		Grantee:   grantee,
+		Allowance: any,
+	}, nil
+}
+
+// GetGrant returns the cached allowance of the grant, or an error if it has
+// not been unpacked.
+func (g Grant) GetGrant() (exported.FeeAllowanceI, error) {
+	allowance := unpackedFeeAllowance(g.Allowance)
+	if allowance == nil {
+		return nil, sdkerrors.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// ValidateBasic performs basic validation on the grant.
+func (g Grant) ValidateBasic() error {
+	if g.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+
+	if g.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+
+	if g.Granter.Equals(g.Grantee) {
+		return ErrGranteeIsGranter
+	}
+
+	allowance, err := g.GetGrant()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g Grant) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance exported.FeeAllowanceI
+	return unpacker.UnpackAny(g.Allowance, &allowance)
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feegrant"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	// FeeAllowanceKeyPrefix is the set of the kvstore for fee allowance data
+	FeeAllowanceKeyPrefix = []byte{0x00}
+)
+
+// FeeAllowanceKey returns the store key of the allowance given by granter to
+// grantee:
+// 0x00 | grantee (20 bytes) | granter (20 bytes)
+//
+// Keys are prefixed by the grantee so that all the allowances of a grantee can
+// be iterated over.
+func FeeAllowanceKey(granter, grantee sdk.AccAddress) []byte {
+	return append(FeeAllowancePrefixByGrantee(grantee), granter...)
+}
+
+// FeeAllowancePrefixByGrantee returns the prefix of the store keys of all the
+// allowances given to grantee.
+func FeeAllowancePrefixByGrantee(grantee sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(FeeAllowanceKeyPrefix)+len(grantee))
+	key = append(key, FeeAllowanceKeyPrefix...)
+	return append(key, grantee...)
+}
+
+// ParseFeeAllowanceKey returns the granter and grantee of a fee allowance
+// store key.
+func ParseFeeAllowanceKey(key []byte) (granter, grantee sdk.AccAddress) {
+	key = key[len(FeeAllowanceKeyPrefix):]
+	grantee = sdk.AccAddress(key[:sdk.AddrLen])
+	granter = sdk.AccAddress(key[sdk.AddrLen:])
+	return granter, grantee
+}
@@ -0,0 +1,139 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+)
+
+// Message types for the feegrant module
+const (
+	TypeMsgGrantAllowance  = "grant_allowance"
+	TypeMsgRevokeAllowance = "revoke_allowance"
+)
+
+var (
+	_ sdk.Msg = &MsgGrantAllowance{}
+	_ sdk.Msg = &MsgRevokeAllowance{}
+
+	_ types.UnpackInterfacesMessage = MsgGrantAllowance{}
+)
+
+// NewMsgGrantAllowance creates a new MsgGrantAllowance granting allowance to
+// grantee on the account of granter.
+func NewMsgGrantAllowance(allowance exported.FeeAllowanceI, granter, grantee sdk.AccAddress) (*MsgGrantAllowance, error) {
+	any, err := packFeeAllowance(allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgGrantAllowance{
+		Granter:   granter,
+		Grantee:   grantee,
+		Allowance: any,
+	}, nil
+}
+
+// Route returns the MsgGrantAllowance's route.
+func (msg MsgGrantAllowance) Route() string { return RouterKey }
+
+// Type returns the MsgGrantAllowance's type.
+func (msg MsgGrantAllowance) Type() string { return TypeMsgGrantAllowance }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a
+// MsgGrantAllowance.
+func (msg MsgGrantAllowance) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+
+	if msg.Granter.Equals(msg.Grantee) {
+		return ErrGranteeIsGranter
+	}
+
+	allowance, err := msg.GetFeeAllowanceI()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when
+// submitting a MsgGrantAllowance message.
+func (msg MsgGrantAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the granter, the single expected signer of a
+// MsgGrantAllowance.
+func (msg MsgGrantAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// GetFeeAllowanceI returns the cached allowance of the message, or an error
+// if it has not been unpacked.
+func (msg MsgGrantAllowance) GetFeeAllowanceI() (exported.FeeAllowanceI, error) {
+	allowance := unpackedFeeAllowance(msg.Allowance)
+	if allowance == nil {
+		return nil, sdkerrors.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrantAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance exported.FeeAllowanceI
+	return unpacker.UnpackAny(msg.Allowance, &allowance)
+}
+
+// NewMsgRevokeAllowance creates a new MsgRevokeAllowance revoking the
+// allowance given by granter to grantee.
+func NewMsgRevokeAllowance(granter, grantee sdk.AccAddress) *MsgRevokeAllowance {
+	return &MsgRevokeAllowance{
+		Granter: granter,
+		Grantee: grantee,
+	}
+}
+
+// Route returns the MsgRevokeAllowance's route.
+func (msg MsgRevokeAllowance) Route() string { return RouterKey }
+
+// Type returns the MsgRevokeAllowance's type.
+func (msg MsgRevokeAllowance) Type() string { return TypeMsgRevokeAllowance }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a
+// MsgRevokeAllowance.
+func (msg MsgRevokeAllowance) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+
+	if msg.Granter.Equals(msg.Grantee) {
+		return ErrGranteeIsGranter
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when
+// submitting a MsgRevokeAllowance message.
+func (msg MsgRevokeAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the granter, the single expected signer of a
+// MsgRevokeAllowance.
+func (msg MsgRevokeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var (
+	_ types.UnpackInterfacesMessage = QueryAllowanceResponse{}
+	_ types.UnpackInterfacesMessage = QueryAllowancesResponse{}
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (res QueryAllowanceResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if res.Allowance == nil {
+		return nil
+	}
+
+	return res.Allowance.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (res QueryAllowancesResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, grant := range res.Allowances {
+		if err := grant.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
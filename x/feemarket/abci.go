@@ -0,0 +1,46 @@
+package feemarket
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// EndBlocker burns the base fees collected in the block, if enabled, and
+// adjusts the base gas price of the next block to the gas the block consumed.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	params := k.GetParams(ctx)
+
+	burned := sdk.Coins{}
+	if params.BurnBaseFee {
+		var err error
+		burned, err = k.BurnBlockBaseFees(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// the block gas meter has no limit if the consensus params do not set a
+	// maximum block gas, in which case the base fee is not adjusted
+	var gasUsed, maxGas uint64
+	if blockGasMeter := ctx.BlockGasMeter(); blockGasMeter != nil {
+		gasUsed, maxGas = blockGasMeter.GasConsumedToLimit(), blockGasMeter.Limit()
+	}
+
+	baseFee := params.NextBaseFee(k.GetBaseFeeAmount(ctx), gasUsed, maxGas)
+	k.SetBaseFeeAmount(ctx, baseFee)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeMarket,
+			sdk.NewAttribute(types.AttributeKeyGasUsed, sdk.NewIntFromUint64(gasUsed).String()),
+			sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee.String()),
+			sdk.NewAttribute(types.AttributeKeyBurned, burned.String()),
+		),
+	)
+}
@@ -0,0 +1,79 @@
+package feemarket_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestEndBlocker(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	k := app.FeeMarketKeeper
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	params := types.NewParams(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 2), 8, 2, true)
+	k.SetParams(ctx, params)
+	k.SetBaseFeeAmount(ctx, sdk.NewDec(1))
+
+	// a full block raises the base fee and burns the recorded base fees
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, fees))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, fees))
+	k.AddBlockBaseFee(ctx, sdk.NewInt64Coin(sdk.DefaultBondDenom, 60))
+
+	blockGasMeter := sdk.NewGasMeter(1000)
+	blockGasMeter.ConsumeGas(1000, "block")
+	feemarket.EndBlocker(ctx.WithBlockGasMeter(blockGasMeter), k)
+
+	require.Equal(t, sdk.NewDecWithPrec(1125, 3), k.GetBaseFeeAmount(ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 40)), app.BankKeeper.GetAllBalances(ctx, feeCollector))
+
+	// the base fees are left to the fee collector when they are not burned
+	params.BurnBaseFee = false
+	k.SetParams(ctx, params)
+	k.AddBlockBaseFee(ctx, sdk.NewInt64Coin(sdk.DefaultBondDenom, 40))
+
+	feemarket.EndBlocker(ctx.WithBlockGasMeter(sdk.NewGasMeter(1000)), k)
+
+	require.Equal(t, sdk.NewDecWithPrec(984375, 6), k.GetBaseFeeAmount(ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 40)), app.BankKeeper.GetAllBalances(ctx, feeCollector))
+
+	// the base fee is not adjusted without a block gas limit
+	feemarket.EndBlocker(ctx.WithBlockGasMeter(sdk.NewInfiniteGasMeter()), k)
+	require.Equal(t, sdk.NewDecWithPrec(984375, 6), k.GetBaseFeeAmount(ctx))
+}
+
+func TestEndBlockerDefaultGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	k := app.FeeMarketKeeper
+
+	k.InitGenesis(ctx, types.DefaultGenesisState())
+	require.Equal(t, types.DefaultMinBaseFee, k.GetBaseFeeAmount(ctx))
+
+	// the base fee rises from the default one under full blocks
+	baseFee := k.GetBaseFeeAmount(ctx)
+	for i := 0; i < 5; i++ {
+		blockGasMeter := sdk.NewGasMeter(1000)
+		blockGasMeter.ConsumeGas(1000, "block")
+		feemarket.EndBlocker(ctx.WithBlockGasMeter(blockGasMeter), k)
+
+		require.True(t, k.GetBaseFeeAmount(ctx).GT(baseFee), "base fee did not rise from %s", baseFee)
+		baseFee = k.GetBaseFeeAmount(ctx)
+	}
+
+	// and falls back to the minimum one under empty blocks
+	for i := 0; i < 50; i++ {
+		feemarket.EndBlocker(ctx.WithBlockGasMeter(sdk.NewGasMeter(1000)), k)
+	}
+	require.Equal(t, types.DefaultMinBaseFee, k.GetBaseFeeAmount(ctx))
+}
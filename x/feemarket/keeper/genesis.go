@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// InitGenesis initializes the feemarket module's state from a given genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	k.SetBaseFeeAmount(ctx, genState.BaseFee)
+
+	// ensure the module account is created
+	k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+}
+
+// ExportGenesis returns the feemarket module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetBaseFeeAmount(ctx))
+}
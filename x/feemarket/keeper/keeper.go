@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	cdc           codec.BinaryMarshaler
+	storeKey      sdk.StoreKey
+	transientKey  sdk.StoreKey
+	paramSpace    paramtypes.Subspace
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+}
+
+// NewKeeper creates a new feemarket Keeper instance
+func NewKeeper(
+	cdc codec.BinaryMarshaler, key, tkey sdk.StoreKey, paramSpace paramtypes.Subspace,
+	ak types.AccountKeeper, bk types.BankKeeper,
+) Keeper {
+	// ensure feemarket module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the feemarket module account has not been set")
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		transientKey:  tkey,
+		paramSpace:    paramSpace,
+		accountKeeper: ak,
+		bankKeeper:    bk,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of feemarket parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of feemarket parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetBaseFeeAmount returns the base gas price of the current block.
+func (k Keeper) GetBaseFeeAmount(ctx sdk.Context) sdk.Dec {
+	bz := ctx.KVStore(k.storeKey).Get(types.BaseFeeKey)
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var baseFee sdk.Dec
+	if err := baseFee.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return baseFee
+}
+
+// SetBaseFeeAmount sets the base gas price of the current block.
+func (k Keeper) SetBaseFeeAmount(ctx sdk.Context, baseFee sdk.Dec) {
+	bz, err := baseFee.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.BaseFeeKey, bz)
+}
+
+// GetBaseFee returns the base gas price of the current block in the fee
+// denom.
+func (k Keeper) GetBaseFee(ctx sdk.Context) sdk.DecCoin {
+	return sdk.NewDecCoinFromDec(k.GetParams(ctx).FeeDenom, k.GetBaseFeeAmount(ctx))
+}
+
+// GetBlockBaseFees returns the base fees collected so far in the current
+// block.
+func (k Keeper) GetBlockBaseFees(ctx sdk.Context) sdk.Int {
+	bz := ctx.TransientStore(k.transientKey).Get(types.BlockBaseFeesKey)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var fees sdk.Int
+	if err := fees.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return fees
+}
+
+// AddBlockBaseFee records the base fee paid by a transaction of the current
+// block. Fees in another denom than the fee denom are ignored.
+func (k Keeper) AddBlockBaseFee(ctx sdk.Context, fee sdk.Coin) {
+	if fee.Denom != k.GetParams(ctx).FeeDenom || fee.IsZero() {
+		return
+	}
+
+	bz, err := k.GetBlockBaseFees(ctx).Add(fee.Amount).Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.TransientStore(k.transientKey).Set(types.BlockBaseFeesKey, bz)
+}
+
+// BurnBlockBaseFees burns the base fees collected in the current block from
+// the fee collector and returns the burned coins.
+func (k Keeper) BurnBlockBaseFees(ctx sdk.Context) (sdk.Coins, error) {
+	denom := k.GetParams(ctx).FeeDenom
+
+	// the fee collector may hold less than the recorded fees if some of them
+	// were already moved out of it during the block
+	amount := k.GetBlockBaseFees(ctx)
+	collected := k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName), denom)
+	if collected.Amount.LT(amount) {
+		amount = collected.Amount
+	}
+
+	if !amount.IsPositive() {
+		return sdk.Coins{}, nil
+	}
+
+	burned := sdk.NewCoins(sdk.NewCoin(denom, amount))
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, burned); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burned); err != nil {
+		return nil, err
+	}
+
+	return burned, nil
+}
@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.FeeMarketKeeper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func (suite *KeeperTestSuite) TestBaseFee() {
+	app, ctx := suite.app, suite.ctx
+
+	suite.Require().True(app.FeeMarketKeeper.GetBaseFeeAmount(ctx).IsZero())
+
+	app.FeeMarketKeeper.SetBaseFeeAmount(ctx, sdk.NewDecWithPrec(25, 2))
+	suite.Require().Equal(sdk.NewDecWithPrec(25, 2), app.FeeMarketKeeper.GetBaseFeeAmount(ctx))
+	suite.Require().Equal(
+		sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(25, 2)),
+		app.FeeMarketKeeper.GetBaseFee(ctx),
+	)
+
+	res, err := suite.queryClient.BaseFee(sdk.WrapSDKContext(ctx), &types.QueryBaseFeeRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(app.FeeMarketKeeper.GetBaseFee(ctx), res.BaseFee)
+
+	paramsRes, err := suite.queryClient.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(app.FeeMarketKeeper.GetParams(ctx), paramsRes.Params)
+}
+
+func (suite *KeeperTestSuite) TestBurnBlockBaseFees() {
+	app, ctx := suite.app, suite.ctx
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	burned, err := app.FeeMarketKeeper.BurnBlockBaseFees(ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(burned.IsZero())
+
+	// fees in another denom are not base fees
+	app.FeeMarketKeeper.AddBlockBaseFee(ctx, sdk.NewInt64Coin(sdk.DefaultBondDenom, 30))
+	app.FeeMarketKeeper.AddBlockBaseFee(ctx, sdk.NewInt64Coin(sdk.DefaultBondDenom, 20))
+	app.FeeMarketKeeper.AddBlockBaseFee(ctx, sdk.NewInt64Coin("atom", 20))
+	suite.Require().Equal(sdk.NewInt(50), app.FeeMarketKeeper.GetBlockBaseFees(ctx))
+
+	// only what the fee collector holds can be burned
+	collected := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 40))
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, collected))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, collected))
+	supply := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount
+
+	burned, err = app.FeeMarketKeeper.BurnBlockBaseFees(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(collected, burned)
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, feeCollector).IsZero())
+	suite.Require().True(supply.SubRaw(40).Equal(app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount))
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	app, ctx := suite.app, suite.ctx
+
+	params := types.NewParams("atom", sdk.NewDecWithPrec(1, 2), 4, 3, false)
+	genState := types.NewGenesisState(params, sdk.NewDecWithPrec(5, 1))
+
+	app.FeeMarketKeeper.InitGenesis(ctx, genState)
+	suite.Require().Equal(genState, app.FeeMarketKeeper.ExportGenesis(ctx))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,47 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/simulation"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	dec := simulation.NewDecodeStore()
+
+	baseFee := sdk.NewDecWithPrec(25, 2)
+	bz, err := baseFee.Marshal()
+	require.NoError(t, err)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.BaseFeeKey, Value: bz},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"BaseFee", fmt.Sprintf("%v\n%v", baseFee, baseFee)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// Simulation parameter constants
+const (
+	BaseFeeChangeDenominator = "base_fee_change_denominator"
+	ElasticityMultiplier     = "elasticity_multiplier"
+	BurnBaseFee              = "burn_base_fee"
+)
+
+// GenBaseFeeChangeDenominator randomized BaseFeeChangeDenominator
+func GenBaseFeeChangeDenominator(r *rand.Rand) uint32 {
+	return uint32(r.Intn(16) + 1)
+}
+
+// GenElasticityMultiplier randomized ElasticityMultiplier
+func GenElasticityMultiplier(r *rand.Rand) uint32 {
+	return uint32(r.Intn(4) + 1)
+}
+
+// GenBurnBaseFee randomized BurnBaseFee
+func GenBurnBaseFee(r *rand.Rand) bool {
+	return r.Int63n(2) == 0
+}
+
+// RandomizedGenState generates a random GenesisState for feemarket. The
+// simulated transactions do not pay any fee so the base fee is left at zero.
+func RandomizedGenState(simState *module.SimulationState) {
+	var baseFeeChangeDenominator uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BaseFeeChangeDenominator, &baseFeeChangeDenominator, simState.Rand,
+		func(r *rand.Rand) { baseFeeChangeDenominator = GenBaseFeeChangeDenominator(r) },
+	)
+
+	var elasticityMultiplier uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ElasticityMultiplier, &elasticityMultiplier, simState.Rand,
+		func(r *rand.Rand) { elasticityMultiplier = GenElasticityMultiplier(r) },
+	)
+
+	var burnBaseFee bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BurnBaseFee, &burnBaseFee, simState.Rand,
+		func(r *rand.Rand) { burnBaseFee = GenBurnBaseFee(r) },
+	)
+
+	params := types.NewParams(sdk.DefaultBondDenom, sdk.ZeroDec(), baseFeeChangeDenominator, elasticityMultiplier, burnBaseFee)
+	feemarketGenesis := types.NewGenesisState(params, sdk.ZeroDec())
+
+	fmt.Printf("Selected randomly generated feemarket parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, feemarketGenesis))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(feemarketGenesis)
+}
@@ -0,0 +1,503 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/feemarket/v1beta1/feemarket.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters of the feemarket module.
+type Params struct {
+	// fee_denom is the denom in which the base fee is charged.
+	FeeDenom string `protobuf:"bytes,1,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty" yaml:"fee_denom"`
+	// min_base_fee is the lower bound of the base gas price. A chain with a zero
+	// base fee and a zero minimum base fee does not charge any base fee.
+	MinBaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_base_fee" yaml:"min_base_fee"`
+	// base_fee_change_denominator bounds the relative change of the base fee
+	// between two blocks.
+	BaseFeeChangeDenominator uint32 `protobuf:"varint,3,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty" yaml:"base_fee_change_denominator"`
+	// elasticity_multiplier is the ratio between the maximum gas of a block and
+	// the gas targeted by the base fee adjustment.
+	ElasticityMultiplier uint32 `protobuf:"varint,4,opt,name=elasticity_multiplier,json=elasticityMultiplier,proto3" json:"elasticity_multiplier,omitempty" yaml:"elasticity_multiplier"`
+	// burn_base_fee defines whether the base fees collected in a block are
+	// burned. They are otherwise left to the fee collector and distributed with
+	// the rest of the fees.
+	BurnBaseFee bool `protobuf:"varint,5,opt,name=burn_base_fee,json=burnBaseFee,proto3" json:"burn_base_fee,omitempty" yaml:"burn_base_fee"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3047acb548fa7c8, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetFeeDenom() string {
+	if m != nil {
+		return m.FeeDenom
+	}
+	return ""
+}
+
+func (m *Params) GetBaseFeeChangeDenominator() uint32 {
+	if m != nil {
+		return m.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (m *Params) GetElasticityMultiplier() uint32 {
+	if m != nil {
+		return m.ElasticityMultiplier
+	}
+	return 0
+}
+
+func (m *Params) GetBurnBaseFee() bool {
+	if m != nil {
+		return m.BurnBaseFee
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos.feemarket.v1beta1.Params")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/feemarket/v1beta1/feemarket.proto", fileDescriptor_f3047acb548fa7c8)
+}
+
+var fileDescriptor_f3047acb548fa7c8 = []byte{
+	// 379 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0xae, 0x93, 0x40,
+	0x14, 0xc6, 0xc1, 0x7b, 0xbd, 0x69, 0x47, 0x9b, 0x18, 0xc4, 0x84, 0xa8, 0x61, 0xc8, 0x2c, 0x1a,
+	0x36, 0x42, 0x1a, 0x77, 0x8d, 0x2b, 0xac, 0xc6, 0x8d, 0x89, 0x21, 0x71, 0xe3, 0x86, 0x0c, 0xf4,
+	0x40, 0x27, 0x65, 0x98, 0x86, 0x99, 0x1a, 0xfb, 0x16, 0x2e, 0x5d, 0xba, 0xf2, 0x59, 0xba, 0xec,
+	0xd2, 0xb8, 0x20, 0xa6, 0x7d, 0x03, 0x9e, 0xc0, 0x14, 0xb0, 0xb0, 0xb8, 0xe9, 0x8a, 0xc3, 0xf7,
+	0xfd, 0xe6, 0xfc, 0x9b, 0x41, 0x6e, 0x22, 0x24, 0x17, 0xd2, 0x4f, 0x01, 0x38, 0x2d, 0xd7, 0xa0,
+	0xfc, 0xaf, 0xb3, 0x18, 0x14, 0x9d, 0xf5, 0x8a, 0xb7, 0x29, 0x85, 0x12, 0x86, 0xd5, 0x92, 0x5e,
+	0xaf, 0x77, 0xe4, 0x73, 0x33, 0x13, 0x99, 0x68, 0x20, 0xff, 0x1c, 0xb5, 0x3c, 0xf9, 0x75, 0x83,
+	0xee, 0x3e, 0xd1, 0x92, 0x72, 0x69, 0xcc, 0xd0, 0x38, 0x05, 0x88, 0x96, 0x50, 0x08, 0x6e, 0xe9,
+	0x8e, 0xee, 0x8e, 0x03, 0xb3, 0xae, 0xf0, 0x93, 0x1d, 0xe5, 0xf9, 0x9c, 0x5c, 0x2c, 0x12, 0x8e,
+	0x52, 0x80, 0xc5, 0x39, 0x34, 0x32, 0xf4, 0x98, 0xb3, 0x22, 0x8a, 0xa9, 0x84, 0x28, 0x05, 0xb0,
+	0x1e, 0x34, 0xa7, 0xde, 0xed, 0x2b, 0xac, 0xfd, 0xa9, 0xf0, 0x34, 0x63, 0x6a, 0xb5, 0x8d, 0xbd,
+	0x44, 0x70, 0xbf, 0x1b, 0xa0, 0xfd, 0xbc, 0x92, 0xcb, 0xb5, 0xaf, 0x76, 0x1b, 0x90, 0xde, 0x02,
+	0x92, 0xba, 0xc2, 0x4f, 0xdb, 0x1a, 0xc3, 0x5c, 0x24, 0x44, 0x9c, 0x15, 0x01, 0x95, 0xf0, 0x1e,
+	0xc0, 0x00, 0xf4, 0xe2, 0xbf, 0x11, 0x25, 0x2b, 0x5a, 0x64, 0x5d, 0x33, 0xac, 0xa0, 0x4a, 0x94,
+	0xd6, 0x8d, 0xa3, 0xbb, 0x93, 0x60, 0x5a, 0x57, 0x98, 0xb4, 0x99, 0xae, 0xc0, 0x24, 0xb4, 0xe2,
+	0x36, 0xeb, 0xdb, 0xc6, 0x5b, 0xf4, 0x96, 0xf1, 0x19, 0x3d, 0x83, 0x9c, 0x4a, 0xc5, 0x12, 0xa6,
+	0x76, 0x11, 0xdf, 0xe6, 0x8a, 0x6d, 0x72, 0x06, 0xa5, 0x75, 0xdb, 0x14, 0x70, 0xea, 0x0a, 0xbf,
+	0x6c, 0x0b, 0xdc, 0x8b, 0x91, 0xd0, 0xec, 0xf5, 0x8f, 0x17, 0xd9, 0x78, 0x83, 0x26, 0xf1, 0xb6,
+	0x1c, 0xec, 0xe9, 0xa1, 0xa3, 0xbb, 0xa3, 0xc0, 0xaa, 0x2b, 0x6c, 0x76, 0xfd, 0x0e, 0x6d, 0x12,
+	0x3e, 0x3a, 0xff, 0x77, 0xb3, 0xcf, 0x6f, 0x7f, 0xfc, 0xc4, 0x5a, 0xf0, 0x61, 0x7f, 0xb4, 0xf5,
+	0xc3, 0xd1, 0xd6, 0xff, 0x1e, 0x6d, 0xfd, 0xfb, 0xc9, 0xd6, 0x0e, 0x27, 0x5b, 0xfb, 0x7d, 0xb2,
+	0xb5, 0x2f, 0xde, 0xd5, 0x35, 0x7f, 0x1b, 0x3c, 0x9a, 0x66, 0xe5, 0xf1, 0x5d, 0x73, 0xf3, 0xaf,
+	0xff, 0x0d, 0x00, 0x11, 0x5b, 0xe4, 0xc6, 0x55, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BurnBaseFee {
+		i--
+		if m.BurnBaseFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ElasticityMultiplier != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.ElasticityMultiplier))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.FeeDenom) > 0 {
+		i -= len(m.FeeDenom)
+		copy(dAtA[i:], m.FeeDenom)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.FeeDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeemarket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeeDenom)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeChangeDenominator))
+	}
+	if m.ElasticityMultiplier != 0 {
+		n += 1 + sovFeemarket(uint64(m.ElasticityMultiplier))
+	}
+	if m.BurnBaseFee {
+		n += 2
+	}
+	return n
+}
+
+func sovFeemarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeemarket(x uint64) (n int) {
+	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElasticityMultiplier", wireType)
+			}
+			m.ElasticityMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElasticityMultiplier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnBaseFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnBaseFee = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeemarket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeemarket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeemarket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeemarket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeemarket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeemarket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeemarket = fmt.Errorf("proto: unexpected end of group")
+)
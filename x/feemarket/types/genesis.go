@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, baseFee sdk.Dec) *GenesisState {
+	return &GenesisState{
+		Params:  params,
+		BaseFee: baseFee,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object, starting from
+// the default minimum base fee.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), DefaultMinBaseFee)
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if gs.BaseFee.IsNil() || gs.BaseFee.IsNegative() {
+		return fmt.Errorf("invalid base fee: %s", gs.BaseFee)
+	}
+
+	return nil
+}
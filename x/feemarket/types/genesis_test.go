@@ -0,0 +1,36 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expErr   bool
+	}{
+		{"default", types.DefaultGenesisState(), false},
+		{"with base fee", types.NewGenesisState(types.DefaultParams(), sdk.NewDecWithPrec(5, 1)), false},
+		{"invalid params", types.NewGenesisState(types.Params{}, sdk.ZeroDec()), true},
+		{"nil base fee", types.NewGenesisState(types.DefaultParams(), sdk.Dec{}), true},
+		{"negative base fee", types.NewGenesisState(types.DefaultParams(), sdk.NewDec(-1)), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
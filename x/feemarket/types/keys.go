@@ -0,0 +1,28 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feemarket"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// TStoreKey defines the transient store key
+	TStoreKey = "transient_" + ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// KVStore keys
+var (
+	// BaseFeeKey is the key of the base gas price.
+	BaseFeeKey = []byte{0x01}
+)
+
+// Transient store keys
+var (
+	// BlockBaseFeesKey is the key of the base fees collected in the current
+	// block.
+	BlockBaseFeesKey = []byte{0x01}
+)
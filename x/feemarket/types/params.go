@@ -0,0 +1,196 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Default parameter values
+const (
+	DefaultBaseFeeChangeDenominator uint32 = 8
+	DefaultElasticityMultiplier     uint32 = 2
+	DefaultBurnBaseFee                     = true
+)
+
+// DefaultMinBaseFee is the default minimum base gas price. It must be positive
+// for the base fee to start moving, as it is adjusted by a ratio of its value.
+var DefaultMinBaseFee = sdk.NewDecWithPrec(1, 3)
+
+// Parameter store keys
+var (
+	KeyFeeDenom                 = []byte("FeeDenom")
+	KeyMinBaseFee               = []byte("MinBaseFee")
+	KeyBaseFeeChangeDenominator = []byte("BaseFeeChangeDenominator")
+	KeyElasticityMultiplier     = []byte("ElasticityMultiplier")
+	KeyBurnBaseFee              = []byte("BurnBaseFee")
+)
+
+// ParamKeyTable returns the parameter key table of the feemarket module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(
+	feeDenom string, minBaseFee sdk.Dec, baseFeeChangeDenominator, elasticityMultiplier uint32, burnBaseFee bool,
+) Params {
+	return Params{
+		FeeDenom:                 feeDenom,
+		MinBaseFee:               minBaseFee,
+		BaseFeeChangeDenominator: baseFeeChangeDenominator,
+		ElasticityMultiplier:     elasticityMultiplier,
+		BurnBaseFee:              burnBaseFee,
+	}
+}
+
+// DefaultParams returns the default feemarket module parameters.
+func DefaultParams() Params {
+	return NewParams(
+		sdk.DefaultBondDenom, DefaultMinBaseFee, DefaultBaseFeeChangeDenominator, DefaultElasticityMultiplier,
+		DefaultBurnBaseFee,
+	)
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateFeeDenom(p.FeeDenom); err != nil {
+		return err
+	}
+	if err := validateMinBaseFee(p.MinBaseFee); err != nil {
+		return err
+	}
+	if err := validateBaseFeeChangeDenominator(p.BaseFeeChangeDenominator); err != nil {
+		return err
+	}
+	if err := validateElasticityMultiplier(p.ElasticityMultiplier); err != nil {
+		return err
+	}
+
+	return validateBurnBaseFee(p.BurnBaseFee)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyFeeDenom, &p.FeeDenom, validateFeeDenom),
+		paramtypes.NewParamSetPair(KeyMinBaseFee, &p.MinBaseFee, validateMinBaseFee),
+		paramtypes.NewParamSetPair(KeyBaseFeeChangeDenominator, &p.BaseFeeChangeDenominator, validateBaseFeeChangeDenominator),
+		paramtypes.NewParamSetPair(KeyElasticityMultiplier, &p.ElasticityMultiplier, validateElasticityMultiplier),
+		paramtypes.NewParamSetPair(KeyBurnBaseFee, &p.BurnBaseFee, validateBurnBaseFee),
+	}
+}
+
+func validateFeeDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := sdk.ValidateDenom(v); err != nil {
+		return fmt.Errorf("invalid fee denom: %w", err)
+	}
+
+	return nil
+}
+
+func validateMinBaseFee(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("min base fee cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("min base fee cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateBaseFeeChangeDenominator(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("base fee change denominator must be positive")
+	}
+
+	return nil
+}
+
+func validateElasticityMultiplier(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("elasticity multiplier must be positive")
+	}
+
+	return nil
+}
+
+func validateBurnBaseFee(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+// NextBaseFee returns the base gas price of the next block given the gas
+// consumed by the current block and its gas limit. The base fee moves towards
+// the price at which blocks consume maxGas / ElasticityMultiplier gas, by at
+// most 1 / BaseFeeChangeDenominator of its value per block, and never goes
+// below MinBaseFee. A base fee below MinBaseFee, e.g. after MinBaseFee was
+// raised, is adjusted from MinBaseFee. Blocks without a gas limit leave it
+// unchanged.
+func (p Params) NextBaseFee(baseFee sdk.Dec, gasUsed, maxGas uint64) sdk.Dec {
+	if baseFee.LT(p.MinBaseFee) {
+		baseFee = p.MinBaseFee
+	}
+
+	next := baseFee
+
+	if target := maxGas / uint64(p.ElasticityMultiplier); target > 0 && gasUsed != target {
+		var gasDelta uint64
+		if gasUsed > target {
+			gasDelta = gasUsed - target
+		} else {
+			gasDelta = target - gasUsed
+		}
+
+		delta := baseFee.
+			MulInt(sdk.NewIntFromUint64(gasDelta)).
+			QuoInt(sdk.NewIntFromUint64(target)).
+			QuoInt64(int64(p.BaseFeeChangeDenominator))
+
+		if gasUsed > target {
+			next = baseFee.Add(delta)
+		} else {
+			next = baseFee.Sub(delta)
+		}
+	}
+
+	if next.LT(p.MinBaseFee) {
+		return p.MinBaseFee
+	}
+
+	return next
+}
@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params types.Params
+		expErr bool
+	}{
+		{"default", types.DefaultParams(), false},
+		{"min base fee", types.NewParams("stake", sdk.NewDecWithPrec(25, 2), 8, 2, false), false},
+		{"invalid denom", types.NewParams("1", sdk.ZeroDec(), 8, 2, true), true},
+		{"nil min base fee", types.NewParams("stake", sdk.Dec{}, 8, 2, true), true},
+		{"negative min base fee", types.NewParams("stake", sdk.NewDec(-1), 8, 2, true), true},
+		{"zero change denominator", types.NewParams("stake", sdk.ZeroDec(), 0, 2, true), true},
+		{"zero elasticity", types.NewParams("stake", sdk.ZeroDec(), 8, 0, true), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNextBaseFee(t *testing.T) {
+	params := types.NewParams("stake", sdk.NewDecWithPrec(1, 2), 8, 2, true)
+	baseFee := sdk.NewDec(1)
+
+	testCases := []struct {
+		name     string
+		baseFee  sdk.Dec
+		gasUsed  uint64
+		maxGas   uint64
+		expected sdk.Dec
+	}{
+		{"at target", baseFee, 500, 1000, baseFee},
+		{"full block", baseFee, 1000, 1000, sdk.NewDecWithPrec(1125, 3)},
+		{"above target", baseFee, 750, 1000, sdk.NewDecWithPrec(10625, 4)},
+		{"empty block", baseFee, 0, 1000, sdk.NewDecWithPrec(875, 3)},
+		{"below target", baseFee, 250, 1000, sdk.NewDecWithPrec(9375, 4)},
+		{"no gas limit", baseFee, 1000, 0, baseFee},
+		{"floored at min base fee", sdk.NewDecWithPrec(1, 2), 0, 1000, sdk.NewDecWithPrec(1, 2)},
+		{"raised to min base fee", sdk.ZeroDec(), 0, 0, sdk.NewDecWithPrec(1, 2)},
+		{"adjusted from min base fee", sdk.ZeroDec(), 1000, 1000, sdk.NewDecWithPrec(1125, 5)},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, params.NextBaseFee(tc.baseFee, tc.gasUsed, tc.maxGas))
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 	v038bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v0_38"
 	v040bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v0_40"
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+	v036gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_36"
+	v040gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_40"
 )
 
 // Migrate migrates exported state from v0.39 to a v0.40 genesis state.
@@ -15,10 +17,12 @@ func Migrate(appState types.AppMap) types.AppMap {
 	v039Codec := codec.New()
 	cryptocodec.RegisterCrypto(v039Codec)
 	v039auth.RegisterCodec(v039Codec)
+	v036gov.RegisterCodec(v039Codec)
 
 	v040Codec := codec.New()
 	cryptocodec.RegisterCrypto(v040Codec)
 	v039auth.RegisterCodec(v040Codec)
+	v036gov.RegisterCodec(v040Codec)
 
 	// remove balances from existing accounts
 	if appState[v039auth.ModuleName] != nil {
@@ -51,5 +55,18 @@ func Migrate(appState types.AppMap) types.AppMap {
 		appState[v040bank.ModuleName] = v040Codec.MustMarshalJSON(v040bank.Migrate(bankGenState, authGenState))
 	}
 
+	if appState[v036gov.ModuleName] != nil {
+		// unmarshal relative source genesis application state
+		var govGenState v036gov.GenesisState
+		v039Codec.MustUnmarshalJSON(appState[v036gov.ModuleName], &govGenState)
+
+		// delete deprecated x/gov genesis state
+		delete(appState, v036gov.ModuleName)
+
+		// Migrate relative source genesis application state and marshal it into
+		// the respective key.
+		appState[v040gov.ModuleName] = v040Codec.MustMarshalJSON(v040gov.Migrate(govGenState))
+	}
+
 	return appState
 }
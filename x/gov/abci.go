@@ -53,13 +53,20 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 		}
 
 		if passes {
-			handler := keeper.Router().GetRoute(proposal.ProposalRoute())
 			cacheCtx, writeCache := ctx.CacheContext()
 
 			// The proposal handler may execute state mutating logic depending
-			// on the proposal content. If the handler fails, no state mutation
+			// on the proposal content, if any, followed by the proposal
+			// messages. If the handler or any message fails, no state mutation
 			// is written and the error message is logged.
-			err := handler(cacheCtx, proposal.GetContent())
+			var err error
+			if content := proposal.GetContent(); content != nil {
+				handler := keeper.Router().GetRoute(content.ProposalRoute())
+				err = handler(cacheCtx, content)
+			}
+			if err == nil {
+				err = keeper.ExecuteProposalMessages(cacheCtx, proposal)
+			}
 			if err == nil {
 				proposal.Status = types.StatusPassed
 				tagValue = types.AttributeValueProposalPassed
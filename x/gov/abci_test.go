@@ -4,11 +4,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
@@ -171,7 +173,11 @@ func TestTickPassedDepositPeriod(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	proposalID := types.GetProposalIDFromBytes(res.Data)
+	var proposalData types.MsgSubmitProposalResponse
+	err = proto.Unmarshal(res.Data, &proposalData)
+	require.NoError(t, err)
+
+	proposalID := proposalData.ProposalId
 
 	inactiveQueue = app.GovKeeper.InactiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
 	require.False(t, inactiveQueue.Valid())
@@ -223,7 +229,11 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	proposalID := types.GetProposalIDFromBytes(res.Data)
+	var proposalData types.MsgSubmitProposalResponse
+	err = proto.Unmarshal(res.Data, &proposalData)
+	require.NoError(t, err)
+
+	proposalID := proposalData.ProposalId
 
 	newHeader := ctx.BlockHeader()
 	newHeader.Time = ctx.BlockHeader().Time.Add(time.Duration(1) * time.Second)
@@ -299,7 +309,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	deposits := initialModuleAccCoins.Add(proposal.TotalDeposit...).Add(proposalCoins...)
 	require.True(t, moduleAccCoins.IsEqual(deposits))
 
-	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.OptionYes)
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
 	require.NoError(t, err)
 
 	newHeader := ctx.BlockHeader()
@@ -344,7 +354,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.OptionYes)
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
 	require.NoError(t, err)
 
 	newHeader := ctx.BlockHeader()
@@ -358,3 +368,69 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// validate that the proposal fails/has been rejected
 	gov.EndBlocker(ctx, app.GovKeeper)
 }
+
+func TestEndBlockerProposalMessages(t *testing.T) {
+	testCases := []struct {
+		name       string
+		content    types.Content
+		fundGov    bool
+		expStatus  types.ProposalStatus
+		expBalance sdk.Coins
+	}{
+		{"messages are executed", TestProposal, true, types.StatusPassed, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))},
+		{"failing message reverts all messages", TestProposal, false, types.StatusFailed, sdk.NewCoins()},
+		{"messages without content are executed", nil, true, types.StatusPassed, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(false)
+			ctx := app.BaseApp.NewContext(false, abci.Header{})
+			addrs := simapp.AddTestAddrs(app, ctx, 2, valTokens)
+			recipient := sdk.AccAddress("recipient")
+
+			stakingHandler := staking.NewHandler(app.StakingKeeper)
+
+			header := abci.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			createValidators(t, stakingHandler, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+			staking.EndBlocker(ctx, app.StakingKeeper)
+
+			govAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+			coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))
+			msgs := []sdk.Msg{
+				banktypes.NewMsgSend(govAddr, recipient, coins),
+				banktypes.NewMsgSend(govAddr, recipient, coins),
+			}
+
+			if tc.fundGov {
+				require.NoError(t, app.BankKeeper.SendCoinsFromAccountToModule(ctx, addrs[1], types.ModuleName, coins.Add(coins...)))
+			} else {
+				// only the first message can be executed
+				require.NoError(t, app.BankKeeper.SendCoinsFromAccountToModule(ctx, addrs[1], types.ModuleName, coins))
+			}
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, tc.content, msgs...)
+			require.NoError(t, err)
+
+			proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10)))
+			_, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addrs[0], proposalCoins)
+			require.NoError(t, err)
+
+			require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = ctx.BlockHeader().Time.Add(app.GovKeeper.GetDepositParams(ctx).MaxDepositPeriod).Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+			require.True(t, ok)
+			require.Equal(t, tc.expStatus, proposal.Status)
+			require.Equal(t, tc.expBalance, app.BankKeeper.GetAllBalances(ctx, recipient))
+		})
+	}
+}
@@ -7,6 +7,9 @@ import (
 
 	"github.com/spf13/pflag"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
 )
 
@@ -42,3 +45,21 @@ func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
 
 	return proposal, nil
 }
+
+// parseProposalMessages decodes the proto JSON encoded messages of a proposal
+// JSON file, each of them carrying its type URL in an "@type" field.
+func parseProposalMessages(cdc codec.JSONMarshaler, registry codectypes.InterfaceRegistry, rawMsgs []json.RawMessage) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(rawMsgs))
+	for i, rawMsg := range rawMsgs {
+		var any codectypes.Any
+		if err := cdc.UnmarshalJSON(rawMsg, &any); err != nil {
+			return nil, fmt.Errorf("message %d: %w", i, err)
+		}
+
+		if err := registry.UnpackAny(&any, &msgs[i]); err != nil {
+			return nil, fmt.Errorf("message %d: %w", i, err)
+		}
+	}
+
+	return msgs, nil
+}
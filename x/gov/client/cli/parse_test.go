@@ -1,11 +1,17 @@
 package cli
 
 import (
+	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func TestParseSubmitProposalFlags(t *testing.T) {
@@ -70,3 +76,21 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	err = badJSON.Close()
 	require.Nil(t, err, "unexpected error")
 }
+
+func TestParseProposalMessages(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	from, to := sdk.AccAddress("from________________"), sdk.AccAddress("to__________________")
+
+	rawMsgs := []json.RawMessage{
+		json.RawMessage(fmt.Sprintf(`{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"%s","to_address":"%s","amount":[{"denom":"test","amount":"10"}]}`, from, to)),
+	}
+
+	msgs, err := parseProposalMessages(cdc, registry, rawMsgs)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("test", 10)))}, msgs)
+
+	_, err = parseProposalMessages(cdc, registry, []json.RawMessage{json.RawMessage(`{"@type":"/unknown.Msg"}`)})
+	require.Error(t, err)
+}
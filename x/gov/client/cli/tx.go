@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -33,6 +34,7 @@ type proposal struct {
 	Description string
 	Type        string
 	Deposit     string
+	Messages    []json.RawMessage
 }
 
 // ProposalFlags defines the core required fields of a proposal. It is used to
@@ -68,6 +70,7 @@ func NewTxCmd(propCmds []*cobra.Command) *cobra.Command {
 	govTxCmd.AddCommand(
 		NewCmdDeposit(),
 		NewCmdVote(),
+		NewCmdWeightedVote(),
 		cmdSubmitProp,
 	)
 
@@ -82,6 +85,9 @@ func NewCmdSubmitProposal() *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Submit a proposal along with an initial deposit.
 Proposal title, description, type and deposit can be given directly or through a proposal JSON file.
+A proposal JSON file may also list messages, in their proto JSON encoding, which are executed on
+behalf of the gov module account if the proposal passes. The title, description and type may then
+be left out, to only execute the messages.
 
 Example:
 $ %s tx gov submit-proposal --proposal="path/to/proposal.json" --from mykey
@@ -92,10 +98,18 @@ Where proposal.json contains:
   "title": "Test Proposal",
   "description": "My awesome proposal",
   "type": "Text",
-  "deposit": "10test"
+  "deposit": "10test",
+  "messages": [
+    {
+      "@type": "/cosmos.bank.v1beta1.MsgSend",
+      "from_address": "<gov module account address>",
+      "to_address": "<recipient address>",
+      "amount": [{"denom": "test", "amount": "10"}]
+    }
+  ]
 }
 
-Which is equivalent to:
+Without messages, it is equivalent to:
 
 $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --from mykey
 `,
@@ -119,9 +133,21 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 				return err
 			}
 
-			content := types.ContentFromProposalType(proposal.Title, proposal.Description, proposal.Type)
+			// a proposal listing messages may leave out its content
+			var content types.Content
+			if proposal.Type != "" || len(proposal.Messages) == 0 {
+				content = types.ContentFromProposalType(proposal.Title, proposal.Description, proposal.Type)
+				if content == nil {
+					return fmt.Errorf("invalid proposal type: %q", proposal.Type)
+				}
+			}
+
+			msgs, err := parseProposalMessages(clientCtx.JSONMarshaler, clientCtx.InterfaceRegistry, proposal.Messages)
+			if err != nil {
+				return fmt.Errorf("failed to parse proposal messages: %w", err)
+			}
 
-			msg, err := types.NewMsgSubmitProposal(content, amount, clientCtx.GetFromAddress())
+			msg, err := types.NewMsgSubmitProposal(content, amount, clientCtx.GetFromAddress(), msgs...)
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
@@ -251,3 +277,59 @@ $ %s tx gov vote 1 yes --from mykey
 
 	return cmd
 }
+
+// NewCmdWeightedVote implements creating a new weighted vote command.
+func NewCmdWeightedVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "weighted-vote [proposal-id] [weighted-options]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for an active proposal, options: yes/no/no_with_veto/abstain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a vote for an active proposal, splitting the voting power
+between several options. The weights must sum to 1. You can find the
+proposal-id by running "%s query gov proposals".
+
+
+Example:
+$ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// Get voter address
+			from := clientCtx.GetFromAddress()
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// Figure out which vote options user chose
+			options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+			if err != nil {
+				return err
+			}
+
+			// Build vote message and run basic validation
+			msg := types.NewMsgVoteWeighted(from, proposalID, options)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -126,7 +126,7 @@ func (q Keeper) Votes(c context.Context, req *types.QueryVotesRequest) (*types.Q
 
 	pageRes, err := query.Paginate(votesStore, req.Pagination, func(key []byte, value []byte) error {
 		var vote types.Vote
-		if err := q.cdc.UnmarshalBinaryBare(value, &vote); err != nil {
+		if err := q.unmarshalVote(value, &vote); err != nil {
 			return err
 		}
 
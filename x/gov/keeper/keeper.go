@@ -6,6 +6,7 @@ import (
 
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -31,6 +32,9 @@ type Keeper struct {
 
 	// Proposal router
 	router types.Router
+
+	// Msg service router executing the messages of passed proposals
+	msgRouter *baseapp.MsgServiceRouter
 }
 
 // NewKeeper returns a governance keeper. It handles:
@@ -43,6 +47,7 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryMarshaler, key sdk.StoreKey, paramSpace types.ParamSubspace,
 	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, sk types.StakingKeeper, rtr types.Router,
+	msgRouter *baseapp.MsgServiceRouter,
 ) Keeper {
 
 	// ensure governance module account is set
@@ -63,6 +68,7 @@ func NewKeeper(
 		sk:         sk,
 		cdc:        cdc,
 		router:     rtr,
+		msgRouter:  msgRouter,
 	}
 }
 
@@ -76,6 +82,11 @@ func (keeper Keeper) Router() types.Router {
 	return keeper.router
 }
 
+// MsgServiceRouter returns the gov Keeper's Msg service router
+func (keeper Keeper) MsgServiceRouter() *baseapp.MsgServiceRouter {
+	return keeper.msgRouter
+}
+
 // GetGovernanceAccount returns the governance ModuleAccount
 func (keeper Keeper) GetGovernanceAccount(ctx sdk.Context) authtypes.ModuleAccountI {
 	return keeper.authKeeper.GetModuleAccount(ctx, types.ModuleName)
@@ -9,19 +9,40 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
-// SubmitProposal create new proposal given a content
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (types.Proposal, error) {
-	if !keeper.router.HasRoute(content.ProposalRoute()) {
+// SubmitProposal create new proposal given a content and the optional
+// messages to execute, on behalf of the gov module account, if it passes. The
+// content may be nil for a proposal which only holds messages.
+func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content, msgs ...sdk.Msg) (types.Proposal, error) {
+	if content == nil && len(msgs) == 0 {
+		return types.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalContent, "missing content")
+	}
+
+	if content != nil && !keeper.router.HasRoute(content.ProposalRoute()) {
 		return types.Proposal{}, sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
 	}
 
+	if err := types.ValidateProposalMessages(msgs); err != nil {
+		return types.Proposal{}, err
+	}
+
+	// The messages are only executed once the proposal passes, as they may
+	// depend on state changes happening in the meantime, e.g. the funding of
+	// the gov module account. Only ensure here that they can be routed.
+	for _, msg := range msgs {
+		if keeper.msgRouter.Handler(msg) == nil {
+			return types.Proposal{}, sdkerrors.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+		}
+	}
+
 	// Execute the proposal content in a cache-wrapped context to validate the
 	// actual parameter changes before the proposal proceeds through the
 	// governance process. State is not persisted.
-	cacheCtx, _ := ctx.CacheContext()
-	handler := keeper.router.GetRoute(content.ProposalRoute())
-	if err := handler(cacheCtx, content); err != nil {
-		return types.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
+	if content != nil {
+		cacheCtx, _ := ctx.CacheContext()
+		handler := keeper.router.GetRoute(content.ProposalRoute())
+		if err := handler(cacheCtx, content); err != nil {
+			return types.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
+		}
 	}
 
 	proposalID, err := keeper.GetProposalID(ctx)
@@ -32,7 +53,7 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	submitTime := ctx.BlockHeader().Time
 	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
 
-	proposal, err := types.NewProposal(content, proposalID, submitTime, submitTime.Add(depositPeriod))
+	proposal, err := types.NewProposal(content, proposalID, submitTime, submitTime.Add(depositPeriod), msgs...)
 	if err != nil {
 		return types.Proposal{}, err
 	}
@@ -51,6 +72,21 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	return proposal, nil
 }
 
+// ExecuteProposalMessages executes the messages of a passed proposal through
+// the Msg service router. The events emitted by the messages are added to the
+// context's EventManager. If a message fails, the error is returned and the
+// caller must discard the state changes of all the messages.
+func (keeper Keeper) ExecuteProposalMessages(ctx sdk.Context, proposal types.Proposal) error {
+	msgs, err := proposal.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	_, err = keeper.msgRouter.DispatchMsgs(ctx, msgs, nil)
+
+	return err
+}
+
 // GetProposal get proposal from store by ProposalID
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (types.Proposal, bool) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -11,7 +11,10 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -83,6 +86,69 @@ func TestSubmitProposal(t *testing.T) {
 	}
 }
 
+func TestSubmitProposalMessages(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	govAddr := authtypes.NewModuleAddress(types.ModuleName)
+	addr := sdk.AccAddress("addr")
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	testCases := []struct {
+		name        string
+		msgs        []sdk.Msg
+		expectedErr error
+	}{
+		{"routable message signed by the gov module account", []sdk.Msg{banktypes.NewMsgSend(govAddr, addr, coins)}, nil},
+		{"message signed by another account", []sdk.Msg{banktypes.NewMsgSend(addr, govAddr, coins)}, types.ErrInvalidProposalMsg},
+		{"invalid message", []sdk.Msg{banktypes.NewMsgSend(govAddr, addr, sdk.Coins{})}, types.ErrInvalidProposalMsg},
+		{"unroutable message", []sdk.Msg{testdata.NewTestMsg(govAddr)}, types.ErrUnroutableProposalMsg},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, tc.msgs...)
+			if tc.expectedErr != nil {
+				require.True(t, errors.Is(err, tc.expectedErr), "got: %v, expected: %v", err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+
+			stored, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+			require.True(t, ok)
+
+			msgs, err := stored.GetMessages()
+			require.NoError(t, err)
+			require.Equal(t, tc.msgs, msgs)
+		})
+	}
+}
+
+func TestSubmitProposalWithoutContent(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	govAddr := authtypes.NewModuleAddress(types.ModuleName)
+	msg := banktypes.NewMsgSend(govAddr, sdk.AccAddress("addr"), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+
+	_, err := app.GovKeeper.SubmitProposal(ctx, nil)
+	require.True(t, errors.Is(err, types.ErrInvalidProposalContent))
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, nil, msg)
+	require.NoError(t, err)
+
+	stored, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+	require.Nil(t, stored.GetContent())
+	require.Empty(t, stored.ProposalType())
+
+	msgs, err := stored.GetMessages()
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{msg}, msgs)
+}
+
 func TestGetProposalsFiltered(t *testing.T) {
 	proposalID := uint64(1)
 	app := simapp.Setup(false)
@@ -101,7 +167,7 @@ func TestGetProposalsFiltered(t *testing.T) {
 
 			if i%2 == 0 {
 				d := types.NewDeposit(proposalID, addr1, nil)
-				v := types.NewVote(proposalID, addr1, types.OptionYes)
+				v := types.NewVote(proposalID, addr1, types.NewNonSplitVoteOption(types.OptionYes))
 				app.GovKeeper.SetDeposit(ctx, d)
 				app.GovKeeper.SetVote(ctx, v)
 			}
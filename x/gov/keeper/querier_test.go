@@ -239,13 +239,13 @@ func TestQueries(t *testing.T) {
 	require.Equal(t, proposal3, proposals[1])
 
 	// Addrs[0] votes on proposals #2 & #3
-	vote1 := types.NewVote(proposal2.ProposalId, TestAddrs[0], types.OptionYes)
-	vote2 := types.NewVote(proposal3.ProposalId, TestAddrs[0], types.OptionYes)
+	vote1 := types.NewVote(proposal2.ProposalId, TestAddrs[0], types.NewNonSplitVoteOption(types.OptionYes))
+	vote2 := types.NewVote(proposal3.ProposalId, TestAddrs[0], types.NewNonSplitVoteOption(types.OptionYes))
 	app.GovKeeper.SetVote(ctx, vote1)
 	app.GovKeeper.SetVote(ctx, vote2)
 
 	// Addrs[1] votes on proposal #3
-	vote3 := types.NewVote(proposal3.ProposalId, TestAddrs[1], types.OptionYes)
+	vote3 := types.NewVote(proposal3.ProposalId, TestAddrs[1], types.NewNonSplitVoteOption(types.OptionYes))
 	app.GovKeeper.SetVote(ctx, vote3)
 
 	// Test query voted by TestAddrs[0]
@@ -308,11 +308,7 @@ func TestPaginatedVotesQuery(t *testing.T) {
 	addr := make(sdk.AccAddress, 20)
 	for i := range votes {
 		rand.Read(addr)
-		vote := types.Vote{
-			ProposalId: proposal.ProposalId,
-			Voter:      addr,
-			Option:     types.OptionYes,
-		}
+		vote := types.NewVote(proposal.ProposalId, addr, types.NewNonSplitVoteOption(types.OptionYes))
 		votes[i] = vote
 		app.GovKeeper.SetVote(ctx, vote)
 	}
@@ -8,8 +8,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
-// AddVote adds a vote on a specific proposal
-func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, option types.VoteOption) error {
+// AddVote adds a vote, split between the given weighted options, on a specific
+// proposal
+func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions) error {
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
 		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
@@ -18,17 +19,17 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
 
-	if !types.ValidVoteOption(option) {
-		return sdkerrors.Wrap(types.ErrInvalidVote, option.String())
+	if err := options.ValidateBasic(); err != nil {
+		return err
 	}
 
-	vote := types.NewVote(proposalID, voterAddr, option)
+	vote := types.NewVote(proposalID, voterAddr, options)
 	keeper.SetVote(ctx, vote)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeProposalVote,
-			sdk.NewAttribute(types.AttributeKeyOption, option.String()),
+			sdk.NewAttribute(types.AttributeKeyOption, options.String()),
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
 		),
 	)
@@ -62,7 +63,7 @@ func (keeper Keeper) GetVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		return vote, false
 	}
 
-	keeper.cdc.MustUnmarshalBinaryBare(bz, &vote)
+	keeper.mustUnmarshalVote(bz, &vote)
 	return vote, true
 }
 
@@ -81,7 +82,7 @@ func (keeper Keeper) IterateAllVotes(ctx sdk.Context, cb func(vote types.Vote) (
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
 		var vote types.Vote
-		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &vote)
+		keeper.mustUnmarshalVote(iterator.Value(), &vote)
 
 		if cb(vote) {
 			break
@@ -97,7 +98,7 @@ func (keeper Keeper) IterateVotes(ctx sdk.Context, proposalID uint64, cb func(vo
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
 		var vote types.Vote
-		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &vote)
+		keeper.mustUnmarshalVote(iterator.Value(), &vote)
 
 		if cb(vote) {
 			break
@@ -110,3 +111,26 @@ func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sd
 	store := ctx.KVStore(keeper.storeKey)
 	store.Delete(types.VoteKey(proposalID, voterAddr))
 }
+
+// unmarshalVote decodes a stored vote. Votes stored before the introduction of
+// weighted votes only have their deprecated Option set, which is converted into
+// the equivalent non-split option.
+func (keeper Keeper) unmarshalVote(bz []byte, vote *types.Vote) error {
+	if err := keeper.cdc.UnmarshalBinaryBare(bz, vote); err != nil {
+		return err
+	}
+
+	if len(vote.Options) == 0 && vote.Option != types.OptionEmpty {
+		vote.Options = types.NewNonSplitVoteOption(vote.Option)
+	}
+
+	return nil
+}
+
+// mustUnmarshalVote decodes a stored vote as unmarshalVote does, and panics on
+// failure.
+func (keeper Keeper) mustUnmarshalVote(bz []byte, vote *types.Vote) {
+	if err := keeper.unmarshalVote(bz, vote); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,27 @@
+package v040
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v036gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_36"
+)
+
+// Migrate accepts exported v0.36 x/gov genesis state and migrates it to v0.40
+// x/gov genesis state. The migration includes:
+//
+// - Converting every vote into a weighted vote casting its whole voting power
+// on the single option of the vote.
+func Migrate(oldGenState v036gov.GenesisState) GenesisState {
+	votes := make(Votes, len(oldGenState.Votes))
+	for i, vote := range oldGenState.Votes {
+		votes[i] = Vote{
+			ProposalID: vote.ProposalID,
+			Voter:      vote.Voter,
+			Options:    WeightedVoteOptions{{Option: vote.Option, Weight: sdk.OneDec()}},
+		}
+	}
+
+	return NewGenesisState(
+		oldGenState.StartingProposalID, oldGenState.Deposits, votes, oldGenState.Proposals,
+		oldGenState.DepositParams, oldGenState.VotingParams, oldGenState.TallyParams,
+	)
+}
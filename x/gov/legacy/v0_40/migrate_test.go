@@ -0,0 +1,47 @@
+package v040_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v034gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_34"
+	v036gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_36"
+	v040gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_40"
+)
+
+func TestMigrate(t *testing.T) {
+	v040Codec := codec.New()
+	v036gov.RegisterCodec(v040Codec)
+
+	voter, _ := sdk.AccAddressFromBech32("cosmos1xxkueklal9vejv9unqu80w9vptyepfa95pd53u")
+
+	govGenState := v036gov.GenesisState{
+		StartingProposalID: 2,
+		Votes: v034gov.Votes{
+			{ProposalID: 1, Voter: voter, Option: v034gov.OptionNoWithVeto},
+		},
+	}
+
+	migrated := v040gov.Migrate(govGenState)
+	require.Equal(t, uint64(2), migrated.StartingProposalID)
+
+	expected := `[
+  {
+    "proposal_id": "1",
+    "voter": "cosmos1xxkueklal9vejv9unqu80w9vptyepfa95pd53u",
+    "options": [
+      {
+        "option": "NoWithVeto",
+        "weight": "1.000000000000000000"
+      }
+    ]
+  }
+]`
+
+	bz, err := v040Codec.MarshalJSONIndent(migrated.Votes, "", "  ")
+	require.NoError(t, err)
+	require.Equal(t, expected, string(bz))
+}
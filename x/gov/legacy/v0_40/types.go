@@ -0,0 +1,56 @@
+package v040
+
+// DONTCOVER
+// nolint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v034gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_34"
+	v036gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_36"
+)
+
+const (
+	ModuleName = "gov"
+)
+
+type (
+	WeightedVoteOption struct {
+		Option v034gov.VoteOption `json:"option" yaml:"option"`
+		Weight sdk.Dec            `json:"weight" yaml:"weight"`
+	}
+
+	WeightedVoteOptions []WeightedVoteOption
+
+	Vote struct {
+		ProposalID uint64              `json:"proposal_id" yaml:"proposal_id"`
+		Voter      sdk.AccAddress      `json:"voter" yaml:"voter"`
+		Options    WeightedVoteOptions `json:"options" yaml:"options"`
+	}
+
+	Votes []Vote
+
+	GenesisState struct {
+		StartingProposalID uint64                `json:"starting_proposal_id" yaml:"starting_proposal_id"`
+		Deposits           v034gov.Deposits      `json:"deposits" yaml:"deposits"`
+		Votes              Votes                 `json:"votes" yaml:"votes"`
+		Proposals          []v036gov.Proposal    `json:"proposals" yaml:"proposals"`
+		DepositParams      v034gov.DepositParams `json:"deposit_params" yaml:"deposit_params"`
+		VotingParams       v034gov.VotingParams  `json:"voting_params" yaml:"voting_params"`
+		TallyParams        v034gov.TallyParams   `json:"tally_params" yaml:"tally_params"`
+	}
+)
+
+func NewGenesisState(
+	startingProposalID uint64, deposits v034gov.Deposits, votes Votes, proposals []v036gov.Proposal,
+	depositParams v034gov.DepositParams, votingParams v034gov.VotingParams, tallyParams v034gov.TallyParams,
+) GenesisState {
+	return GenesisState{
+		StartingProposalID: startingProposalID,
+		Deposits:           deposits,
+		Votes:              votes,
+		Proposals:          proposals,
+		DepositParams:      depositParams,
+		VotingParams:       votingParams,
+		TallyParams:        tallyParams,
+	}
+}
@@ -20,8 +20,9 @@ var initialProposalID = uint64(100000000000000)
 
 // Simulation operation weights constants
 const (
-	OpWeightMsgDeposit = "op_weight_msg_deposit"
-	OpWeightMsgVote    = "op_weight_msg_vote"
+	OpWeightMsgDeposit      = "op_weight_msg_deposit"
+	OpWeightMsgVote         = "op_weight_msg_vote"
+	OpWeightMsgVoteWeighted = "op_weight_msg_weighted_vote"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -31,8 +32,9 @@ func WeightedOperations(
 ) simulation.WeightedOperations {
 
 	var (
-		weightMsgDeposit int
-		weightMsgVote    int
+		weightMsgDeposit      int
+		weightMsgVote         int
+		weightMsgVoteWeighted int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgDeposit, &weightMsgDeposit, nil,
@@ -47,6 +49,12 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgVoteWeighted, &weightMsgVoteWeighted, nil,
+		func(_ *rand.Rand) {
+			weightMsgVoteWeighted = simappparams.DefaultWeightMsgVoteWeighted
+		},
+	)
+
 	// generate the weighted operations for the proposal contents
 	var wProposalOps simulation.WeightedOperations
 
@@ -74,6 +82,10 @@ func WeightedOperations(
 			weightMsgVote,
 			SimulateMsgVote(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgVoteWeighted,
+			SimulateMsgVoteWeighted(ak, bk, k),
+		),
 	}
 
 	return append(wProposalOps, wGovOps...)
@@ -315,6 +327,54 @@ func operationSimulateMsgVote(ak types.AccountKeeper, bk types.BankKeeper, k kee
 	}
 }
 
+// SimulateMsgVoteWeighted generates a MsgVoteWeighted with random values.
+func SimulateMsgVoteWeighted(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		proposalID, ok := randomProposalID(r, k, ctx, types.StatusVotingPeriod)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgVoteWeighted, "unable to generate proposalID"), nil, nil
+		}
+
+		options := randomWeightedVotingOptions(r)
+		msg := types.NewMsgVoteWeighted(simAccount.Address, proposalID, options)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+		}
+
+		txGen := simappparams.MakeEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
 // Pick a random deposit with a random denomination with a
 // deposit amount between (0, min(balance, minDepositAmount))
 // This is to simulate multiple users depositing to get the
@@ -393,3 +453,24 @@ func randomVotingOption(r *rand.Rand) types.VoteOption {
 		panic("invalid vote option")
 	}
 }
+
+// randomWeightedVotingOptions returns random weighted vote options, whose
+// weights are multiples of 0.01 summing to 1.
+func randomWeightedVotingOptions(r *rand.Rand) types.WeightedVoteOptions {
+	w1 := r.Intn(100 + 1)
+	w2 := r.Intn(100 - w1 + 1)
+	w3 := r.Intn(100 - w1 - w2 + 1)
+	w4 := 100 - w1 - w2 - w3
+
+	var options types.WeightedVoteOptions
+	for i, w := range []int{w1, w2, w3, w4} {
+		if w == 0 {
+			continue
+		}
+
+		option := []types.VoteOption{types.OptionYes, types.OptionAbstain, types.OptionNo, types.OptionNoWithVeto}[i]
+		options = append(options, types.NewWeightedVoteOption(option, sdk.NewDecWithPrec(int64(w), 2)))
+	}
+
+	return options
+}
@@ -80,6 +80,7 @@ func TestWeightedOperations(t *testing.T) {
 		{2, types.ModuleName, "submit_proposal"},
 		{simappparams.DefaultWeightMsgDeposit, types.ModuleName, types.TypeMsgDeposit},
 		{simappparams.DefaultWeightMsgVote, types.ModuleName, types.TypeMsgVote},
+		{simappparams.DefaultWeightMsgVoteWeighted, types.ModuleName, types.TypeMsgVoteWeighted},
 	}
 
 	for i, w := range weightesOps {
@@ -208,6 +209,47 @@ func TestSimulateMsgVote(t *testing.T) {
 
 }
 
+// TestSimulateMsgVoteWeighted tests the normal scenario of a valid message of type TypeMsgVoteWeighted.
+// Abonormal scenarios, where the message is created by an errors are not tested here.
+func TestSimulateMsgVoteWeighted(t *testing.T) {
+	app, ctx := createTestApp(false)
+	blockTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(blockTime)
+
+	// setup 3 accounts
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := getTestingAccounts(t, r, app, ctx, 3)
+
+	// setup a proposal
+	content := types.NewTextProposal("Test", "description")
+
+	submitTime := ctx.BlockHeader().Time
+	depositPeriod := app.GovKeeper.GetDepositParams(ctx).MaxDepositPeriod
+
+	proposal, err := types.NewProposal(content, 1, submitTime, submitTime.Add(depositPeriod))
+	require.NoError(t, err)
+
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash, Time: blockTime}})
+
+	// execute operation
+	op := simulation.SimulateMsgVoteWeighted(app.AccountKeeper, app.BankKeeper, app.GovKeeper)
+	operationMsg, _, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgVoteWeighted
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, uint64(1), msg.ProposalId)
+	require.NoError(t, msg.Options.ValidateBasic())
+	require.Equal(t, "gov", msg.Route())
+	require.Equal(t, types.TypeMsgVoteWeighted, msg.Type())
+}
+
 // returns context and an app with updated mint keeper
 func createTestApp(isCheckTx bool) (*simapp.SimApp, sdk.Context) {
 	app := simapp.Setup(isCheckTx)
@@ -14,4 +14,6 @@ var (
 	ErrInvalidVote             = sdkerrors.Register(ModuleName, 7, "invalid vote option")
 	ErrInvalidGenesis          = sdkerrors.Register(ModuleName, 8, "invalid genesis state")
 	ErrNoProposalHandlerExists = sdkerrors.Register(ModuleName, 9, "no handler exists for proposal type")
+	ErrInvalidProposalMsg      = sdkerrors.Register(ModuleName, 10, "invalid proposal message")
+	ErrUnroutableProposalMsg   = sdkerrors.Register(ModuleName, 11, "proposal message not recognized by router")
 )
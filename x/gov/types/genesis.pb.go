@@ -130,7 +130,7 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/genesis.proto", fileDescriptor_43cd825e0fa7a627) }
 
 var fileDescriptor_43cd825e0fa7a627 = []byte{
-	// 430 bytes of a gzipped FileDescriptorProto
+	// 427 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x31, 0x6f, 0xd3, 0x40,
 	0x14, 0xc7, 0x63, 0x9a, 0x94, 0xf6, 0x92, 0x20, 0x38, 0x82, 0x64, 0x35, 0xc1, 0x36, 0x9e, 0xb2,
 	0x60, 0xab, 0x65, 0x43, 0x62, 0xb1, 0x90, 0x50, 0x07, 0xa4, 0x62, 0x10, 0x03, 0x4b, 0x74, 0x89,
@@ -157,7 +157,7 @@ var fileDescriptor_43cd825e0fa7a627 = []byte{
 	0xae, 0xb7, 0x8e, 0xf5, 0x73, 0xeb, 0x58, 0x5f, 0x77, 0x4e, 0xef, 0x7a, 0xe7, 0xf4, 0xbe, 0xef,
 	0x9c, 0xde, 0x87, 0xb9, 0x48, 0xe5, 0xc7, 0xcf, 0xcb, 0x60, 0x05, 0x79, 0x68, 0xd6, 0x55, 0xff,
 	0x3c, 0xc5, 0xe4, 0x53, 0xf8, 0x45, 0xed, 0xae, 0x5c, 0x17, 0x1c, 0x97, 0x87, 0x6a, 0x6d, 0x9f,
-	0xfd, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xa4, 0x63, 0x67, 0xf6, 0x22, 0x03, 0x00, 0x00,
+	0xfd, 0x1e, 0x00, 0xa4, 0x63, 0x67, 0xf6, 0x22, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
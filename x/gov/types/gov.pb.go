@@ -117,6 +117,44 @@ func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_6e82113c1a9a4b7c, []int{1}
 }
 
+// WeightedVoteOption defines a unit of vote for vote split.
+type WeightedVoteOption struct {
+	Option VoteOption                             `protobuf:"varint,1,opt,name=option,proto3,enum=cosmos.gov.v1beta1.VoteOption" json:"option,omitempty"`
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight" yaml:"weight"`
+}
+
+func (m *WeightedVoteOption) Reset()      { *m = WeightedVoteOption{} }
+func (*WeightedVoteOption) ProtoMessage() {}
+func (*WeightedVoteOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82113c1a9a4b7c, []int{0}
+}
+func (m *WeightedVoteOption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedVoteOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedVoteOption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedVoteOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedVoteOption.Merge(m, src)
+}
+func (m *WeightedVoteOption) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedVoteOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedVoteOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedVoteOption proto.InternalMessageInfo
+
 // TextProposal defines a standard text proposal whose changes need to be
 // manually updated in case of approval
 type TextProposal struct {
@@ -127,7 +165,7 @@ type TextProposal struct {
 func (m *TextProposal) Reset()      { *m = TextProposal{} }
 func (*TextProposal) ProtoMessage() {}
 func (*TextProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{0}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{1}
 }
 func (m *TextProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -166,7 +204,7 @@ type Deposit struct {
 func (m *Deposit) Reset()      { *m = Deposit{} }
 func (*Deposit) ProtoMessage() {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{1}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{2}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -206,12 +244,15 @@ type Proposal struct {
 	TotalDeposit     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_deposit" yaml:"total_deposit"`
 	VotingStartTime  time.Time                                `protobuf:"bytes,8,opt,name=voting_start_time,json=votingStartTime,proto3,stdtime" json:"voting_start_time" yaml:"voting_start_time"`
 	VotingEndTime    time.Time                                `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time" yaml:"voting_end_time"`
+	// messages are the sdk.Msgs signed by the gov module account which are
+	// executed, after the content handler, if the proposal passes.
+	Messages []*types1.Any `protobuf:"bytes,10,rep,name=messages,proto3" json:"messages,omitempty"`
 }
 
 func (m *Proposal) Reset()      { *m = Proposal{} }
 func (*Proposal) ProtoMessage() {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{2}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{3}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -251,7 +292,7 @@ type TallyResult struct {
 func (m *TallyResult) Reset()      { *m = TallyResult{} }
 func (*TallyResult) ProtoMessage() {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{3}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{4}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -285,13 +326,17 @@ var xxx_messageInfo_TallyResult proto.InternalMessageInfo
 type Vote struct {
 	ProposalId uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
 	Voter      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
-	Option     VoteOption                                    `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos.gov.v1beta1.VoteOption" json:"option,omitempty"`
+	// Deprecated: prefer to use `options` instead. This field is set to the
+	// option of non-split votes, and to VOTE_OPTION_UNSPECIFIED otherwise.
+	Option VoteOption `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos.gov.v1beta1.VoteOption" json:"option,omitempty"` // Deprecated: Do not use.
+	// options are the weighted options of the vote, whose weights sum to 1.
+	Options WeightedVoteOptions `protobuf:"bytes,4,rep,name=options,proto3,castrepeated=WeightedVoteOptions" json:"options"`
 }
 
 func (m *Vote) Reset()      { *m = Vote{} }
 func (*Vote) ProtoMessage() {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{4}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{5}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -331,7 +376,7 @@ type DepositParams struct {
 func (m *DepositParams) Reset()      { *m = DepositParams{} }
 func (*DepositParams) ProtoMessage() {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{5}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{6}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -369,7 +414,7 @@ type VotingParams struct {
 func (m *VotingParams) Reset()      { *m = VotingParams{} }
 func (*VotingParams) ProtoMessage() {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{6}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{7}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -411,7 +456,7 @@ type TallyParams struct {
 func (m *TallyParams) Reset()      { *m = TallyParams{} }
 func (*TallyParams) ProtoMessage() {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{7}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{8}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -443,6 +488,7 @@ var xxx_messageInfo_TallyParams proto.InternalMessageInfo
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1beta1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1beta1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
+	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1beta1.WeightedVoteOption")
 	proto.RegisterType((*TextProposal)(nil), "cosmos.gov.v1beta1.TextProposal")
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1beta1.Deposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1beta1.Proposal")
@@ -456,95 +502,129 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/gov.proto", fileDescriptor_6e82113c1a9a4b7c) }
 
 var fileDescriptor_6e82113c1a9a4b7c = []byte{
-	// 1375 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x41, 0x6c, 0xdb, 0xd4,
-	0x1b, 0x8f, 0xd3, 0xb4, 0x5d, 0x5f, 0xd2, 0xd6, 0x7b, 0xed, 0xbf, 0x4d, 0xfd, 0xdf, 0xdf, 0xf6,
-	0xdf, 0x20, 0x54, 0x4d, 0x5b, 0xba, 0x15, 0x04, 0x62, 0x93, 0xd0, 0x92, 0xc6, 0xdb, 0x82, 0xa6,
-	0x24, 0x72, 0xbc, 0x4c, 0x1b, 0x07, 0xcb, 0x89, 0xdf, 0x52, 0x43, 0xec, 0x17, 0xe2, 0x97, 0xd2,
-	0x88, 0x0b, 0xc7, 0x29, 0x48, 0x68, 0x47, 0x24, 0x14, 0x09, 0x09, 0x4e, 0x9c, 0x39, 0x73, 0x44,
-	0x15, 0xe2, 0x30, 0x71, 0x9a, 0x38, 0x64, 0xac, 0x93, 0x10, 0xea, 0xb1, 0x07, 0x0e, 0x1c, 0x10,
-	0xb2, 0xdf, 0x73, 0xe3, 0x24, 0x15, 0x5d, 0xe0, 0x54, 0xfb, 0xfb, 0xbe, 0xdf, 0xef, 0xfb, 0xde,
-	0xef, 0x7d, 0xdf, 0xe7, 0x06, 0x5c, 0xa8, 0x63, 0xcf, 0xc1, 0xde, 0x56, 0x03, 0xef, 0x6d, 0xed,
-	0x5d, 0xad, 0x21, 0x62, 0x5e, 0xf5, 0x9f, 0x33, 0xad, 0x36, 0x26, 0x18, 0x42, 0xea, 0xcd, 0xf8,
-	0x16, 0xe6, 0x15, 0x44, 0x86, 0xa8, 0x99, 0x1e, 0x3a, 0x81, 0xd4, 0xb1, 0xed, 0x52, 0x8c, 0xb0,
-	0xda, 0xc0, 0x0d, 0x1c, 0x3c, 0x6e, 0xf9, 0x4f, 0xcc, 0xba, 0x41, 0x51, 0x06, 0x75, 0x30, 0x5a,
-	0xea, 0x92, 0x1a, 0x18, 0x37, 0x9a, 0x68, 0x2b, 0x78, 0xab, 0x75, 0x1e, 0x6e, 0x11, 0xdb, 0x41,
-	0x1e, 0x31, 0x9d, 0x56, 0x88, 0x1d, 0x0f, 0x30, 0xdd, 0x2e, 0x73, 0x89, 0xe3, 0x2e, 0xab, 0xd3,
-	0x36, 0x89, 0x8d, 0x59, 0x31, 0xca, 0x3d, 0x90, 0xd2, 0xd1, 0x3e, 0x29, 0xb7, 0x71, 0x0b, 0x7b,
-	0x66, 0x13, 0xae, 0x82, 0x59, 0x62, 0x93, 0x26, 0x4a, 0x73, 0x32, 0xb7, 0xb9, 0xa0, 0xd1, 0x17,
-	0x28, 0x83, 0xa4, 0x85, 0xbc, 0x7a, 0xdb, 0x6e, 0xf9, 0xd0, 0x74, 0x3c, 0xf0, 0x45, 0x4d, 0xd7,
-	0x96, 0x7f, 0xfb, 0x52, 0xe2, 0x7e, 0xfa, 0xf6, 0xf2, 0xfc, 0x0e, 0x76, 0x09, 0x72, 0x89, 0xf2,
-	0x27, 0x07, 0xe6, 0xf3, 0xa8, 0x85, 0x3d, 0x9b, 0xc0, 0xb7, 0x40, 0xb2, 0xc5, 0x12, 0x18, 0xb6,
-	0x15, 0x50, 0x27, 0x72, 0x6b, 0xc7, 0x03, 0x09, 0x76, 0x4d, 0xa7, 0x79, 0x4d, 0x89, 0x38, 0x15,
-	0x0d, 0x84, 0x6f, 0x05, 0x0b, 0x96, 0xc0, 0x82, 0x45, 0x39, 0x70, 0x3b, 0xc8, 0x9a, 0xca, 0x5d,
-	0xfd, 0x63, 0x20, 0x5d, 0x6e, 0xd8, 0x64, 0xb7, 0x53, 0xcb, 0xd4, 0xb1, 0xc3, 0x94, 0x62, 0x7f,
-	0x2e, 0x7b, 0xd6, 0x07, 0x5b, 0xa4, 0xdb, 0x42, 0x5e, 0x26, 0x5b, 0xaf, 0x67, 0x2d, 0xab, 0x8d,
-	0x3c, 0x4f, 0x1b, 0x72, 0xc0, 0x3a, 0x98, 0x33, 0x1d, 0xdc, 0x71, 0x49, 0x7a, 0x46, 0x9e, 0xd9,
-	0x4c, 0x6e, 0x6f, 0x64, 0x98, 0xd2, 0xfe, 0x65, 0x85, 0x37, 0x98, 0xd9, 0xc1, 0xb6, 0x9b, 0xbb,
-	0x72, 0x30, 0x90, 0x62, 0xdf, 0x3c, 0x93, 0x36, 0x5f, 0x22, 0x99, 0x0f, 0xf0, 0x34, 0x46, 0x7d,
-	0x2d, 0xe1, 0x6b, 0xa1, 0xfc, 0x3e, 0x07, 0xce, 0x9d, 0xc8, 0xfa, 0xc6, 0x69, 0x0a, 0xac, 0x1c,
-	0x0d, 0xa4, 0xb8, 0x6d, 0x1d, 0x0f, 0xa4, 0x05, 0xaa, 0xc3, 0xf8, 0xf1, 0xaf, 0x83, 0xf9, 0x3a,
-	0x95, 0x33, 0x38, 0x7c, 0x72, 0x7b, 0x35, 0x43, 0xaf, 0x33, 0x13, 0x5e, 0x67, 0x26, 0xeb, 0x76,
-	0x73, 0xc9, 0x1f, 0x86, 0xba, 0x6b, 0x21, 0x02, 0x56, 0xc1, 0x9c, 0x47, 0x4c, 0xd2, 0xf1, 0xd2,
-	0x33, 0x32, 0xb7, 0xb9, 0xb4, 0xad, 0x64, 0x26, 0x7b, 0x35, 0x13, 0x16, 0x58, 0x09, 0x22, 0x73,
-	0xc2, 0xf1, 0x40, 0x5a, 0x1b, 0xbb, 0x13, 0x4a, 0xa2, 0x68, 0x8c, 0x0d, 0xb6, 0x00, 0x7c, 0x68,
-	0xbb, 0x66, 0xd3, 0x20, 0x66, 0xb3, 0xd9, 0x35, 0xda, 0xc8, 0xeb, 0x34, 0x49, 0x3a, 0x11, 0xd4,
-	0x27, 0x9d, 0x96, 0x43, 0xf7, 0xe3, 0xb4, 0x20, 0x2c, 0xf7, 0x7f, 0x5f, 0xd4, 0xe3, 0x81, 0xb4,
-	0x41, 0x93, 0x4c, 0x12, 0x29, 0x1a, 0x1f, 0x18, 0x23, 0x20, 0xf8, 0x1e, 0x48, 0x7a, 0x9d, 0x9a,
-	0x63, 0x13, 0xc3, 0x6f, 0xfc, 0xf4, 0x6c, 0x90, 0x4a, 0x98, 0x90, 0x42, 0x0f, 0xa7, 0x22, 0x27,
-	0xb2, 0x2c, 0xac, 0xbd, 0x22, 0x60, 0xe5, 0xf1, 0x33, 0x89, 0xd3, 0x00, 0xb5, 0xf8, 0x00, 0x68,
-	0x03, 0x9e, 0xb5, 0x87, 0x81, 0x5c, 0x8b, 0x66, 0x98, 0x3b, 0x33, 0xc3, 0x2b, 0x2c, 0xc3, 0x3a,
-	0xcd, 0x30, 0xce, 0x40, 0xd3, 0x2c, 0x31, 0xb3, 0xea, 0x5a, 0x41, 0xaa, 0x47, 0x1c, 0x58, 0x24,
-	0x98, 0x98, 0x4d, 0x83, 0x39, 0xd2, 0xf3, 0x67, 0x35, 0xe1, 0x6d, 0x96, 0x67, 0x95, 0xe6, 0x19,
-	0x41, 0x2b, 0x53, 0x35, 0x67, 0x2a, 0xc0, 0x86, 0x13, 0xd9, 0x04, 0xe7, 0xf7, 0x30, 0xb1, 0xdd,
-	0x86, 0x7f, 0xbd, 0x6d, 0x26, 0xec, 0xb9, 0x33, 0x8f, 0xfd, 0x2a, 0x2b, 0x27, 0x4d, 0xcb, 0x99,
-	0xa0, 0xa0, 0xe7, 0x5e, 0xa6, 0xf6, 0x8a, 0x6f, 0x0e, 0x0e, 0xfe, 0x10, 0x30, 0xd3, 0x50, 0xe2,
-	0x85, 0x33, 0x73, 0x29, 0x2c, 0xd7, 0xda, 0x48, 0xae, 0x51, 0x85, 0x17, 0xa9, 0x95, 0x09, 0xcc,
-	0x06, 0xef, 0x20, 0x0e, 0x92, 0xd1, 0xf6, 0xb9, 0x01, 0x66, 0xba, 0xc8, 0xa3, 0x0b, 0x2d, 0x97,
-	0xf1, 0x59, 0x7f, 0x1e, 0x48, 0xaf, 0xbd, 0x84, 0x70, 0x05, 0x97, 0x68, 0x3e, 0x14, 0xde, 0x06,
-	0xf3, 0x66, 0xcd, 0x23, 0xa6, 0xcd, 0x56, 0xdf, 0xd4, 0x2c, 0x21, 0x1c, 0xbe, 0x03, 0xe2, 0x2e,
-	0x0e, 0x06, 0x72, 0x7a, 0x92, 0xb8, 0x8b, 0x61, 0x03, 0xa4, 0x5c, 0x6c, 0x7c, 0x64, 0x93, 0x5d,
-	0x63, 0x0f, 0x11, 0x1c, 0x8c, 0xdd, 0x42, 0x4e, 0x9d, 0x8e, 0xe9, 0x78, 0x20, 0xad, 0x50, 0x51,
-	0xa3, 0x5c, 0x8a, 0x06, 0x5c, 0x7c, 0xcf, 0x26, 0xbb, 0x55, 0x44, 0x30, 0x93, 0xf2, 0x7b, 0x0e,
-	0x24, 0xaa, 0x98, 0xa0, 0x7f, 0xbe, 0xc1, 0x6f, 0x81, 0xd9, 0x3d, 0x4c, 0xd0, 0xbf, 0xd8, 0xde,
-	0x14, 0x0f, 0xdf, 0x04, 0x73, 0x98, 0x7e, 0x7d, 0xe8, 0x3a, 0x13, 0x4f, 0x5b, 0x35, 0x7e, 0xad,
-	0xa5, 0x20, 0x4a, 0x63, 0xd1, 0xec, 0x20, 0xdf, 0xc5, 0xc1, 0x22, 0xeb, 0xfd, 0xb2, 0xd9, 0x36,
-	0x1d, 0x0f, 0x7e, 0xc1, 0x81, 0xa4, 0x63, 0xbb, 0x27, 0xa3, 0xc8, 0x9d, 0x35, 0x8a, 0x86, 0x2f,
-	0xf2, 0xd1, 0x40, 0xfa, 0x4f, 0x04, 0x75, 0x09, 0x3b, 0x36, 0x41, 0x4e, 0x8b, 0x74, 0x87, 0x52,
-	0x44, 0xdc, 0xd3, 0x4d, 0x28, 0x70, 0x6c, 0x37, 0x9c, 0xcf, 0xcf, 0x38, 0x00, 0x1d, 0x73, 0x3f,
-	0x24, 0x32, 0x5a, 0xa8, 0x6d, 0x63, 0x8b, 0x7d, 0x05, 0x36, 0x26, 0xa6, 0x26, 0xcf, 0x3e, 0xea,
-	0xb4, 0x13, 0x8e, 0x06, 0xd2, 0x85, 0x49, 0xf0, 0x48, 0xad, 0x6c, 0xff, 0x4e, 0x46, 0x29, 0x9f,
-	0xfb, 0x73, 0xc5, 0x3b, 0xe6, 0x7e, 0x28, 0x17, 0x35, 0x7f, 0xca, 0x81, 0x54, 0x35, 0x18, 0x36,
-	0xa6, 0xdf, 0xc7, 0x80, 0x0d, 0x5f, 0x58, 0x1b, 0x77, 0x56, 0x6d, 0xd7, 0x59, 0x6d, 0xeb, 0x23,
-	0xb8, 0x91, 0xb2, 0x56, 0x47, 0x66, 0x3d, 0x5a, 0x51, 0x8a, 0xda, 0x58, 0x35, 0x5f, 0x87, 0x23,
-	0xce, 0x8a, 0x79, 0x00, 0xe6, 0x3e, 0xec, 0xe0, 0x76, 0xc7, 0x09, 0xaa, 0x48, 0xe5, 0x72, 0x53,
-	0x0c, 0x44, 0x1e, 0xd5, 0x8f, 0x06, 0x12, 0x4f, 0xf1, 0xc3, 0x6a, 0x34, 0xc6, 0x08, 0xeb, 0x60,
-	0x81, 0xec, 0xb6, 0x91, 0xb7, 0x8b, 0x9b, 0x16, 0xeb, 0x62, 0x75, 0x6a, 0xfa, 0x95, 0x13, 0x8a,
-	0x48, 0x86, 0x21, 0x2f, 0xd4, 0x41, 0x22, 0x98, 0xe7, 0x99, 0x80, 0xff, 0xc6, 0xd4, 0xfc, 0x4b,
-	0x3e, 0x3a, 0x42, 0x1d, 0xb0, 0x5d, 0xfc, 0x95, 0x03, 0x60, 0x38, 0x12, 0xf0, 0x12, 0x58, 0xaf,
-	0x96, 0x74, 0xd5, 0x28, 0x95, 0xf5, 0x42, 0xa9, 0x68, 0xdc, 0x2d, 0x56, 0xca, 0xea, 0x4e, 0xe1,
-	0x66, 0x41, 0xcd, 0xf3, 0x31, 0x61, 0xb9, 0xd7, 0x97, 0x93, 0x34, 0x50, 0xf5, 0x29, 0xa0, 0x02,
-	0x96, 0xa3, 0xd1, 0xf7, 0xd5, 0x0a, 0xcf, 0x09, 0x8b, 0xbd, 0xbe, 0xbc, 0x40, 0xa3, 0xee, 0x23,
-	0x0f, 0x5e, 0x04, 0x2b, 0xd1, 0x98, 0x6c, 0xae, 0xa2, 0x67, 0x0b, 0x45, 0x3e, 0x2e, 0x9c, 0xef,
-	0xf5, 0xe5, 0x45, 0x1a, 0x97, 0x65, 0xab, 0x4f, 0x06, 0x4b, 0xd1, 0xd8, 0x62, 0x89, 0x9f, 0x11,
-	0x52, 0xbd, 0xbe, 0x7c, 0x8e, 0x86, 0x15, 0x31, 0xdc, 0x06, 0xe9, 0xd1, 0x08, 0xe3, 0x5e, 0x41,
-	0xbf, 0x6d, 0x54, 0x55, 0xbd, 0xc4, 0x27, 0x84, 0xd5, 0x5e, 0x5f, 0xe6, 0xc3, 0xd8, 0x70, 0x4f,
-	0x09, 0x89, 0x47, 0x5f, 0x89, 0xb1, 0x8b, 0x3f, 0xc6, 0xc1, 0xd2, 0xe8, 0xbf, 0x32, 0x30, 0x03,
-	0xfe, 0x5b, 0xd6, 0x4a, 0xe5, 0x52, 0x25, 0x7b, 0xc7, 0xa8, 0xe8, 0x59, 0xfd, 0x6e, 0x65, 0xec,
-	0xc0, 0xc1, 0x51, 0x68, 0x70, 0xd1, 0x6e, 0xc2, 0xeb, 0x40, 0x1c, 0x8f, 0xcf, 0xab, 0xe5, 0x52,
-	0xa5, 0xa0, 0x1b, 0x65, 0x55, 0x2b, 0x94, 0xf2, 0x3c, 0x27, 0xac, 0xf7, 0xfa, 0xf2, 0x0a, 0x85,
-	0x8c, 0x4c, 0x07, 0x7c, 0x1b, 0xfc, 0x6f, 0x1c, 0x5c, 0x2d, 0xe9, 0x85, 0xe2, 0xad, 0x10, 0x1b,
-	0x17, 0xd6, 0x7a, 0x7d, 0x19, 0x52, 0x6c, 0x35, 0xd2, 0xca, 0xf0, 0x12, 0x58, 0x1b, 0x87, 0x96,
-	0xb3, 0x95, 0x8a, 0x9a, 0xe7, 0x67, 0x04, 0xbe, 0xd7, 0x97, 0x53, 0x14, 0x53, 0x36, 0x3d, 0x0f,
-	0x59, 0xf0, 0x0a, 0x48, 0x8f, 0x47, 0x6b, 0xea, 0xbb, 0xea, 0x8e, 0xae, 0xe6, 0xf9, 0x84, 0x00,
-	0x7b, 0x7d, 0x79, 0x89, 0xc6, 0x6b, 0xe8, 0x7d, 0x54, 0x27, 0xe8, 0x54, 0xfe, 0x9b, 0xd9, 0xc2,
-	0x1d, 0x35, 0xcf, 0xcf, 0x46, 0xf9, 0x6f, 0x9a, 0x76, 0x13, 0x59, 0x54, 0xce, 0x5c, 0xf1, 0xe0,
-	0xb9, 0x18, 0x7b, 0xfa, 0x5c, 0x8c, 0x7d, 0x72, 0x28, 0xc6, 0x0e, 0x0e, 0x45, 0xee, 0xc9, 0xa1,
-	0xc8, 0xfd, 0x72, 0x28, 0x72, 0x8f, 0x5f, 0x88, 0xb1, 0x27, 0x2f, 0xc4, 0xd8, 0xd3, 0x17, 0x62,
-	0xec, 0xc1, 0xdf, 0x6f, 0xb6, 0xfd, 0xe0, 0x17, 0x53, 0xd0, 0xa3, 0xb5, 0xb9, 0x60, 0x19, 0xbc,
-	0xfe, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x49, 0xad, 0x38, 0x5a, 0x4c, 0x0d, 0x00, 0x00,
-}
+	// 1478 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4f, 0x6c, 0x1b, 0x45,
+	0x17, 0xf7, 0xda, 0x4e, 0x1c, 0x8f, 0x9d, 0xc4, 0x9d, 0xe4, 0x4b, 0x1c, 0xb7, 0x9f, 0x77, 0xbf,
+	0xfd, 0x50, 0x15, 0x55, 0x8d, 0xd3, 0x06, 0x04, 0x6a, 0x2a, 0x41, 0xbd, 0xf1, 0xb6, 0x35, 0x2a,
+	0xb6, 0xb5, 0x76, 0x1d, 0xb5, 0x08, 0xad, 0x36, 0xde, 0xa9, 0xb3, 0xe0, 0xdd, 0x31, 0xde, 0x71,
+	0x9a, 0x88, 0x0b, 0xc7, 0xca, 0x48, 0xa8, 0x47, 0x24, 0x64, 0x09, 0x09, 0x2e, 0xc0, 0x09, 0xa9,
+	0x67, 0xce, 0x51, 0xc5, 0xa1, 0xe2, 0x54, 0x71, 0x70, 0x69, 0x2a, 0x21, 0x94, 0x63, 0x8e, 0x1c,
+	0x10, 0xda, 0x9d, 0x59, 0x67, 0x6d, 0x07, 0x52, 0x97, 0x53, 0xd6, 0x6f, 0x7e, 0xbf, 0xdf, 0xfb,
+	0xb3, 0xef, 0xbd, 0xd9, 0x80, 0x73, 0x35, 0x6c, 0x9b, 0xd8, 0x5e, 0xad, 0xe3, 0x9d, 0xd5, 0x9d,
+	0xcb, 0x5b, 0x88, 0x68, 0x97, 0x9d, 0xe7, 0x4c, 0xb3, 0x85, 0x09, 0x86, 0x90, 0x9e, 0x66, 0x1c,
+	0x0b, 0x3b, 0x4d, 0xa5, 0x19, 0x63, 0x4b, 0xb3, 0x51, 0x9f, 0x52, 0xc3, 0x86, 0x45, 0x39, 0xa9,
+	0xf9, 0x3a, 0xae, 0x63, 0xf7, 0x71, 0xd5, 0x79, 0x62, 0xd6, 0x25, 0xca, 0x52, 0xe9, 0x01, 0x93,
+	0xa5, 0x47, 0x7c, 0x1d, 0xe3, 0x7a, 0x03, 0xad, 0xba, 0xbf, 0xb6, 0xda, 0xf7, 0x56, 0x89, 0x61,
+	0x22, 0x9b, 0x68, 0x66, 0xd3, 0xe3, 0x0e, 0x03, 0x34, 0x6b, 0x8f, 0x1d, 0xa5, 0x87, 0x8f, 0xf4,
+	0x76, 0x4b, 0x23, 0x06, 0x66, 0xc1, 0x88, 0xdf, 0x73, 0x00, 0x6e, 0x22, 0xa3, 0xbe, 0x4d, 0x90,
+	0x5e, 0xc5, 0x04, 0x15, 0x9b, 0xce, 0x21, 0x7c, 0x13, 0x4c, 0x62, 0xf7, 0x29, 0xc9, 0x09, 0xdc,
+	0xf2, 0xcc, 0x5a, 0x3a, 0x33, 0x9a, 0x68, 0xe6, 0x18, 0xaf, 0x30, 0x34, 0xdc, 0x04, 0x93, 0xf7,
+	0x5d, 0xb5, 0x64, 0x50, 0xe0, 0x96, 0xa3, 0xd2, 0x3b, 0xfb, 0x3d, 0x3e, 0xf0, 0x4b, 0x8f, 0x3f,
+	0x5f, 0x37, 0xc8, 0x76, 0x7b, 0x2b, 0x53, 0xc3, 0x26, 0xcb, 0x8d, 0xfd, 0x59, 0xb1, 0xf5, 0x8f,
+	0x56, 0xc9, 0x5e, 0x13, 0xd9, 0x99, 0x1c, 0xaa, 0x1d, 0xf5, 0xf8, 0xe9, 0x3d, 0xcd, 0x6c, 0xac,
+	0x8b, 0x54, 0x45, 0x54, 0x98, 0xdc, 0x7a, 0xf8, 0xf7, 0xaf, 0x78, 0x4e, 0xdc, 0x04, 0xf1, 0x0a,
+	0xda, 0x25, 0xa5, 0x16, 0x6e, 0x62, 0x5b, 0x6b, 0xc0, 0x79, 0x30, 0x41, 0x0c, 0xd2, 0x40, 0x6e,
+	0x94, 0x51, 0x85, 0xfe, 0x80, 0x02, 0x88, 0xe9, 0xc8, 0xae, 0xb5, 0x0c, 0x9a, 0x81, 0x1b, 0x89,
+	0xe2, 0x37, 0xad, 0xcf, 0x3a, 0x6a, 0x3f, 0x3f, 0x5a, 0x89, 0x6c, 0x60, 0x8b, 0x20, 0x8b, 0x88,
+	0x7f, 0x72, 0x20, 0x92, 0x43, 0x4d, 0x6c, 0x1b, 0x04, 0xbe, 0x05, 0x62, 0x4d, 0xe6, 0x40, 0x35,
+	0x74, 0x57, 0x3a, 0x2c, 0x2d, 0x1c, 0xf5, 0x78, 0x48, 0x43, 0xf3, 0x1d, 0x8a, 0x0a, 0xf0, 0x7e,
+	0xe5, 0x75, 0x58, 0x04, 0x51, 0x9d, 0x6a, 0xe0, 0x96, 0xeb, 0x35, 0x2e, 0x5d, 0xfe, 0xa3, 0xc7,
+	0xaf, 0xbc, 0x44, 0xee, 0xd9, 0x5a, 0x2d, 0xab, 0xeb, 0x2d, 0x64, 0xdb, 0xca, 0xb1, 0x06, 0xac,
+	0x81, 0x49, 0xcd, 0xc4, 0x6d, 0x8b, 0x24, 0x43, 0x42, 0x68, 0x39, 0xb6, 0xb6, 0xe4, 0xbd, 0x05,
+	0xa7, 0xb5, 0xfa, 0xaf, 0x61, 0x03, 0x1b, 0x96, 0x74, 0xc9, 0x29, 0xf4, 0x77, 0xcf, 0xf8, 0xe5,
+	0x97, 0x70, 0xe6, 0x10, 0x6c, 0x85, 0x49, 0xb3, 0xca, 0xfe, 0x10, 0x01, 0x53, 0xfd, 0xb2, 0xbe,
+	0x71, 0x52, 0x05, 0xe6, 0x0e, 0x7b, 0x7c, 0xd0, 0xd0, 0x8f, 0x7a, 0x7c, 0x94, 0xd6, 0x61, 0x38,
+	0xfd, 0xab, 0x20, 0x52, 0xa3, 0xe5, 0x74, 0x93, 0x8f, 0xad, 0xcd, 0x67, 0x68, 0xf3, 0x65, 0xbc,
+	0xe6, 0xcb, 0x64, 0xad, 0x3d, 0x29, 0xf6, 0xf8, 0xb8, 0xee, 0x8a, 0xc7, 0x80, 0x55, 0x30, 0x69,
+	0x13, 0x8d, 0xb4, 0xed, 0x64, 0xc8, 0x6d, 0x38, 0xf1, 0xa4, 0x86, 0xf3, 0x02, 0x2c, 0xbb, 0x48,
+	0x29, 0x75, 0xd4, 0xe3, 0x17, 0x86, 0xde, 0x09, 0x15, 0x11, 0x15, 0xa6, 0x06, 0x9b, 0x00, 0xde,
+	0x33, 0x2c, 0xad, 0xa1, 0x12, 0xad, 0xd1, 0xd8, 0x53, 0x5b, 0xc8, 0x6e, 0x37, 0x48, 0x32, 0xec,
+	0xc6, 0xc7, 0x9f, 0xe4, 0xa3, 0xe2, 0xe0, 0x14, 0x17, 0x26, 0xfd, 0xcf, 0x29, 0xea, 0x51, 0x8f,
+	0x5f, 0xa2, 0x4e, 0x46, 0x85, 0x44, 0x25, 0xe1, 0x1a, 0x7d, 0x24, 0xf8, 0x3e, 0x88, 0xd9, 0xed,
+	0x2d, 0xd3, 0x20, 0xaa, 0x33, 0xa6, 0xc9, 0x09, 0xd7, 0x55, 0x6a, 0xa4, 0x14, 0x15, 0x6f, 0x86,
+	0xa5, 0x34, 0xf3, 0xc2, 0xda, 0xcb, 0x47, 0x16, 0x1f, 0x3e, 0xe3, 0x39, 0x05, 0x50, 0x8b, 0x43,
+	0x80, 0x06, 0x48, 0xb0, 0xf6, 0x50, 0x91, 0xa5, 0x53, 0x0f, 0x93, 0xa7, 0x7a, 0xf8, 0x3f, 0xf3,
+	0xb0, 0x48, 0x3d, 0x0c, 0x2b, 0x50, 0x37, 0x33, 0xcc, 0x2c, 0x5b, 0xba, 0xeb, 0xea, 0x01, 0x07,
+	0xa6, 0x09, 0x26, 0x5a, 0x43, 0x65, 0x07, 0xc9, 0xc8, 0x69, 0x4d, 0x78, 0x93, 0xf9, 0x99, 0xa7,
+	0x7e, 0x06, 0xd8, 0xe2, 0x58, 0xcd, 0x19, 0x77, 0xb9, 0xde, 0x44, 0x36, 0xc0, 0x99, 0x1d, 0x4c,
+	0x0c, 0xab, 0xee, 0xbc, 0xde, 0x16, 0x2b, 0xec, 0xd4, 0xa9, 0x69, 0xbf, 0xc6, 0xc2, 0x49, 0xd2,
+	0x70, 0x46, 0x24, 0x68, 0xde, 0xb3, 0xd4, 0x5e, 0x76, 0xcc, 0x6e, 0xe2, 0xf7, 0x00, 0x33, 0x1d,
+	0x97, 0x38, 0x7a, 0xaa, 0x2f, 0x91, 0xf9, 0x5a, 0x18, 0xf0, 0x35, 0x58, 0xe1, 0x69, 0x6a, 0xf5,
+	0x0a, 0x7c, 0x05, 0x4c, 0x99, 0xc8, 0xb6, 0xb5, 0x3a, 0xb2, 0x93, 0x40, 0x08, 0xfd, 0xed, 0xc0,
+	0x44, 0x1e, 0x3f, 0x5a, 0x09, 0xbd, 0x67, 0xd7, 0x95, 0x3e, 0x9c, 0xcd, 0xec, 0x7e, 0x10, 0xc4,
+	0xfc, 0x9d, 0x77, 0x0d, 0x84, 0xf6, 0x90, 0x4d, 0x77, 0xa1, 0x94, 0x19, 0x63, 0xf3, 0xe6, 0x2d,
+	0xa2, 0x38, 0x54, 0x78, 0x13, 0x44, 0xb4, 0x2d, 0x9b, 0x68, 0x06, 0xdb, 0x9a, 0x63, 0xab, 0x78,
+	0x74, 0xf8, 0x36, 0x08, 0x5a, 0x38, 0x19, 0x7a, 0x25, 0x91, 0xa0, 0x85, 0x61, 0x1d, 0xc4, 0x2d,
+	0xac, 0xde, 0x37, 0xc8, 0xb6, 0xba, 0x83, 0x08, 0x76, 0x27, 0x36, 0x2a, 0xc9, 0xe3, 0x29, 0x1d,
+	0xf5, 0xf8, 0x39, 0xfa, 0x3e, 0xfc, 0x5a, 0xa2, 0x02, 0x2c, 0xbc, 0x69, 0x90, 0xed, 0x2a, 0x22,
+	0x98, 0x95, 0xf2, 0xdb, 0x20, 0x08, 0x3b, 0xd7, 0xd9, 0xab, 0x2f, 0xff, 0x1b, 0x60, 0x62, 0x07,
+	0x13, 0xf4, 0x2f, 0x16, 0x3f, 0xe5, 0xc3, 0xf5, 0xfe, 0xd5, 0x1b, 0x7a, 0x99, 0xab, 0x57, 0x0a,
+	0x26, 0xb9, 0xfe, 0xf5, 0xfb, 0x01, 0x88, 0xd0, 0x27, 0x3b, 0x19, 0x76, 0x3b, 0xea, 0xfc, 0x49,
+	0xe4, 0xd1, 0xfb, 0x5e, 0x3a, 0xcb, 0xae, 0x8f, 0xb9, 0xd1, 0x33, 0x5b, 0xf1, 0x34, 0x59, 0xad,
+	0x7e, 0x0c, 0x82, 0x69, 0x36, 0x99, 0x25, 0xad, 0xa5, 0x99, 0x36, 0xfc, 0x92, 0x03, 0x31, 0xd3,
+	0xb0, 0xfa, 0x8b, 0x82, 0x3b, 0x6d, 0x51, 0xa8, 0x8e, 0xbb, 0xc3, 0x1e, 0xff, 0x1f, 0x1f, 0xeb,
+	0x22, 0x36, 0x0d, 0x82, 0xcc, 0x26, 0xd9, 0x3b, 0xae, 0xb6, 0xef, 0x78, 0xbc, 0xfd, 0x01, 0x4c,
+	0xc3, 0xf2, 0xb6, 0xc7, 0xe7, 0x1c, 0x80, 0xa6, 0xb6, 0xeb, 0x09, 0xa9, 0x4d, 0xd4, 0x32, 0xb0,
+	0xce, 0xee, 0xa8, 0xa5, 0x91, 0x91, 0xcb, 0xb1, 0x0f, 0x24, 0xda, 0x6c, 0x87, 0x3d, 0xfe, 0xdc,
+	0x28, 0x79, 0x20, 0x56, 0x76, 0x3b, 0x8c, 0xa2, 0xc4, 0x2f, 0x9c, 0xa9, 0x4f, 0x98, 0xda, 0xae,
+	0x57, 0x2e, 0x6a, 0xfe, 0x8c, 0x03, 0xf1, 0xaa, 0xbb, 0x0a, 0x58, 0xfd, 0x3e, 0x01, 0x6c, 0x35,
+	0x78, 0xb1, 0x71, 0xa7, 0xc5, 0x76, 0x95, 0xc5, 0xb6, 0x38, 0xc0, 0x1b, 0x08, 0x6b, 0x7e, 0x60,
+	0x13, 0xf9, 0x23, 0x8a, 0x53, 0x1b, 0x8b, 0xe6, 0x1b, 0x6f, 0x8b, 0xb0, 0x60, 0xee, 0x82, 0xc9,
+	0x8f, 0xdb, 0xb8, 0xd5, 0x36, 0xdd, 0x28, 0xe2, 0x92, 0x34, 0xde, 0x27, 0xdc, 0x61, 0x8f, 0x4f,
+	0x50, 0xfe, 0x71, 0x34, 0x0a, 0x53, 0x84, 0x35, 0x10, 0x25, 0xdb, 0x2d, 0x64, 0x6f, 0xe3, 0x86,
+	0xce, 0x06, 0x45, 0x1e, 0x5b, 0x7e, 0xae, 0x2f, 0xe1, 0xf3, 0x70, 0xac, 0x0b, 0x2b, 0x20, 0xec,
+	0xae, 0x8c, 0x90, 0xab, 0x7f, 0x6d, 0x6c, 0xfd, 0x19, 0x87, 0xed, 0x93, 0x76, 0xd5, 0x2e, 0xfc,
+	0xc6, 0x01, 0xe0, 0xfb, 0x40, 0xbe, 0x08, 0x16, 0xab, 0xc5, 0x8a, 0xac, 0x16, 0x4b, 0x95, 0x7c,
+	0xb1, 0xa0, 0xde, 0x2e, 0x94, 0x4b, 0xf2, 0x46, 0xfe, 0x7a, 0x5e, 0xce, 0x25, 0x02, 0xa9, 0xd9,
+	0x4e, 0x57, 0x88, 0x51, 0xa0, 0xec, 0x48, 0x40, 0x11, 0xcc, 0xfa, 0xd1, 0x77, 0xe4, 0x72, 0x82,
+	0x4b, 0x4d, 0x77, 0xba, 0x42, 0x94, 0xa2, 0xee, 0x20, 0x1b, 0x5e, 0x00, 0x73, 0x7e, 0x4c, 0x56,
+	0x2a, 0x57, 0xb2, 0xf9, 0x42, 0x22, 0x98, 0x3a, 0xd3, 0xe9, 0x0a, 0xd3, 0x14, 0x97, 0x65, 0xdb,
+	0x55, 0x00, 0x33, 0x7e, 0x6c, 0xa1, 0x98, 0x08, 0xa5, 0xe2, 0x9d, 0xae, 0x30, 0x45, 0x61, 0x05,
+	0x0c, 0xd7, 0x40, 0x72, 0x10, 0xa1, 0x6e, 0xe6, 0x2b, 0x37, 0xd5, 0xaa, 0x5c, 0x29, 0x26, 0xc2,
+	0xa9, 0xf9, 0x4e, 0x57, 0x48, 0x78, 0x58, 0x6f, 0x15, 0xa6, 0xc2, 0x0f, 0xbe, 0x4e, 0x07, 0x2e,
+	0xfc, 0x14, 0x04, 0x33, 0x83, 0x1f, 0x5a, 0x30, 0x03, 0xce, 0x96, 0x94, 0x62, 0xa9, 0x58, 0xce,
+	0xde, 0x52, 0xcb, 0x95, 0x6c, 0xe5, 0x76, 0x79, 0x28, 0x61, 0x37, 0x15, 0x0a, 0x2e, 0x18, 0x0d,
+	0x78, 0x15, 0xa4, 0x87, 0xf1, 0x39, 0xb9, 0x54, 0x2c, 0xe7, 0x2b, 0x6a, 0x49, 0x56, 0xf2, 0xc5,
+	0x5c, 0x82, 0x4b, 0x2d, 0x76, 0xba, 0xc2, 0x1c, 0xa5, 0x0c, 0x4c, 0x07, 0xbc, 0x02, 0xfe, 0x3b,
+	0x4c, 0xae, 0x16, 0x2b, 0xf9, 0xc2, 0x0d, 0x8f, 0x1b, 0x4c, 0x2d, 0x74, 0xba, 0x02, 0xa4, 0xdc,
+	0xaa, 0xaf, 0x95, 0xe1, 0x45, 0xb0, 0x30, 0x4c, 0x2d, 0x65, 0xcb, 0x65, 0x39, 0x97, 0x08, 0xa5,
+	0x12, 0x9d, 0xae, 0x10, 0xa7, 0x9c, 0x92, 0x66, 0xdb, 0x48, 0x87, 0x97, 0x40, 0x72, 0x18, 0xad,
+	0xc8, 0xef, 0xca, 0x1b, 0x15, 0x39, 0x97, 0x08, 0xa7, 0x60, 0xa7, 0x2b, 0xcc, 0x50, 0xbc, 0x82,
+	0x3e, 0x44, 0x35, 0x82, 0x4e, 0xd4, 0xbf, 0x9e, 0xcd, 0xdf, 0x92, 0x73, 0x89, 0x09, 0xbf, 0xfe,
+	0x75, 0xcd, 0x68, 0x20, 0x9d, 0x96, 0x53, 0x2a, 0xec, 0x3f, 0x4f, 0x07, 0x9e, 0x3e, 0x4f, 0x07,
+	0x3e, 0x3d, 0x48, 0x07, 0xf6, 0x0f, 0xd2, 0xdc, 0x93, 0x83, 0x34, 0xf7, 0xeb, 0x41, 0x9a, 0x7b,
+	0xf8, 0x22, 0x1d, 0x78, 0xf2, 0x22, 0x1d, 0x78, 0xfa, 0x22, 0x1d, 0xb8, 0xfb, 0xcf, 0x9b, 0x6d,
+	0xd7, 0xfd, 0xef, 0xd3, 0xed, 0xd1, 0xad, 0x49, 0x77, 0x19, 0xbc, 0xfe, 0xd7, 0x00, 0x8b, 0xb2,
+	0x96, 0x63, 0x98, 0x0e, 0x00, 0x00,
+}
+
+func (this *WeightedVoteOption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
+	that1, ok := that.(*WeightedVoteOption)
+	if !ok {
+		that2, ok := that.(WeightedVoteOption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Option != that1.Option {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
 func (this *TextProposal) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -658,6 +738,14 @@ func (this *Proposal) Equal(that interface{}) bool {
 	if !this.VotingEndTime.Equal(that1.VotingEndTime) {
 		return false
 	}
+	if len(this.Messages) != len(that1.Messages) {
+		return false
+	}
+	for i := range this.Messages {
+		if !this.Messages[i].Equal(that1.Messages[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *TallyResult) Equal(that interface{}) bool {
@@ -721,8 +809,54 @@ func (this *Vote) Equal(that interface{}) bool {
 	if this.Option != that1.Option {
 		return false
 	}
+	if len(this.Options) != len(that1.Options) {
+		return false
+	}
+	for i := range this.Options {
+		if !this.Options[i].Equal(&that1.Options[i]) {
+			return false
+		}
+	}
 	return true
 }
+func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Option != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TextProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -829,6 +963,20 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
 	if err1 != nil {
 		return 0, err1
@@ -993,6 +1141,20 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Option != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.Option))
 		i--
@@ -1153,6 +1315,20 @@ func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *WeightedVoteOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovGov(uint64(m.Option))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
 func (m *TextProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1224,6 +1400,12 @@ func (m *Proposal) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
 	n += 1 + l + sovGov(uint64(l))
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1260,6 +1442,12 @@ func (m *Vote) Size() (n int) {
 	if m.Option != 0 {
 		n += 1 + sovGov(uint64(m.Option))
 	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1312,6 +1500,112 @@ func sovGov(x uint64) (n int) {
 func sozGov(x uint64) (n int) {
 	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TextProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1871,6 +2165,40 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2185,6 +2513,40 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
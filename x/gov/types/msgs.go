@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 
 	yaml "gopkg.in/yaml.v2"
@@ -16,11 +17,13 @@ import (
 const (
 	TypeMsgDeposit        = "deposit"
 	TypeMsgVote           = "vote"
+	TypeMsgVoteWeighted   = "weighted_vote"
 	TypeMsgSubmitProposal = "submit_proposal"
 )
 
 var (
 	_, _, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}
+	_       sdk.Msg                       = &MsgVoteWeighted{}
 	_       MsgSubmitProposalI            = &MsgSubmitProposal{}
 	_       types.UnpackInterfacesMessage = &MsgSubmitProposal{}
 )
@@ -39,10 +42,15 @@ type MsgSubmitProposalI interface {
 
 	GetProposer() sdk.AccAddress
 	SetProposer(sdk.AccAddress)
+
+	GetMessages() ([]sdk.Msg, error)
+	SetMessages([]sdk.Msg) error
 }
 
-// NewMsgSubmitProposal creates a new MsgSubmitProposal.
-func NewMsgSubmitProposal(content Content, initialDeposit sdk.Coins, proposer sdk.AccAddress) (*MsgSubmitProposal, error) {
+// NewMsgSubmitProposal creates a new MsgSubmitProposal. The optional msgs must
+// be signed by the gov module account and are executed if the proposal passes.
+// The content may be nil if msgs is not empty.
+func NewMsgSubmitProposal(content Content, initialDeposit sdk.Coins, proposer sdk.AccAddress, msgs ...sdk.Msg) (*MsgSubmitProposal, error) {
 	m := &MsgSubmitProposal{
 		InitialDeposit: initialDeposit,
 		Proposer:       proposer,
@@ -51,6 +59,9 @@ func NewMsgSubmitProposal(content Content, initialDeposit sdk.Coins, proposer sd
 	if err != nil {
 		return nil, err
 	}
+	if err := m.SetMessages(msgs); err != nil {
+		return nil, err
+	}
 	return m, nil
 }
 
@@ -59,6 +70,9 @@ func (m *MsgSubmitProposal) GetInitialDeposit() sdk.Coins { return m.InitialDepo
 func (m *MsgSubmitProposal) GetProposer() sdk.AccAddress { return m.Proposer }
 
 func (m *MsgSubmitProposal) GetContent() Content {
+	if m.Content == nil {
+		return nil
+	}
 	content, ok := m.Content.GetCachedValue().(Content)
 	if !ok {
 		return nil
@@ -75,6 +89,11 @@ func (m *MsgSubmitProposal) SetProposer(address sdk.AccAddress) {
 }
 
 func (m *MsgSubmitProposal) SetContent(content Content) error {
+	if content == nil {
+		m.Content = nil
+		return nil
+	}
+
 	msg, ok := content.(proto.Message)
 	if !ok {
 		return fmt.Errorf("can't proto marshal %T", msg)
@@ -87,6 +106,20 @@ func (m *MsgSubmitProposal) SetContent(content Content) error {
 	return nil
 }
 
+// GetMessages returns the unpacked messages of the proposal.
+func (m *MsgSubmitProposal) GetMessages() ([]sdk.Msg, error) {
+	return unpackMessages(m.Messages)
+}
+
+func (m *MsgSubmitProposal) SetMessages(msgs []sdk.Msg) error {
+	anys, err := packMessages(msgs)
+	if err != nil {
+		return err
+	}
+	m.Messages = anys
+	return nil
+}
+
 // Route implements Msg
 func (m MsgSubmitProposal) Route() string { return RouterKey }
 
@@ -105,23 +138,71 @@ func (m MsgSubmitProposal) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.InitialDeposit.String())
 	}
 
+	msgs, err := m.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	// the content is optional for a proposal holding messages
 	content := m.GetContent()
-	if content == nil {
+	switch {
+	case content != nil:
+		if !IsValidProposalType(content.ProposalType()) {
+			return sdkerrors.Wrap(ErrInvalidProposalType, content.ProposalType())
+		}
+		if err := content.ValidateBasic(); err != nil {
+			return err
+		}
+
+	case m.Content != nil || len(msgs) == 0:
 		return sdkerrors.Wrap(ErrInvalidProposalContent, "missing content")
 	}
-	if !IsValidProposalType(content.ProposalType()) {
-		return sdkerrors.Wrap(ErrInvalidProposalType, content.ProposalType())
-	}
-	if err := content.ValidateBasic(); err != nil {
-		return err
-	}
 
-	return nil
+	return ValidateProposalMessages(msgs)
 }
 
 // GetSignBytes implements Msg
 func (m MsgSubmitProposal) GetSignBytes() []byte {
-	bz := ModuleCdc.MustMarshalJSON(m)
+	if len(m.Messages) == 0 {
+		bz := ModuleCdc.MustMarshalJSON(m)
+		return sdk.MustSortJSON(bz)
+	}
+
+	msgs, err := m.GetMessages()
+	if err != nil {
+		panic(err)
+	}
+
+	// The proposal messages are not registered with the gov amino codec, so
+	// their sign bytes are embedded as they are into the amino JSON encoding
+	// of the remaining fields.
+	signBytes := make([]json.RawMessage, len(msgs))
+	for i, msg := range msgs {
+		signBytes[i] = msg.GetSignBytes()
+	}
+
+	withoutMsgs := m
+	withoutMsgs.Messages = nil
+
+	var doc struct {
+		Type  string                     `json:"type"`
+		Value map[string]json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(ModuleCdc.MustMarshalJSON(withoutMsgs), &doc); err != nil {
+		panic(fmt.Errorf("failed to decode MsgSubmitProposal sign bytes: %w", err))
+	}
+
+	msgsBz, err := json.Marshal(signBytes)
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal MsgSubmitProposal sign bytes: %w", err))
+	}
+	doc.Value["messages"] = msgsBz
+
+	bz, err := json.Marshal(doc)
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal MsgSubmitProposal sign bytes: %w", err))
+	}
+
 	return sdk.MustSortJSON(bz)
 }
 
@@ -138,8 +219,14 @@ func (m MsgSubmitProposal) String() string {
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (m MsgSubmitProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
-	var content Content
-	return unpacker.UnpackAny(m.Content, &content)
+	if m.Content != nil {
+		var content Content
+		if err := unpacker.UnpackAny(m.Content, &content); err != nil {
+			return err
+		}
+	}
+
+	return unpackMessagesInterfaces(m.Messages, unpacker)
 }
 
 // NewMsgDeposit creates a new MsgDeposit instance
@@ -224,3 +311,41 @@ func (msg MsgVote) GetSignBytes() []byte {
 func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Voter}
 }
+
+// NewMsgVoteWeighted creates a message to cast a vote, split between several
+// weighted options, on an active proposal
+func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) *MsgVoteWeighted {
+	return &MsgVoteWeighted{proposalID, voter, options}
+}
+
+// Route implements Msg
+func (msg MsgVoteWeighted) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgVoteWeighted) Type() string { return TypeMsgVoteWeighted }
+
+// ValidateBasic implements Msg
+func (msg MsgVoteWeighted) ValidateBasic() error {
+	if msg.Voter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Voter.String())
+	}
+
+	return msg.Options.ValidateBasic()
+}
+
+// String implements the Stringer interface
+func (msg MsgVoteWeighted) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
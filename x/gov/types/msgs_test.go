@@ -1,12 +1,15 @@
 package types
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 var (
@@ -118,6 +121,46 @@ func TestMsgVote(t *testing.T) {
 	}
 }
 
+func TestMsgVoteWeighted(t *testing.T) {
+	tests := []struct {
+		proposalID uint64
+		voterAddr  sdk.AccAddress
+		options    WeightedVoteOptions
+		expectPass bool
+	}{
+		{0, addrs[0], NewNonSplitVoteOption(OptionYes), true},
+		{0, sdk.AccAddress{}, NewNonSplitVoteOption(OptionYes), false},
+		{0, addrs[0], NewNonSplitVoteOption(OptionNoWithVeto), true},
+		{0, addrs[0], NewNonSplitVoteOption(VoteOption(0x13)), false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+			NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(4, 1)),
+		}, true},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(4, 1)),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+			NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(5, 1)),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDec(2)),
+			NewWeightedVoteOption(OptionNo, sdk.NewDec(-1)),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgVoteWeighted(tc.voterAddr, tc.proposalID, tc.options)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 // this tests that Amino JSON MsgSubmitProposal.GetSignBytes() still works with Content as Any using the ModuleCdc
 func TestMsgSubmitProposal_GetSignBytes(t *testing.T) {
 	msg, err := NewMsgSubmitProposal(NewTextProposal("test", "abcd"), sdk.NewCoins(), sdk.AccAddress{})
@@ -130,3 +173,67 @@ func TestMsgSubmitProposal_GetSignBytes(t *testing.T) {
 		`{"type":"cosmos-sdk/MsgSubmitProposal","value":{"content":{"type":"cosmos-sdk/TextProposal","value":{"description":"abcd","title":"test"}},"initial_deposit":[]}}`,
 		string(bz))
 }
+
+func TestMsgSubmitProposalMessages(t *testing.T) {
+	govAddr := authtypes.NewModuleAddress(ModuleName)
+	content := NewTextProposal("Test Proposal", "the purpose of this proposal is to test")
+
+	testCases := []struct {
+		name       string
+		msgs       []sdk.Msg
+		expectPass bool
+	}{
+		{"no messages", nil, true},
+		{"signed by the gov module account", []sdk.Msg{testdata.NewTestMsg(govAddr), testdata.NewTestMsg(govAddr)}, true},
+		{"signed by another account", []sdk.Msg{testdata.NewTestMsg(govAddr), testdata.NewTestMsg(addrs[0])}, false},
+		{"multiple signers", []sdk.Msg{testdata.NewTestMsg(govAddr, addrs[0])}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := NewMsgSubmitProposal(content, coinsPos, addrs[0], tc.msgs...)
+			require.NoError(t, err)
+
+			msgs, err := msg.GetMessages()
+			require.NoError(t, err)
+			require.Len(t, msgs, len(tc.msgs))
+
+			if tc.expectPass {
+				require.NoError(t, msg.ValidateBasic())
+			} else {
+				require.True(t, ErrInvalidProposalMsg.Is(msg.ValidateBasic()))
+			}
+		})
+	}
+}
+
+func TestMsgSubmitProposalWithoutContent(t *testing.T) {
+	govAddr := authtypes.NewModuleAddress(ModuleName)
+
+	msg, err := NewMsgSubmitProposal(nil, coinsPos, addrs[0], testdata.NewTestMsg(govAddr))
+	require.NoError(t, err)
+	require.Nil(t, msg.GetContent())
+	require.NoError(t, msg.ValidateBasic())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+
+	// the content is required without messages
+	msg, err = NewMsgSubmitProposal(nil, coinsPos, addrs[0])
+	require.NoError(t, err)
+	require.True(t, ErrInvalidProposalContent.Is(msg.ValidateBasic()))
+}
+
+func TestMsgSubmitProposal_GetSignBytesWithMessages(t *testing.T) {
+	govAddr := authtypes.NewModuleAddress(ModuleName)
+
+	msg, err := NewMsgSubmitProposal(NewTextProposal("test", "abcd"), sdk.NewCoins(), sdk.AccAddress{}, testdata.NewTestMsg(govAddr))
+	require.NoError(t, err)
+
+	var bz []byte
+	require.NotPanics(t, func() {
+		bz = msg.GetSignBytes()
+	})
+	require.Equal(t,
+		fmt.Sprintf(`{"type":"cosmos-sdk/MsgSubmitProposal","value":{"content":{"type":"cosmos-sdk/TextProposal","value":{"description":"abcd","title":"test"}},"initial_deposit":[],"messages":[["%s"]]}}`, govAddr),
+		string(bz))
+}
@@ -16,8 +16,10 @@ import (
 // DefaultStartingProposalID is 1
 const DefaultStartingProposalID uint64 = 1
 
-// NewProposal creates a new Proposal instance
-func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Time) (Proposal, error) {
+// NewProposal creates a new Proposal instance. The optional msgs are executed
+// after the content handler if the proposal passes. The content may be nil if
+// msgs is not empty.
+func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Time, msgs ...sdk.Msg) (Proposal, error) {
 	p := Proposal{
 		ProposalId:       id,
 		Status:           StatusDepositPeriod,
@@ -27,18 +29,26 @@ func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Tim
 		DepositEndTime:   depositEndTime,
 	}
 
-	msg, ok := content.(proto.Message)
-	if !ok {
-		return Proposal{}, fmt.Errorf("%T does not implement proto.Message", content)
+	if content != nil {
+		msg, ok := content.(proto.Message)
+		if !ok {
+			return Proposal{}, fmt.Errorf("%T does not implement proto.Message", content)
+		}
+
+		any, err := types.NewAnyWithValue(msg)
+		if err != nil {
+			return Proposal{}, err
+		}
+
+		p.Content = any
 	}
 
-	any, err := types.NewAnyWithValue(msg)
+	var err error
+	p.Messages, err = packMessages(msgs)
 	if err != nil {
 		return Proposal{}, err
 	}
 
-	p.Content = any
-
 	return p, nil
 }
 
@@ -48,8 +58,12 @@ func (p Proposal) String() string {
 	return string(out)
 }
 
-// GetContent returns the proposal Content
+// GetContent returns the proposal Content, or nil for a proposal which only
+// holds messages.
 func (p Proposal) GetContent() Content {
+	if p.Content == nil {
+		return nil
+	}
 	content, ok := p.Content.GetCachedValue().(Content)
 	if !ok {
 		return nil
@@ -57,6 +71,11 @@ func (p Proposal) GetContent() Content {
 	return content
 }
 
+// GetMessages returns the unpacked messages of the proposal.
+func (p Proposal) GetMessages() ([]sdk.Msg, error) {
+	return unpackMessages(p.Messages)
+}
+
 func (p Proposal) ProposalType() string {
 	content := p.GetContent()
 	if content == nil {
@@ -83,8 +102,14 @@ func (p Proposal) GetTitle() string {
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (p Proposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
-	var content Content
-	return unpacker.UnpackAny(p.Content, &content)
+	if p.Content != nil {
+		var content Content
+		if err := unpacker.UnpackAny(p.Content, &content); err != nil {
+			return err
+		}
+	}
+
+	return unpackMessagesInterfaces(p.Messages, unpacker)
 }
 
 // Proposals is an array of proposal
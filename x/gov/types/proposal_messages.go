@@ -0,0 +1,77 @@
+package types
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ValidateProposalMessages performs basic validation of the messages of a
+// proposal. Every message must pass its own basic validation and have the gov
+// module account as its single signer, as it is executed on behalf of the
+// module account when the proposal passes.
+func ValidateProposalMessages(msgs []sdk.Msg) error {
+	govAddr := authtypes.NewModuleAddress(ModuleName)
+
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(govAddr) {
+			return sdkerrors.Wrapf(
+				ErrInvalidProposalMsg, "message %d (%s) must be signed by the gov module account %s only",
+				i, proto.MessageName(msg), govAddr,
+			)
+		}
+
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidProposalMsg, "message %d (%s): %s", i, proto.MessageName(msg), err)
+		}
+	}
+
+	return nil
+}
+
+func packMessages(msgs []sdk.Msg) ([]*types.Any, error) {
+	if len(msgs) == 0 {
+		return nil, nil
+	}
+
+	anys := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := types.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		anys[i] = any
+	}
+
+	return anys, nil
+}
+
+func unpackMessages(anys []*types.Any) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(anys))
+	for i, any := range anys {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "message %s contains an unknown sdk.Msg", any.TypeUrl)
+		}
+
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+func unpackMessagesInterfaces(anys []*types.Any, unpacker types.AnyUnpacker) error {
+	for _, any := range anys {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
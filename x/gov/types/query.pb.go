@@ -894,68 +894,68 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/query.proto", fileDescriptor_e35c0d133e91c0a2) }
 
 var fileDescriptor_e35c0d133e91c0a2 = []byte{
-	// 975 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x41, 0x6f, 0x1b, 0x55,
-	0x10, 0xce, 0x73, 0x9c, 0x36, 0x9e, 0xa4, 0x01, 0x86, 0x02, 0x96, 0x29, 0x76, 0x58, 0xd1, 0xd6,
-	0xa4, 0xc4, 0x4b, 0x92, 0x02, 0x2a, 0x05, 0xd4, 0x58, 0xa8, 0x29, 0xaa, 0x04, 0xc5, 0xad, 0x40,
-	0xe2, 0x12, 0x6d, 0xe2, 0xd5, 0x62, 0xe1, 0xf8, 0x6d, 0xfd, 0x9e, 0x2d, 0xa2, 0x34, 0x42, 0xe2,
-	0x82, 0x10, 0x17, 0x50, 0x11, 0x37, 0xc4, 0xa1, 0x12, 0x3f, 0x05, 0xf5, 0x58, 0x09, 0x0e, 0x9c,
-	0x2a, 0x94, 0xf0, 0x2b, 0x10, 0x07, 0xb4, 0xb3, 0xf3, 0xd6, 0xbb, 0xc9, 0xda, 0xeb, 0xa4, 0x11,
-	0xa7, 0x38, 0xef, 0xcd, 0x37, 0xf3, 0xcd, 0x37, 0xf3, 0x66, 0x6c, 0x28, 0x6f, 0x4a, 0xb5, 0x25,
-	0x95, 0xed, 0xc9, 0xbe, 0xdd, 0x5f, 0xda, 0x70, 0xb5, 0xb3, 0x64, 0xdf, 0xed, 0xb9, 0xdd, 0xed,
-	0x9a, 0xdf, 0x95, 0x5a, 0x22, 0x86, 0xf7, 0x35, 0x4f, 0xf6, 0x6b, 0x7c, 0x5f, 0x5a, 0x60, 0xcc,
-	0x86, 0xa3, 0xdc, 0xd0, 0x38, 0x82, 0xfa, 0x8e, 0xd7, 0xea, 0x38, 0xba, 0x25, 0x3b, 0x21, 0xbe,
-	0x74, 0xd6, 0x93, 0x9e, 0xa4, 0x8f, 0x76, 0xf0, 0x89, 0x4f, 0xcf, 0x79, 0x52, 0x7a, 0x6d, 0xd7,
-	0x76, 0xfc, 0x96, 0xed, 0x74, 0x3a, 0x52, 0x13, 0x44, 0x99, 0xdb, 0x14, 0x4e, 0x41, 0x7c, 0xba,
-	0xb5, 0xde, 0x82, 0xb3, 0x1f, 0x07, 0x31, 0x6f, 0x75, 0xa5, 0x2f, 0x95, 0xd3, 0x6e, 0xb8, 0x77,
-	0x7b, 0xae, 0xd2, 0x58, 0x81, 0x19, 0x9f, 0x8f, 0xd6, 0x5b, 0xcd, 0xa2, 0x98, 0x17, 0xd5, 0x7c,
-	0x03, 0xcc, 0xd1, 0x07, 0x4d, 0xeb, 0x53, 0x78, 0xee, 0x00, 0x50, 0xf9, 0xb2, 0xa3, 0x5c, 0x7c,
-	0x0f, 0xa6, 0x8d, 0x19, 0xc1, 0x66, 0x96, 0xcf, 0xd5, 0x0e, 0xa7, 0x5d, 0x33, 0xb8, 0x7a, 0xfe,
-	0xe1, 0xe3, 0xca, 0x44, 0x23, 0xc2, 0x58, 0xbf, 0xe5, 0x0e, 0x78, 0x56, 0x86, 0xd3, 0x4d, 0x78,
-	0x2a, 0xe2, 0xa4, 0xb4, 0xa3, 0x7b, 0x8a, 0x02, 0xcc, 0x2d, 0x5b, 0xa3, 0x02, 0xdc, 0x26, 0xcb,
-	0xc6, 0x9c, 0x9f, 0xf8, 0x1f, 0xd7, 0x60, 0xaa, 0x2f, 0xb5, 0xdb, 0x2d, 0xe6, 0xe6, 0x45, 0x75,
-	0xb6, 0xbe, 0xf4, 0xcf, 0xe3, 0xca, 0xa2, 0xd7, 0xd2, 0x9f, 0xf7, 0x36, 0x6a, 0x9b, 0x72, 0xcb,
-	0x66, 0xd1, 0xc2, 0x3f, 0x8b, 0xaa, 0xf9, 0x85, 0xad, 0xb7, 0x7d, 0x57, 0xd5, 0x56, 0x37, 0x37,
-	0x57, 0x9b, 0xcd, 0xae, 0xab, 0x54, 0x23, 0xc4, 0xe3, 0x47, 0x50, 0x68, 0xba, 0xbe, 0x54, 0x2d,
-	0x2d, 0xbb, 0xc5, 0xc9, 0xe3, 0x3a, 0x1b, 0xf8, 0xc0, 0xeb, 0x00, 0x83, 0xc2, 0x17, 0xf3, 0x24,
-	0xe1, 0x05, 0x93, 0x61, 0xd0, 0x25, 0xb5, 0xb0, 0xa5, 0xa2, 0x44, 0x1d, 0xcf, 0x65, 0x89, 0x1a,
-	0x31, 0xa4, 0xf5, 0x40, 0xc0, 0xf3, 0x07, 0x85, 0xe4, 0x1a, 0x5d, 0x83, 0x82, 0x91, 0x23, 0xd0,
-	0x70, 0x72, 0xcc, 0x22, 0x0d, 0x40, 0xb8, 0x96, 0x20, 0x99, 0x23, 0x92, 0x17, 0x33, 0x49, 0x86,
-	0xe1, 0x13, 0x2c, 0xef, 0xc1, 0xd3, 0x44, 0xf2, 0x13, 0xa9, 0xdd, 0x71, 0x9b, 0xef, 0xc4, 0x8a,
-	0x67, 0xad, 0xc1, 0x33, 0xb1, 0xe8, 0xac, 0xce, 0x32, 0xe4, 0x83, 0x5b, 0xee, 0xde, 0x62, 0x9a,
-	0x30, 0x81, 0x3d, 0x8b, 0x42, 0xb6, 0xd6, 0xbd, 0x98, 0x23, 0x35, 0x76, 0x1e, 0xd7, 0x53, 0x54,
-	0x3c, 0x4e, 0xa9, 0xef, 0x0b, 0xc0, 0x78, 0x78, 0x4e, 0xe4, 0x72, 0x28, 0x93, 0x29, 0x71, 0x56,
-	0x26, 0xa1, 0xf1, 0xc9, 0x95, 0xf6, 0x0d, 0x26, 0x75, 0xcb, 0xe9, 0x3a, 0x5b, 0x09, 0x51, 0xe8,
-	0x60, 0x3d, 0x28, 0x0a, 0x89, 0x52, 0x08, 0x60, 0xc1, 0xd1, 0x9d, 0x6d, 0xdf, 0xb5, 0xfe, 0x15,
-	0xf0, 0x6c, 0x02, 0xc7, 0xd9, 0xdc, 0x84, 0x33, 0x7d, 0xa9, 0x5b, 0x1d, 0x6f, 0x3d, 0x34, 0xe6,
-	0xfa, 0xcc, 0x0f, 0xc9, 0xaa, 0xd5, 0xf1, 0x42, 0x07, 0x9c, 0xdd, 0x6c, 0x3f, 0x76, 0x86, 0x1f,
-	0xc2, 0x1c, 0xbf, 0x38, 0xe3, 0x2d, 0x4c, 0xf4, 0xe5, 0x34, 0x6f, 0xef, 0x87, 0x96, 0x09, 0x77,
-	0x67, 0x9a, 0xf1, 0x43, 0xbc, 0x01, 0xb3, 0xda, 0x69, 0xb7, 0xb7, 0x8d, 0xb7, 0x49, 0xf2, 0x56,
-	0x49, 0xf3, 0x76, 0x27, 0xb0, 0x4b, 0xf8, 0x9a, 0xd1, 0x83, 0x23, 0xeb, 0x1b, 0x93, 0x3e, 0x47,
-	0x1d, 0xbb, 0x99, 0x12, 0x83, 0x28, 0xf7, 0xe4, 0x83, 0xc8, 0xba, 0xcd, 0xbb, 0x21, 0x22, 0xc2,
-	0x85, 0xb8, 0x0a, 0xa7, 0xd9, 0x88, 0x4b, 0xf0, 0xe2, 0x08, 0xd1, 0x38, 0x45, 0x83, 0xb0, 0xbe,
-	0x4a, 0x3a, 0xfd, 0xff, 0xdf, 0xca, 0x2f, 0x82, 0xf7, 0xcb, 0x80, 0x01, 0xe7, 0xf5, 0x2e, 0x4c,
-	0x33, 0x4b, 0xf3, 0x62, 0xc6, 0x48, 0x2c, 0x82, 0x9c, 0xdc, 0xbb, 0x79, 0x1b, 0x5e, 0x20, 0x82,
-	0xd4, 0x28, 0x0d, 0x57, 0xf5, 0xda, 0xfa, 0x08, 0x6b, 0xb9, 0x78, 0x18, 0x1b, 0xd5, 0x6d, 0x8a,
-	0x1a, 0x8d, 0xab, 0x36, 0xbc, 0x39, 0x43, 0x9c, 0x99, 0x0a, 0x84, 0x59, 0xfe, 0xa3, 0x00, 0x53,
-	0xe4, 0x19, 0x7f, 0x14, 0x30, 0x6d, 0x16, 0x03, 0x56, 0xd3, 0x9c, 0xa4, 0x7d, 0xa3, 0x28, 0xbd,
-	0x3a, 0x86, 0x65, 0x48, 0xd4, 0x5a, 0xf9, 0xfa, 0xf7, 0xbf, 0xef, 0xe7, 0x16, 0xf1, 0x92, 0x9d,
-	0xf2, 0xdd, 0x25, 0xda, 0x41, 0xf6, 0x4e, 0x4c, 0x8a, 0x5d, 0xfc, 0x56, 0x40, 0x21, 0xda, 0x74,
-	0x98, 0x1d, 0xcd, 0x74, 0x5e, 0x69, 0x61, 0x1c, 0x53, 0x66, 0x76, 0x9e, 0x98, 0x55, 0xf0, 0xa5,
-	0x91, 0xcc, 0xf0, 0x27, 0x01, 0xf9, 0x60, 0xb0, 0xe2, 0x2b, 0x43, 0x7d, 0xc7, 0xf6, 0x5d, 0xe9,
-	0x7c, 0x86, 0x15, 0x07, 0x5f, 0xa5, 0xe0, 0x57, 0xf1, 0xca, 0x11, 0x64, 0xb1, 0x69, 0xa6, 0xdb,
-	0x3b, 0xb4, 0xee, 0x76, 0xf1, 0x07, 0x01, 0x53, 0xb4, 0x23, 0x70, 0x74, 0xcc, 0x48, 0x9c, 0x0b,
-	0x59, 0x66, 0xcc, 0xed, 0x0a, 0x71, 0x5b, 0xc1, 0xa5, 0x23, 0x73, 0xc3, 0xef, 0x04, 0x9c, 0xe2,
-	0x29, 0x3a, 0x3c, 0x5a, 0x62, 0x87, 0x94, 0x2e, 0x66, 0xda, 0x31, 0xad, 0xd7, 0x89, 0xd6, 0x02,
-	0x56, 0x53, 0x69, 0x91, 0xad, 0xbd, 0x13, 0x5b, 0x47, 0xbb, 0xf8, 0xab, 0x80, 0xd3, 0xfc, 0xc2,
-	0x71, 0x78, 0x98, 0xe4, 0x6c, 0x2e, 0x55, 0xb3, 0x0d, 0x99, 0xd0, 0x0d, 0x22, 0x54, 0xc7, 0x6b,
-	0x47, 0xd1, 0xc9, 0x8c, 0x18, 0x7b, 0x27, 0x1a, 0xce, 0xbb, 0xf8, 0xb3, 0x80, 0x69, 0x33, 0xc2,
-	0x30, 0x93, 0x80, 0xca, 0x7e, 0x86, 0x07, 0xe7, 0xa1, 0xf5, 0x0e, 0x71, 0x7d, 0x13, 0x2f, 0x1f,
-	0x87, 0x2b, 0x3e, 0x10, 0x30, 0x13, 0x9b, 0x26, 0x78, 0x69, 0x68, 0xe0, 0xc3, 0x73, 0xae, 0xf4,
-	0xda, 0x78, 0xc6, 0x4f, 0xd2, 0x7c, 0x34, 0xd6, 0xea, 0xf5, 0x87, 0x7b, 0x65, 0xf1, 0x68, 0xaf,
-	0x2c, 0xfe, 0xda, 0x2b, 0x8b, 0xef, 0xf7, 0xcb, 0x13, 0x8f, 0xf6, 0xcb, 0x13, 0x7f, 0xee, 0x97,
-	0x27, 0x3e, 0xab, 0x8e, 0xdc, 0x9b, 0x5f, 0x52, 0x0c, 0xda, 0x9e, 0x1b, 0xa7, 0xe8, 0xa7, 0xd4,
-	0xca, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x87, 0x8d, 0x76, 0xb6, 0xfe, 0x0d, 0x00, 0x00,
+	// 971 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x38, 0x4e, 0x1b, 0xbf, 0xa4, 0x01, 0x1e, 0x29, 0x58, 0xa6, 0xd8, 0x61, 0x45, 0x5b,
+	0x93, 0x92, 0x5d, 0x92, 0x14, 0x50, 0x29, 0xa0, 0xc6, 0x42, 0x4d, 0x51, 0x25, 0x28, 0xdb, 0x0a,
+	0x24, 0x2e, 0xd1, 0x26, 0x5e, 0x2d, 0x2b, 0x1c, 0xcf, 0x76, 0x67, 0x6c, 0x11, 0xa5, 0x11, 0x12,
+	0x17, 0x84, 0xb8, 0x80, 0x8a, 0xb8, 0x21, 0x0e, 0x95, 0xf8, 0x53, 0x50, 0x8f, 0x95, 0xe0, 0xc0,
+	0xa9, 0x42, 0x09, 0x7f, 0x05, 0xe2, 0x80, 0x76, 0x7e, 0x6c, 0x76, 0x9d, 0xb5, 0x77, 0x9d, 0x46,
+	0x3d, 0xd9, 0x99, 0xf9, 0xde, 0xf7, 0xbe, 0xf7, 0xbd, 0x99, 0x37, 0x0e, 0xd4, 0xb7, 0x28, 0xdb,
+	0xa6, 0xcc, 0xf2, 0x68, 0xdf, 0xea, 0x2f, 0x6f, 0xba, 0xdc, 0x59, 0xb6, 0xee, 0xf6, 0xdc, 0x70,
+	0xc7, 0x0c, 0x42, 0xca, 0x29, 0xa2, 0xdc, 0x37, 0x3d, 0xda, 0x37, 0xd5, 0x7e, 0x6d, 0x51, 0xc5,
+	0x6c, 0x3a, 0xcc, 0x95, 0xe0, 0x38, 0x34, 0x70, 0x3c, 0xbf, 0xeb, 0x70, 0x9f, 0x76, 0x65, 0x7c,
+	0x6d, 0xde, 0xa3, 0x1e, 0x15, 0x5f, 0xad, 0xe8, 0x9b, 0x5a, 0x3d, 0xe7, 0x51, 0xea, 0x75, 0x5c,
+	0xcb, 0x09, 0x7c, 0xcb, 0xe9, 0x76, 0x29, 0x17, 0x21, 0x4c, 0xef, 0x66, 0x68, 0x8a, 0xf2, 0x8b,
+	0x5d, 0xe3, 0x6d, 0x98, 0xff, 0x24, 0xca, 0x79, 0x2b, 0xa4, 0x01, 0x65, 0x4e, 0xc7, 0x76, 0xef,
+	0xf6, 0x5c, 0xc6, 0xb1, 0x01, 0x33, 0x81, 0x5a, 0xda, 0xf0, 0xdb, 0x55, 0xb2, 0x40, 0x9a, 0x65,
+	0x1b, 0xf4, 0xd2, 0x87, 0x6d, 0xe3, 0x33, 0x38, 0x3b, 0x10, 0xc8, 0x02, 0xda, 0x65, 0x2e, 0xbe,
+	0x0f, 0xd3, 0x1a, 0x26, 0xc2, 0x66, 0x56, 0xce, 0x99, 0x47, 0xcb, 0x36, 0x75, 0x5c, 0xab, 0xfc,
+	0xf0, 0x71, 0x63, 0xc2, 0x8e, 0x63, 0x8c, 0xdf, 0x4b, 0x03, 0xcc, 0x4c, 0x6b, 0xba, 0x09, 0xcf,
+	0xc4, 0x9a, 0x18, 0x77, 0x78, 0x8f, 0x89, 0x04, 0x73, 0x2b, 0xc6, 0xa8, 0x04, 0xb7, 0x05, 0xd2,
+	0x9e, 0x0b, 0x52, 0x7f, 0xe3, 0x3a, 0x4c, 0xf5, 0x29, 0x77, 0xc3, 0x6a, 0x69, 0x81, 0x34, 0x67,
+	0x5b, 0xcb, 0xff, 0x3e, 0x6e, 0x2c, 0x79, 0x3e, 0xff, 0xa2, 0xb7, 0x69, 0x6e, 0xd1, 0x6d, 0x4b,
+	0x99, 0x26, 0x3f, 0x96, 0x58, 0xfb, 0x4b, 0x8b, 0xef, 0x04, 0x2e, 0x33, 0xd7, 0xb6, 0xb6, 0xd6,
+	0xda, 0xed, 0xd0, 0x65, 0xcc, 0x96, 0xf1, 0xf8, 0x31, 0x54, 0xda, 0x6e, 0x40, 0x99, 0xcf, 0x69,
+	0x58, 0x9d, 0x3c, 0x2e, 0xd9, 0x21, 0x07, 0x5e, 0x07, 0x38, 0x6c, 0x7c, 0xb5, 0x2c, 0x2c, 0xbc,
+	0xa0, 0x2b, 0x8c, 0x4e, 0x89, 0x29, 0x8f, 0x54, 0x5c, 0xa8, 0xe3, 0xb9, 0xca, 0x22, 0x3b, 0x11,
+	0x69, 0x3c, 0x20, 0xf0, 0xc2, 0xa0, 0x91, 0xaa, 0x47, 0xd7, 0xa0, 0xa2, 0xed, 0x88, 0x3c, 0x9c,
+	0x2c, 0xd8, 0xa4, 0xc3, 0x20, 0x5c, 0x4f, 0x89, 0x2c, 0x09, 0x91, 0x17, 0x73, 0x45, 0xca, 0xf4,
+	0x29, 0x95, 0xf7, 0xe0, 0x59, 0x21, 0xf2, 0x53, 0xca, 0xdd, 0xa2, 0x87, 0xef, 0xc4, 0x9a, 0x67,
+	0xac, 0xc3, 0x73, 0x89, 0xec, 0xca, 0x9d, 0x15, 0x28, 0x47, 0xbb, 0xea, 0xf4, 0x56, 0xb3, 0x8c,
+	0x89, 0xf0, 0xca, 0x14, 0x81, 0x35, 0xee, 0x25, 0x88, 0x58, 0xe1, 0x3a, 0xae, 0x67, 0xb8, 0x78,
+	0x9c, 0x56, 0xdf, 0x27, 0x80, 0xc9, 0xf4, 0xaa, 0x90, 0xcb, 0xd2, 0x26, 0xdd, 0xe2, 0xbc, 0x4a,
+	0x24, 0xf8, 0xe4, 0x5a, 0xfb, 0xa6, 0x12, 0x75, 0xcb, 0x09, 0x9d, 0xed, 0x94, 0x29, 0x62, 0x61,
+	0x23, 0x6a, 0x8a, 0x30, 0xa5, 0x62, 0x83, 0x5c, 0xba, 0xb3, 0x13, 0xb8, 0xc6, 0x7f, 0x04, 0x9e,
+	0x4f, 0xc5, 0xa9, 0x6a, 0x6e, 0xc2, 0x99, 0x3e, 0xe5, 0x7e, 0xd7, 0xdb, 0x90, 0x60, 0xd5, 0x9f,
+	0x85, 0x21, 0x55, 0xf9, 0x5d, 0x4f, 0x12, 0xa8, 0xea, 0x66, 0xfb, 0x89, 0x35, 0xfc, 0x08, 0xe6,
+	0xd4, 0x8d, 0xd3, 0x6c, 0xb2, 0xd0, 0x57, 0xb2, 0xd8, 0x3e, 0x90, 0xc8, 0x14, 0xdd, 0x99, 0x76,
+	0x72, 0x11, 0x6f, 0xc0, 0x2c, 0x77, 0x3a, 0x9d, 0x1d, 0xcd, 0x36, 0x29, 0xd8, 0x1a, 0x59, 0x6c,
+	0x77, 0x22, 0x5c, 0x8a, 0x6b, 0x86, 0x1f, 0x2e, 0x19, 0xdf, 0xea, 0xf2, 0x55, 0xd6, 0xc2, 0x87,
+	0x29, 0x35, 0x88, 0x4a, 0x4f, 0x3e, 0x88, 0x8c, 0xdb, 0x30, 0x9f, 0x16, 0xa2, 0x1a, 0x71, 0x15,
+	0x4e, 0x2b, 0x90, 0x6a, 0xc1, 0x4b, 0x23, 0x4c, 0x53, 0x25, 0xea, 0x08, 0xe3, 0xeb, 0x34, 0xe9,
+	0xd3, 0xbf, 0x2b, 0xbf, 0x12, 0x38, 0x3b, 0xa0, 0x40, 0xd5, 0xf5, 0x1e, 0x4c, 0x2b, 0x95, 0xfa,
+	0xc6, 0x14, 0x28, 0x2c, 0x0e, 0x39, 0xb9, 0x7b, 0xf3, 0x0e, 0xbc, 0x28, 0x04, 0x8a, 0x83, 0x62,
+	0xbb, 0xac, 0xd7, 0xe1, 0x63, 0x3c, 0xcb, 0xd5, 0xa3, 0xb1, 0x71, 0xdf, 0xa6, 0xc4, 0x41, 0xab,
+	0x92, 0x9c, 0xc3, 0x29, 0xe3, 0xf4, 0x54, 0x10, 0x31, 0x2b, 0x7f, 0x56, 0x60, 0x4a, 0x30, 0xe3,
+	0x4f, 0x04, 0xa6, 0xf5, 0xc3, 0x80, 0xcd, 0x2c, 0x92, 0xac, 0x5f, 0x14, 0xb5, 0xd7, 0x0a, 0x20,
+	0xa5, 0x50, 0x63, 0xf5, 0x9b, 0x3f, 0xfe, 0xb9, 0x5f, 0x5a, 0xc2, 0x4b, 0x56, 0xc6, 0x6f, 0x17,
+	0x5d, 0x2c, 0xb3, 0x76, 0x13, 0x56, 0xec, 0xe1, 0x77, 0x04, 0x2a, 0x9a, 0x89, 0x61, 0x7e, 0x36,
+	0x7d, 0xf2, 0x6a, 0x8b, 0x45, 0xa0, 0x4a, 0xd9, 0x79, 0xa1, 0xac, 0x81, 0x2f, 0x8f, 0x54, 0x86,
+	0x3f, 0x13, 0x28, 0x47, 0x83, 0x15, 0x5f, 0x1d, 0xca, 0x9d, 0x78, 0xef, 0x6a, 0xe7, 0x73, 0x50,
+	0x2a, 0xf9, 0x9a, 0x48, 0x7e, 0x15, 0xaf, 0x8c, 0x61, 0x8b, 0x25, 0x66, 0xba, 0xb5, 0x1b, 0x7d,
+	0x84, 0x7b, 0xf8, 0x23, 0x81, 0xa9, 0x88, 0x93, 0xe1, 0xe8, 0x9c, 0xb1, 0x39, 0x17, 0xf2, 0x60,
+	0x4a, 0xdb, 0x15, 0xa1, 0x6d, 0x15, 0x97, 0xc7, 0xd6, 0x86, 0xdf, 0x13, 0x38, 0xa5, 0xa6, 0xe8,
+	0xf0, 0x6c, 0xa9, 0x37, 0xa4, 0x76, 0x31, 0x17, 0xa7, 0x64, 0xbd, 0x21, 0x64, 0x2d, 0x62, 0x33,
+	0x53, 0x96, 0xc0, 0x5a, 0xbb, 0x89, 0xe7, 0x68, 0x0f, 0x7f, 0x23, 0x70, 0x5a, 0xdd, 0x70, 0x1c,
+	0x9e, 0x26, 0x3d, 0x9b, 0x6b, 0xcd, 0x7c, 0xa0, 0x12, 0x74, 0x43, 0x08, 0x6a, 0xe1, 0xb5, 0x71,
+	0x7c, 0xd2, 0x23, 0xc6, 0xda, 0x8d, 0x87, 0xf3, 0x1e, 0xfe, 0x42, 0x60, 0x5a, 0xb1, 0x33, 0xcc,
+	0x15, 0xc0, 0xf2, 0xaf, 0xe1, 0xe0, 0x3c, 0x34, 0xde, 0x15, 0x5a, 0xdf, 0xc2, 0xcb, 0xc7, 0xd1,
+	0x8a, 0x0f, 0x08, 0xcc, 0x24, 0xa6, 0x09, 0x5e, 0x1a, 0x9a, 0xf8, 0xe8, 0x9c, 0xab, 0xbd, 0x5e,
+	0x0c, 0xfc, 0x24, 0x87, 0x4f, 0x8c, 0xb5, 0x56, 0xeb, 0xe1, 0x7e, 0x9d, 0x3c, 0xda, 0xaf, 0x93,
+	0xbf, 0xf7, 0xeb, 0xe4, 0x87, 0x83, 0xfa, 0xc4, 0xa3, 0x83, 0xfa, 0xc4, 0x5f, 0x07, 0xf5, 0x89,
+	0xcf, 0x9b, 0x23, 0xdf, 0xcd, 0xaf, 0x44, 0x0e, 0xf1, 0x7a, 0x6e, 0x9e, 0x12, 0xff, 0x4a, 0xad,
+	0xfe, 0x3f, 0x00, 0x87, 0x8d, 0x76, 0xb6, 0xfe, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
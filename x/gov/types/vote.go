@@ -2,15 +2,23 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	yaml "gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// NewVote creates a new Vote instance
-func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter, option}
+// NewVote creates a new Vote instance. The deprecated Option field is set for
+// non-split votes, so that clients unaware of weighted votes can display them.
+func NewVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	vote := Vote{ProposalId: proposalID, Voter: voter, Options: options}
+	if len(options) == 1 && options[0].Weight.Equal(sdk.OneDec()) {
+		vote.Option = options[0].Option
+	}
+
+	return vote
 }
 
 func (v Vote) String() string {
@@ -42,7 +50,7 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalId)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Options)
 	}
 	return out
 }
@@ -52,6 +60,99 @@ func (v Vote) Empty() bool {
 	return v.Equal(Vote{})
 }
 
+// NewWeightedVoteOption creates a new WeightedVoteOption instance
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{Option: option, Weight: weight}
+}
+
+// NewNonSplitVoteOption creates the weighted options of a vote which puts all
+// its voting power on a single option.
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{NewWeightedVoteOption(option, sdk.OneDec())}
+}
+
+func (w WeightedVoteOption) String() string {
+	out, _ := yaml.Marshal(w)
+	return string(out)
+}
+
+// WeightedVoteOptions describes the options of a vote and their weights
+type WeightedVoteOptions []WeightedVoteOption
+
+// String implements the Stringer interface, in the format parsed by
+// WeightedVoteOptionsFromString.
+func (v WeightedVoteOptions) String() string {
+	parts := make([]string, len(v))
+	for i, option := range v {
+		parts[i] = fmt.Sprintf("%s=%s", option.Option, option.Weight)
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// ValidateBasic checks that every option is valid and appears once, and that
+// the weights sum to 1.
+func (v WeightedVoteOptions) ValidateBasic() error {
+	if len(v) == 0 {
+		return sdkerrors.Wrap(ErrInvalidVote, "missing vote options")
+	}
+
+	seen := make(map[VoteOption]bool, len(v))
+	totalWeight := sdk.ZeroDec()
+
+	for _, option := range v {
+		if !ValidWeightedVoteOption(option) {
+			return sdkerrors.Wrap(ErrInvalidVote, option.String())
+		}
+
+		if seen[option.Option] {
+			return sdkerrors.Wrapf(ErrInvalidVote, "duplicated vote option %s", option.Option)
+		}
+		seen[option.Option] = true
+
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidVote, "total weight of vote options must be 1, got %s", totalWeight)
+	}
+
+	return nil
+}
+
+// WeightedVoteOptionsFromString returns weighted vote options from a string
+// of comma-separated option=weight pairs, e.g.
+// "VOTE_OPTION_YES=0.6,VOTE_OPTION_NO=0.4". A single option without weight is
+// given a weight of 1. It returns an error if the string is invalid.
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	var options WeightedVoteOptions
+
+	for _, part := range strings.Split(str, ",") {
+		fields := strings.Split(strings.TrimSpace(part), "=")
+
+		option, err := VoteOptionFromString(fields[0])
+		if err != nil {
+			return nil, err
+		}
+
+		weight := sdk.OneDec()
+		switch len(fields) {
+		case 1:
+		case 2:
+			weight, err = sdk.NewDecFromStr(fields[1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid weight of option %s: %w", fields[0], err)
+			}
+		default:
+			return nil, fmt.Errorf("'%s' is not a valid weighted vote option", part)
+		}
+
+		options = append(options, NewWeightedVoteOption(option, weight))
+	}
+
+	return options, nil
+}
+
 // VoteOptionFromString returns a VoteOption from a string. It returns an error
 // if the string is invalid.
 func VoteOptionFromString(str string) (VoteOption, error) {
@@ -73,6 +174,16 @@ func ValidVoteOption(option VoteOption) bool {
 	return false
 }
 
+// ValidWeightedVoteOption returns true if the option is valid and its weight
+// is in (0, 1].
+func ValidWeightedVoteOption(option WeightedVoteOption) bool {
+	if option.Weight.IsNil() || !option.Weight.IsPositive() || option.Weight.GT(sdk.OneDec()) {
+		return false
+	}
+
+	return ValidVoteOption(option.Option)
+}
+
 // Marshal needed for protobuf compatibility.
 func (vo VoteOption) Marshal() ([]byte, error) {
 	return []byte{byte(vo)}, nil
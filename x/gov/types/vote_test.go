@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestWeightedVoteOptionsFromString(t *testing.T) {
+	options, err := WeightedVoteOptionsFromString("VOTE_OPTION_YES=0.6,VOTE_OPTION_NO=0.4")
+	require.NoError(t, err)
+	require.Equal(t, WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+		NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(4, 1)),
+	}, options)
+	require.NoError(t, options.ValidateBasic())
+
+	options, err = WeightedVoteOptionsFromString("VOTE_OPTION_ABSTAIN")
+	require.NoError(t, err)
+	require.Equal(t, NewNonSplitVoteOption(OptionAbstain), options)
+
+	_, err = WeightedVoteOptionsFromString("VOTE_OPTION_MAYBE=1")
+	require.Error(t, err)
+
+	_, err = WeightedVoteOptionsFromString("VOTE_OPTION_YES=a")
+	require.Error(t, err)
+
+	_, err = WeightedVoteOptionsFromString("VOTE_OPTION_YES=0.5=0.5")
+	require.Error(t, err)
+}
+
+func TestNewVote(t *testing.T) {
+	voter := sdk.AccAddress("voter")
+
+	vote := NewVote(1, voter, NewNonSplitVoteOption(OptionYes))
+	require.Equal(t, OptionYes, vote.Option)
+
+	vote = NewVote(1, voter, WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+		NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(4, 1)),
+	})
+	require.Equal(t, OptionEmpty, vote.Option)
+	require.Len(t, vote.Options, 2)
+}
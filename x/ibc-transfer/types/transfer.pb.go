@@ -4,11 +4,16 @@
 package types
 
 import (
+	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -129,6 +134,43 @@ func (m *MsgTransfer) GetTimeoutTimestamp() uint64 {
 	return 0
 }
 
+// MsgTransferResponse defines the Msg/Transfer response type.
+type MsgTransferResponse struct {
+}
+
+func (m *MsgTransferResponse) Reset()         { *m = MsgTransferResponse{} }
+func (m *MsgTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferResponse) ProtoMessage()    {}
+func (*MsgTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08134a70fd29e656, []int{1}
+}
+func (m *MsgTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferResponse.Merge(m, src)
+}
+func (m *MsgTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferResponse proto.InternalMessageInfo
+
 // FungibleTokenPacketData defines a struct for the packet payload
 // See FungibleTokenPacketData spec:
 // https://github.com/cosmos/ics/tree/master/spec/ics-020-fungible-token-transfer#data-structures
@@ -147,7 +189,7 @@ func (m *FungibleTokenPacketData) Reset()         { *m = FungibleTokenPacketData
 func (m *FungibleTokenPacketData) String() string { return proto.CompactTextString(m) }
 func (*FungibleTokenPacketData) ProtoMessage()    {}
 func (*FungibleTokenPacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_08134a70fd29e656, []int{1}
+	return fileDescriptor_08134a70fd29e656, []int{2}
 }
 func (m *FungibleTokenPacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -217,7 +259,7 @@ func (m *FungibleTokenPacketAcknowledgement) Reset()         { *m = FungibleToke
 func (m *FungibleTokenPacketAcknowledgement) String() string { return proto.CompactTextString(m) }
 func (*FungibleTokenPacketAcknowledgement) ProtoMessage()    {}
 func (*FungibleTokenPacketAcknowledgement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_08134a70fd29e656, []int{2}
+	return fileDescriptor_08134a70fd29e656, []int{3}
 }
 func (m *FungibleTokenPacketAcknowledgement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -262,6 +304,7 @@ func (m *FungibleTokenPacketAcknowledgement) GetError() string {
 
 func init() {
 	proto.RegisterType((*MsgTransfer)(nil), "ibc.transfer.MsgTransfer")
+	proto.RegisterType((*MsgTransferResponse)(nil), "ibc.transfer.MsgTransferResponse")
 	proto.RegisterType((*FungibleTokenPacketData)(nil), "ibc.transfer.FungibleTokenPacketData")
 	proto.RegisterType((*FungibleTokenPacketAcknowledgement)(nil), "ibc.transfer.FungibleTokenPacketAcknowledgement")
 }
@@ -269,39 +312,123 @@ func init() {
 func init() { proto.RegisterFile("ibc/transfer/transfer.proto", fileDescriptor_08134a70fd29e656) }
 
 var fileDescriptor_08134a70fd29e656 = []byte{
-	// 505 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x4f, 0x6f, 0xd3, 0x30,
-	0x1c, 0x6d, 0xe8, 0x9f, 0x0d, 0x77, 0x43, 0x60, 0x8d, 0x91, 0x15, 0x94, 0x56, 0x39, 0xf5, 0xd2,
-	0x44, 0x05, 0x21, 0x24, 0x4e, 0xb4, 0x43, 0x88, 0x09, 0x21, 0x4d, 0x56, 0x4f, 0x5c, 0xa6, 0xc4,
-	0xf9, 0x91, 0x5a, 0x6d, 0xec, 0xca, 0x76, 0x06, 0x13, 0x5f, 0x82, 0xef, 0xc2, 0x97, 0xd8, 0x71,
-	0x47, 0x4e, 0x11, 0x6a, 0xbf, 0x41, 0x8f, 0x9c, 0x50, 0x62, 0xb7, 0xac, 0xd2, 0xb4, 0x93, 0xfd,
-	0xde, 0xfb, 0xf9, 0x97, 0xe7, 0xf7, 0x73, 0xd0, 0x73, 0x16, 0xd3, 0x50, 0xcb, 0x88, 0xab, 0xaf,
-	0x20, 0xb7, 0x9b, 0x60, 0x21, 0x85, 0x16, 0xf8, 0x80, 0xc5, 0x34, 0xd8, 0x70, 0x9d, 0xa3, 0x54,
-	0xa4, 0xa2, 0x12, 0xc2, 0x72, 0x67, 0x6a, 0x3a, 0x1e, 0x15, 0x2a, 0x13, 0x2a, 0x8c, 0x23, 0x05,
-	0xe1, 0xe5, 0x30, 0x06, 0x1d, 0x0d, 0x43, 0x2a, 0x18, 0x37, 0xba, 0xff, 0xab, 0x8e, 0xda, 0x9f,
-	0x55, 0x3a, 0xb1, 0x5d, 0xf0, 0x1b, 0xd4, 0x56, 0x22, 0x97, 0x14, 0x2e, 0x16, 0x42, 0x6a, 0xd7,
-	0xe9, 0x39, 0xfd, 0x87, 0xe3, 0xe3, 0x75, 0xd1, 0xc5, 0x57, 0x51, 0x36, 0x7f, 0xeb, 0xdf, 0x12,
-	0x7d, 0x82, 0x0c, 0x3a, 0x17, 0x52, 0xe3, 0x77, 0xe8, 0x91, 0xd5, 0xe8, 0x34, 0xe2, 0x1c, 0xe6,
-	0xee, 0x83, 0xea, 0xec, 0xc9, 0xba, 0xe8, 0x3e, 0xdd, 0x39, 0x6b, 0x75, 0x9f, 0x1c, 0x1a, 0xe2,
-	0xd4, 0x60, 0xfc, 0x1a, 0x35, 0xb5, 0x98, 0x01, 0x77, 0xeb, 0x3d, 0xa7, 0xdf, 0x7e, 0x79, 0x12,
-	0x18, 0xeb, 0x41, 0x69, 0x3d, 0xb0, 0xd6, 0x83, 0x53, 0xc1, 0xf8, 0xb8, 0x71, 0x5d, 0x74, 0x6b,
-	0xc4, 0x54, 0xe3, 0x33, 0xd4, 0x52, 0xc0, 0x13, 0x90, 0x6e, 0xa3, 0xe7, 0xf4, 0x0f, 0xc6, 0xc3,
-	0xbf, 0x45, 0x77, 0x90, 0x32, 0x3d, 0xcd, 0xe3, 0x80, 0x8a, 0x2c, 0xb4, 0x01, 0x98, 0x65, 0xa0,
-	0x92, 0x59, 0xa8, 0xaf, 0x16, 0xa0, 0x82, 0x11, 0xa5, 0xa3, 0x24, 0x91, 0xa0, 0x14, 0xb1, 0x0d,
-	0x70, 0x07, 0xed, 0x4b, 0xa0, 0xc0, 0x2e, 0x41, 0xba, 0xcd, 0xd2, 0x3d, 0xd9, 0xe2, 0xf2, 0x7e,
-	0x9a, 0x65, 0x20, 0x72, 0x7d, 0x31, 0x05, 0x96, 0x4e, 0xb5, 0xdb, 0xea, 0x39, 0xfd, 0xc6, 0xed,
-	0xfb, 0xed, 0xea, 0x3e, 0x39, 0xb4, 0xc4, 0xc7, 0x0a, 0xe3, 0x33, 0xf4, 0x64, 0x53, 0x51, 0xae,
-	0x4a, 0x47, 0xd9, 0xc2, 0xdd, 0xab, 0x9a, 0xbc, 0x58, 0x17, 0x5d, 0x77, 0xb7, 0xc9, 0xb6, 0xc4,
-	0x27, 0x8f, 0x2d, 0x37, 0xd9, 0x52, 0x3f, 0xd0, 0xb3, 0x0f, 0x39, 0x4f, 0x59, 0x3c, 0x87, 0x49,
-	0x19, 0xc2, 0x79, 0x44, 0x67, 0xa0, 0xdf, 0x47, 0x3a, 0xc2, 0x47, 0xa8, 0x99, 0x00, 0x17, 0x99,
-	0x19, 0x1d, 0x31, 0x00, 0x1f, 0xa3, 0x56, 0x94, 0x89, 0x9c, 0xeb, 0x6a, 0x2a, 0x0d, 0x62, 0x51,
-	0xc9, 0xdb, 0xf0, 0xea, 0x55, 0xf9, 0x5d, 0x49, 0x34, 0x76, 0x93, 0xf0, 0x27, 0xc8, 0xbf, 0xe3,
-	0xe3, 0x23, 0x3a, 0xe3, 0xe2, 0xdb, 0x1c, 0x92, 0x14, 0x32, 0xe0, 0x1a, 0xbb, 0x68, 0x4f, 0xe5,
-	0x94, 0x82, 0x52, 0x95, 0x93, 0x7d, 0xb2, 0x81, 0xa5, 0x43, 0x90, 0x52, 0x48, 0xf3, 0x40, 0x88,
-	0x01, 0xe3, 0x4f, 0xd7, 0x4b, 0xcf, 0xb9, 0x59, 0x7a, 0xce, 0x9f, 0xa5, 0xe7, 0xfc, 0x5c, 0x79,
-	0xb5, 0x9b, 0x95, 0x57, 0xfb, 0xbd, 0xf2, 0x6a, 0x5f, 0x86, 0xf7, 0x0e, 0xf3, 0x7b, 0xc8, 0x62,
-	0x3a, 0xf8, 0xff, 0x8b, 0x94, 0xb3, 0x8d, 0x5b, 0xd5, 0xe3, 0x7e, 0xf5, 0x2f, 0x00, 0x00, 0xff,
-	0xff, 0x0d, 0x50, 0xec, 0xf7, 0x3f, 0x03, 0x00, 0x00,
+	// 541 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x18, 0x6e, 0x7e, 0xfd, 0xb3, 0xfe, 0xdc, 0x0d, 0x81, 0xd9, 0x46, 0x5a, 0x50, 0x5a, 0x72, 0xea,
+	0xa5, 0x89, 0x3a, 0x84, 0x90, 0x38, 0xd1, 0x0e, 0xa1, 0x4d, 0x68, 0x62, 0xb2, 0x7a, 0xe2, 0x32,
+	0x25, 0xce, 0x4b, 0x1a, 0xb5, 0xb1, 0x2b, 0xdb, 0x19, 0x4c, 0x7c, 0x09, 0xbe, 0x0b, 0x5f, 0x62,
+	0xc7, 0x1d, 0x39, 0x55, 0xa8, 0xfd, 0x06, 0x3d, 0x72, 0x42, 0x89, 0xdd, 0xd2, 0x4a, 0x83, 0x53,
+	0xfc, 0x3c, 0xcf, 0xeb, 0x37, 0x8f, 0x1f, 0xbf, 0x46, 0x4f, 0x93, 0x90, 0xfa, 0x4a, 0x04, 0x4c,
+	0x7e, 0x02, 0xb1, 0x59, 0x78, 0x33, 0xc1, 0x15, 0xc7, 0xfb, 0x49, 0x48, 0xbd, 0x35, 0xd7, 0x3a,
+	0x8c, 0x79, 0xcc, 0x0b, 0xc1, 0xcf, 0x57, 0xba, 0xa6, 0xe5, 0x50, 0x2e, 0x53, 0x2e, 0xfd, 0x30,
+	0x90, 0xe0, 0x5f, 0xf7, 0x43, 0x50, 0x41, 0xdf, 0xa7, 0x3c, 0x61, 0x5a, 0x77, 0xbf, 0x97, 0x51,
+	0xe3, 0x42, 0xc6, 0x23, 0xd3, 0x05, 0xbf, 0x42, 0x0d, 0xc9, 0x33, 0x41, 0xe1, 0x6a, 0xc6, 0x85,
+	0xb2, 0xad, 0x8e, 0xd5, 0xfd, 0x7f, 0x78, 0xbc, 0x9a, 0xb7, 0xf1, 0x4d, 0x90, 0x4e, 0x5f, 0xbb,
+	0x5b, 0xa2, 0x4b, 0x90, 0x46, 0x97, 0x5c, 0x28, 0xfc, 0x06, 0x3d, 0x30, 0x1a, 0x1d, 0x07, 0x8c,
+	0xc1, 0xd4, 0xfe, 0xaf, 0xd8, 0xdb, 0x5c, 0xcd, 0xdb, 0x47, 0x3b, 0x7b, 0x8d, 0xee, 0x92, 0x03,
+	0x4d, 0x9c, 0x6a, 0x8c, 0x5f, 0xa2, 0xaa, 0xe2, 0x13, 0x60, 0x76, 0xb9, 0x63, 0x75, 0x1b, 0x27,
+	0x4d, 0x4f, 0x5b, 0xf7, 0x72, 0xeb, 0x9e, 0xb1, 0xee, 0x9d, 0xf2, 0x84, 0x0d, 0x2b, 0xb7, 0xf3,
+	0x76, 0x89, 0xe8, 0x6a, 0x7c, 0x8e, 0x6a, 0x12, 0x58, 0x04, 0xc2, 0xae, 0x74, 0xac, 0xee, 0xfe,
+	0xb0, 0xff, 0x6b, 0xde, 0xee, 0xc5, 0x89, 0x1a, 0x67, 0xa1, 0x47, 0x79, 0xea, 0x9b, 0x00, 0xf4,
+	0xa7, 0x27, 0xa3, 0x89, 0xaf, 0x6e, 0x66, 0x20, 0xbd, 0x01, 0xa5, 0x83, 0x28, 0x12, 0x20, 0x25,
+	0x31, 0x0d, 0x70, 0x0b, 0xd5, 0x05, 0x50, 0x48, 0xae, 0x41, 0xd8, 0xd5, 0xdc, 0x3d, 0xd9, 0xe0,
+	0xfc, 0x7c, 0x2a, 0x49, 0x81, 0x67, 0xea, 0x6a, 0x0c, 0x49, 0x3c, 0x56, 0x76, 0xad, 0x63, 0x75,
+	0x2b, 0xdb, 0xe7, 0xdb, 0xd5, 0x5d, 0x72, 0x60, 0x88, 0xb3, 0x02, 0xe3, 0x73, 0xf4, 0x68, 0x5d,
+	0x91, 0x7f, 0xa5, 0x0a, 0xd2, 0x99, 0xbd, 0x57, 0x34, 0x79, 0xb6, 0x9a, 0xb7, 0xed, 0xdd, 0x26,
+	0x9b, 0x12, 0x97, 0x3c, 0x34, 0xdc, 0x68, 0x43, 0x1d, 0xa1, 0xc7, 0x5b, 0x97, 0x46, 0x40, 0xce,
+	0x38, 0x93, 0xe0, 0x7e, 0x45, 0x4f, 0xde, 0x65, 0x2c, 0x4e, 0xc2, 0x29, 0x8c, 0xf2, 0x6c, 0x2e,
+	0x03, 0x3a, 0x01, 0xf5, 0x36, 0x50, 0x01, 0x3e, 0x44, 0xd5, 0x08, 0x18, 0x4f, 0xf5, 0x8d, 0x12,
+	0x0d, 0xf0, 0x31, 0xaa, 0x05, 0x29, 0xcf, 0x98, 0x2a, 0x2e, 0xab, 0x42, 0x0c, 0xca, 0x79, 0x93,
+	0x69, 0xb9, 0x28, 0xbf, 0x2f, 0xa0, 0xca, 0x6e, 0x40, 0xee, 0x08, 0xb9, 0xf7, 0xfc, 0x7c, 0x40,
+	0x27, 0x8c, 0x7f, 0x9e, 0x42, 0x14, 0x43, 0x0a, 0x4c, 0x61, 0x1b, 0xed, 0xc9, 0x8c, 0x52, 0x90,
+	0xb2, 0x70, 0x52, 0x27, 0x6b, 0x98, 0x3b, 0x04, 0x21, 0xb8, 0xd0, 0x73, 0x43, 0x34, 0x38, 0xf9,
+	0x80, 0xca, 0x17, 0x32, 0xc6, 0x67, 0xa8, 0xbe, 0x19, 0xd1, 0xa6, 0xb7, 0x3d, 0xf7, 0xde, 0x56,
+	0x10, 0xad, 0xe7, 0x7f, 0x95, 0xd6, 0x19, 0x0d, 0xdf, 0xdf, 0x2e, 0x1c, 0xeb, 0x6e, 0xe1, 0x58,
+	0x3f, 0x17, 0x8e, 0xf5, 0x6d, 0xe9, 0x94, 0xee, 0x96, 0x4e, 0xe9, 0xc7, 0xd2, 0x29, 0x7d, 0xec,
+	0xff, 0x73, 0x68, 0xbe, 0xf8, 0x49, 0x48, 0x7b, 0x7f, 0x9e, 0x62, 0x3e, 0x43, 0x61, 0xad, 0x78,
+	0x44, 0x2f, 0x7e, 0x0f, 0x00, 0xec, 0x21, 0x3f, 0x82, 0xa7, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Transfer defines a rpc handler method for MsgTransfer.
+	Transfer(ctx context.Context, in *MsgTransfer, opts ...grpc.CallOption) (*MsgTransferResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Transfer(ctx context.Context, in *MsgTransfer, opts ...grpc.CallOption) (*MsgTransferResponse, error) {
+	out := new(MsgTransferResponse)
+	err := c.cc.Invoke(ctx, "/ibc.transfer.Msg/Transfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Transfer defines a rpc handler method for MsgTransfer.
+	Transfer(context.Context, *MsgTransfer) (*MsgTransferResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) Transfer(ctx context.Context, req *MsgTransfer) (*MsgTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.transfer.Msg/Transfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Transfer(ctx, req.(*MsgTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.transfer.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Transfer",
+			Handler:    _Msg_Transfer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/transfer/transfer.proto",
 }
 
 func (m *MsgTransfer) Marshal() (dAtA []byte, err error) {
@@ -375,6 +502,29 @@ func (m *MsgTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *FungibleTokenPacketData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -508,6 +658,15 @@ func (m *MsgTransfer) Size() (n int) {
 	return n
 }
 
+func (m *MsgTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *FungibleTokenPacketData) Size() (n int) {
 	if m == nil {
 		return 0
@@ -808,6 +967,59 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *FungibleTokenPacketData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
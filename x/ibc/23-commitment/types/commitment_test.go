@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/iavl"
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/smt"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 
 	dbm "github.com/tendermint/tm-db"
@@ -18,6 +19,9 @@ type MerkleTestSuite struct {
 	store     *rootmulti.Store
 	storeKey  *storetypes.KVStoreKey
 	iavlStore *iavl.Store
+
+	smtStoreKey *storetypes.KVStoreKey
+	smtStore    *smt.Store
 }
 
 func (suite *MerkleTestSuite) SetupTest() {
@@ -26,10 +30,14 @@ func (suite *MerkleTestSuite) SetupTest() {
 
 	suite.storeKey = storetypes.NewKVStoreKey("iavlStoreKey")
 
+	suite.smtStoreKey = storetypes.NewKVStoreKey("smtStoreKey")
+
 	suite.store.MountStoreWithDB(suite.storeKey, storetypes.StoreTypeIAVL, nil)
+	suite.store.MountStoreWithDB(suite.smtStoreKey, storetypes.StoreTypeSMT, nil)
 	suite.store.LoadVersion(0)
 
 	suite.iavlStore = suite.store.GetCommitStore(suite.storeKey).(*iavl.Store)
+	suite.smtStore = suite.store.GetCommitStore(suite.smtStoreKey).(*smt.Store)
 }
 
 func TestMerkleTestSuite(t *testing.T) {
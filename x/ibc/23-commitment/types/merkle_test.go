@@ -145,6 +145,46 @@ func (suite *MerkleTestSuite) TestVerifyNonMembership() {
 
 }
 
+func (suite *MerkleTestSuite) TestVerifySMTProofs() {
+	for _, key := range []string{"KEY1", "KEY3", "KEY5"} {
+		suite.smtStore.Set([]byte(key), []byte("VALUE"+key))
+	}
+	cid := suite.store.Commit()
+	root := types.NewMerkleRoot(cid.Hash)
+
+	query := func(key string) types.MerkleProof {
+		res := suite.store.Query(abci.RequestQuery{
+			Path:  fmt.Sprintf("/%s/key", suite.smtStoreKey.Name()),
+			Data:  []byte(key),
+			Prove: true,
+		})
+		suite.Require().NotNil(res.Proof)
+
+		proof := types.MerkleProof{Proof: res.Proof}
+		suite.Require().NoError(proof.ValidateBasic())
+
+		return proof
+	}
+
+	path := func(key string) types.MerklePath {
+		return types.NewMerklePath([]string{suite.smtStoreKey.Name(), key})
+	}
+
+	for _, key := range []string{"KEY1", "KEY3", "KEY5"} {
+		proof := query(key)
+		suite.Require().NoError(proof.VerifyMembership(types.GetSDKSpecs(), &root, path(key), []byte("VALUE"+key)), key)
+		suite.Require().Error(proof.VerifyMembership(types.GetSDKSpecs(), &root, path(key), []byte("WRONGVALUE")), key)
+		suite.Require().Error(proof.VerifyNonMembership(types.GetSDKSpecs(), &root, path(key)), key)
+	}
+
+	// absent keys before, between and after the existing ones
+	for _, key := range []string{"KEY0", "KEY2", "KEY4", "KEY6"} {
+		proof := query(key)
+		suite.Require().NoError(proof.VerifyNonMembership(types.GetSDKSpecs(), &root, path(key)), key)
+		suite.Require().Error(proof.VerifyNonMembership(types.GetSDKSpecs(), &root, path("KEY3")), key)
+	}
+}
+
 func TestApplyPrefix(t *testing.T) {
 	prefix := types.NewMerklePrefix([]byte("storePrefixKey"))
 
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"math/rand"
 
-	"github.com/gogo/protobuf/grpc"
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
 
@@ -124,9 +123,10 @@ func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc codec.JSONMarshaler) s
 	return keeper.NewQuerier(*am.keeper, legacyQuerierCdc)
 }
 
-// RegisterQueryService registers the gRPC query service for the ibc module.
-func (am AppModule) RegisterQueryService(server grpc.Server) {
-	types.RegisterQueryService(server, am.keeper)
+// RegisterServices registers a GRPC query service to respond to the
+// module-specific GRPC queries.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterQueryService(cfg.QueryServer(), am.keeper)
 }
 
 // InitGenesis performs genesis initialization for the ibc module. It returns
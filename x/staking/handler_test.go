@@ -7,7 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 
-	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -17,7 +17,6 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -36,7 +35,9 @@ func bootstrapHandlerGenesisTest(t *testing.T, power int64, numAddrs int, accAmo
 	require.NoError(t, err)
 
 	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
-	app.BankKeeper.SetSupply(ctx, banktypes.NewSupply(totalSupply))
+	for _, coin := range totalSupply {
+		app.BankKeeper.SetSupply(ctx, coin)
+	}
 
 	return app, ctx, addrDels, addrVals
 }
@@ -117,12 +118,12 @@ func TestValidatorByPowerIndex(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	ts := &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-	finishTime, err := gogotypes.TimestampFromProto(ts)
+	var resData types.MsgUndelegateResponse
+	err = proto.Unmarshal(res.Data, &resData)
 	require.NoError(t, err)
 
+	finishTime := resData.CompletionTime
+
 	ctx = ctx.WithBlockTime(finishTime)
 	staking.EndBlocker(ctx, app.StakingKeeper)
 	staking.EndBlocker(ctx, app.StakingKeeper)
@@ -263,12 +264,12 @@ func TestLegacyValidatorDelegations(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	ts := &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-	finishTime, err := gogotypes.TimestampFromProto(ts)
+	var resData types.MsgUndelegateResponse
+	err = proto.Unmarshal(res.Data, &resData)
 	require.NoError(t, err)
 
+	finishTime := resData.CompletionTime
+
 	ctx = ctx.WithBlockTime(finishTime)
 	staking.EndBlocker(ctx, app.StakingKeeper)
 
@@ -508,12 +509,12 @@ func TestIncrementsMsgUnbond(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, res)
 
-		ts := &gogotypes.Timestamp{}
-		types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-		finishTime, err := gogotypes.TimestampFromProto(ts)
+		var resData types.MsgUndelegateResponse
+		err = proto.Unmarshal(res.Data, &resData)
 		require.NoError(t, err)
 
+		finishTime := resData.CompletionTime
+
 		ctx = ctx.WithBlockTime(finishTime)
 		staking.EndBlocker(ctx, app.StakingKeeper)
 
@@ -626,10 +627,8 @@ func TestMultipleMsgCreateValidator(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, res)
 
-		ts := &gogotypes.Timestamp{}
-		types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-		_, err = gogotypes.TimestampFromProto(ts)
+		var resData types.MsgUndelegateResponse
+		err = proto.Unmarshal(res.Data, &resData)
 		require.NoError(t, err)
 
 		// adds validator into unbonding queue
@@ -684,12 +683,12 @@ func TestMultipleMsgDelegate(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, res)
 
-		ts := &gogotypes.Timestamp{}
-		types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-		finishTime, err := gogotypes.TimestampFromProto(ts)
+		var resData types.MsgUndelegateResponse
+		err = proto.Unmarshal(res.Data, &resData)
 		require.NoError(t, err)
 
+		finishTime := resData.CompletionTime
+
 		ctx = ctx.WithBlockTime(finishTime)
 		staking.EndBlocker(ctx, app.StakingKeeper)
 
@@ -723,12 +722,12 @@ func TestJailValidator(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	ts := &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-	finishTime, err := gogotypes.TimestampFromProto(ts)
+	var resData types.MsgUndelegateResponse
+	err = proto.Unmarshal(res.Data, &resData)
 	require.NoError(t, err)
 
+	finishTime := resData.CompletionTime
+
 	ctx = ctx.WithBlockTime(finishTime)
 	staking.EndBlocker(ctx, app.StakingKeeper)
 
@@ -743,12 +742,12 @@ func TestJailValidator(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	ts = &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-	finishTime, err = gogotypes.TimestampFromProto(ts)
+	resData = types.MsgUndelegateResponse{}
+	err = proto.Unmarshal(res.Data, &resData)
 	require.NoError(t, err)
 
+	finishTime = resData.CompletionTime
+
 	ctx = ctx.WithBlockTime(finishTime)
 	staking.EndBlocker(ctx, app.StakingKeeper)
 
@@ -791,12 +790,12 @@ func TestValidatorQueue(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	ts := &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-	finishTime, err := gogotypes.TimestampFromProto(ts)
+	var resData types.MsgUndelegateResponse
+	err = proto.Unmarshal(res.Data, &resData)
 	require.NoError(t, err)
 
+	finishTime := resData.CompletionTime
+
 	ctx = ctx.WithBlockTime(finishTime)
 	staking.EndBlocker(ctx, app.StakingKeeper)
 
@@ -897,12 +896,12 @@ func TestUnbondingFromUnbondingValidator(t *testing.T) {
 	require.NotNil(t, res)
 
 	// change the ctx to Block Time one second before the validator would have unbonded
-	ts := &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-	finishTime, err := gogotypes.TimestampFromProto(ts)
+	var resData types.MsgUndelegateResponse
+	err = proto.Unmarshal(res.Data, &resData)
 	require.NoError(t, err)
 
+	finishTime := resData.CompletionTime
+
 	ctx = ctx.WithBlockTime(finishTime.Add(time.Second * -1))
 
 	// unbond the delegator from the validator
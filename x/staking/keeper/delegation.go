@@ -870,3 +870,205 @@ func (k Keeper) ValidateUnbondAmount(
 
 	return shares, nil
 }
+
+// GetDelegatorBonded returns the amount of tokens a delegator has bonded to
+// all the validators, based on the current exchange rate of each validator.
+func (k Keeper) GetDelegatorBonded(ctx sdk.Context, delegator sdk.AccAddress) sdk.Int {
+	bonded := sdk.ZeroDec()
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetDelegationsKey(delegator))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		delegation := types.MustUnmarshalDelegation(k.cdc, iterator.Value())
+
+		validator, found := k.GetValidator(ctx, delegation.ValidatorAddress)
+		if found {
+			bonded = bonded.Add(validator.TokensFromShares(delegation.Shares))
+		}
+	}
+
+	return bonded.TruncateInt()
+}
+
+// GetDelegatorUnbonding returns the amount of tokens a delegator has in all of
+// its unbonding delegations.
+func (k Keeper) GetDelegatorUnbonding(ctx sdk.Context, delegator sdk.AccAddress) sdk.Int {
+	unbonding := sdk.ZeroInt()
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetUBDsKey(delegator))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		ubd := types.MustUnmarshalUBD(k.cdc, iterator.Value())
+		for _, entry := range ubd.Entries {
+			unbonding = unbonding.Add(entry.Balance)
+		}
+	}
+
+	return unbonding
+}
+
+// TransferDelegation moves up to wantShares of the delegation from fromAddr to
+// valAddr over to toAddr, without unbonding the underlying tokens. It returns
+// the amount of shares actually transferred.
+//
+// The shares backing an incoming redelegation of fromAddr to valAddr are never
+// transferred, as the redelegation must still be slashable from the delegation
+// of fromAddr if the source validator misbehaved.
+func (k Keeper) TransferDelegation(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantShares sdk.Dec,
+) sdk.Dec {
+	transferred := sdk.ZeroDec()
+
+	if !wantShares.IsPositive() || fromAddr.Equals(toAddr) {
+		return transferred
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return transferred
+	}
+
+	delFrom, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred
+	}
+
+	// the shares received through redelegations in progress must stay with
+	// the original delegator
+	available := delFrom.Shares
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetREDsKey(fromAddr))
+	for ; iterator.Valid(); iterator.Next() {
+		red := types.MustUnmarshalRED(k.cdc, iterator.Value())
+		if !red.ValidatorDstAddress.Equals(valAddr) {
+			continue
+		}
+
+		for _, entry := range red.Entries {
+			available = available.Sub(entry.SharesDst)
+		}
+	}
+	iterator.Close()
+
+	if !available.IsPositive() {
+		return transferred
+	}
+
+	transferred = sdk.MinDec(wantShares, available)
+
+	// update the delegation of the sender
+	k.BeforeDelegationSharesModified(ctx, fromAddr, valAddr)
+	delFrom.Shares = delFrom.Shares.Sub(transferred)
+
+	isValidatorOperator := fromAddr.Equals(validator.OperatorAddress)
+
+	// the transfer of a self-delegation is bound by the same rules as unbonding
+	if isValidatorOperator && !validator.Jailed &&
+		validator.TokensFromShares(delFrom.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
+		k.jailValidator(ctx, validator)
+	}
+
+	if delFrom.Shares.IsZero() {
+		k.RemoveDelegation(ctx, delFrom)
+	} else {
+		k.SetDelegation(ctx, delFrom)
+		k.AfterDelegationModified(ctx, fromAddr, valAddr)
+	}
+
+	// update the delegation of the recipient
+	delTo, found := k.GetDelegation(ctx, toAddr, valAddr)
+	if found {
+		k.BeforeDelegationSharesModified(ctx, toAddr, valAddr)
+	} else {
+		k.BeforeDelegationCreated(ctx, toAddr, valAddr)
+		delTo = types.NewDelegation(toAddr, valAddr, sdk.ZeroDec())
+	}
+
+	delTo.Shares = delTo.Shares.Add(transferred)
+	k.SetDelegation(ctx, delTo)
+	k.AfterDelegationModified(ctx, toAddr, valAddr)
+
+	return transferred
+}
+
+// TransferUnbonding moves up to wantAmt of the tokens held by the unbonding
+// delegation from fromAddr to valAddr over to toAddr. The entries keep their
+// creation height and completion time, so they remain slashable for the same
+// infractions and mature at the same time. It returns the amount of tokens
+// actually transferred.
+func (k Keeper) TransferUnbonding(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt sdk.Int,
+) sdk.Int {
+	transferred := sdk.ZeroInt()
+
+	if !wantAmt.IsPositive() || fromAddr.Equals(toAddr) {
+		return transferred
+	}
+
+	ubdFrom, found := k.GetUnbondingDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred
+	}
+
+	ubdFromModified := false
+
+	for i := 0; i < len(ubdFrom.Entries) && wantAmt.IsPositive(); i++ {
+		if k.HasMaxUnbondingDelegationEntries(ctx, toAddr, valAddr) {
+			break
+		}
+
+		entry := ubdFrom.Entries[i]
+		toXfer := sdk.MinInt(entry.Balance, wantAmt)
+		if !toXfer.IsPositive() {
+			continue
+		}
+
+		// split the initial balance in proportion to the transferred balance
+		// so that the recipient gets slashed for its share of the entry only
+		initialToXfer := entry.InitialBalance
+		if toXfer.LT(entry.Balance) {
+			initialToXfer = entry.InitialBalance.Mul(toXfer).Quo(entry.Balance)
+		}
+
+		if toXfer.Equal(entry.Balance) {
+			ubdFrom.RemoveEntry(int64(i))
+			i--
+		} else {
+			ubdFrom.Entries[i].Balance = entry.Balance.Sub(toXfer)
+			ubdFrom.Entries[i].InitialBalance = entry.InitialBalance.Sub(initialToXfer)
+		}
+		ubdFromModified = true
+
+		ubdTo, found := k.GetUnbondingDelegation(ctx, toAddr, valAddr)
+		if !found {
+			ubdTo = types.UnbondingDelegation{DelegatorAddress: toAddr, ValidatorAddress: valAddr}
+		}
+
+		ubdTo.Entries = append(ubdTo.Entries, types.UnbondingDelegationEntry{
+			CreationHeight: entry.CreationHeight,
+			CompletionTime: entry.CompletionTime,
+			InitialBalance: initialToXfer,
+			Balance:        toXfer,
+		})
+		k.SetUnbondingDelegation(ctx, ubdTo)
+		k.InsertUBDQueue(ctx, ubdTo, entry.CompletionTime)
+
+		transferred = transferred.Add(toXfer)
+		wantAmt = wantAmt.Sub(toXfer)
+	}
+
+	if ubdFromModified {
+		if len(ubdFrom.Entries) == 0 {
+			k.RemoveUnbondingDelegation(ctx, ubdFrom)
+		} else {
+			k.SetUnbondingDelegation(ctx, ubdFrom)
+		}
+	}
+
+	return transferred
+}
@@ -1023,3 +1023,141 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	red, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(t, found, "%v", red)
 }
+
+func TestTransferDelegation(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+
+	startTokens := sdk.TokensFromConsensusPower(10)
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+	require.NoError(t,
+		app.BankKeeper.SetBalances(
+			ctx,
+			notBondedPool.GetAddress(),
+			sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), startTokens)),
+		),
+	)
+	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	validator := types.NewValidator(valAddrs[0], PKs[0], types.Description{})
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(delAddrs[1], valAddrs[0], issuedShares))
+	require.Equal(t, startTokens, app.StakingKeeper.GetDelegatorBonded(ctx, delAddrs[1]))
+
+	// transfer part of the delegation to a new delegator
+	xferShares := sdk.TokensFromConsensusPower(4).ToDec()
+	transferred := app.StakingKeeper.TransferDelegation(ctx, delAddrs[1], delAddrs[2], valAddrs[0], xferShares)
+	require.Equal(t, xferShares, transferred)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, issuedShares.Sub(xferShares), delegation.Shares)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delAddrs[2], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, xferShares, delegation.Shares)
+
+	// the validator is left untouched
+	resValidator, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, validator.Tokens, resValidator.Tokens)
+	require.Equal(t, validator.DelegatorShares, resValidator.DelegatorShares)
+
+	// the transfer is capped to the shares of the delegation
+	transferred = app.StakingKeeper.TransferDelegation(ctx, delAddrs[1], delAddrs[2], valAddrs[0], issuedShares)
+	require.Equal(t, issuedShares.Sub(xferShares), transferred)
+
+	_, found = app.StakingKeeper.GetDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.False(t, found)
+	require.Equal(t, startTokens, app.StakingKeeper.GetDelegatorBonded(ctx, delAddrs[2]))
+
+	// nothing happens without a delegation
+	transferred = app.StakingKeeper.TransferDelegation(ctx, delAddrs[1], delAddrs[2], valAddrs[0], issuedShares)
+	require.True(t, transferred.IsZero())
+}
+
+func TestTransferDelegationWithRedelegation(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+	require.NoError(t,
+		app.BankKeeper.SetBalances(
+			ctx,
+			notBondedPool.GetAddress(),
+			sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), sdk.TokensFromConsensusPower(10))),
+		),
+	)
+	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	shares := sdk.TokensFromConsensusPower(10).ToDec()
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(delAddrs[2], valAddrs[1], shares))
+
+	validator := types.NewValidator(valAddrs[1], PKs[1], types.Description{})
+	validator, _ = validator.AddTokensFromDel(sdk.TokensFromConsensusPower(10))
+	keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+
+	// a part of the delegation comes from a redelegation still in progress
+	redShares := sdk.TokensFromConsensusPower(3).ToDec()
+	rd := types.NewRedelegation(delAddrs[2], valAddrs[0], valAddrs[1], 0,
+		time.Unix(0, 0), sdk.NewInt(5), redShares)
+	app.StakingKeeper.SetRedelegation(ctx, rd)
+
+	transferred := app.StakingKeeper.TransferDelegation(ctx, delAddrs[2], delAddrs[0], valAddrs[1], shares)
+	require.Equal(t, shares.Sub(redShares), transferred)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddrs[2], valAddrs[1])
+	require.True(t, found)
+	require.Equal(t, redShares, delegation.Shares)
+}
+
+func TestTransferUnbonding(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+
+	time1 := time.Unix(100, 0).UTC()
+	time2 := time.Unix(200, 0).UTC()
+
+	app.StakingKeeper.SetUnbondingDelegationEntry(ctx, delAddrs[0], valAddrs[0], 1, time1, sdk.NewInt(10))
+	app.StakingKeeper.SetUnbondingDelegationEntry(ctx, delAddrs[0], valAddrs[0], 2, time2, sdk.NewInt(20))
+	require.Equal(t, sdk.NewInt(30), app.StakingKeeper.GetDelegatorUnbonding(ctx, delAddrs[0]))
+
+	// the first entry moves entirely, the second one is split
+	transferred := app.StakingKeeper.TransferUnbonding(ctx, delAddrs[0], delAddrs[1], valAddrs[0], sdk.NewInt(15))
+	require.Equal(t, sdk.NewInt(15), transferred)
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, sdk.NewInt(15), ubd.Entries[0].Balance)
+	require.Equal(t, time2, ubd.Entries[0].CompletionTime)
+
+	ubd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 2)
+	require.Equal(t, sdk.NewInt(10), ubd.Entries[0].Balance)
+	require.Equal(t, int64(1), ubd.Entries[0].CreationHeight)
+	require.Equal(t, sdk.NewInt(5), ubd.Entries[1].Balance)
+	require.Equal(t, sdk.NewInt(5), ubd.Entries[1].InitialBalance)
+	require.Equal(t, int64(2), ubd.Entries[1].CreationHeight)
+
+	// the recipient matures with the original completion time
+	require.Equal(t, []types.DVPair{{DelegatorAddress: delAddrs[1], ValidatorAddress: valAddrs[0]}},
+		app.StakingKeeper.GetUBDQueueTimeSlice(ctx, time1))
+
+	// the transfer is capped to the unbonding balance
+	transferred = app.StakingKeeper.TransferUnbonding(ctx, delAddrs[0], delAddrs[1], valAddrs[0], sdk.NewInt(100))
+	require.Equal(t, sdk.NewInt(15), transferred)
+
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.False(t, found)
+	require.Equal(t, sdk.NewInt(30), app.StakingKeeper.GetDelegatorUnbonding(ctx, delAddrs[1]))
+}
@@ -5,20 +5,29 @@ package types
 
 import (
 	bytes "bytes"
+	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -112,6 +121,43 @@ func (m *MsgCreateValidator) GetValue() types.Coin {
 	return types.Coin{}
 }
 
+// MsgCreateValidatorResponse defines the Msg/CreateValidator response type.
+type MsgCreateValidatorResponse struct {
+}
+
+func (m *MsgCreateValidatorResponse) Reset()         { *m = MsgCreateValidatorResponse{} }
+func (m *MsgCreateValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateValidatorResponse) ProtoMessage()    {}
+func (*MsgCreateValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{1}
+}
+func (m *MsgCreateValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateValidatorResponse.Merge(m, src)
+}
+func (m *MsgCreateValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateValidatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateValidatorResponse proto.InternalMessageInfo
+
 // MsgEditValidator defines an SDK message for editing an existing validator.
 type MsgEditValidator struct {
 	Description      Description                                   `protobuf:"bytes,1,opt,name=description,proto3" json:"description"`
@@ -129,7 +175,7 @@ func (m *MsgEditValidator) Reset()         { *m = MsgEditValidator{} }
 func (m *MsgEditValidator) String() string { return proto.CompactTextString(m) }
 func (*MsgEditValidator) ProtoMessage()    {}
 func (*MsgEditValidator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{1}
+	return fileDescriptor_0926ef28816b35ab, []int{2}
 }
 func (m *MsgEditValidator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -172,6 +218,43 @@ func (m *MsgEditValidator) GetValidatorAddress() github_com_cosmos_cosmos_sdk_ty
 	return nil
 }
 
+// MsgEditValidatorResponse defines the Msg/EditValidator response type.
+type MsgEditValidatorResponse struct {
+}
+
+func (m *MsgEditValidatorResponse) Reset()         { *m = MsgEditValidatorResponse{} }
+func (m *MsgEditValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEditValidatorResponse) ProtoMessage()    {}
+func (*MsgEditValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{3}
+}
+func (m *MsgEditValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEditValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEditValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEditValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEditValidatorResponse.Merge(m, src)
+}
+func (m *MsgEditValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEditValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEditValidatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEditValidatorResponse proto.InternalMessageInfo
+
 // MsgDelegate defines an SDK message for performing a delegation from a
 // delegate to a validator.
 type MsgDelegate struct {
@@ -184,7 +267,7 @@ func (m *MsgDelegate) Reset()         { *m = MsgDelegate{} }
 func (m *MsgDelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegate) ProtoMessage()    {}
 func (*MsgDelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{2}
+	return fileDescriptor_0926ef28816b35ab, []int{4}
 }
 func (m *MsgDelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -234,6 +317,43 @@ func (m *MsgDelegate) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+// MsgDelegateResponse defines the Msg/Delegate response type.
+type MsgDelegateResponse struct {
+}
+
+func (m *MsgDelegateResponse) Reset()         { *m = MsgDelegateResponse{} }
+func (m *MsgDelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateResponse) ProtoMessage()    {}
+func (*MsgDelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{5}
+}
+func (m *MsgDelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateResponse.Merge(m, src)
+}
+func (m *MsgDelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateResponse proto.InternalMessageInfo
+
 // MsgBeginRedelegate defines an SDK message for performing a redelegation from
 // a delegate and source validator to a destination validator.
 type MsgBeginRedelegate struct {
@@ -247,7 +367,7 @@ func (m *MsgBeginRedelegate) Reset()         { *m = MsgBeginRedelegate{} }
 func (m *MsgBeginRedelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgBeginRedelegate) ProtoMessage()    {}
 func (*MsgBeginRedelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{3}
+	return fileDescriptor_0926ef28816b35ab, []int{6}
 }
 func (m *MsgBeginRedelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -304,6 +424,51 @@ func (m *MsgBeginRedelegate) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+// MsgBeginRedelegateResponse defines the Msg/BeginRedelegate response type.
+type MsgBeginRedelegateResponse struct {
+	CompletionTime time.Time `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+}
+
+func (m *MsgBeginRedelegateResponse) Reset()         { *m = MsgBeginRedelegateResponse{} }
+func (m *MsgBeginRedelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBeginRedelegateResponse) ProtoMessage()    {}
+func (*MsgBeginRedelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{7}
+}
+func (m *MsgBeginRedelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBeginRedelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBeginRedelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBeginRedelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBeginRedelegateResponse.Merge(m, src)
+}
+func (m *MsgBeginRedelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBeginRedelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBeginRedelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBeginRedelegateResponse proto.InternalMessageInfo
+
+func (m *MsgBeginRedelegateResponse) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
 // MsgUndelegate defines an SDK message for performing an undelegation from a
 // delegate and a validator.
 type MsgUndelegate struct {
@@ -316,7 +481,7 @@ func (m *MsgUndelegate) Reset()         { *m = MsgUndelegate{} }
 func (m *MsgUndelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgUndelegate) ProtoMessage()    {}
 func (*MsgUndelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{4}
+	return fileDescriptor_0926ef28816b35ab, []int{8}
 }
 func (m *MsgUndelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -366,59 +531,121 @@ func (m *MsgUndelegate) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+// MsgUndelegateResponse defines the Msg/Undelegate response type.
+type MsgUndelegateResponse struct {
+	CompletionTime time.Time `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+}
+
+func (m *MsgUndelegateResponse) Reset()         { *m = MsgUndelegateResponse{} }
+func (m *MsgUndelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateResponse) ProtoMessage()    {}
+func (*MsgUndelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{9}
+}
+func (m *MsgUndelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateResponse.Merge(m, src)
+}
+func (m *MsgUndelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateResponse proto.InternalMessageInfo
+
+func (m *MsgUndelegateResponse) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateValidator)(nil), "cosmos.staking.v1beta1.MsgCreateValidator")
+	proto.RegisterType((*MsgCreateValidatorResponse)(nil), "cosmos.staking.v1beta1.MsgCreateValidatorResponse")
 	proto.RegisterType((*MsgEditValidator)(nil), "cosmos.staking.v1beta1.MsgEditValidator")
+	proto.RegisterType((*MsgEditValidatorResponse)(nil), "cosmos.staking.v1beta1.MsgEditValidatorResponse")
 	proto.RegisterType((*MsgDelegate)(nil), "cosmos.staking.v1beta1.MsgDelegate")
+	proto.RegisterType((*MsgDelegateResponse)(nil), "cosmos.staking.v1beta1.MsgDelegateResponse")
 	proto.RegisterType((*MsgBeginRedelegate)(nil), "cosmos.staking.v1beta1.MsgBeginRedelegate")
+	proto.RegisterType((*MsgBeginRedelegateResponse)(nil), "cosmos.staking.v1beta1.MsgBeginRedelegateResponse")
 	proto.RegisterType((*MsgUndelegate)(nil), "cosmos.staking.v1beta1.MsgUndelegate")
+	proto.RegisterType((*MsgUndelegateResponse)(nil), "cosmos.staking.v1beta1.MsgUndelegateResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/staking/v1beta1/tx.proto", fileDescriptor_0926ef28816b35ab) }
 
 var fileDescriptor_0926ef28816b35ab = []byte{
-	// 644 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x31, 0x6f, 0xd3, 0x4e,
-	0x1c, 0x8d, 0x13, 0x37, 0x7f, 0xfd, 0xaf, 0x50, 0x5a, 0x17, 0xaa, 0x50, 0x21, 0xbb, 0x32, 0x08,
-	0x32, 0x50, 0x5b, 0x05, 0x21, 0xa4, 0x6e, 0x4d, 0x02, 0x02, 0x81, 0x07, 0x5c, 0xd1, 0x81, 0x25,
-	0xba, 0xd8, 0x57, 0x73, 0x8a, 0xed, 0x8b, 0x7c, 0x97, 0x28, 0x41, 0xac, 0xec, 0x7c, 0x04, 0x3e,
-	0x0a, 0x03, 0x42, 0x1d, 0x3b, 0x22, 0x06, 0x83, 0x92, 0x85, 0x39, 0x23, 0x2c, 0xc8, 0xf6, 0xc5,
-	0x09, 0x89, 0x83, 0xa2, 0x96, 0x02, 0x03, 0x53, 0x92, 0xcb, 0xf3, 0xfb, 0x3d, 0xbd, 0xf7, 0x7b,
-	0xd6, 0x01, 0xc5, 0x22, 0xd4, 0x23, 0x54, 0xa7, 0x0c, 0x36, 0xb1, 0xef, 0xe8, 0x9d, 0x9d, 0x06,
-	0x62, 0x70, 0x47, 0x67, 0x5d, 0xad, 0x15, 0x10, 0x46, 0xa4, 0x8d, 0x04, 0xa0, 0x71, 0x80, 0xc6,
-	0x01, 0x9b, 0x17, 0x1d, 0xe2, 0x90, 0x18, 0xa2, 0x47, 0xdf, 0x12, 0xf4, 0xa6, 0xcc, 0xe9, 0x1a,
-	0x90, 0xa2, 0x94, 0xcb, 0x22, 0xd8, 0xe7, 0xff, 0x5f, 0x9b, 0x33, 0x6e, 0xc4, 0x1e, 0xa3, 0xd4,
-	0x6f, 0x22, 0x90, 0x0c, 0xea, 0x54, 0x03, 0x04, 0x19, 0x3a, 0x80, 0x2e, 0xb6, 0x21, 0x23, 0x81,
-	0xf4, 0x08, 0x2c, 0xdb, 0x88, 0x5a, 0x01, 0x6e, 0x31, 0x4c, 0xfc, 0x92, 0xb0, 0x25, 0x94, 0x97,
-	0x6f, 0x5d, 0xd5, 0xb2, 0x05, 0x6a, 0xb5, 0x31, 0xb4, 0x22, 0x1e, 0x85, 0x4a, 0xce, 0x9c, 0x7c,
-	0x5a, 0x32, 0x00, 0xb0, 0x88, 0xe7, 0x61, 0x4a, 0x23, 0xae, 0x7c, 0xcc, 0x75, 0x63, 0x1e, 0x57,
-	0x35, 0x45, 0x9a, 0x90, 0x21, 0xca, 0xf9, 0x26, 0x08, 0xa4, 0x97, 0x60, 0xdd, 0xc3, 0x7e, 0x9d,
-	0x22, 0xf7, 0xb0, 0x6e, 0x23, 0x17, 0x39, 0x30, 0xd6, 0x58, 0xd8, 0x12, 0xca, 0xff, 0x57, 0x1e,
-	0x47, 0xf0, 0x8f, 0xa1, 0x72, 0xdd, 0xc1, 0xec, 0x79, 0xbb, 0xa1, 0x59, 0xc4, 0xd3, 0xb9, 0x11,
-	0xc9, 0xc7, 0x36, 0xb5, 0x9b, 0x3a, 0xeb, 0xb5, 0x10, 0xd5, 0x1e, 0xfa, 0x6c, 0x18, 0x2a, 0x9b,
-	0x3d, 0xe8, 0xb9, 0xbb, 0x6a, 0x06, 0xa5, 0x6a, 0xae, 0x79, 0xd8, 0xdf, 0x47, 0xee, 0x61, 0x2d,
-	0x3d, 0x93, 0x5e, 0x80, 0x35, 0x8e, 0x20, 0x41, 0x1d, 0xda, 0x76, 0x80, 0x28, 0x2d, 0x89, 0x5b,
-	0x42, 0xf9, 0x5c, 0xc5, 0x18, 0x86, 0x4a, 0x29, 0x61, 0x9b, 0x81, 0xa8, 0x5f, 0x43, 0x65, 0x7b,
-	0x01, 0x4d, 0x7b, 0x96, 0xb5, 0x97, 0x3c, 0x61, 0xae, 0xa6, 0x24, 0xfc, 0x24, 0x9a, 0xdd, 0x19,
-	0x45, 0x94, 0xce, 0x5e, 0x9a, 0x9e, 0x3d, 0x03, 0x59, 0x74, 0xf6, 0x01, 0x74, 0xd3, 0xd9, 0x29,
-	0xc9, 0x68, 0xf6, 0x06, 0x28, 0xb6, 0xda, 0x8d, 0x26, 0xea, 0x95, 0x8a, 0x91, 0xd1, 0x26, 0xff,
-	0x25, 0xdd, 0x01, 0x4b, 0x1d, 0xe8, 0xb6, 0x51, 0xe9, 0xbf, 0x38, 0xd7, 0xcb, 0xa3, 0x5c, 0xa3,
-	0xb5, 0x9c, 0x08, 0x15, 0x8f, 0x36, 0x23, 0x41, 0xef, 0x8a, 0x5f, 0xde, 0x28, 0x82, 0xfa, 0xb6,
-	0x00, 0x56, 0x0d, 0xea, 0xdc, 0xb3, 0x31, 0x3b, 0xa3, 0xdd, 0x6b, 0x65, 0x59, 0x96, 0x8f, 0x2d,
-	0xab, 0x0e, 0x43, 0x65, 0x25, 0xb1, 0xec, 0x57, 0x1a, 0xe5, 0x81, 0x0b, 0xe3, 0x65, 0xad, 0x07,
-	0x90, 0x21, 0xbe, 0x9a, 0xb5, 0x05, 0xd7, 0xb2, 0x86, 0xac, 0x61, 0xa8, 0x6c, 0x24, 0xca, 0xa6,
-	0xa8, 0x54, 0x73, 0xc5, 0xfa, 0xa1, 0x20, 0x52, 0x37, 0xbb, 0x0d, 0x62, 0x3c, 0xf2, 0xc1, 0x19,
-	0x36, 0x81, 0x47, 0xf8, 0x2e, 0x0f, 0x96, 0x0d, 0xea, 0xf0, 0x73, 0x94, 0xdd, 0x0f, 0xe1, 0x0f,
-	0xf6, 0x23, 0xff, 0x7b, 0xfa, 0x71, 0x17, 0x14, 0xa1, 0x47, 0xda, 0x3e, 0x8b, 0xd3, 0x5e, 0xa0,
-	0x08, 0x1c, 0xce, 0x6d, 0xfc, 0x54, 0x88, 0xdf, 0xc3, 0x15, 0xe4, 0x60, 0xdf, 0x44, 0xf6, 0xdf,
-	0xe0, 0xe6, 0x2b, 0x01, 0x5c, 0x1a, 0x7b, 0x45, 0x03, 0x6b, 0xca, 0xd2, 0x27, 0xc3, 0x50, 0xb9,
-	0x32, 0x6d, 0xe9, 0x04, 0xec, 0x04, 0xb6, 0xae, 0xa7, 0x44, 0xfb, 0x81, 0x95, 0xad, 0xc3, 0xa6,
-	0x2c, 0xd5, 0x51, 0x98, 0xaf, 0x63, 0x02, 0x76, 0x2a, 0x1d, 0x35, 0xca, 0x66, 0x13, 0x16, 0x4f,
-	0x92, 0xf0, 0xfb, 0x3c, 0x38, 0x6f, 0x50, 0xe7, 0xa9, 0x6f, 0xff, 0xab, 0xca, 0x69, 0xaa, 0x52,
-	0xb9, 0x7f, 0xd4, 0x97, 0x85, 0xe3, 0xbe, 0x2c, 0x7c, 0xee, 0xcb, 0xc2, 0xeb, 0x81, 0x9c, 0x3b,
-	0x1e, 0xc8, 0xb9, 0x0f, 0x03, 0x39, 0xf7, 0xec, 0xe6, 0x4f, 0x95, 0x75, 0xd3, 0xab, 0x50, 0xac,
-	0xb1, 0x51, 0x8c, 0x6f, 0x40, 0xb7, 0xbf, 0x07, 0x00, 0x00, 0xff, 0xff, 0x28, 0x49, 0xde, 0x06,
-	0x98, 0x09, 0x00, 0x00,
+	// 839 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x97, 0xbd, 0x6f, 0xdb, 0x46,
+	0x18, 0xc6, 0x45, 0x49, 0x56, 0xdd, 0x73, 0xfd, 0x45, 0xd7, 0x86, 0x4a, 0x18, 0xa2, 0x41, 0xf7,
+	0x43, 0x68, 0x6b, 0xb2, 0x56, 0x51, 0x14, 0xf0, 0x66, 0x59, 0x2d, 0x5a, 0xb4, 0x1c, 0x4a, 0x37,
+	0x1e, 0x82, 0x00, 0x02, 0x45, 0x9e, 0x18, 0x42, 0x24, 0x8f, 0xe6, 0x9d, 0x0c, 0x3b, 0xc8, 0x9a,
+	0xdd, 0x73, 0xa6, 0xfc, 0x29, 0x19, 0x82, 0xc0, 0xa3, 0xc7, 0x20, 0x03, 0x1d, 0xd8, 0x4b, 0x66,
+	0x8d, 0xc9, 0x12, 0xf0, 0xeb, 0x24, 0x51, 0x1f, 0x20, 0xec, 0x38, 0xc9, 0x90, 0xc9, 0x96, 0xf8,
+	0xbb, 0xe7, 0x7d, 0xf9, 0xbc, 0xef, 0x7b, 0x77, 0x02, 0xbc, 0x86, 0xb0, 0x8d, 0xb0, 0x84, 0x89,
+	0xda, 0x31, 0x1d, 0x43, 0x3a, 0xda, 0x6e, 0x41, 0xa2, 0x6e, 0x4b, 0xe4, 0x58, 0x74, 0x3d, 0x44,
+	0x10, 0xbb, 0x16, 0x01, 0x62, 0x0c, 0x88, 0x31, 0xc0, 0x7d, 0x6d, 0x20, 0x03, 0x85, 0x88, 0x14,
+	0xfc, 0x17, 0xd1, 0x5c, 0x25, 0x96, 0x6b, 0xa9, 0x18, 0x52, 0x2d, 0x0d, 0x99, 0x4e, 0xfc, 0xfc,
+	0xdb, 0x09, 0xe1, 0x12, 0xf5, 0x88, 0xe2, 0x0d, 0x84, 0x0c, 0x0b, 0x4a, 0xe1, 0xa7, 0x56, 0xb7,
+	0x2d, 0x11, 0xd3, 0x86, 0x98, 0xa8, 0xb6, 0x1b, 0x01, 0xc2, 0xdb, 0x22, 0x60, 0x65, 0x6c, 0xec,
+	0x79, 0x50, 0x25, 0xf0, 0x40, 0xb5, 0x4c, 0x5d, 0x25, 0xc8, 0x63, 0xff, 0x01, 0x73, 0x3a, 0xc4,
+	0x9a, 0x67, 0xba, 0xc4, 0x44, 0x4e, 0x99, 0xd9, 0x60, 0xaa, 0x73, 0xb5, 0x4d, 0x71, 0xfc, 0x1b,
+	0x88, 0x8d, 0x3e, 0x5a, 0x2f, 0x9e, 0xf9, 0x7c, 0x4e, 0x19, 0x5c, 0xcd, 0xca, 0x00, 0x68, 0xc8,
+	0xb6, 0x4d, 0x8c, 0x03, 0xad, 0x7c, 0xa8, 0xf5, 0xc3, 0x24, 0xad, 0x3d, 0x4a, 0x2a, 0x2a, 0x81,
+	0x38, 0xd6, 0x1b, 0x10, 0x60, 0x1f, 0x82, 0x15, 0xdb, 0x74, 0x9a, 0x18, 0x5a, 0xed, 0xa6, 0x0e,
+	0x2d, 0x68, 0xa8, 0x61, 0x8e, 0x85, 0x0d, 0xa6, 0xfa, 0x65, 0xfd, 0xdf, 0x00, 0x7f, 0xe9, 0xf3,
+	0xdf, 0x1b, 0x26, 0xb9, 0xdf, 0x6d, 0x89, 0x1a, 0xb2, 0xa5, 0xd8, 0xa9, 0xe8, 0xcf, 0x16, 0xd6,
+	0x3b, 0x12, 0x39, 0x71, 0x21, 0x16, 0xff, 0x76, 0x48, 0xcf, 0xe7, 0xb9, 0x13, 0xd5, 0xb6, 0x76,
+	0x84, 0x31, 0x92, 0x82, 0xb2, 0x6c, 0x9b, 0xce, 0x3e, 0xb4, 0xda, 0x0d, 0xfa, 0x1d, 0xfb, 0x00,
+	0x2c, 0xc7, 0x04, 0xf2, 0x9a, 0xaa, 0xae, 0x7b, 0x10, 0xe3, 0x72, 0x71, 0x83, 0xa9, 0x7e, 0x55,
+	0x97, 0x7b, 0x3e, 0x5f, 0x8e, 0xd4, 0x46, 0x10, 0xe1, 0x8d, 0xcf, 0x6f, 0x65, 0xc8, 0x69, 0x57,
+	0xd3, 0x76, 0xa3, 0x15, 0xca, 0x12, 0x15, 0x89, 0xbf, 0x09, 0x62, 0x1f, 0x25, 0x25, 0xa2, 0xb1,
+	0x67, 0xd2, 0xb1, 0x47, 0x90, 0xac, 0xb1, 0x0f, 0x54, 0x8b, 0xc6, 0xa6, 0x22, 0x49, 0xec, 0x35,
+	0x50, 0x72, 0xbb, 0xad, 0x0e, 0x3c, 0x29, 0x97, 0x02, 0xa3, 0x95, 0xf8, 0x13, 0xfb, 0x1b, 0x98,
+	0x39, 0x52, 0xad, 0x2e, 0x2c, 0x7f, 0x11, 0xd6, 0xf5, 0x9b, 0xa4, 0xae, 0x41, 0xdf, 0x0e, 0x14,
+	0xd5, 0x4c, 0x3a, 0x23, 0xa2, 0x77, 0x8a, 0xaf, 0x9f, 0xf0, 0x8c, 0xb0, 0x0e, 0xb8, 0xd1, 0xe6,
+	0x53, 0x20, 0x76, 0x91, 0x83, 0xa1, 0xf0, 0xb4, 0x00, 0x96, 0x64, 0x6c, 0xfc, 0xa1, 0x9b, 0xe4,
+	0x96, 0x3a, 0xd3, 0x1d, 0x67, 0x68, 0x3e, 0x34, 0x74, 0xaf, 0xe7, 0xf3, 0x0b, 0x91, 0xa1, 0xef,
+	0xd3, 0x46, 0x1b, 0x2c, 0xf6, 0x5b, 0xb9, 0xe9, 0xa9, 0x04, 0xc6, 0x8d, 0xdb, 0xc8, 0xd8, 0xb4,
+	0x0d, 0xa8, 0xf5, 0x7c, 0x7e, 0x2d, 0xca, 0x2c, 0x25, 0x25, 0x28, 0x0b, 0xda, 0xd0, 0xf8, 0xb0,
+	0xc7, 0xe3, 0x67, 0xa5, 0x18, 0x86, 0xfc, 0xeb, 0x16, 0xe7, 0x24, 0x2e, 0x30, 0x07, 0xca, 0xe9,
+	0x0a, 0xd2, 0xf2, 0x3e, 0xcb, 0x83, 0x39, 0x19, 0x1b, 0xf1, 0x1a, 0x38, 0x7e, 0xb2, 0x98, 0x8f,
+	0x38, 0x59, 0xf9, 0x0f, 0x33, 0x59, 0xbf, 0x83, 0x92, 0x6a, 0xa3, 0xae, 0x43, 0xca, 0x85, 0x6c,
+	0x23, 0x14, 0xe3, 0xb1, 0xc5, 0xab, 0x60, 0x65, 0xc0, 0x45, 0xea, 0xee, 0x45, 0x21, 0xdc, 0xd8,
+	0xeb, 0xd0, 0x30, 0x1d, 0x05, 0xea, 0x9f, 0x82, 0xc9, 0x8f, 0x18, 0xb0, 0xda, 0xb7, 0x10, 0x7b,
+	0x5a, 0xca, 0xe9, 0xff, 0x7a, 0x3e, 0xbf, 0x9e, 0x76, 0x7a, 0x00, 0xbb, 0x86, 0xdb, 0x2b, 0x54,
+	0x68, 0xdf, 0xd3, 0xc6, 0xe7, 0xa1, 0x63, 0x42, 0xf3, 0x28, 0x4c, 0xce, 0x63, 0x00, 0xbb, 0x51,
+	0x1e, 0x0d, 0x4c, 0x46, 0x0b, 0x5f, 0xbc, 0x4e, 0xe1, 0x3b, 0x80, 0x1b, 0x2d, 0x70, 0x52, 0x7f,
+	0x56, 0x0e, 0x37, 0x1a, 0xd7, 0x82, 0xc1, 0x34, 0x36, 0x83, 0x63, 0x3f, 0xde, 0x2b, 0x39, 0x31,
+	0xba, 0x13, 0x88, 0xc9, 0x9d, 0x40, 0xfc, 0x3f, 0xb9, 0x13, 0xd4, 0x67, 0x83, 0x30, 0xa7, 0x17,
+	0x3c, 0xa3, 0x2c, 0xf4, 0x17, 0x07, 0x8f, 0x85, 0xe7, 0x79, 0x30, 0x2f, 0x63, 0xe3, 0x8e, 0xa3,
+	0x7f, 0x1e, 0xd7, 0x1b, 0x8d, 0x6b, 0x1b, 0xac, 0x0e, 0xf9, 0x78, 0x4b, 0x05, 0xab, 0x3d, 0x2e,
+	0x82, 0x82, 0x8c, 0x0d, 0xf6, 0x10, 0x2c, 0xa6, 0x2f, 0x77, 0x3f, 0x4e, 0x3a, 0x2d, 0x47, 0xcf,
+	0x62, 0xae, 0x96, 0x9d, 0xa5, 0x6f, 0xd2, 0x01, 0xf3, 0xc3, 0x67, 0x76, 0x75, 0x8a, 0xc8, 0x10,
+	0xc9, 0xfd, 0x92, 0x95, 0xa4, 0xc1, 0xee, 0x81, 0x59, 0x7a, 0x82, 0x6c, 0x4e, 0x59, 0x9d, 0x40,
+	0xdc, 0x4f, 0x19, 0x20, 0xaa, 0x7e, 0x08, 0x16, 0xd3, 0x3b, 0xe8, 0x34, 0xf7, 0x52, 0x2c, 0x57,
+	0xcb, 0xce, 0xd2, 0x90, 0x2d, 0x00, 0x06, 0xa6, 0xec, 0xbb, 0x29, 0x0a, 0x7d, 0x8c, 0xdb, 0xca,
+	0x84, 0x25, 0x31, 0xea, 0x7f, 0x9e, 0x5d, 0x56, 0x98, 0xf3, 0xcb, 0x0a, 0xf3, 0xea, 0xb2, 0xc2,
+	0x9c, 0x5e, 0x55, 0x72, 0xe7, 0x57, 0x95, 0xdc, 0x8b, 0xab, 0x4a, 0xee, 0xee, 0xcf, 0x53, 0xc7,
+	0xe3, 0x98, 0xfe, 0xdc, 0x08, 0x07, 0xa5, 0x55, 0x0a, 0x5b, 0xf2, 0xd7, 0x77, 0x03, 0x00, 0x81,
+	0xfb, 0x1f, 0x7f, 0xfc, 0x0c, 0x00, 0x00,
 }
 
 func (this *MsgCreateValidator) Equal(that interface{}) bool {
@@ -597,6 +824,247 @@ func (this *MsgUndelegate) Equal(that interface{}) bool {
 	}
 	return true
 }
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateValidator defines a method for creating a new validator.
+	CreateValidator(ctx context.Context, in *MsgCreateValidator, opts ...grpc.CallOption) (*MsgCreateValidatorResponse, error)
+	// EditValidator defines a method for editing an existing validator.
+	EditValidator(ctx context.Context, in *MsgEditValidator, opts ...grpc.CallOption) (*MsgEditValidatorResponse, error)
+	// Delegate defines a method for performing a delegation of coins
+	// from a delegator to a validator.
+	Delegate(ctx context.Context, in *MsgDelegate, opts ...grpc.CallOption) (*MsgDelegateResponse, error)
+	// BeginRedelegate defines a method for performing a redelegation
+	// of coins from a delegator and source validator to a destination validator.
+	BeginRedelegate(ctx context.Context, in *MsgBeginRedelegate, opts ...grpc.CallOption) (*MsgBeginRedelegateResponse, error)
+	// Undelegate defines a method for performing an undelegation from a
+	// delegate and a validator.
+	Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateValidator(ctx context.Context, in *MsgCreateValidator, opts ...grpc.CallOption) (*MsgCreateValidatorResponse, error) {
+	out := new(MsgCreateValidatorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/CreateValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EditValidator(ctx context.Context, in *MsgEditValidator, opts ...grpc.CallOption) (*MsgEditValidatorResponse, error) {
+	out := new(MsgEditValidatorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/EditValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Delegate(ctx context.Context, in *MsgDelegate, opts ...grpc.CallOption) (*MsgDelegateResponse, error) {
+	out := new(MsgDelegateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/Delegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BeginRedelegate(ctx context.Context, in *MsgBeginRedelegate, opts ...grpc.CallOption) (*MsgBeginRedelegateResponse, error) {
+	out := new(MsgBeginRedelegateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/BeginRedelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error) {
+	out := new(MsgUndelegateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/Undelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateValidator defines a method for creating a new validator.
+	CreateValidator(context.Context, *MsgCreateValidator) (*MsgCreateValidatorResponse, error)
+	// EditValidator defines a method for editing an existing validator.
+	EditValidator(context.Context, *MsgEditValidator) (*MsgEditValidatorResponse, error)
+	// Delegate defines a method for performing a delegation of coins
+	// from a delegator to a validator.
+	Delegate(context.Context, *MsgDelegate) (*MsgDelegateResponse, error)
+	// BeginRedelegate defines a method for performing a redelegation
+	// of coins from a delegator and source validator to a destination validator.
+	BeginRedelegate(context.Context, *MsgBeginRedelegate) (*MsgBeginRedelegateResponse, error)
+	// Undelegate defines a method for performing an undelegation from a
+	// delegate and a validator.
+	Undelegate(context.Context, *MsgUndelegate) (*MsgUndelegateResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateValidator(ctx context.Context, req *MsgCreateValidator) (*MsgCreateValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateValidator not implemented")
+}
+func (*UnimplementedMsgServer) EditValidator(ctx context.Context, req *MsgEditValidator) (*MsgEditValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditValidator not implemented")
+}
+func (*UnimplementedMsgServer) Delegate(ctx context.Context, req *MsgDelegate) (*MsgDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delegate not implemented")
+}
+func (*UnimplementedMsgServer) BeginRedelegate(ctx context.Context, req *MsgBeginRedelegate) (*MsgBeginRedelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginRedelegate not implemented")
+}
+func (*UnimplementedMsgServer) Undelegate(ctx context.Context, req *MsgUndelegate) (*MsgUndelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Undelegate not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateValidator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/CreateValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateValidator(ctx, req.(*MsgCreateValidator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EditValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEditValidator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EditValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/EditValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EditValidator(ctx, req.(*MsgEditValidator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Delegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Delegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/Delegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Delegate(ctx, req.(*MsgDelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BeginRedelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBeginRedelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BeginRedelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/BeginRedelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BeginRedelegate(ctx, req.(*MsgBeginRedelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Undelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Undelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/Undelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Undelegate(ctx, req.(*MsgUndelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.staking.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateValidator",
+			Handler:    _Msg_CreateValidator_Handler,
+		},
+		{
+			MethodName: "EditValidator",
+			Handler:    _Msg_EditValidator_Handler,
+		},
+		{
+			MethodName: "Delegate",
+			Handler:    _Msg_Delegate_Handler,
+		},
+		{
+			MethodName: "BeginRedelegate",
+			Handler:    _Msg_BeginRedelegate_Handler,
+		},
+		{
+			MethodName: "Undelegate",
+			Handler:    _Msg_Undelegate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/staking/v1beta1/tx.proto",
+}
+
 func (m *MsgCreateValidator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -681,6 +1149,29 @@ func (m *MsgCreateValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCreateValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgEditValidator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -745,6 +1236,29 @@ func (m *MsgEditValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgEditValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgDelegate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -792,7 +1306,7 @@ func (m *MsgDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBeginRedelegate) Marshal() (dAtA []byte, err error) {
+func (m *MsgDelegateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -802,25 +1316,48 @@ func (m *MsgBeginRedelegate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBeginRedelegate) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDelegateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBeginRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBeginRedelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBeginRedelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBeginRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
 	dAtA[i] = 0x22
 	if len(m.ValidatorDstAddress) > 0 {
 		i -= len(m.ValidatorDstAddress)
@@ -846,6 +1383,37 @@ func (m *MsgBeginRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgBeginRedelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBeginRedelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBeginRedelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTx(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUndelegate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -893,6 +1461,37 @@ func (m *MsgUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUndelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintTx(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -933,6 +1532,15 @@ func (m *MsgCreateValidator) Size() (n int) {
 	return n
 }
 
+func (m *MsgCreateValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgEditValidator) Size() (n int) {
 	if m == nil {
 		return 0
@@ -956,6 +1564,15 @@ func (m *MsgEditValidator) Size() (n int) {
 	return n
 }
 
+func (m *MsgEditValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgDelegate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -975,6 +1592,15 @@ func (m *MsgDelegate) Size() (n int) {
 	return n
 }
 
+func (m *MsgDelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgBeginRedelegate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -998,6 +1624,17 @@ func (m *MsgBeginRedelegate) Size() (n int) {
 	return n
 }
 
+func (m *MsgBeginRedelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func (m *MsgUndelegate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1017,6 +1654,17 @@ func (m *MsgUndelegate) Size() (n int) {
 	return n
 }
 
+func (m *MsgUndelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1309,6 +1957,59 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1501,6 +2202,59 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgEditValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1655,6 +2409,59 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1843,6 +2650,92 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgBeginRedelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginRedelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginRedelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1997,6 +2890,92 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
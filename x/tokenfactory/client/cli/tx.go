@@ -0,0 +1,234 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/tokenfactory
+// transaction commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Token factory transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewCreateDenomCmd(),
+		NewMintCmd(),
+		NewBurnCmd(),
+		NewChangeAdminCmd(),
+		NewSetDenomMetadataCmd(),
+	)
+
+	return txCmd
+}
+
+// NewCreateDenomCmd returns a CLI command handler for creating a
+// MsgCreateDenom transaction.
+func NewCreateDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-denom [subdenom]",
+		Short: "Create a new denom namespaced as factory/{sender}/{subdenom}",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a new denom administered by the sender. The denom creation fee
+is charged to the sender.
+
+Example:
+$ %s tx %s create-denom mytoken --from=<key_or_address>
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateDenom(clientCtx.GetFromAddress(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMintCmd returns a CLI command handler for creating a MsgMint transaction.
+func NewMintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [amount]",
+		Short: "Mint coins of a denom administered by the sender to the sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoin(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMint(clientCtx.GetFromAddress(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewBurnCmd returns a CLI command handler for creating a MsgBurn transaction.
+func NewBurnCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [amount]",
+		Short: "Burn coins of a denom administered by the sender from the sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoin(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurn(clientCtx.GetFromAddress(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewChangeAdminCmd returns a CLI command handler for creating a
+// MsgChangeAdmin transaction.
+func NewChangeAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-admin [denom] [new_admin_address]",
+		Short: "Transfer the admin role of a denom administered by the sender",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			newAdmin, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgChangeAdmin(clientCtx.GetFromAddress(), args[0], newAdmin)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetDenomMetadataCmd returns a CLI command handler for creating a
+// MsgSetDenomMetadata transaction.
+func NewSetDenomMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [metadata-file]",
+		Short: "Set the bank metadata of a denom administered by the sender",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the bank metadata of a denom administered by the sender. The
+metadata must be supplied via a JSON file.
+
+Example:
+$ %s tx %s set-denom-metadata <path/to/metadata.json> --from=<key_or_address>
+
+Where metadata.json contains:
+
+{
+  "description": "My token",
+  "denom_units": [
+    {"denom": "factory/<creator>/umytoken", "exponent": 0},
+    {"denom": "factory/<creator>/mytoken", "exponent": 6}
+  ],
+  "base": "factory/<creator>/umytoken",
+  "display": "factory/<creator>/mytoken"
+}
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var metadata banktypes.Metadata
+			if err := clientCtx.JSONMarshaler.UnmarshalJSON(contents, &metadata); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetDenomMetadata(clientCtx.GetFromAddress(), metadata)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
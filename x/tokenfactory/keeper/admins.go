@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// assertAdmin returns an error if the account is not the admin of the denom.
+func (k Keeper) assertAdmin(ctx sdk.Context, denom string, addr sdk.AccAddress) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if !metadata.Admin.Equals(addr) {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the admin of %s", addr, denom)
+	}
+
+	return nil
+}
+
+// Mint mints coins of a denom administered by the admin and sends them to the
+// admin.
+func (k Keeper) Mint(ctx sdk.Context, admin sdk.AccAddress, amount sdk.Coin) error {
+	if err := k.assertAdmin(ctx, amount.Denom, admin); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, admin, coins); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMint,
+			sdk.NewAttribute(types.AttributeKeyMintToAddress, admin.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// Burn burns coins of a denom administered by the admin from the balance of
+// the admin.
+func (k Keeper) Burn(ctx sdk.Context, admin sdk.AccAddress, amount sdk.Coin) error {
+	if err := k.assertAdmin(ctx, amount.Denom, admin); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, admin, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurn,
+			sdk.NewAttribute(types.AttributeKeyBurnFromAddress, admin.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// ChangeAdmin transfers the admin role of a denom to a new account.
+func (k Keeper) ChangeAdmin(ctx sdk.Context, admin sdk.AccAddress, denom string, newAdmin sdk.AccAddress) error {
+	if err := k.assertAdmin(ctx, denom, admin); err != nil {
+		return err
+	}
+
+	k.setAuthorityMetadata(ctx, denom, types.DenomAuthorityMetadata{Admin: newAdmin})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChangeAdmin,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyNewAdmin, newAdmin.String()),
+		),
+	)
+
+	return nil
+}
+
+// SetDenomMetadata sets the bank metadata of a denom administered by the
+// admin.
+func (k Keeper) SetDenomMetadata(ctx sdk.Context, admin sdk.AccAddress, metadata banktypes.Metadata) error {
+	if err := k.assertAdmin(ctx, metadata.Base, admin); err != nil {
+		return err
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDenomMetadata,
+			sdk.NewAttribute(types.AttributeKeyDenom, metadata.Base),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// CreateDenom creates the denom factory/{creator}/{subdenom}, charging the
+// denom creation fee to the creator and making it the admin of the denom. It
+// returns the new denom.
+func (k Keeper) CreateDenom(ctx sdk.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	denom, err := types.GetTokenDenom(creator, subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := k.GetAuthorityMetadata(ctx, denom); err == nil || k.bankKeeper.HasSupply(ctx, denom) {
+		return "", sdkerrors.Wrap(types.ErrDenomExists, denom)
+	}
+
+	if fee := k.GetParams(ctx).DenomCreationFee; !fee.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, fee, creator); err != nil {
+			return "", err
+		}
+	}
+
+	k.createDenom(ctx, denom, creator)
+
+	// the denom can only be displayed in its base unit until its admin sets
+	// its metadata
+	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnits{{Denom: denom, Exponent: 0}},
+		Base:       denom,
+		Display:    denom,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateDenom,
+			sdk.NewAttribute(types.AttributeKeyCreator, creator.String()),
+			sdk.NewAttribute(types.AttributeKeyNewTokenDenom, denom),
+		),
+	)
+
+	return denom, nil
+}
+
+// createDenom stores a new denom with the creator as its admin.
+func (k Keeper) createDenom(ctx sdk.Context, denom string, creator sdk.AccAddress) {
+	k.setAuthorityMetadata(ctx, denom, types.DenomAuthorityMetadata{Admin: creator})
+	k.addDenomFromCreator(ctx, creator, denom)
+}
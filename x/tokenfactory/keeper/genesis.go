@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// InitGenesis initializes the tokenfactory module's state from a given genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+
+	// ensure the module account is created
+	k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+
+	for _, genDenom := range genState.FactoryDenoms {
+		creator, _, err := types.DeconstructDenom(genDenom.Denom)
+		if err != nil {
+			panic(err)
+		}
+
+		k.createDenom(ctx, genDenom.Denom, creator)
+		k.setAuthorityMetadata(ctx, genDenom.Denom, genDenom.AuthorityMetadata)
+	}
+}
+
+// ExportGenesis returns the tokenfactory module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	denoms := []types.GenesisDenom{}
+	k.IterateAuthorityMetadata(ctx, func(denom string, metadata types.DenomAuthorityMetadata) bool {
+		denoms = append(denoms, types.GenesisDenom{
+			Denom:             denom,
+			AuthorityMetadata: metadata,
+		})
+		return false
+	})
+
+	return types.NewGenesisState(k.GetParams(ctx), denoms)
+}
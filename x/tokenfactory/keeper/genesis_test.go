@@ -0,0 +1,36 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	app, ctx := suite.app, suite.ctx
+
+	denom1, err := types.GetTokenDenom(creatorAddr, "bitcoin")
+	suite.Require().NoError(err)
+	denom2, err := types.GetTokenDenom(otherAddr, "atom")
+	suite.Require().NoError(err)
+
+	genState := types.NewGenesisState(
+		types.NewParams(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))),
+		[]types.GenesisDenom{
+			{Denom: denom1, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: otherAddr}},
+			{Denom: denom2, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: otherAddr}},
+		},
+	)
+
+	app.TokenFactoryKeeper.InitGenesis(ctx, genState)
+
+	suite.Require().Equal([]string{denom1}, app.TokenFactoryKeeper.GetDenomsFromCreator(ctx, creatorAddr))
+	suite.Require().Equal([]string{denom2}, app.TokenFactoryKeeper.GetDenomsFromCreator(ctx, otherAddr))
+
+	metadata, err := app.TokenFactoryKeeper.GetAuthorityMetadata(ctx, denom1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(otherAddr, metadata.Admin)
+
+	exported := app.TokenFactoryKeeper.ExportGenesis(ctx)
+	suite.Require().Equal(genState.Params, exported.Params)
+	suite.Require().ElementsMatch(genState.FactoryDenoms, exported.FactoryDenoms)
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the params of the tokenfactory module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// DenomAuthorityMetadata returns the authority metadata of a denom.
+func (k Keeper) DenomAuthorityMetadata(c context.Context, req *types.QueryDenomAuthorityMetadataRequest) (*types.QueryDenomAuthorityMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, _, err := types.DeconstructDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	metadata, err := k.GetAuthorityMetadata(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryDenomAuthorityMetadataResponse{AuthorityMetadata: metadata}, nil
+}
+
+// DenomsFromCreator returns the denoms created by an account.
+func (k Keeper) DenomsFromCreator(c context.Context, req *types.QueryDenomsFromCreatorRequest) (*types.QueryDenomsFromCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Creator.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "invalid creator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDenomsFromCreatorResponse{Denoms: k.GetDenomsFromCreator(ctx, req.Creator)}, nil
+}
@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestGRPCQueries() {
+	params, err := suite.queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.app.TokenFactoryKeeper.GetParams(suite.ctx), params.Params)
+
+	denoms, err := suite.queryClient.DenomsFromCreator(gocontext.Background(), &types.QueryDenomsFromCreatorRequest{Creator: creatorAddr})
+	suite.Require().NoError(err)
+	suite.Require().Empty(denoms.Denoms)
+
+	denom1 := suite.createDenom("bitcoin")
+	denom2 := suite.createDenom("atom")
+
+	denoms, err = suite.queryClient.DenomsFromCreator(gocontext.Background(), &types.QueryDenomsFromCreatorRequest{Creator: creatorAddr})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{denom2, denom1}, denoms.Denoms)
+
+	_, err = suite.queryClient.DenomsFromCreator(gocontext.Background(), &types.QueryDenomsFromCreatorRequest{})
+	suite.Require().Error(err)
+
+	res, err := suite.queryClient.DenomAuthorityMetadata(gocontext.Background(), &types.QueryDenomAuthorityMetadataRequest{Denom: denom1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(creatorAddr, res.AuthorityMetadata.Admin)
+
+	_, err = suite.queryClient.DenomAuthorityMetadata(gocontext.Background(), &types.QueryDenomAuthorityMetadataRequest{Denom: "stake"})
+	suite.Require().Error(err)
+
+	unknown, err := types.GetTokenDenom(creatorAddr, "unknown")
+	suite.Require().NoError(err)
+	_, err = suite.queryClient.DenomAuthorityMetadata(gocontext.Background(), &types.QueryDenomAuthorityMetadataRequest{Denom: unknown})
+	suite.Require().Error(err)
+}
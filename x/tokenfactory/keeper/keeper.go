@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// Keeper of the tokenfactory store
+type Keeper struct {
+	cdc           codec.BinaryMarshaler
+	storeKey      sdk.StoreKey
+	paramSpace    paramtypes.Subspace
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistrKeeper
+}
+
+// NewKeeper creates a new tokenfactory Keeper instance
+func NewKeeper(
+	cdc codec.BinaryMarshaler, key sdk.StoreKey, paramSpace paramtypes.Subspace,
+	ak types.AccountKeeper, bk types.BankKeeper, dk types.DistrKeeper,
+) Keeper {
+	// ensure tokenfactory module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the tokenfactory module account has not been set")
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		paramSpace:    paramSpace,
+		accountKeeper: ak,
+		bankKeeper:    bk,
+		distrKeeper:   dk,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of tokenfactory parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of tokenfactory parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetAuthorityMetadata returns the authority metadata of a denom created by
+// the module.
+func (k Keeper) GetAuthorityMetadata(ctx sdk.Context, denom string) (types.DenomAuthorityMetadata, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.DenomAuthorityMetadataKey(denom))
+	if bz == nil {
+		return types.DenomAuthorityMetadata{}, sdkerrors.Wrap(types.ErrDenomDoesNotExist, denom)
+	}
+
+	var metadata types.DenomAuthorityMetadata
+	k.cdc.MustUnmarshalBinaryBare(bz, &metadata)
+
+	return metadata, nil
+}
+
+// setAuthorityMetadata stores the authority metadata of a denom.
+func (k Keeper) setAuthorityMetadata(ctx sdk.Context, denom string, metadata types.DenomAuthorityMetadata) {
+	ctx.KVStore(k.storeKey).Set(types.DenomAuthorityMetadataKey(denom), k.cdc.MustMarshalBinaryBare(&metadata))
+}
+
+// IterateAuthorityMetadata iterates over the authority metadata of all the
+// denoms created by the module. If true is returned from the callback,
+// iteration is halted.
+func (k Keeper) IterateAuthorityMetadata(ctx sdk.Context, cb func(denom string, metadata types.DenomAuthorityMetadata) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomAuthorityMetadataPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.DenomAuthorityMetadata
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &metadata)
+
+		if cb(string(iterator.Key()), metadata) {
+			break
+		}
+	}
+}
+
+// GetDenomsFromCreator returns the denoms created by an account.
+func (k Keeper) GetDenomsFromCreator(ctx sdk.Context, creator sdk.AccAddress) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreatorDenomsPrefixKey(creator))
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	denoms := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()))
+	}
+
+	return denoms
+}
+
+// addDenomFromCreator adds a denom to the index of the denoms created by an
+// account.
+func (k Keeper) addDenomFromCreator(ctx sdk.Context, creator sdk.AccAddress, denom string) {
+	ctx.KVStore(k.storeKey).Set(types.CreatorDenomKey(creator, denom), []byte{0})
+}
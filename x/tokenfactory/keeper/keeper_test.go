@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/keeper"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+var (
+	creatorAddr = sdk.AccAddress([]byte("creator_____________"))
+	otherAddr   = sdk.AccAddress([]byte("other_______________"))
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.TokenFactoryKeeper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.msgServer = keeper.NewMsgServerImpl(app.TokenFactoryKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, creatorAddr, suite.creationFee().Add(suite.creationFee()...)))
+}
+
+func (suite *KeeperTestSuite) creationFee() sdk.Coins {
+	return suite.app.TokenFactoryKeeper.GetParams(suite.ctx).DenomCreationFee
+}
+
+// createDenom creates the denom factory/{creator}/{subdenom} and returns it.
+func (suite *KeeperTestSuite) createDenom(subdenom string) string {
+	res, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.ctx), types.NewMsgCreateDenom(creatorAddr, subdenom))
+	suite.Require().NoError(err)
+	return res.NewTokenDenom
+}
+
+func (suite *KeeperTestSuite) TestCreateDenom() {
+	app, ctx := suite.app, suite.ctx
+	fee := suite.creationFee()
+	suite.Require().False(fee.IsZero())
+
+	balance := app.BankKeeper.GetAllBalances(ctx, creatorAddr)
+	communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+	denom, err := app.TokenFactoryKeeper.CreateDenom(ctx, creatorAddr, "bitcoin")
+	suite.Require().NoError(err)
+	suite.Require().Equal("factory/"+creatorAddr.String()+"/bitcoin", denom)
+
+	// the fee is sent to the community pool
+	suite.Require().Equal(balance.Sub(fee), app.BankKeeper.GetAllBalances(ctx, creatorAddr))
+	suite.Require().Equal(communityPool.Add(sdk.NewDecCoinsFromCoins(fee...)...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+
+	metadata, err := app.TokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(creatorAddr, metadata.Admin)
+	suite.Require().Equal([]string{denom}, app.TokenFactoryKeeper.GetDenomsFromCreator(ctx, creatorAddr))
+
+	bankMetadata := app.BankKeeper.GetDenomMetaData(ctx, denom)
+	suite.Require().NoError(bankMetadata.Validate())
+	suite.Require().Equal(denom, bankMetadata.Base)
+
+	// a denom can only be created once
+	_, err = app.TokenFactoryKeeper.CreateDenom(ctx, creatorAddr, "bitcoin")
+	suite.Require().Error(err)
+
+	// the creator must be able to pay the fee
+	_, err = app.TokenFactoryKeeper.CreateDenom(ctx, otherAddr, "bitcoin")
+	suite.Require().Error(err)
+	suite.Require().Empty(app.TokenFactoryKeeper.GetDenomsFromCreator(ctx, otherAddr))
+
+	// no fee is charged when the fee is zero
+	app.TokenFactoryKeeper.SetParams(ctx, types.NewParams(sdk.NewCoins()))
+	_, err = app.TokenFactoryKeeper.CreateDenom(ctx, otherAddr, "bitcoin")
+	suite.Require().NoError(err)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
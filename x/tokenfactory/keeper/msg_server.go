@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the tokenfactory MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// CreateDenom implements the Msg/CreateDenom method
+func (k msgServer) CreateDenom(goCtx context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	denom, err := k.Keeper.CreateDenom(ctx, msg.Sender, msg.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx, msg.Sender)
+
+	return &types.MsgCreateDenomResponse{NewTokenDenom: denom}, nil
+}
+
+// Mint implements the Msg/Mint method
+func (k msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.Mint(ctx, msg.Sender, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx, msg.Sender)
+
+	return &types.MsgMintResponse{}, nil
+}
+
+// Burn implements the Msg/Burn method
+func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.Burn(ctx, msg.Sender, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx, msg.Sender)
+
+	return &types.MsgBurnResponse{}, nil
+}
+
+// ChangeAdmin implements the Msg/ChangeAdmin method
+func (k msgServer) ChangeAdmin(goCtx context.Context, msg *types.MsgChangeAdmin) (*types.MsgChangeAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.ChangeAdmin(ctx, msg.Sender, msg.Denom, msg.NewAdmin); err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx, msg.Sender)
+
+	return &types.MsgChangeAdminResponse{}, nil
+}
+
+// SetDenomMetadata implements the Msg/SetDenomMetadata method
+func (k msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.SetDenomMetadata(ctx, msg.Sender, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx, msg.Sender)
+
+	return &types.MsgSetDenomMetadataResponse{}, nil
+}
+
+func emitMessageEvent(ctx sdk.Context, sender sdk.AccAddress) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		),
+	)
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestMintBurn() {
+	app, ctx := suite.app, suite.ctx
+	goCtx := sdk.WrapSDKContext(ctx)
+	denom := suite.createDenom("bitcoin")
+
+	_, err := suite.msgServer.Mint(goCtx, types.NewMsgMint(creatorAddr, sdk.NewInt64Coin(denom, 100)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 100), app.BankKeeper.GetBalance(ctx, creatorAddr, denom))
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 100), app.BankKeeper.GetSupply(ctx, denom))
+
+	_, err = suite.msgServer.Burn(goCtx, types.NewMsgBurn(creatorAddr, sdk.NewInt64Coin(denom, 40)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 60), app.BankKeeper.GetBalance(ctx, creatorAddr, denom))
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 60), app.BankKeeper.GetSupply(ctx, denom))
+
+	// only the balance of the admin can be burnt
+	_, err = suite.msgServer.Burn(goCtx, types.NewMsgBurn(creatorAddr, sdk.NewInt64Coin(denom, 61)))
+	suite.Require().Error(err)
+
+	// only the admin can mint and burn
+	_, err = suite.msgServer.Mint(goCtx, types.NewMsgMint(otherAddr, sdk.NewInt64Coin(denom, 100)))
+	suite.Require().True(errors.Is(err, types.ErrUnauthorized))
+	_, err = suite.msgServer.Burn(goCtx, types.NewMsgBurn(otherAddr, sdk.NewInt64Coin(denom, 10)))
+	suite.Require().True(errors.Is(err, types.ErrUnauthorized))
+
+	// unknown denoms cannot be minted
+	unknown, err := types.GetTokenDenom(creatorAddr, "unknown")
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(goCtx, types.NewMsgMint(creatorAddr, sdk.NewInt64Coin(unknown, 100)))
+	suite.Require().True(errors.Is(err, types.ErrDenomDoesNotExist))
+}
+
+func (suite *KeeperTestSuite) TestChangeAdmin() {
+	app, ctx := suite.app, suite.ctx
+	goCtx := sdk.WrapSDKContext(ctx)
+	denom := suite.createDenom("bitcoin")
+
+	_, err := suite.msgServer.ChangeAdmin(goCtx, types.NewMsgChangeAdmin(otherAddr, denom, otherAddr))
+	suite.Require().True(errors.Is(err, types.ErrUnauthorized))
+
+	_, err = suite.msgServer.ChangeAdmin(goCtx, types.NewMsgChangeAdmin(creatorAddr, denom, otherAddr))
+	suite.Require().NoError(err)
+
+	metadata, err := app.TokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(otherAddr, metadata.Admin)
+
+	// the previous admin loses its rights while the creator index is kept
+	_, err = suite.msgServer.Mint(goCtx, types.NewMsgMint(creatorAddr, sdk.NewInt64Coin(denom, 100)))
+	suite.Require().True(errors.Is(err, types.ErrUnauthorized))
+	_, err = suite.msgServer.Mint(goCtx, types.NewMsgMint(otherAddr, sdk.NewInt64Coin(denom, 100)))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{denom}, app.TokenFactoryKeeper.GetDenomsFromCreator(ctx, creatorAddr))
+}
+
+func (suite *KeeperTestSuite) TestSetDenomMetadata() {
+	app, ctx := suite.app, suite.ctx
+	goCtx := sdk.WrapSDKContext(ctx)
+	denom := suite.createDenom("ubitcoin")
+	display := "factory/" + creatorAddr.String() + "/bitcoin"
+
+	metadata := banktypes.Metadata{
+		Description: "Bitcoin",
+		DenomUnits: []*banktypes.DenomUnits{
+			{Denom: denom, Exponent: 0},
+			{Denom: display, Exponent: 6},
+		},
+		Base:    denom,
+		Display: display,
+	}
+
+	_, err := suite.msgServer.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(otherAddr, metadata))
+	suite.Require().True(errors.Is(err, types.ErrUnauthorized))
+
+	_, err = suite.msgServer.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(creatorAddr, metadata))
+	suite.Require().NoError(err)
+	suite.Require().Equal(metadata, app.BankKeeper.GetDenomMetaData(ctx, denom))
+}
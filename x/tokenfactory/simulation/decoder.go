@@ -0,0 +1,30 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// NewDecodeStore returns a decoder function closure that umarshals the KVPair's
+// Value to the corresponding tokenfactory type.
+func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.HasPrefix(kvA.Key, types.DenomAuthorityMetadataPrefix):
+			var metadataA, metadataB types.DenomAuthorityMetadata
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &metadataA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &metadataB)
+			return fmt.Sprintf("%v\n%v", metadataA, metadataB)
+
+		case bytes.HasPrefix(kvA.Key, types.CreatorDenomsPrefix):
+			return fmt.Sprintf("%s\n%s", kvA.Key[len(types.CreatorDenomsPrefix):], kvB.Key[len(types.CreatorDenomsPrefix):])
+
+		default:
+			panic(fmt.Sprintf("invalid tokenfactory key %X", kvA.Key))
+		}
+	}
+}
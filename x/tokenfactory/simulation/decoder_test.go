@@ -0,0 +1,52 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/simulation"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc, _ := simapp.MakeCodecs()
+	dec := simulation.NewDecodeStore(cdc)
+
+	creator := sdk.AccAddress([]byte("creator_____________"))
+	denom, err := types.GetTokenDenom(creator, "bitcoin")
+	require.NoError(t, err)
+	metadata := types.DenomAuthorityMetadata{Admin: creator}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.DenomAuthorityMetadataKey(denom), Value: cdc.MustMarshalBinaryBare(&metadata)},
+			{Key: types.CreatorDenomKey(creator, denom), Value: []byte{0}},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"DenomAuthorityMetadata", fmt.Sprintf("%v\n%v", metadata, metadata)},
+		{"CreatorDenom", fmt.Sprintf("%s\n%s", append(creator.Bytes(), denom...), append(creator.Bytes(), denom...))},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// Simulation parameter constants
+const (
+	DenomCreationFee = "denom_creation_fee"
+)
+
+// GenDenomCreationFee randomized DenomCreationFee
+func GenDenomCreationFee(r *rand.Rand) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(r.Intn(1000))))
+}
+
+// RandomizedGenState generates a random GenesisState for tokenfactory
+func RandomizedGenState(simState *module.SimulationState) {
+	var denomCreationFee sdk.Coins
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DenomCreationFee, &denomCreationFee, simState.Rand,
+		func(r *rand.Rand) { denomCreationFee = GenDenomCreationFee(r) },
+	)
+
+	tokenfactoryGenesis := types.NewGenesisState(types.NewParams(denomCreationFee), []types.GenesisDenom{})
+
+	fmt.Printf("Selected randomly generated tokenfactory parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, tokenfactoryGenesis))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(tokenfactoryGenesis)
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// ModuleDenomPrefix is the prefix of all the denoms created by the module.
+	ModuleDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of a subdenom.
+	MaxSubdenomLength = 44
+)
+
+// reSubdenom matches the subdenoms that can be used to create a denom. The
+// full denom must additionally be a valid sdk denom.
+var reSubdenom = regexp.MustCompile(fmt.Sprintf(`^[a-zA-Z0-9]{1,%d}$`, MaxSubdenomLength))
+
+// ValidateSubdenom returns an error if the subdenom cannot be used to create a
+// denom.
+func ValidateSubdenom(subdenom string) error {
+	if !reSubdenom.MatchString(subdenom) {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "invalid subdenom: %s", subdenom)
+	}
+
+	return nil
+}
+
+// GetTokenDenom returns the denom namespaced as factory/{creator}/{subdenom}.
+// An error is returned if the subdenom or the resulting denom are invalid.
+func GetTokenDenom(creator sdk.AccAddress, subdenom string) (string, error) {
+	if creator.Empty() {
+		return "", sdkerrors.Wrap(ErrInvalidCreator, "creator address cannot be empty")
+	}
+
+	if err := ValidateSubdenom(subdenom); err != nil {
+		return "", err
+	}
+
+	denom := strings.Join([]string{ModuleDenomPrefix, creator.String(), subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	return denom, nil
+}
+
+// DeconstructDenom splits a denom created by the module into its creator and
+// subdenom. An error is returned if the denom is not a valid token factory
+// denom.
+func DeconstructDenom(denom string) (creator sdk.AccAddress, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, "", sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) != 3 || parts[0] != ModuleDenomPrefix {
+		return nil, "", sdkerrors.Wrapf(ErrInvalidDenom, "denom must be of the form %s/{creator}/{subdenom}: %s", ModuleDenomPrefix, denom)
+	}
+
+	creator, err = sdk.AccAddressFromBech32(parts[1])
+	if err != nil {
+		return nil, "", sdkerrors.Wrapf(ErrInvalidCreator, "invalid creator address %s: %s", parts[1], err)
+	}
+
+	if err := ValidateSubdenom(parts[2]); err != nil {
+		return nil, "", err
+	}
+
+	return creator, parts[2], nil
+}
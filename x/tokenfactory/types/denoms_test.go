@@ -0,0 +1,74 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+func TestGetTokenDenom(t *testing.T) {
+	creator := sdk.AccAddress([]byte("creator_____________"))
+
+	testCases := []struct {
+		name     string
+		creator  sdk.AccAddress
+		subdenom string
+		expErr   bool
+	}{
+		{"valid", creator, "bitcoin", false},
+		{"valid with digits", creator, "1bitcoin2", false},
+		{"max length", creator, strings.Repeat("a", types.MaxSubdenomLength), false},
+		{"empty creator", nil, "bitcoin", true},
+		{"empty subdenom", creator, "", true},
+		{"too long", creator, strings.Repeat("a", types.MaxSubdenomLength+1), true},
+		{"slash", creator, "bit/coin", true},
+		{"invalid character", creator, "bit-coin", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			denom, err := types.GetTokenDenom(tc.creator, tc.subdenom)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "factory/"+tc.creator.String()+"/"+tc.subdenom, denom)
+
+			creator, subdenom, err := types.DeconstructDenom(denom)
+			require.NoError(t, err)
+			require.Equal(t, tc.creator, creator)
+			require.Equal(t, tc.subdenom, subdenom)
+		})
+	}
+}
+
+func TestDeconstructDenom(t *testing.T) {
+	creator := sdk.AccAddress([]byte("creator_____________"))
+
+	testCases := []struct {
+		name  string
+		denom string
+	}{
+		{"not a factory denom", "stake"},
+		{"wrong prefix", "ibc/" + creator.String() + "/bitcoin"},
+		{"missing subdenom", "factory/" + creator.String()},
+		{"invalid creator", "factory/cosmos1invalid/bitcoin"},
+		{"nested subdenom", "factory/" + creator.String() + "/bit/coin"},
+		{"invalid denom", "factory/" + creator.String() + "/bit-coin"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := types.DeconstructDenom(tc.denom)
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package types
+
+// tokenfactory module event types
+const (
+	EventTypeCreateDenom      = "create_denom"
+	EventTypeMint             = "tf_mint"
+	EventTypeBurn             = "tf_burn"
+	EventTypeChangeAdmin      = "change_admin"
+	EventTypeSetDenomMetadata = "set_denom_metadata"
+
+	AttributeKeyCreator         = "creator"
+	AttributeKeyNewTokenDenom   = "new_token_denom"
+	AttributeKeyMintToAddress   = "mint_to_address"
+	AttributeKeyBurnFromAddress = "burn_from_address"
+	AttributeKeyAmount          = "amount"
+	AttributeKeyDenom           = "denom"
+	AttributeKeyNewAdmin        = "new_admin"
+	AttributeValueCategory      = ModuleName
+)
@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, denoms []GenesisDenom) *GenesisState {
+	return &GenesisState{
+		Params:        params,
+		FactoryDenoms: denoms,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []GenesisDenom{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenDenoms := make(map[string]bool)
+	for _, denom := range gs.FactoryDenoms {
+		if seenDenoms[denom.Denom] {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "duplicate denom: %s", denom.Denom)
+		}
+
+		if _, _, err := DeconstructDenom(denom.Denom); err != nil {
+			return err
+		}
+
+		if denom.AuthorityMetadata.Admin.Empty() {
+			return sdkerrors.Wrapf(ErrInvalidAuthorityMetadata, "denom %s has no admin", denom.Denom)
+		}
+
+		seenDenoms[denom.Denom] = true
+	}
+
+	return nil
+}
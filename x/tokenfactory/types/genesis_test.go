@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	creator := sdk.AccAddress([]byte("creator_____________"))
+	denom, err := types.GetTokenDenom(creator, "bitcoin")
+	require.NoError(t, err)
+
+	genDenom := types.GenesisDenom{
+		Denom:             denom,
+		AuthorityMetadata: types.DenomAuthorityMetadata{Admin: creator},
+	}
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expErr   bool
+	}{
+		{"default", types.DefaultGenesisState(), false},
+		{"with denoms", types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{genDenom}), false},
+		{
+			"invalid fee",
+			types.NewGenesisState(types.NewParams(sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}), nil),
+			true,
+		},
+		{"duplicate denom", types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{genDenom, genDenom}), true},
+		{
+			"invalid denom",
+			types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{{Denom: "stake", AuthorityMetadata: genDenom.AuthorityMetadata}}),
+			true,
+		},
+		{"no admin", types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{{Denom: denom}}), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
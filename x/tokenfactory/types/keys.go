@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "tokenfactory"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// KVStore keys
+var (
+	// DenomAuthorityMetadataPrefix is the prefix of the authority metadata of
+	// the denoms, keyed by denom.
+	DenomAuthorityMetadataPrefix = []byte{0x01}
+
+	// CreatorDenomsPrefix is the prefix of the index of the denoms created by
+	// an account, keyed by creator address and denom.
+	CreatorDenomsPrefix = []byte{0x02}
+)
+
+// DenomAuthorityMetadataKey returns the store key of the authority metadata of
+// a denom.
+func DenomAuthorityMetadataKey(denom string) []byte {
+	return append(DenomAuthorityMetadataPrefix, []byte(denom)...)
+}
+
+// CreatorDenomsPrefixKey returns the store prefix of the denoms created by an
+// account.
+func CreatorDenomsPrefixKey(creator sdk.AccAddress) []byte {
+	return append(CreatorDenomsPrefix, creator.Bytes()...)
+}
+
+// CreatorDenomKey returns the store key of a denom in the index of the denoms
+// created by an account.
+func CreatorDenomKey(creator sdk.AccAddress, denom string) []byte {
+	return append(CreatorDenomsPrefixKey(creator), []byte(denom)...)
+}
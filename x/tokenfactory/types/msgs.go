@@ -0,0 +1,212 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// tokenfactory message types
+const (
+	TypeMsgCreateDenom      = "create_denom"
+	TypeMsgMint             = "tf_mint"
+	TypeMsgBurn             = "tf_burn"
+	TypeMsgChangeAdmin      = "change_admin"
+	TypeMsgSetDenomMetadata = "set_denom_metadata"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateDenom{}
+	_ sdk.Msg = &MsgMint{}
+	_ sdk.Msg = &MsgBurn{}
+	_ sdk.Msg = &MsgChangeAdmin{}
+	_ sdk.Msg = &MsgSetDenomMetadata{}
+)
+
+// NewMsgCreateDenom creates a new MsgCreateDenom instance
+func NewMsgCreateDenom(sender sdk.AccAddress, subdenom string) *MsgCreateDenom {
+	return &MsgCreateDenom{
+		Sender:   sender,
+		Subdenom: subdenom,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCreateDenom) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCreateDenom) Type() string { return TypeMsgCreateDenom }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateDenom) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+
+	_, err := GetTokenDenom(msg.Sender, msg.Subdenom)
+	return err
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgCreateDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCreateDenom) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// NewMsgMint creates a new MsgMint instance
+func NewMsgMint(sender sdk.AccAddress, amount sdk.Coin) *MsgMint {
+	return &MsgMint{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgMint) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgMint) Type() string { return TypeMsgMint }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgMint) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+
+	return validateFactoryCoin(msg.Amount)
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgMint) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgMint) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// NewMsgBurn creates a new MsgBurn instance
+func NewMsgBurn(sender sdk.AccAddress, amount sdk.Coin) *MsgBurn {
+	return &MsgBurn{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgBurn) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgBurn) Type() string { return TypeMsgBurn }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgBurn) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+
+	return validateFactoryCoin(msg.Amount)
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgBurn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgBurn) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// NewMsgChangeAdmin creates a new MsgChangeAdmin instance
+func NewMsgChangeAdmin(sender sdk.AccAddress, denom string, newAdmin sdk.AccAddress) *MsgChangeAdmin {
+	return &MsgChangeAdmin{
+		Sender:   sender,
+		Denom:    denom,
+		NewAdmin: newAdmin,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgChangeAdmin) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgChangeAdmin) Type() string { return TypeMsgChangeAdmin }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgChangeAdmin) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+
+	if msg.NewAdmin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing new admin address")
+	}
+
+	_, _, err := DeconstructDenom(msg.Denom)
+	return err
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgChangeAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgChangeAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// NewMsgSetDenomMetadata creates a new MsgSetDenomMetadata instance
+func NewMsgSetDenomMetadata(sender sdk.AccAddress, metadata banktypes.Metadata) *MsgSetDenomMetadata {
+	return &MsgSetDenomMetadata{
+		Sender:   sender,
+		Metadata: metadata,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgSetDenomMetadata) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgSetDenomMetadata) Type() string { return TypeMsgSetDenomMetadata }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSetDenomMetadata) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+
+	if err := msg.Metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	_, _, err := DeconstructDenom(msg.Metadata.Base)
+	return err
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgSetDenomMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSetDenomMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// validateFactoryCoin checks that the coin is a positive amount of a token
+// factory denom.
+func validateFactoryCoin(coin sdk.Coin) error {
+	if !coin.IsValid() || coin.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, coin.String())
+	}
+
+	_, _, err := DeconstructDenom(coin.Denom)
+	return err
+}
@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+func TestMsgsValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress([]byte("sender______________"))
+	other := sdk.AccAddress([]byte("other_______________"))
+	denom, err := types.GetTokenDenom(sender, "bitcoin")
+	require.NoError(t, err)
+
+	metadata := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnits{{Denom: denom, Exponent: 0}},
+		Base:       denom,
+		Display:    denom,
+	}
+
+	testCases := []struct {
+		name   string
+		msg    sdk.Msg
+		expErr bool
+	}{
+		{"create denom", types.NewMsgCreateDenom(sender, "bitcoin"), false},
+		{"create denom without sender", types.NewMsgCreateDenom(nil, "bitcoin"), true},
+		{"create denom with invalid subdenom", types.NewMsgCreateDenom(sender, "bit/coin"), true},
+		{"mint", types.NewMsgMint(sender, sdk.NewInt64Coin(denom, 10)), false},
+		{"mint without sender", types.NewMsgMint(nil, sdk.NewInt64Coin(denom, 10)), true},
+		{"mint zero", types.NewMsgMint(sender, sdk.NewInt64Coin(denom, 0)), true},
+		{"mint non factory denom", types.NewMsgMint(sender, sdk.NewInt64Coin("stake", 10)), true},
+		{"burn", types.NewMsgBurn(sender, sdk.NewInt64Coin(denom, 10)), false},
+		{"burn zero", types.NewMsgBurn(sender, sdk.NewInt64Coin(denom, 0)), true},
+		{"burn non factory denom", types.NewMsgBurn(sender, sdk.NewInt64Coin("stake", 10)), true},
+		{"change admin", types.NewMsgChangeAdmin(sender, denom, other), false},
+		{"change admin without new admin", types.NewMsgChangeAdmin(sender, denom, nil), true},
+		{"change admin of non factory denom", types.NewMsgChangeAdmin(sender, "stake", other), true},
+		{"set denom metadata", types.NewMsgSetDenomMetadata(sender, metadata), false},
+		{"set invalid denom metadata", types.NewMsgSetDenomMetadata(sender, banktypes.Metadata{Base: denom}), true},
+		{
+			"set denom metadata of non factory denom",
+			types.NewMsgSetDenomMetadata(sender, banktypes.Metadata{
+				DenomUnits: []*banktypes.DenomUnits{{Denom: "stake", Exponent: 0}},
+				Base:       "stake",
+				Display:    "stake",
+			}),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, []sdk.AccAddress{sender}, tc.msg.GetSigners())
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyDenomCreationFee = []byte("DenomCreationFee")
+)
+
+// ParamKeyTable returns the parameter key table of the tokenfactory module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(denomCreationFee sdk.Coins) Params {
+	return Params{
+		DenomCreationFee: denomCreationFee,
+	}
+}
+
+// DefaultParams returns the default tokenfactory module parameters.
+func DefaultParams() Params {
+	return Params{
+		DenomCreationFee: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000_000)),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	return validateDenomCreationFee(p.DenomCreationFee)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDenomCreationFee, &p.DenomCreationFee, validateDenomCreationFee),
+	}
+}
+
+func validateDenomCreationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid denom creation fee: %w", err)
+	}
+
+	return nil
+}
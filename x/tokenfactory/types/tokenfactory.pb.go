@@ -0,0 +1,549 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/tokenfactory/v1beta1/tokenfactory.proto
+
+package types
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DenomAuthorityMetadata specifies the authority of a token factory denom.
+type DenomAuthorityMetadata struct {
+	// admin is the account allowed to mint and burn the denom, to set its bank
+	// metadata and to transfer the admin role.
+	Admin github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=admin,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"admin,omitempty"`
+}
+
+func (m *DenomAuthorityMetadata) Reset()         { *m = DenomAuthorityMetadata{} }
+func (m *DenomAuthorityMetadata) String() string { return proto.CompactTextString(m) }
+func (*DenomAuthorityMetadata) ProtoMessage()    {}
+func (*DenomAuthorityMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df9a22aec4c6f80, []int{0}
+}
+func (m *DenomAuthorityMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomAuthorityMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomAuthorityMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomAuthorityMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomAuthorityMetadata.Merge(m, src)
+}
+func (m *DenomAuthorityMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomAuthorityMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomAuthorityMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomAuthorityMetadata proto.InternalMessageInfo
+
+func (m *DenomAuthorityMetadata) GetAdmin() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Admin
+	}
+	return nil
+}
+
+// Params defines the parameters of the tokenfactory module.
+type Params struct {
+	// denom_creation_fee is the fee charged to the creator of a new denom. It
+	// is sent to the community pool.
+	DenomCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=denom_creation_fee,json=denomCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"denom_creation_fee" yaml:"denom_creation_fee"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df9a22aec4c6f80, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDenomCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DenomCreationFee
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DenomAuthorityMetadata)(nil), "cosmos.tokenfactory.v1beta1.DenomAuthorityMetadata")
+	proto.RegisterType((*Params)(nil), "cosmos.tokenfactory.v1beta1.Params")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/tokenfactory/v1beta1/tokenfactory.proto", fileDescriptor_2df9a22aec4c6f80)
+}
+
+var fileDescriptor_2df9a22aec4c6f80 = []byte{
+	// 331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xbf, 0x4b, 0xc3, 0x40,
+	0x14, 0xc7, 0x73, 0x58, 0x3b, 0x44, 0x07, 0x09, 0x22, 0x6d, 0x85, 0x4b, 0xc9, 0xd4, 0xa5, 0x17,
+	0xa2, 0x5b, 0xb7, 0xb6, 0xa2, 0x83, 0x14, 0xa4, 0xa3, 0x4b, 0xb9, 0x5c, 0x5e, 0xd3, 0x50, 0x93,
+	0x57, 0x72, 0x57, 0x31, 0xff, 0x85, 0x93, 0x38, 0x76, 0x13, 0xfc, 0x4b, 0x3a, 0x76, 0x74, 0xaa,
+	0xd2, 0x2e, 0xce, 0x8e, 0x4e, 0x92, 0x1f, 0x0a, 0x41, 0x10, 0xa7, 0x3b, 0xee, 0x7d, 0xbf, 0x9f,
+	0xef, 0xbb, 0xf7, 0x74, 0x26, 0x50, 0x86, 0x28, 0x6d, 0x85, 0x53, 0x88, 0xc6, 0x5c, 0x28, 0x8c,
+	0x13, 0xfb, 0xd6, 0x71, 0x41, 0x71, 0xa7, 0xf4, 0xc8, 0x66, 0x31, 0x2a, 0x34, 0x8e, 0x73, 0x3d,
+	0x2b, 0x95, 0x0a, 0x7d, 0xe3, 0xd0, 0x47, 0x1f, 0x33, 0x9d, 0x9d, 0xde, 0x72, 0x4b, 0x83, 0x16,
+	0x11, 0x2e, 0x97, 0xf0, 0x83, 0x16, 0x18, 0x44, 0x79, 0xdd, 0xf2, 0xf5, 0xa3, 0x33, 0x88, 0x30,
+	0xec, 0xce, 0xd5, 0x04, 0xe3, 0x40, 0x25, 0x03, 0x50, 0xdc, 0xe3, 0x8a, 0x1b, 0x17, 0xfa, 0x2e,
+	0xf7, 0xc2, 0x20, 0xaa, 0x91, 0x26, 0x69, 0xed, 0xf7, 0x9c, 0xcf, 0xb5, 0xd9, 0xf6, 0x03, 0x35,
+	0x99, 0xbb, 0x4c, 0x60, 0x68, 0x17, 0xdc, 0xfc, 0x68, 0x4b, 0x6f, 0x6a, 0xab, 0x64, 0x06, 0x92,
+	0x75, 0x85, 0xe8, 0x7a, 0x5e, 0x0c, 0x52, 0x0e, 0x73, 0x7f, 0xa7, 0xf2, 0xbe, 0x30, 0x89, 0xf5,
+	0x44, 0xf4, 0xea, 0x15, 0x8f, 0x79, 0x28, 0x8d, 0x07, 0xa2, 0x1b, 0x5e, 0x1a, 0x3a, 0x12, 0x31,
+	0x70, 0x15, 0x60, 0x34, 0x1a, 0x03, 0xd4, 0x48, 0x73, 0xa7, 0xb5, 0x77, 0x52, 0x2f, 0x86, 0xc2,
+	0xd2, 0x8e, 0xbf, 0x3f, 0xc7, 0xfa, 0x18, 0x44, 0xbd, 0xc1, 0x72, 0x6d, 0x6a, 0x1f, 0x6b, 0xb3,
+	0x9e, 0xf0, 0xf0, 0xa6, 0x63, 0xfd, 0x46, 0x58, 0xcf, 0xaf, 0x66, 0xeb, 0x1f, 0x3d, 0xa6, 0x34,
+	0x39, 0x3c, 0xc8, 0x00, 0xfd, 0xc2, 0x7f, 0x0e, 0xd0, 0xa9, 0x3c, 0x2e, 0x4c, 0xad, 0x77, 0xb9,
+	0xdc, 0x50, 0xb2, 0xda, 0x50, 0xf2, 0xb6, 0xa1, 0xe4, 0x7e, 0x4b, 0xb5, 0xd5, 0x96, 0x6a, 0x2f,
+	0x5b, 0xaa, 0x5d, 0x3b, 0x7f, 0xb2, 0xef, 0xca, 0x7b, 0xcc, 0xa2, 0xdc, 0x6a, 0x36, 0xe6, 0xd3,
+	0xaf, 0x01, 0x00, 0xbd, 0xeb, 0x09, 0xb0, 0xeb, 0x01, 0x00, 0x00,
+}
+
+func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomAuthorityMetadata)
+	if !ok {
+		that2, ok := that.(DenomAuthorityMetadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Admin, that1.Admin) {
+		return false
+	}
+	return true
+}
+func (m *DenomAuthorityMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomAuthorityMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTokenfactory(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomCreationFee) > 0 {
+		for iNdEx := len(m.DenomCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTokenfactory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTokenfactory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTokenfactory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DenomAuthorityMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTokenfactory(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DenomCreationFee) > 0 {
+		for _, e := range m.DenomCreationFee {
+			l = e.Size()
+			n += 1 + l + sovTokenfactory(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTokenfactory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTokenfactory(x uint64) (n int) {
+	return sovTokenfactory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTokenfactory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomAuthorityMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomAuthorityMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenfactory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTokenfactory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenfactory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Admin == nil {
+				m.Admin = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTokenfactory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTokenfactory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTokenfactory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTokenfactory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenfactory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTokenfactory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenfactory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomCreationFee = append(m.DenomCreationFee, types.Coin{})
+			if err := m.DenomCreationFee[len(m.DenomCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTokenfactory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTokenfactory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTokenfactory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTokenfactory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTokenfactory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTokenfactory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTokenfactory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTokenfactory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTokenfactory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTokenfactory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTokenfactory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTokenfactory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTokenfactory = fmt.Errorf("proto: unexpected end of group")
+)